@@ -30,15 +30,15 @@ func main() {
 	options := cmdserver.NewServerOptions(true)
 
 	domainManager := virtwrap.NewMockDomainManager(gomock.NewController(nil))
-	domainManager.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-		AnyTimes().DoAndReturn(func(domainName string, _ string, _ []string) (string, error) {
+	domainManager.EXPECT().Exec(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().DoAndReturn(func(domainName string, _ string, _ []string, _ int32, _ int64) (string, string, error) {
 		if domainName == "error" {
-			return "", errors.New("fake error")
+			return "", "", errors.New("fake error")
 		}
 		if domainName == "fail" {
-			return "command failed", agent.ExecExitCode{ExitCode: 1}
+			return "command failed", "", agent.ExecExitCode{ExitCode: 1}
 		}
-		return "success", nil
+		return "success", "", nil
 	})
 	log.Log.Info("running fake server")
 	done, err := cmdserver.RunServer(*socket, domainManager, stopChan, options)
@@ -44,3 +44,5 @@ if [ "$ARCH" == "x86_64" ]; then
 fi
 
 virsh capabilities > /var/lib/kubevirt-node-labeller/capabilities.xml
+
+virsh version > /var/lib/kubevirt-node-labeller/virsh_version.txt
@@ -148,6 +148,7 @@ libguestfstools_extra="
 "
 
 exportserverbase_main="
+  qemu-img-${QEMU_VERSION}
   tar
 "
 
@@ -59,6 +59,8 @@ import (
 	exportv1 "kubevirt.io/api/export/v1alpha1"
 	instancetypeapi "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/api/ksm"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	"kubevirt.io/api/migrations"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -108,6 +110,9 @@ type KubeInformerFactory interface {
 	// Watches for VirtualMachinePool objects
 	VMPool() cache.SharedIndexInformer
 
+	// Watches for VirtualMachineDisruptionBudget objects
+	VirtualMachineDisruptionBudget() cache.SharedIndexInformer
+
 	// Watches for VirtualMachineInstancePreset objects
 	VirtualMachinePreset() cache.SharedIndexInformer
 
@@ -135,9 +140,21 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineGroupSnapshot objects
+	VirtualMachineGroupSnapshot() cache.SharedIndexInformer
+
+	// Watches VirtualMachineGroupRestore objects
+	VirtualMachineGroupRestore() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
+	// Watches KSMPolicy objects
+	KSMPolicy() cache.SharedIndexInformer
+
 	// Watches VirtualMachineClone objects
 	VirtualMachineClone() cache.SharedIndexInformer
 
@@ -485,6 +502,13 @@ func (f *kubeInformerFactory) VMPool() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineDisruptionBudget() cache.SharedIndexInformer {
+	return f.getInformer("vmDisruptionBudgetInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().PoolV1alpha1().RESTClient(), "virtualmachinedisruptionbudgets", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &poolv1.VirtualMachineDisruptionBudget{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func (f *kubeInformerFactory) VirtualMachinePreset() cache.SharedIndexInformer {
 	return f.getInformer("vmiPresetInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.restClient, "virtualmachineinstancepresets", k8sv1.NamespaceAll, fields.Everything())
@@ -583,7 +607,11 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 			if export.Spec.Source.APIGroup != nil &&
 				*export.Spec.Source.APIGroup == snapshotv1.SchemeGroupVersion.Group &&
 				export.Spec.Source.Kind == "VirtualMachineSnapshot" {
-				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+				keys := []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}
+				if export.Spec.BaseSnapshot != nil {
+					keys = append(keys, fmt.Sprintf("%s/%s", export.Namespace, *export.Spec.BaseSnapshot))
+				}
+				return keys, nil
 			}
 
 			return nil, nil
@@ -626,6 +654,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"schedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if schedule, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, schedule)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -676,7 +716,11 @@ func GetVirtualMachineRestoreInformerIndexers() cache.Indexers {
 			if vmr.Spec.Target.APIGroup != nil &&
 				*vmr.Spec.Target.APIGroup == core.GroupName &&
 				vmr.Spec.Target.Kind == "VirtualMachine" {
-				return []string{fmt.Sprintf("%s/%s", vmr.Namespace, vmr.Spec.Target.Name)}, nil
+				namespace := vmr.Namespace
+				if vmr.Spec.TargetNamespace != nil && *vmr.Spec.TargetNamespace != "" {
+					namespace = *vmr.Spec.TargetNamespace
+				}
+				return []string{fmt.Sprintf("%s/%s", namespace, vmr.Spec.Target.Name)}, nil
 			}
 
 			return nil, nil
@@ -691,6 +735,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineGroupSnapshot() cache.SharedIndexInformer {
+	return f.getInformer("vmGroupSnapshotInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinegroupsnapshots", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineGroupSnapshot{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineGroupRestore() cache.SharedIndexInformer {
+	return f.getInformer("vmGroupRestoreInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1alpha1().RESTClient(), "virtualmachinegrouprestores", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineGroupRestore{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -698,6 +763,13 @@ func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) KSMPolicy() cache.SharedIndexInformer {
+	return f.getInformer("ksmPolicyInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().KsmV1alpha1().RESTClient(), ksm.ResourceKSMPolicies, k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &ksmv1alpha1.KSMPolicy{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 	getkey := func(vmClone *clonev1alpha1.VirtualMachineClone, resourceName string) string {
 		return fmt.Sprintf("%s/%s", vmClone.Namespace, resourceName)
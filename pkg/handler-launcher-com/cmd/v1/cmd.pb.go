@@ -40,6 +40,9 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	GuestFileReadRequest
+	GuestFileReadResponse
+	GuestFileWriteRequest
 */
 package v1
 
@@ -409,8 +412,9 @@ type VirtualMachineOptions struct {
 	Topology              *Topology            `protobuf:"bytes,4,opt,name=topology" json:"topology,omitempty"`
 	DisksInfo             map[string]*DiskInfo `protobuf:"bytes,5,rep,name=DisksInfo" json:"DisksInfo,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Deprecated, use clusterConfig.ExpandDisksEnabled
-	ExpandDisksEnabled bool           `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
-	ClusterConfig      *ClusterConfig `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	ExpandDisksEnabled        bool              `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	ClusterConfig             *ClusterConfig    `protobuf:"bytes,7,opt,name=clusterConfig" json:"clusterConfig,omitempty"`
+	InterfaceDomainAttachment map[string]string `protobuf:"bytes,8,rep,name=interfaceDomainAttachment" json:"interfaceDomainAttachment,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *VirtualMachineOptions) Reset()                    { *m = VirtualMachineOptions{} }
@@ -467,6 +471,13 @@ func (m *VirtualMachineOptions) GetClusterConfig() *ClusterConfig {
 	return nil
 }
 
+func (m *VirtualMachineOptions) GetInterfaceDomainAttachment() map[string]string {
+	if m != nil {
+		return m.InterfaceDomainAttachment
+	}
+	return nil
+}
+
 type VMIRequest struct {
 	Vmi     *VMI                   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	Options *VirtualMachineOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
@@ -520,6 +531,7 @@ type ExecRequest struct {
 	Command        string   `protobuf:"bytes,2,opt,name=Command" json:"Command,omitempty"`
 	Args           []string `protobuf:"bytes,3,rep,name=Args" json:"Args,omitempty"`
 	TimeoutSeconds int32    `protobuf:"varint,4,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+	MaxOutputBytes int64    `protobuf:"varint,5,opt,name=maxOutputBytes" json:"maxOutputBytes,omitempty"`
 }
 
 func (m *ExecRequest) Reset()                    { *m = ExecRequest{} }
@@ -555,6 +567,13 @@ func (m *ExecRequest) GetTimeoutSeconds() int32 {
 	return 0
 }
 
+func (m *ExecRequest) GetMaxOutputBytes() int64 {
+	if m != nil {
+		return m.MaxOutputBytes
+	}
+	return 0
+}
+
 type EmptyRequest struct {
 }
 
@@ -711,6 +730,7 @@ type ExecResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	ExitCode int32     `protobuf:"varint,2,opt,name=exitCode" json:"exitCode,omitempty"`
 	StdOut   string    `protobuf:"bytes,3,opt,name=stdOut" json:"stdOut,omitempty"`
+	StdErr   string    `protobuf:"bytes,4,opt,name=stdErr" json:"stdErr,omitempty"`
 }
 
 func (m *ExecResponse) Reset()                    { *m = ExecResponse{} }
@@ -739,6 +759,13 @@ func (m *ExecResponse) GetStdOut() string {
 	return ""
 }
 
+func (m *ExecResponse) GetStdErr() string {
+	if m != nil {
+		return m.StdErr
+	}
+	return ""
+}
+
 type GuestPingRequest struct {
 	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
 	TimeoutSeconds int32  `protobuf:"varint,2,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
@@ -899,6 +926,118 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type GuestFileReadRequest struct {
+	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path           string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	MaxBytes       int64  `protobuf:"varint,3,opt,name=maxBytes" json:"maxBytes,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,4,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestFileReadRequest) Reset()                    { *m = GuestFileReadRequest{} }
+func (m *GuestFileReadRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadRequest) ProtoMessage()               {}
+func (*GuestFileReadRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GuestFileReadRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileReadRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileReadRequest) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *GuestFileReadRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type GuestFileReadResponse struct {
+	Response  *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Content   []byte    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Truncated bool      `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
+}
+
+func (m *GuestFileReadResponse) Reset()                    { *m = GuestFileReadResponse{} }
+func (m *GuestFileReadResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileReadResponse) ProtoMessage()               {}
+func (*GuestFileReadResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *GuestFileReadResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *GuestFileReadResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type GuestFileWriteRequest struct {
+	DomainName     string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path           string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Content        []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	TimeoutSeconds int32  `protobuf:"varint,4,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestFileWriteRequest) Reset()                    { *m = GuestFileWriteRequest{} }
+func (m *GuestFileWriteRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileWriteRequest) ProtoMessage()               {}
+func (*GuestFileWriteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *GuestFileWriteRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileWriteRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileWriteRequest) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *GuestFileWriteRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -930,6 +1069,9 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*GuestFileReadRequest)(nil), "kubevirt.cmd.v1.GuestFileReadRequest")
+	proto.RegisterType((*GuestFileReadResponse)(nil), "kubevirt.cmd.v1.GuestFileReadResponse")
+	proto.RegisterType((*GuestFileWriteRequest)(nil), "kubevirt.cmd.v1.GuestFileWriteRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -973,6 +1115,8 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error)
+	GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1253,6 +1397,24 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) GuestFileRead(ctx context.Context, in *GuestFileReadRequest, opts ...grpc.CallOption) (*GuestFileReadResponse, error) {
+	out := new(GuestFileReadResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileWrite(ctx context.Context, in *GuestFileWriteRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1286,6 +1448,8 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	GuestFileRead(context.Context, *GuestFileReadRequest) (*GuestFileReadResponse, error)
+	GuestFileWrite(context.Context, *GuestFileWriteRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1832,6 +1996,42 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestFileRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileRead(ctx, req.(*GuestFileReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileWrite(ctx, req.(*GuestFileWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1956,6 +2156,14 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "GuestFileRead",
+			Handler:    _Cmd_GuestFileRead_Handler,
+		},
+		{
+			MethodName: "GuestFileWrite",
+			Handler:    _Cmd_GuestFileWrite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1964,111 +2172,123 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1688 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x6f, 0xdb, 0xc8,
-	0x11, 0xb7, 0x2c, 0xd9, 0x91, 0xc7, 0x7f, 0x2e, 0x59, 0xff, 0x29, 0xe3, 0x36, 0x89, 0xbb, 0x28,
-	0x02, 0x5f, 0x71, 0x67, 0x37, 0x69, 0xee, 0x50, 0x04, 0x45, 0x71, 0xb5, 0x2c, 0xfb, 0x7c, 0x17,
-	0x25, 0x3a, 0xca, 0x76, 0xd0, 0x6b, 0x0f, 0x87, 0x35, 0xb9, 0xa6, 0xb7, 0x26, 0x77, 0x59, 0xee,
-	0x52, 0x8d, 0xee, 0xa9, 0x40, 0x8b, 0x3e, 0x14, 0xe8, 0xe7, 0xe8, 0x47, 0xea, 0x27, 0x29, 0xd0,
-	0xc7, 0xc3, 0x2e, 0x97, 0x32, 0x25, 0x52, 0x71, 0x0c, 0xe9, 0xc9, 0xdc, 0x9d, 0x99, 0xdf, 0xcc,
-	0x0e, 0x67, 0x66, 0x7f, 0xa2, 0xe1, 0xe3, 0xf8, 0x3a, 0xd8, 0xbf, 0x22, 0xdc, 0x0f, 0x69, 0xf2,
-	0x69, 0x48, 0x52, 0xee, 0x5d, 0xd1, 0xe4, 0x53, 0x4f, 0x44, 0xfb, 0x5e, 0xe4, 0xef, 0xf7, 0x9f,
-	0xe9, 0x3f, 0x7b, 0x71, 0x22, 0x94, 0x40, 0x1f, 0x5d, 0xa7, 0x17, 0xb4, 0xcf, 0x12, 0xb5, 0xa7,
-	0xf7, 0xfa, 0xcf, 0xf0, 0x25, 0xac, 0x7f, 0x43, 0xa3, 0xf4, 0x9c, 0x26, 0x92, 0x09, 0xee, 0x52,
-	0x19, 0x0b, 0x2e, 0x29, 0xfa, 0x0c, 0x9a, 0x89, 0x7d, 0x76, 0x6a, 0x3b, 0xb5, 0xdd, 0xe5, 0xe7,
-	0x0f, 0xf7, 0xc6, 0x4c, 0xf7, 0x72, 0x65, 0x77, 0xa8, 0x8a, 0x1c, 0xb8, 0xd7, 0xcf, 0x90, 0x9c,
-	0xf9, 0x9d, 0xda, 0xee, 0x92, 0x9b, 0x2f, 0xf1, 0x13, 0xa8, 0x9f, 0x77, 0x4e, 0x8c, 0x42, 0xc4,
-	0xbe, 0x92, 0x82, 0x1b, 0xd8, 0x15, 0x37, 0x5f, 0xe2, 0x67, 0x50, 0x6f, 0x75, 0xcf, 0xd0, 0x1a,
-	0xcc, 0x33, 0xdf, 0xc8, 0x56, 0xdd, 0x79, 0xe6, 0xa3, 0x6d, 0x68, 0x4a, 0x76, 0x11, 0x32, 0x1e,
-	0x48, 0x67, 0x7e, 0xa7, 0xbe, 0xbb, 0xea, 0x0e, 0xd7, 0x78, 0x1f, 0xee, 0xf5, 0xb2, 0xe7, 0x92,
-	0xd9, 0x06, 0x2c, 0xf4, 0x49, 0x98, 0x52, 0x13, 0x46, 0xc3, 0xcd, 0x16, 0xb8, 0x0d, 0x0b, 0x5d,
-	0x12, 0x50, 0xa9, 0xc5, 0x9e, 0x48, 0xb9, 0x32, 0x16, 0x0d, 0x37, 0x5b, 0x20, 0x04, 0x8d, 0x94,
-	0x33, 0x65, 0x43, 0x37, 0xcf, 0x7a, 0x4f, 0xb2, 0x1f, 0xa8, 0x53, 0x37, 0xd0, 0xe6, 0x19, 0xbf,
-	0x80, 0xc5, 0x0e, 0x8d, 0x44, 0x32, 0x40, 0x5b, 0xb0, 0x48, 0xa2, 0x02, 0x90, 0x5d, 0x55, 0x21,
-	0xe1, 0xff, 0xd6, 0xa0, 0xd1, 0xa2, 0x61, 0x58, 0x8a, 0x75, 0x1f, 0x16, 0x23, 0x03, 0x67, 0xd4,
-	0x97, 0x9f, 0xff, 0xa4, 0x94, 0xe9, 0xcc, 0x9b, 0x6b, 0xd5, 0xd0, 0x27, 0xb0, 0x10, 0xeb, 0x63,
-	0x38, 0xf5, 0x9d, 0xfa, 0xee, 0xf2, 0xf3, 0xad, 0x92, 0xbe, 0x39, 0xa4, 0x9b, 0x29, 0xa1, 0xcf,
-	0x61, 0xc9, 0x67, 0x52, 0x11, 0xee, 0x51, 0xe9, 0x34, 0x8c, 0x85, 0x53, 0xb2, 0xb0, 0x79, 0x74,
-	0x6f, 0x54, 0xd1, 0x2e, 0x34, 0xbc, 0x38, 0x95, 0xce, 0x82, 0x31, 0xd9, 0x28, 0x99, 0xb4, 0xba,
-	0x67, 0xae, 0xd1, 0xc0, 0x5f, 0x40, 0xf3, 0x54, 0xc4, 0x22, 0x14, 0xc1, 0x00, 0xbd, 0x00, 0xe0,
-	0x69, 0x44, 0xbe, 0xf7, 0x68, 0x18, 0x4a, 0xa7, 0x66, 0x6c, 0x37, 0xcb, 0xb6, 0x34, 0x0c, 0xdd,
-	0x25, 0xad, 0xa8, 0x9f, 0x24, 0xfe, 0x57, 0x0d, 0x16, 0x7b, 0x9d, 0x03, 0x26, 0x24, 0xc2, 0xb0,
-	0x12, 0x11, 0x9e, 0x5e, 0x12, 0x4f, 0xa5, 0x09, 0x4d, 0x4c, 0x9e, 0x96, 0xdc, 0x91, 0x3d, 0x5d,
-	0x45, 0x71, 0x22, 0xfc, 0xd4, 0xcb, 0x33, 0x9c, 0x2f, 0x8b, 0x05, 0x58, 0x1f, 0x29, 0x40, 0x74,
-	0x1f, 0xea, 0xf2, 0x3a, 0x75, 0x1a, 0x66, 0x57, 0x3f, 0xea, 0x97, 0x77, 0x49, 0x22, 0x16, 0x0e,
-	0x9c, 0x05, 0xb3, 0x69, 0x57, 0xf8, 0x9f, 0x35, 0x68, 0x1e, 0x32, 0x79, 0x7d, 0xc2, 0x2f, 0x85,
-	0x51, 0x12, 0x49, 0x44, 0x94, 0x0d, 0xc4, 0xae, 0xd0, 0x0e, 0x2c, 0x5f, 0x10, 0xef, 0x9a, 0xf1,
-	0xe0, 0x88, 0x85, 0xd4, 0x86, 0x51, 0xdc, 0x42, 0x8f, 0x01, 0x74, 0xbc, 0x24, 0xec, 0xe5, 0xf5,
-	0xd3, 0x70, 0x0b, 0x3b, 0x1a, 0x41, 0xa7, 0x24, 0x57, 0x68, 0x18, 0x85, 0xe2, 0x16, 0xfe, 0x5f,
-	0x0d, 0x56, 0x5b, 0x61, 0x2a, 0x15, 0x4d, 0x5a, 0x82, 0x5f, 0xb2, 0x00, 0xed, 0x01, 0x6a, 0xbf,
-	0x8b, 0x09, 0xf7, 0x75, 0x7c, 0xb2, 0xcd, 0xc9, 0x45, 0x48, 0xb3, 0x52, 0x6a, 0xba, 0x15, 0x12,
-	0xf4, 0x5b, 0x78, 0x78, 0x94, 0x50, 0xaa, 0xeb, 0xc1, 0xa5, 0xb1, 0x48, 0x14, 0xe3, 0xc1, 0x21,
-	0x93, 0x99, 0xd9, 0xbc, 0x31, 0x9b, 0xac, 0x80, 0x5e, 0x82, 0x73, 0x20, 0xbc, 0x2b, 0x79, 0xc8,
-	0x64, 0x1c, 0x92, 0xc1, 0x91, 0x48, 0xda, 0x47, 0x27, 0xc7, 0x29, 0x95, 0x4a, 0x9a, 0xf3, 0x34,
-	0xdd, 0x89, 0x72, 0x6d, 0xdb, 0xa3, 0x09, 0x23, 0x61, 0x4b, 0x70, 0x29, 0x42, 0xfa, 0x4a, 0xdc,
-	0x38, 0x6e, 0x64, 0xb6, 0x93, 0xe4, 0xf8, 0x3f, 0x0d, 0xd8, 0x3c, 0xcf, 0xf2, 0xd0, 0x21, 0xde,
-	0x15, 0xe3, 0xf4, 0x4d, 0xac, 0x98, 0xe0, 0x12, 0x7d, 0x0d, 0x1b, 0xa3, 0x82, 0xac, 0x68, 0xec,
-	0x88, 0x2a, 0x37, 0x4e, 0x26, 0x76, 0x2b, 0x8d, 0xd0, 0x0b, 0xd8, 0xec, 0xd0, 0xe8, 0x80, 0x84,
-	0xa1, 0x10, 0xbc, 0xa7, 0x88, 0x92, 0x5d, 0x9a, 0x30, 0x91, 0x25, 0x66, 0xd5, 0xad, 0x16, 0xa2,
-	0x5f, 0xc1, 0x7a, 0x37, 0xa1, 0x7a, 0xdf, 0x23, 0x8a, 0xfa, 0xe7, 0x22, 0x4c, 0x23, 0xdb, 0x8a,
-	0x4b, 0x6e, 0x95, 0x48, 0xcf, 0x52, 0x65, 0xdb, 0xc3, 0x1c, 0xbd, 0x6a, 0x96, 0xe6, 0xfd, 0xe3,
-	0x0e, 0x55, 0x51, 0x0f, 0x96, 0xcc, 0xbb, 0xd4, 0x65, 0x68, 0x9b, 0xf0, 0xb3, 0x92, 0x5d, 0x65,
-	0x9a, 0xf6, 0x86, 0x76, 0x6d, 0xae, 0x92, 0x81, 0x7b, 0x83, 0x33, 0xa1, 0x80, 0x16, 0x27, 0x16,
-	0xd0, 0x21, 0xac, 0x7a, 0xc5, 0x0a, 0x74, 0xee, 0x99, 0x03, 0x3c, 0x2e, 0x77, 0x74, 0x51, 0xcb,
-	0x1d, 0x35, 0xda, 0x7e, 0x0b, 0x6b, 0xa3, 0x21, 0xe9, 0x6e, 0xbc, 0xa6, 0x03, 0xdb, 0x53, 0xfa,
-	0x11, 0xed, 0x17, 0x27, 0x76, 0x55, 0x8a, 0xf2, 0x96, 0xb4, 0xc3, 0xfc, 0xe5, 0xfc, 0x6f, 0x6a,
-	0xb8, 0x0f, 0x70, 0xde, 0x39, 0x71, 0xe9, 0x5f, 0x74, 0xd1, 0xa1, 0xa7, 0x50, 0xef, 0x47, 0xcc,
-	0x16, 0x43, 0x79, 0x60, 0x69, 0x4d, 0xad, 0x80, 0xbe, 0x80, 0x7b, 0x22, 0xcb, 0x94, 0x75, 0xf6,
-	0xf4, 0xc3, 0xf2, 0xea, 0xe6, 0x66, 0xf8, 0x14, 0xee, 0x77, 0x58, 0x90, 0x10, 0x65, 0xee, 0xcc,
-	0xbb, 0x79, 0x77, 0x46, 0xbd, 0xaf, 0xdc, 0xa0, 0xfe, 0xbd, 0x06, 0xcb, 0xed, 0x77, 0xd4, 0xcb,
-	0x11, 0x1f, 0x03, 0xf8, 0x22, 0x22, 0x8c, 0xbf, 0x26, 0x11, 0xb5, 0xb9, 0x2a, 0xec, 0x68, 0xa4,
-	0x96, 0x88, 0x22, 0xc2, 0xfd, 0x7c, 0x0c, 0xda, 0xa5, 0xbe, 0x7f, 0x7e, 0x9f, 0x04, 0x79, 0x55,
-	0x9a, 0x67, 0xf4, 0x14, 0xd6, 0x14, 0x8b, 0xa8, 0x48, 0x55, 0x8f, 0x7a, 0x82, 0xfb, 0xd2, 0x14,
-	0xe3, 0x82, 0x3b, 0xb6, 0x8b, 0xd7, 0x60, 0xa5, 0x1d, 0xc5, 0x6a, 0x60, 0xa3, 0xc0, 0xbf, 0x83,
-	0xa6, 0x5b, 0xb8, 0xdf, 0x65, 0xea, 0x79, 0x54, 0x4a, 0x3b, 0x74, 0xf2, 0xa5, 0x96, 0x44, 0x54,
-	0x4a, 0x12, 0xe4, 0xb3, 0x30, 0x5f, 0xe2, 0xef, 0x61, 0xed, 0xd0, 0xc4, 0x3c, 0x2d, 0xb9, 0xd8,
-	0x82, 0xc5, 0xec, 0xf0, 0xd6, 0x83, 0x5d, 0x61, 0x0e, 0xeb, 0x99, 0x03, 0xd3, 0xa6, 0xd3, 0x7a,
-	0xd9, 0x81, 0x65, 0xff, 0x06, 0x2d, 0x1f, 0xec, 0x85, 0x2d, 0xfc, 0x0e, 0x1e, 0x98, 0x21, 0x67,
-	0x8a, 0x71, 0x4a, 0x6f, 0x9f, 0xc0, 0x83, 0x60, 0x1c, 0xcb, 0xfa, 0x2c, 0x0b, 0xf0, 0x3f, 0x6a,
-	0xb0, 0x69, 0x5c, 0x9f, 0x49, 0x9a, 0xbc, 0x62, 0x52, 0x4d, 0xeb, 0xfe, 0x05, 0x6c, 0x06, 0x55,
-	0x78, 0x36, 0x84, 0x6a, 0x21, 0xfe, 0x77, 0x0d, 0x1c, 0x13, 0x86, 0xbe, 0xe7, 0xe4, 0x40, 0x2a,
-	0x1a, 0x4d, 0x9d, 0xf6, 0x97, 0xe0, 0x04, 0x13, 0x20, 0x6d, 0x30, 0x13, 0xe5, 0x78, 0x00, 0x2b,
-	0x59, 0xdb, 0x4c, 0x17, 0xc2, 0x36, 0x34, 0xe9, 0x3b, 0xa6, 0x5a, 0xc2, 0xcf, 0x5c, 0x2e, 0xb8,
-	0xc3, 0xb5, 0xae, 0x3d, 0xa9, 0xfc, 0x37, 0xa9, 0xb2, 0xb4, 0xc2, 0xae, 0xf0, 0xb7, 0x70, 0xdf,
-	0x64, 0xa2, 0xab, 0xc9, 0xd3, 0x07, 0xb6, 0x6d, 0xb9, 0x11, 0xe7, 0x2b, 0x1b, 0xf1, 0x2b, 0x5b,
-	0x67, 0x19, 0xf6, 0x54, 0x67, 0xc3, 0x02, 0x56, 0xf5, 0x3d, 0xff, 0x03, 0xbd, 0xeb, 0xb4, 0xfa,
-	0x1c, 0xb6, 0x52, 0x7e, 0x69, 0x4c, 0x4f, 0xab, 0x82, 0x9e, 0x20, 0xc5, 0x6f, 0xe1, 0x41, 0xc6,
-	0x5a, 0x0f, 0xd3, 0x28, 0xbe, 0xab, 0xd3, 0x6d, 0x68, 0xfa, 0x69, 0x14, 0x77, 0x89, 0xba, 0xb2,
-	0x2f, 0x7f, 0xb8, 0xc6, 0x17, 0xf0, 0x51, 0xaf, 0x7d, 0x3e, 0x8b, 0xde, 0xd3, 0xc3, 0x8c, 0xf6,
-	0xcd, 0xf5, 0x6a, 0x07, 0xb1, 0x5d, 0xe2, 0xbf, 0xd5, 0xe0, 0xe1, 0x2b, 0xf3, 0x3b, 0xaa, 0x43,
-	0x89, 0x4c, 0x13, 0x1a, 0x51, 0xae, 0x66, 0xd0, 0xea, 0xe1, 0x38, 0xa6, 0x75, 0x5c, 0x16, 0xe0,
-	0xef, 0xe0, 0xe1, 0x09, 0xff, 0x33, 0xf5, 0x54, 0x16, 0x47, 0x8f, 0x7a, 0x09, 0x55, 0x33, 0xbb,
-	0x6a, 0x9e, 0xff, 0x7f, 0x1d, 0xea, 0xad, 0xc8, 0x47, 0xaf, 0x01, 0xf5, 0x06, 0xdc, 0x1b, 0xbd,
-	0xee, 0xd0, 0x4f, 0x2b, 0x21, 0x33, 0xe7, 0xdb, 0x93, 0x0f, 0x8b, 0xe7, 0xd0, 0x1b, 0x58, 0xef,
-	0x92, 0x54, 0xd2, 0x99, 0x01, 0x7e, 0x03, 0x9b, 0x67, 0x3c, 0x9e, 0x29, 0x64, 0x0f, 0x36, 0xb2,
-	0x5e, 0x18, 0x43, 0x2c, 0x93, 0x9a, 0x91, 0x96, 0x79, 0x3f, 0xa8, 0x0b, 0x5b, 0x67, 0xb6, 0x13,
-	0x66, 0x16, 0xe8, 0x29, 0x38, 0x3d, 0x71, 0xa9, 0x5c, 0x7a, 0x21, 0x84, 0x9a, 0x19, 0xaa, 0x0b,
-	0x5b, 0xbd, 0xab, 0x54, 0xf9, 0xe2, 0xaf, 0x7c, 0x66, 0x98, 0xaf, 0x01, 0x7d, 0xcd, 0xc2, 0x70,
-	0x66, 0x78, 0x5d, 0xd8, 0x38, 0xa4, 0x21, 0x55, 0xb3, 0xcb, 0xe5, 0x5b, 0xd8, 0xcc, 0x18, 0xdb,
-	0x38, 0xe4, 0xcf, 0xcb, 0xbf, 0xb6, 0xc7, 0x98, 0xdd, 0xad, 0x15, 0xaf, 0x3b, 0x68, 0x68, 0x74,
-	0x4a, 0x92, 0x80, 0xaa, 0x29, 0x22, 0xfd, 0x03, 0x3c, 0x6a, 0xe9, 0x5f, 0xe0, 0x63, 0xd9, 0x1c,
-	0x3a, 0x98, 0xf2, 0xd5, 0xb3, 0x80, 0x93, 0x30, 0x0b, 0xb2, 0x2b, 0xfc, 0x56, 0x48, 0x09, 0x4f,
-	0xe3, 0x29, 0x30, 0xff, 0x08, 0x4f, 0x8e, 0x18, 0x27, 0x21, 0x1b, 0x2f, 0xfc, 0x59, 0x04, 0xfc,
-	0x1a, 0xd0, 0x97, 0x42, 0xc5, 0x61, 0x1a, 0x7c, 0x29, 0xa4, 0x3a, 0xa4, 0x7d, 0xe6, 0x51, 0x39,
-	0x05, 0x5e, 0x07, 0x96, 0x8e, 0xa9, 0xca, 0xd8, 0x22, 0x7a, 0x54, 0xd2, 0x2c, 0xf2, 0xde, 0xed,
-	0x27, 0xe5, 0x5f, 0x20, 0x23, 0x34, 0xd6, 0x14, 0xd5, 0xda, 0x10, 0xce, 0x70, 0xc3, 0xdb, 0x30,
-	0x7f, 0x31, 0x01, 0x73, 0x84, 0xb9, 0x9a, 0x11, 0xb5, 0x72, 0x4c, 0xd5, 0x90, 0x65, 0xde, 0x06,
-	0x8b, 0x4b, 0xe2, 0x12, 0x41, 0x35, 0xa0, 0xcd, 0x63, 0x6a, 0xd8, 0xdc, 0xad, 0x71, 0x3e, 0xad,
-	0x06, 0x2c, 0x31, 0xc1, 0x39, 0xf4, 0x27, 0x93, 0x82, 0x02, 0x2b, 0xbb, 0x0d, 0xfa, 0xe3, 0x6a,
-	0xe8, 0x2a, 0x5e, 0x37, 0x87, 0x0e, 0xa0, 0xa1, 0xd9, 0xcf, 0x6d, 0x98, 0xef, 0x7d, 0xe7, 0x6d,
-	0x68, 0x68, 0x76, 0x88, 0x7e, 0x56, 0xc6, 0xb8, 0xf9, 0xad, 0xb5, 0xfd, 0x68, 0x82, 0xb4, 0x30,
-	0x8c, 0x97, 0x86, 0x6c, 0xac, 0x62, 0x68, 0x8c, 0xb3, 0xc0, 0x49, 0xef, 0xa4, 0x48, 0xe6, 0x4c,
-	0xf7, 0x38, 0x63, 0x5d, 0x33, 0x24, 0x4d, 0x08, 0x4f, 0xf8, 0x0e, 0x58, 0x60, 0x54, 0xb7, 0xcd,
-	0x3c, 0xfd, 0x6e, 0x0a, 0x9f, 0x77, 0xef, 0x5e, 0x9e, 0x15, 0xdf, 0x86, 0xed, 0x1c, 0x29, 0xb1,
-	0x86, 0x56, 0xf7, 0x4c, 0x4e, 0x79, 0xd9, 0x95, 0x30, 0xed, 0x67, 0xd6, 0x69, 0xf8, 0x08, 0x1c,
-	0x53, 0x65, 0x09, 0xe3, 0x6d, 0xc7, 0xdf, 0x29, 0x7f, 0x3f, 0x1a, 0x65, 0x9a, 0x78, 0x0e, 0x11,
-	0xd8, 0x38, 0xa6, 0xaa, 0x44, 0x0e, 0xdf, 0x1f, 0xe2, 0x2f, 0x4b, 0xc2, 0x89, 0xec, 0x12, 0xcf,
-	0xa1, 0xef, 0x00, 0x95, 0xa9, 0x1f, 0x2a, 0x63, 0x4c, 0xe4, 0x87, 0xef, 0x4d, 0xc9, 0x41, 0xe3,
-	0xdb, 0xf9, 0xfe, 0xb3, 0x8b, 0x45, 0xf3, 0xff, 0x80, 0x5f, 0xff, 0x18, 0x00, 0x00, 0xff, 0xff,
-	0x6e, 0x62, 0xcb, 0xbc, 0x3c, 0x18, 0x00, 0x00,
+	// 1879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
+	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7a, 0x66, 0x63, 0x5b, 0xc5, 0xb4,
+	0x1a, 0xa5, 0x93, 0x48, 0xb5, 0xeb, 0x64, 0x3a, 0x9e, 0x4e, 0x27, 0x11, 0x45, 0x29, 0x4a, 0x2c,
+	0x9b, 0x39, 0x4a, 0xf2, 0x34, 0x6d, 0x26, 0x85, 0xee, 0x20, 0xea, 0xaa, 0x3b, 0xe0, 0x7a, 0xc0,
+	0xb1, 0xa2, 0x9f, 0x3a, 0xd3, 0x4e, 0x1e, 0x3a, 0x93, 0xce, 0xf4, 0x73, 0xf4, 0xa9, 0x9f, 0xa6,
+	0x9f, 0xa4, 0x4f, 0x7d, 0xe9, 0x00, 0x87, 0x23, 0x8f, 0xbc, 0x3b, 0x49, 0x0e, 0xf9, 0xa4, 0x5b,
+	0x60, 0xf7, 0x87, 0xc5, 0x62, 0x77, 0xf1, 0x83, 0x08, 0x1f, 0x84, 0x97, 0xbd, 0x9d, 0x0b, 0xc2,
+	0x5c, 0x9f, 0x46, 0x1f, 0xf9, 0x24, 0x66, 0xce, 0x05, 0x8d, 0x3e, 0x72, 0x78, 0xb0, 0xe3, 0x04,
+	0xee, 0x4e, 0xff, 0xa9, 0xfa, 0xb3, 0x1d, 0x46, 0x5c, 0x72, 0xf4, 0xde, 0x65, 0x7c, 0x46, 0xfb,
+	0x5e, 0x24, 0xb7, 0xd5, 0x58, 0xff, 0x29, 0x3e, 0x87, 0x07, 0x5f, 0xd1, 0x20, 0x3e, 0xa5, 0x91,
+	0xf0, 0x38, 0xb3, 0xa9, 0x08, 0x39, 0x13, 0x14, 0x7d, 0x0c, 0xf5, 0xc8, 0x7c, 0x5b, 0x95, 0x8d,
+	0xca, 0xd6, 0xe2, 0xb3, 0x87, 0xdb, 0x13, 0xa6, 0xdb, 0xa9, 0xb2, 0x3d, 0x54, 0x45, 0x16, 0xdc,
+	0xed, 0x27, 0x48, 0xd6, 0xdc, 0x46, 0x65, 0xab, 0x61, 0xa7, 0x22, 0x7e, 0x02, 0xd5, 0xd3, 0xa3,
+	0x43, 0xad, 0x10, 0x78, 0x5f, 0x08, 0xce, 0x34, 0xec, 0x92, 0x9d, 0x8a, 0xf8, 0x29, 0x54, 0x5b,
+	0x9d, 0x13, 0xb4, 0x02, 0x73, 0x9e, 0xab, 0xe7, 0x96, 0xed, 0x39, 0xcf, 0x45, 0x4d, 0xa8, 0x0b,
+	0xef, 0xcc, 0xf7, 0x58, 0x4f, 0x58, 0x73, 0x1b, 0xd5, 0xad, 0x65, 0x7b, 0x28, 0xe3, 0x1d, 0xb8,
+	0xdb, 0x4d, 0xbe, 0x73, 0x66, 0xab, 0x30, 0xdf, 0x27, 0x7e, 0x4c, 0xb5, 0x1b, 0x35, 0x3b, 0x11,
+	0x70, 0x1b, 0xe6, 0x3b, 0xa4, 0x47, 0x85, 0x9a, 0x76, 0x78, 0xcc, 0xa4, 0xb6, 0xa8, 0xd9, 0x89,
+	0x80, 0x10, 0xd4, 0x62, 0xe6, 0x49, 0xe3, 0xba, 0xfe, 0x56, 0x63, 0xc2, 0x7b, 0x4b, 0xad, 0xaa,
+	0x86, 0xd6, 0xdf, 0xf8, 0x39, 0x2c, 0x1c, 0xd1, 0x80, 0x47, 0x03, 0xb4, 0x0e, 0x0b, 0x24, 0xc8,
+	0x00, 0x19, 0xa9, 0x08, 0x09, 0xff, 0xa7, 0x02, 0xb5, 0x16, 0xf5, 0xfd, 0x9c, 0xaf, 0x3b, 0xb0,
+	0x10, 0x68, 0x38, 0xad, 0xbe, 0xf8, 0xec, 0x47, 0xb9, 0x48, 0x27, 0xab, 0xd9, 0x46, 0x0d, 0x7d,
+	0x08, 0xf3, 0xa1, 0xda, 0x86, 0x55, 0xdd, 0xa8, 0x6e, 0x2d, 0x3e, 0x5b, 0xcf, 0xe9, 0xeb, 0x4d,
+	0xda, 0x89, 0x12, 0xfa, 0x04, 0x1a, 0xae, 0x27, 0x24, 0x61, 0x0e, 0x15, 0x56, 0x4d, 0x5b, 0x58,
+	0x39, 0x0b, 0x13, 0x47, 0x7b, 0xa4, 0x8a, 0xb6, 0xa0, 0xe6, 0x84, 0xb1, 0xb0, 0xe6, 0xb5, 0xc9,
+	0x6a, 0xce, 0xa4, 0xd5, 0x39, 0xb1, 0xb5, 0x06, 0xfe, 0x14, 0xea, 0xc7, 0x3c, 0xe4, 0x3e, 0xef,
+	0x0d, 0xd0, 0x73, 0x00, 0x16, 0x07, 0xe4, 0x5b, 0x87, 0xfa, 0xbe, 0xb0, 0x2a, 0xda, 0x76, 0x2d,
+	0x6f, 0x4b, 0x7d, 0xdf, 0x6e, 0x28, 0x45, 0xf5, 0x25, 0xf0, 0xdf, 0x2b, 0xb0, 0xd0, 0x3d, 0xda,
+	0xf5, 0xb8, 0x40, 0x18, 0x96, 0x02, 0xc2, 0xe2, 0x73, 0xe2, 0xc8, 0x38, 0xa2, 0x91, 0x8e, 0x53,
+	0xc3, 0x1e, 0x1b, 0x53, 0x59, 0x14, 0x46, 0xdc, 0x8d, 0x9d, 0x34, 0xc2, 0xa9, 0x98, 0x4d, 0xc0,
+	0xea, 0x58, 0x02, 0xa2, 0x7b, 0x50, 0x15, 0x97, 0xb1, 0x55, 0xd3, 0xa3, 0xea, 0x53, 0x1d, 0xde,
+	0x39, 0x09, 0x3c, 0x7f, 0x60, 0xcd, 0xeb, 0x41, 0x23, 0xe1, 0xef, 0x2a, 0x50, 0xdf, 0xf3, 0xc4,
+	0xe5, 0x21, 0x3b, 0xe7, 0x5a, 0x89, 0x47, 0x01, 0x91, 0xc6, 0x11, 0x23, 0xa1, 0x0d, 0x58, 0x3c,
+	0x23, 0xce, 0xa5, 0xc7, 0x7a, 0xfb, 0x9e, 0x4f, 0x8d, 0x1b, 0xd9, 0x21, 0xf4, 0x18, 0x40, 0xf9,
+	0x4b, 0xfc, 0x6e, 0x9a, 0x3f, 0x35, 0x3b, 0x33, 0xa2, 0x10, 0x54, 0x48, 0x52, 0x85, 0x9a, 0x56,
+	0xc8, 0x0e, 0xe1, 0xff, 0x56, 0x60, 0xb9, 0xe5, 0xc7, 0x42, 0xd2, 0xa8, 0xc5, 0xd9, 0xb9, 0xd7,
+	0x43, 0xdb, 0x80, 0xda, 0x57, 0x21, 0x61, 0xae, 0xf2, 0x4f, 0xb4, 0x19, 0x39, 0xf3, 0x69, 0x92,
+	0x4a, 0x75, 0xbb, 0x60, 0x06, 0xfd, 0x1a, 0x1e, 0xee, 0x47, 0x94, 0xaa, 0x7c, 0xb0, 0x69, 0xc8,
+	0x23, 0xe9, 0xb1, 0xde, 0x9e, 0x27, 0x12, 0xb3, 0x39, 0x6d, 0x56, 0xae, 0x80, 0x5e, 0x80, 0xb5,
+	0xcb, 0x9d, 0x0b, 0xb1, 0xe7, 0x89, 0xd0, 0x27, 0x83, 0x7d, 0x1e, 0xb5, 0xf7, 0x0f, 0x0f, 0x62,
+	0x2a, 0xa4, 0xd0, 0xfb, 0xa9, 0xdb, 0xa5, 0xf3, 0xca, 0xb6, 0x4b, 0x23, 0x8f, 0xf8, 0x2d, 0xce,
+	0x04, 0xf7, 0xe9, 0x4b, 0x3e, 0x5a, 0xb8, 0x96, 0xd8, 0x96, 0xcd, 0xe3, 0xff, 0xcd, 0xc3, 0xda,
+	0x69, 0x12, 0x87, 0x23, 0xe2, 0x5c, 0x78, 0x8c, 0xbe, 0x0e, 0xa5, 0xc7, 0x99, 0x40, 0x5f, 0xc2,
+	0xea, 0xf8, 0x44, 0x92, 0x34, 0x56, 0xa5, 0xa4, 0x70, 0x92, 0x69, 0xbb, 0xd0, 0x08, 0x3d, 0x87,
+	0xb5, 0x23, 0x1a, 0xec, 0x12, 0xdf, 0xe7, 0x9c, 0x75, 0x25, 0x91, 0xa2, 0x43, 0x23, 0x8f, 0x27,
+	0x81, 0x59, 0xb6, 0x8b, 0x27, 0xd1, 0x2f, 0xe0, 0x41, 0x27, 0xa2, 0x6a, 0xdc, 0x21, 0x92, 0xba,
+	0xa7, 0xdc, 0x8f, 0x03, 0x53, 0x8a, 0x0d, 0xbb, 0x68, 0x4a, 0xf5, 0x52, 0x69, 0xca, 0xc3, 0xaa,
+	0x95, 0xf4, 0xd2, 0xb4, 0x7e, 0xec, 0xa1, 0x2a, 0xea, 0x42, 0x43, 0x9f, 0xa5, 0x4a, 0x43, 0x53,
+	0x84, 0x1f, 0xe7, 0xec, 0x0a, 0xc3, 0xb4, 0x3d, 0xb4, 0x6b, 0x33, 0x19, 0x0d, 0xec, 0x11, 0x4e,
+	0x49, 0x02, 0x2d, 0x94, 0x26, 0xd0, 0x1e, 0x2c, 0x3b, 0xd9, 0x0c, 0xb4, 0xee, 0xea, 0x0d, 0x3c,
+	0xce, 0x57, 0x74, 0x56, 0xcb, 0x1e, 0x37, 0x42, 0x7f, 0xad, 0xc0, 0x43, 0x8f, 0x49, 0x1a, 0x9d,
+	0x13, 0x87, 0xee, 0xf1, 0x80, 0x78, 0xec, 0x33, 0x29, 0x89, 0x73, 0x11, 0x50, 0x26, 0xad, 0xba,
+	0xde, 0x5b, 0xfb, 0x96, 0x7b, 0x3b, 0x2c, 0xc3, 0x49, 0xf6, 0x5a, 0xbe, 0x4e, 0xf3, 0x0d, 0xac,
+	0x8c, 0x07, 0x46, 0xf5, 0x84, 0x4b, 0x3a, 0x30, 0x95, 0xad, 0x3e, 0xd1, 0x4e, 0xf6, 0xde, 0x28,
+	0x3a, 0xa8, 0xb4, 0x31, 0x98, 0x2b, 0xe5, 0xc5, 0xdc, 0xaf, 0x2a, 0xcd, 0x97, 0xf0, 0xf8, 0x7a,
+	0xaf, 0x0a, 0x16, 0x1a, 0xbb, 0xa0, 0x1a, 0x19, 0x34, 0xdc, 0x07, 0x38, 0x3d, 0x3a, 0xb4, 0xe9,
+	0x9f, 0x54, 0x21, 0xa1, 0x4d, 0xa8, 0xf6, 0x03, 0xcf, 0x24, 0x78, 0xbe, 0x09, 0x2b, 0x4d, 0xa5,
+	0x80, 0x3e, 0x85, 0xbb, 0x3c, 0x89, 0x90, 0x71, 0x7d, 0xf3, 0x76, 0xf1, 0xb4, 0x53, 0x33, 0x7c,
+	0x0c, 0xf7, 0x8e, 0xbc, 0x5e, 0x44, 0xa4, 0xe6, 0x01, 0xef, 0xb6, 0xba, 0x35, 0xbe, 0xfa, 0xd2,
+	0x08, 0xf5, 0x5f, 0x15, 0x58, 0x6c, 0x5f, 0x51, 0x27, 0x45, 0x7c, 0x0c, 0xe0, 0xea, 0x10, 0xbd,
+	0x22, 0x01, 0x35, 0x01, 0xc9, 0x8c, 0x28, 0xa4, 0x16, 0x0f, 0x02, 0xc2, 0xdc, 0xb4, 0xb5, 0x1b,
+	0x51, 0xdd, 0xa9, 0x9f, 0x45, 0xbd, 0xb4, 0xd2, 0xf4, 0x37, 0xda, 0x84, 0x15, 0xe9, 0x05, 0x94,
+	0xc7, 0xb2, 0x4b, 0x1d, 0xce, 0x5c, 0xa1, 0x0b, 0x6c, 0xde, 0x9e, 0x18, 0x55, 0x7a, 0x01, 0xb9,
+	0x7a, 0x1d, 0xcb, 0x30, 0x96, 0xbb, 0x03, 0x49, 0x85, 0x6e, 0xf9, 0x55, 0x7b, 0x62, 0x14, 0xaf,
+	0xc0, 0x52, 0x3b, 0x08, 0xe5, 0xc0, 0x78, 0x8b, 0x7f, 0x03, 0x75, 0x3b, 0xc3, 0x6d, 0x44, 0xec,
+	0x38, 0x54, 0x08, 0xd3, 0x70, 0x53, 0x51, 0xcd, 0x04, 0x54, 0x08, 0xd2, 0x4b, 0x4f, 0x33, 0x15,
+	0xf1, 0xb7, 0xb0, 0x92, 0x24, 0xc4, 0xb4, 0xc4, 0x6a, 0x1d, 0x16, 0x92, 0x20, 0x99, 0x15, 0x8c,
+	0x84, 0x19, 0x3c, 0x48, 0x16, 0xd0, 0x2d, 0x6a, 0xda, 0x55, 0x36, 0x60, 0xd1, 0x1d, 0xa1, 0xa5,
+	0x97, 0x5a, 0x66, 0x08, 0x5f, 0xc1, 0x7d, 0xdd, 0xe0, 0x75, 0x09, 0x4c, 0xb9, 0xda, 0x87, 0x70,
+	0xbf, 0x37, 0x89, 0x65, 0xd6, 0xcc, 0x4f, 0xe0, 0xbf, 0x55, 0x60, 0x4d, 0x2f, 0x7d, 0x22, 0x68,
+	0xf4, 0xd2, 0x13, 0x72, 0xda, 0xe5, 0x9f, 0xc3, 0x5a, 0xaf, 0x08, 0xcf, 0xb8, 0x50, 0x3c, 0x89,
+	0xbf, 0xaf, 0x80, 0xa5, 0xdd, 0x50, 0x77, 0xbc, 0x18, 0x08, 0x49, 0x83, 0xa9, 0xc3, 0xfe, 0x02,
+	0xac, 0x5e, 0x09, 0xa4, 0x71, 0xa6, 0x74, 0x1e, 0xff, 0xb3, 0x02, 0x4b, 0x49, 0x7d, 0x4d, 0xe7,
+	0x43, 0x13, 0xea, 0xf4, 0xca, 0x93, 0x2d, 0xee, 0x26, 0x6b, 0xce, 0xdb, 0x43, 0x59, 0x25, 0x9f,
+	0x90, 0xee, 0xeb, 0x58, 0x1a, 0x4e, 0x65, 0x24, 0x33, 0xde, 0x8e, 0x22, 0xc3, 0xaa, 0x8c, 0x84,
+	0xbf, 0x86, 0x7b, 0x3a, 0x44, 0x1d, 0xc5, 0x28, 0x6f, 0x59, 0xf7, 0xf9, 0x4a, 0x9e, 0x2b, 0xaa,
+	0x64, 0xfc, 0x05, 0xdc, 0xcf, 0x60, 0x4f, 0xb5, 0x67, 0xcc, 0x61, 0x59, 0x91, 0x9f, 0xb7, 0xf4,
+	0x5d, 0xdb, 0xdd, 0x27, 0xb0, 0x1e, 0xb3, 0x73, 0x6d, 0x7a, 0x5c, 0xe4, 0x74, 0xc9, 0x2c, 0x7e,
+	0x03, 0xf7, 0x13, 0x2a, 0xbf, 0x17, 0x07, 0xe1, 0xbb, 0x2e, 0xda, 0x84, 0xba, 0x1b, 0x07, 0x61,
+	0x87, 0xc8, 0x0b, 0x93, 0x15, 0x43, 0x19, 0x9f, 0xc1, 0x7b, 0xdd, 0xf6, 0xe9, 0x2c, 0x8a, 0x52,
+	0x75, 0x39, 0xda, 0xd7, 0x9c, 0xc3, 0x74, 0x72, 0x23, 0xe2, 0xbf, 0x54, 0xe0, 0xe1, 0x4b, 0xfd,
+	0xb8, 0x3c, 0xa2, 0x44, 0xc4, 0x11, 0x55, 0xd7, 0xdb, 0x0c, 0x7a, 0x80, 0x3f, 0x89, 0x69, 0x16,
+	0xce, 0x4f, 0xe0, 0x6f, 0xe0, 0xe1, 0x21, 0xfb, 0x23, 0x75, 0x64, 0xe2, 0x47, 0x97, 0x3a, 0x11,
+	0x95, 0xb3, 0xbb, 0xab, 0xfe, 0x51, 0x81, 0xd5, 0x61, 0x6d, 0xdb, 0x94, 0xb8, 0xb7, 0x4d, 0x5e,
+	0x04, 0xb5, 0x70, 0x74, 0x2c, 0xfa, 0x5b, 0x1d, 0x57, 0x40, 0xae, 0x92, 0xcb, 0xa6, 0xaa, 0x2f,
+	0x9b, 0xa1, 0x7c, 0xdb, 0x6b, 0x0b, 0x7f, 0x97, 0xf6, 0xbc, 0x91, 0x43, 0x53, 0x9f, 0xae, 0xc3,
+	0x99, 0x1c, 0x05, 0x39, 0x15, 0xd1, 0xfb, 0xd0, 0x90, 0x51, 0xcc, 0x34, 0x71, 0x35, 0xe4, 0x7e,
+	0x34, 0x80, 0xbf, 0xcf, 0x3a, 0xf2, 0x26, 0xf2, 0x24, 0x9d, 0x26, 0x34, 0x19, 0x2f, 0xaa, 0xe3,
+	0x5e, 0xdc, 0x32, 0x30, 0xcf, 0xfe, 0xbd, 0x06, 0xd5, 0x56, 0xe0, 0xa2, 0x57, 0x80, 0xba, 0x03,
+	0xe6, 0x8c, 0x33, 0x1b, 0xf4, 0xe3, 0xc2, 0xc3, 0x4f, 0x1c, 0x6e, 0x96, 0xc7, 0x09, 0xdf, 0x41,
+	0xaf, 0xe1, 0x41, 0x87, 0xc4, 0x82, 0xce, 0x0c, 0xf0, 0x2b, 0x58, 0x3b, 0x61, 0xe1, 0x4c, 0x21,
+	0xbb, 0xb0, 0x9a, 0x74, 0xad, 0x09, 0xc4, 0x3c, 0x27, 0x1f, 0x6b, 0x6e, 0xd7, 0x83, 0xda, 0xb0,
+	0x7e, 0xc2, 0xce, 0x8b, 0x60, 0x7f, 0xb8, 0xa3, 0xc7, 0x60, 0x75, 0xf9, 0xb9, 0xb4, 0xe9, 0x19,
+	0xe7, 0x72, 0x66, 0xa8, 0x36, 0xac, 0x77, 0x2f, 0x62, 0xe9, 0xf2, 0x3f, 0xb3, 0x99, 0x61, 0xbe,
+	0x02, 0xf4, 0xa5, 0xe7, 0xfb, 0x33, 0xc3, 0xeb, 0xc0, 0xea, 0x1e, 0xf5, 0xa9, 0x9c, 0x5d, 0x2c,
+	0xdf, 0xc0, 0x5a, 0x42, 0xce, 0x27, 0x21, 0x7f, 0x92, 0xb3, 0x9a, 0x24, 0xf1, 0x37, 0x66, 0xbc,
+	0xaa, 0xa0, 0xa1, 0xd1, 0x31, 0x89, 0x7a, 0x54, 0x4e, 0xe1, 0xe9, 0x6f, 0xe1, 0x51, 0x4b, 0xfd,
+	0x03, 0x69, 0x22, 0x9a, 0xc3, 0x05, 0xa6, 0x3c, 0x7a, 0xaf, 0xc7, 0x88, 0x9f, 0x38, 0xd9, 0xe1,
+	0x6e, 0xcb, 0xa7, 0x84, 0xc5, 0xe1, 0x14, 0x98, 0xbf, 0x83, 0x27, 0xfb, 0x1e, 0x23, 0xbe, 0xf7,
+	0x96, 0xce, 0xde, 0xe1, 0x57, 0x80, 0x3e, 0xe7, 0x32, 0xf4, 0xe3, 0xde, 0xe7, 0x5c, 0xc8, 0x3d,
+	0xda, 0xf7, 0x1c, 0x2a, 0xa6, 0xc0, 0x3b, 0x82, 0xc6, 0x01, 0x95, 0x09, 0xe1, 0x47, 0x8f, 0x72,
+	0x9a, 0xd9, 0xa7, 0x4b, 0xf3, 0x49, 0xfe, 0xe9, 0x3a, 0xf6, 0x12, 0xd1, 0x49, 0xb5, 0x32, 0x84,
+	0xd3, 0xf4, 0xfe, 0x26, 0xcc, 0x9f, 0x96, 0x60, 0x8e, 0x3d, 0x3e, 0x74, 0x8b, 0x5a, 0x3a, 0xa0,
+	0x72, 0xf8, 0x50, 0xb8, 0x09, 0x16, 0xe7, 0xa6, 0x73, 0x6f, 0x0c, 0x0d, 0x5a, 0x3f, 0xa0, 0x9a,
+	0x90, 0xdf, 0xe8, 0xe7, 0x66, 0x31, 0x60, 0x8e, 0xcc, 0xdf, 0x41, 0xbf, 0xd7, 0x21, 0xc8, 0x10,
+	0xeb, 0x9b, 0xa0, 0x3f, 0x28, 0x86, 0x2e, 0xa2, 0xe6, 0x77, 0xd0, 0x2e, 0xd4, 0x14, 0x4f, 0xbd,
+	0x09, 0xf3, 0xda, 0x33, 0x6f, 0x43, 0x4d, 0xf1, 0x7b, 0xf4, 0x7e, 0x1e, 0x63, 0xf4, 0xac, 0x6e,
+	0x3e, 0x2a, 0x99, 0xcd, 0x34, 0xe3, 0xc6, 0x90, 0x37, 0x17, 0x34, 0x8d, 0x49, 0xbe, 0xde, 0xc4,
+	0xd7, 0xa9, 0x64, 0xaa, 0xc7, 0x9a, 0xa8, 0x9a, 0x21, 0xbd, 0x45, 0xb8, 0xe4, 0xdf, 0xd8, 0x19,
+	0xee, 0x7b, 0x53, 0xcf, 0x53, 0x67, 0x93, 0xf9, 0x75, 0xe2, 0xdd, 0xd3, 0xb3, 0xe0, 0xa7, 0x0d,
+	0xd3, 0x47, 0x72, 0xac, 0xa1, 0xd5, 0x39, 0x11, 0x53, 0x5e, 0x76, 0x39, 0xcc, 0x64, 0xc3, 0x53,
+	0xf1, 0x11, 0x38, 0xa0, 0xd2, 0x50, 0xfb, 0x9b, 0xb6, 0xbf, 0x91, 0x9b, 0x9e, 0x78, 0x13, 0xe0,
+	0x3b, 0x88, 0xc0, 0xea, 0x01, 0x95, 0x39, 0x1a, 0x7f, 0xbd, 0x8b, 0x3f, 0xcf, 0x4d, 0x96, 0xbe,
+	0x03, 0xf0, 0x1d, 0xf4, 0x0d, 0xa0, 0x3c, 0x49, 0x47, 0x79, 0x8c, 0x52, 0x26, 0x7f, 0x7d, 0x48,
+	0xfe, 0x00, 0xcb, 0x63, 0x94, 0x18, 0xfd, 0xac, 0xbc, 0x22, 0x33, 0x1c, 0xbe, 0xb9, 0x79, 0x93,
+	0xda, 0x70, 0x85, 0x13, 0x58, 0x19, 0xe7, 0xba, 0xe8, 0x1a, 0xdb, 0x2c, 0x19, 0xbe, 0xd6, 0xf1,
+	0xdd, 0xda, 0xd7, 0x73, 0xfd, 0xa7, 0x67, 0x0b, 0xfa, 0x77, 0xb8, 0x5f, 0xfe, 0x7f, 0x00, 0x17,
+	0x37, 0xbb, 0x7e, 0xb4, 0x1b, 0x00, 0x00,
 }
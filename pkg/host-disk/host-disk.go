@@ -33,6 +33,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -105,6 +106,44 @@ func ReplacePVCByHostDisk(vmi *v1.VirtualMachineInstance) error {
 	return nil
 }
 
+// ApplyMigratedVolumesSize sets the capacity of the host-disks which receive the data of
+// migrated volumes to the size of the disk images on the source, as reported in the volume
+// status. It returns false if the size of a disk image was not reported yet.
+func ApplyMigratedVolumesSize(vmi *v1.VirtualMachineInstance) bool {
+	if vmi.Status.MigrationState == nil {
+		return true
+	}
+	sizes := make(map[string]int64, len(vmi.Status.VolumeStatus))
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		sizes[volumeStatus.Name] = volumeStatus.Size
+	}
+
+	for i := range vmi.Spec.Volumes {
+		hostDisk := vmi.Spec.Volumes[i].HostDisk
+		if hostDisk == nil || !isMigratedVolume(vmi, vmi.Spec.Volumes[i].Name) {
+			continue
+		}
+		size := sizes[vmi.Spec.Volumes[i].Name]
+		if size == 0 {
+			return false
+		}
+		hostDisk.Capacity = *resource.NewQuantity(size, resource.BinarySI)
+	}
+	return true
+}
+
+func isMigratedVolume(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	if vmi.Status.MigrationState == nil {
+		return false
+	}
+	for _, migratedVolume := range vmi.Status.MigrationState.MigratedVolumes {
+		if migratedVolume.VolumeName == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
 func replaceForHostDisk(volumeSource *v1.VolumeSource, volumeName string, pvcVolume map[string]v1.VolumeStatus) error {
 	volumeStatus := pvcVolume[volumeName]
 	isShared := types.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes)
@@ -240,10 +279,20 @@ func (hdc *DiskImgCreator) mountHostDiskAndSetOwnership(vmi *v1.VirtualMachineIn
 	if err != nil {
 		return err
 	}
+	migrated := isMigratedVolume(vmi, volumeName)
 	if !fileExists {
-		if err := hdc.handleRequestedSizeAndCreateSparseRaw(vmi, diskDir, diskPath, hostDisk); err != nil {
+		if err := hdc.handleRequestedSizeAndCreateSparseRaw(vmi, diskDir, diskPath, hostDisk, migrated); err != nil {
 			return err
 		}
+	} else if migrated {
+		// The data of the source disk image has to fit into an already existing disk image
+		fileInfo, err := os.Stat(diskPath)
+		if err != nil {
+			return err
+		}
+		if requestedSize, _ := hostDisk.Capacity.AsInt64(); fileInfo.Size() < requestedSize {
+			return fmt.Errorf("disk image %s with a size of %d B is smaller than the disk image of the migrated volume with a size of %d B", hostDisk.Path, fileInfo.Size(), requestedSize)
+		}
 	}
 	// Change file ownership to the qemu user.
 	if err := ephemeraldiskutils.DefaultOwnershipManager.UnsafeSetFileOwnership(diskPath); err != nil {
@@ -253,14 +302,18 @@ func (hdc *DiskImgCreator) mountHostDiskAndSetOwnership(vmi *v1.VirtualMachineIn
 	return nil
 }
 
-func (hdc *DiskImgCreator) handleRequestedSizeAndCreateSparseRaw(vmi *v1.VirtualMachineInstance, diskDir string, diskPath string, hostDisk *v1.HostDisk) error {
+func (hdc *DiskImgCreator) handleRequestedSizeAndCreateSparseRaw(vmi *v1.VirtualMachineInstance, diskDir string, diskPath string, hostDisk *v1.HostDisk, migrated bool) error {
 	size, err := hdc.dirBytesAvailableFunc(diskDir, hdc.minimumPVCReserveBytes)
 	availableSize := int64(size)
 	if err != nil {
 		return err
 	}
 	requestedSize, _ := hostDisk.Capacity.AsInt64()
-	if requestedSize > availableSize {
+	if requestedSize > availableSize && migrated {
+		// The disk image has to hold all data of the disk image on the source
+		return fmt.Errorf("unable to create %s, not enough space for the disk image of the migrated volume, demanded size %d B is bigger than available space %d B",
+			hostDisk.Path, uint64(requestedSize), availableSize)
+	} else if requestedSize > availableSize {
 		requestedSize, err = hdc.shrinkRequestedSize(vmi, requestedSize, availableSize, hostDisk)
 		if err != nil {
 			return err
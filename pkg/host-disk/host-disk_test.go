@@ -366,6 +366,58 @@ var _ = Describe("HostDisk", func() {
 		})
 	})
 
+	Describe("HostDisk of a migrated volume", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("fake-vmi")
+			addHostDisk(vmi, "volume1", v1.HostDiskExistsOrCreate, "128Mi")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigratedVolumes: []v1.StorageMigratedVolume{{VolumeName: "volume1", DestinationClaim: "dst"}},
+			}
+		})
+
+		It("Should wait for the size of the disk image on the source", func() {
+			Expect(ApplyMigratedVolumesSize(vmi)).To(BeFalse())
+		})
+
+		It("Should create disk.img with the size of the disk image on the source", func() {
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "volume1", Size: 67108864}}
+			Expect(ApplyMigratedVolumesSize(vmi)).To(BeTrue())
+
+			Expect(hostDiskCreator.Create(vmi)).To(Succeed())
+
+			img, err := os.Stat(vmi.Spec.Volumes[0].HostDisk.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(img.Size()).To(Equal(int64(67108864))) // 64Mi
+		})
+
+		It("Should not shrink disk.img if there is not enough space", func() {
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "volume1", Size: 67108864}}
+			Expect(ApplyMigratedVolumesSize(vmi)).To(BeTrue())
+			hostDiskCreator.setlessPVCSpaceToleration(10)
+			hostDiskCreator.dirBytesAvailableFunc = func(path string, reserve uint64) (uint64, error) {
+				return 67108864 - 1048576, nil
+			}
+
+			err := hostDiskCreator.Create(vmi)
+			Expect(err).To(MatchError(ContainSubstring("not enough space for the disk image of the migrated volume")))
+
+			_, err = os.Stat(vmi.Spec.Volumes[0].HostDisk.Path)
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+
+		It("Should refuse an existing disk.img which is smaller than the disk image on the source", func() {
+			// 67108864 = 64Mi
+			Expect(createSparseRaw(vmi.Spec.Volumes[0].HostDisk.Path, 67108864)).To(Succeed())
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "volume1", Size: 134217728}}
+			Expect(ApplyMigratedVolumesSize(vmi)).To(BeTrue())
+
+			err := hostDiskCreator.Create(vmi)
+			Expect(err).To(MatchError(ContainSubstring("is smaller than the disk image of the migrated volume")))
+		})
+	})
+
 	Describe("VMI with PVC volume", func() {
 
 		var (
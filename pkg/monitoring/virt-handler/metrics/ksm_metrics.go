@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+)
+
+var (
+	ksmMetrics = []operatormetrics.Metric{
+		ksmRunning,
+		ksmPagesShared,
+		ksmPagesSharing,
+		ksmSavedMemory,
+	}
+
+	ksmRunning = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ksm_running",
+			Help: "Indication for KSM merging pages on the node.",
+		},
+		[]string{"node"},
+	)
+
+	ksmPagesShared = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ksm_pages_shared",
+			Help: "The number of shared pages which are in use on the node.",
+		},
+		[]string{"node"},
+	)
+
+	ksmPagesSharing = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ksm_pages_sharing",
+			Help: "The number of additional sites which share the shared pages on the node.",
+		},
+		[]string{"node"},
+	)
+
+	ksmSavedMemory = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_ksm_saved_memory_bytes",
+			Help: "The amount of memory saved by KSM on the node.",
+		},
+		[]string{"node"},
+	)
+)
+
+func SetKSMMetrics(node string, running bool, pagesShared, pagesSharing, savedMemoryBytes int64) {
+	runningValue := 0.0
+	if running {
+		runningValue = 1.0
+	}
+	ksmRunning.WithLabelValues(node).Set(runningValue)
+	ksmPagesShared.WithLabelValues(node).Set(float64(pagesShared))
+	ksmPagesSharing.WithLabelValues(node).Set(float64(pagesSharing))
+	ksmSavedMemory.WithLabelValues(node).Set(float64(savedMemoryBytes))
+}
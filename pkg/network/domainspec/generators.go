@@ -87,6 +87,20 @@ func NewBridgeLibvirtSpecGenerator(
 	}
 }
 
+func NewTapLibvirtSpecGenerator(
+	iface *v1.Interface,
+	domain *api.Domain,
+	tapName string,
+	handler netdriver.NetworkHandler,
+) *TapLibvirtSpecGenerator {
+	return &TapLibvirtSpecGenerator{
+		vmiSpecIface: iface,
+		domain:       domain,
+		tapName:      tapName,
+		handler:      handler,
+	}
+}
+
 func NewPasstLibvirtSpecGenerator(
 	iface *v1.Interface,
 	domain *api.Domain,
@@ -248,6 +262,54 @@ func (b *MacvtapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface,
 	}, nil
 }
 
+type TapLibvirtSpecGenerator struct {
+	vmiSpecIface *v1.Interface
+	domain       *api.Domain
+	tapName      string
+	handler      netdriver.NetworkHandler
+}
+
+func (b *TapLibvirtSpecGenerator) Generate() error {
+	domainIface, err := b.discoverDomainIfaceSpec()
+	if err != nil {
+		return err
+	}
+	ifaces := b.domain.Spec.Devices.Interfaces
+	for i, iface := range ifaces {
+		if iface.Alias.GetName() == b.vmiSpecIface.Name {
+			ifaces[i].MTU = domainIface.MTU
+			ifaces[i].MAC = domainIface.MAC
+			ifaces[i].Target = domainIface.Target
+			break
+		}
+	}
+	return nil
+}
+
+func (b *TapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface, error) {
+	tapLink, err := b.handler.LinkByName(b.tapName)
+	if err != nil {
+		log.Log.Reason(err).Errorf(linkIfaceFailFmt, b.tapName)
+		return nil, err
+	}
+	mac, err := virtnetlink.RetrieveMacAddressFromVMISpecIface(b.vmiSpecIface)
+	if err != nil {
+		return nil, err
+	}
+
+	domainIface := &api.Interface{
+		MTU: &api.MTU{Size: strconv.Itoa(tapLink.Attrs().MTU)},
+		Target: &api.InterfaceTarget{
+			Device:  b.tapName,
+			Managed: "no",
+		},
+	}
+	if mac != nil {
+		domainIface.MAC = &api.MAC{MAC: mac.String()}
+	}
+	return domainIface, nil
+}
+
 type PasstLibvirtSpecGenerator struct {
 	vmiSpecIface     *v1.Interface
 	domain           *api.Domain
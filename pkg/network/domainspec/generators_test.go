@@ -103,6 +103,39 @@ var _ = Describe("Pod Network", func() {
 			})
 		})
 
+		Context("Tap plug", func() {
+			const (
+				networkName = "default"
+				tapName     = "tap0"
+			)
+
+			var domain *api.Domain
+
+			BeforeEach(func() {
+				domain = NewDomainWithMacvtapInterface(networkName)
+				api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
+				tapLink := &netlink.Tuntap{LinkAttrs: netlink.LinkAttrs{Name: tapName, MTU: mtu}}
+				mockNetwork.EXPECT().LinkByName(tapName).Return(tapLink, nil)
+			})
+
+			It("Should pass the existing tap device to qemu", func() {
+				iface := &v1.Interface{Name: networkName, Binding: &v1.PluginBinding{Name: "tapplugin"}}
+				Expect(NewTapLibvirtSpecGenerator(iface, domain, tapName, mockNetwork).Generate()).To(Succeed())
+
+				Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+				Expect(domain.Spec.Devices.Interfaces[0].Target).To(Equal(&api.InterfaceTarget{Device: tapName, Managed: "no"}))
+				Expect(domain.Spec.Devices.Interfaces[0].MTU).To(Equal(&api.MTU{Size: "1410"}))
+				Expect(domain.Spec.Devices.Interfaces[0].MAC).To(BeNil(), "should leave the MAC address to libvirt")
+			})
+
+			It("Should set the MAC address specified by the interface", func() {
+				iface := &v1.Interface{Name: networkName, Binding: &v1.PluginBinding{Name: "tapplugin"}, MacAddress: fakeMac.String()}
+				Expect(NewTapLibvirtSpecGenerator(iface, domain, tapName, mockNetwork).Generate()).To(Succeed())
+
+				Expect(domain.Spec.Devices.Interfaces[0].MAC).To(Equal(&api.MAC{MAC: fakeMac.String()}))
+			})
+		})
+
 		Context("Passt plug", func() {
 			const podIfaceName = "eth0"
 			var specGenerator *PasstLibvirtSpecGenerator
@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package announce
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ethernetHeaderLen = 14
+	arpLen            = 28
+	ipv6HeaderLen     = 40
+	icmpv6NALen       = 24
+	targetLLAOptLen   = 8
+
+	etherTypeARP  = 0x0806
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+
+	arpHardwareTypeEthernet = 1
+	arpOperationRequest     = 1
+
+	protocolICMPv6                   = 58
+	icmpv6TypeNeighborAdvertisement  = 136
+	neighborAdvertisementOverrideBit = 0x20
+	optionTargetLinkLayerAddress     = 2
+	hopLimitNeighborDiscovery        = 255
+)
+
+var (
+	broadcastMAC      = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	allNodesMAC       = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+	allNodesMulticast = net.IPv6linklocalallnodes
+)
+
+// Announcer advertises addresses on behalf of a guest, updating the neighbor tables and the
+// forwarding databases of the network the given link is connected to.
+type Announcer struct{}
+
+// GratuitousARP sends a gratuitous ARP request for the given IPv4 address, originated from the given MAC address.
+func (a Announcer) GratuitousARP(linkName string, mac net.HardwareAddr, ip net.IP) error {
+	frame, err := gratuitousARPFrame(mac, ip)
+	if err != nil {
+		return err
+	}
+	return sendFrame(linkName, broadcastMAC, frame)
+}
+
+// UnsolicitedNeighborAdvertisement sends an unsolicited neighbor advertisement with the override flag
+// for the given IPv6 address, originated from the given MAC address.
+func (a Announcer) UnsolicitedNeighborAdvertisement(linkName string, mac net.HardwareAddr, ip net.IP) error {
+	frame, err := unsolicitedNeighborAdvertisementFrame(mac, ip)
+	if err != nil {
+		return err
+	}
+	return sendFrame(linkName, allNodesMAC, frame)
+}
+
+func gratuitousARPFrame(mac net.HardwareAddr, ip net.IP) ([]byte, error) {
+	ipv4 := ip.To4()
+	if ipv4 == nil {
+		return nil, fmt.Errorf("gratuitous ARP requires an IPv4 address, got %s", ip)
+	}
+	if len(mac) != 6 {
+		return nil, fmt.Errorf("gratuitous ARP requires an ethernet MAC address, got %s", mac)
+	}
+
+	frame := make([]byte, ethernetHeaderLen+arpLen)
+	writeEthernetHeader(frame, broadcastMAC, mac, etherTypeARP)
+
+	arp := frame[ethernetHeaderLen:]
+	binary.BigEndian.PutUint16(arp[0:2], arpHardwareTypeEthernet)
+	binary.BigEndian.PutUint16(arp[2:4], etherTypeIPv4)
+	arp[4] = 6
+	arp[5] = net.IPv4len
+	binary.BigEndian.PutUint16(arp[6:8], arpOperationRequest)
+	copy(arp[8:14], mac)
+	copy(arp[14:18], ipv4)
+	// The target hardware address is left zeroed, the target protocol address is the announced one.
+	copy(arp[24:28], ipv4)
+
+	return frame, nil
+}
+
+func unsolicitedNeighborAdvertisementFrame(mac net.HardwareAddr, ip net.IP) ([]byte, error) {
+	if ip.To4() != nil || ip.To16() == nil {
+		return nil, fmt.Errorf("neighbor advertisement requires an IPv6 address, got %s", ip)
+	}
+	if len(mac) != 6 {
+		return nil, fmt.Errorf("neighbor advertisement requires an ethernet MAC address, got %s", mac)
+	}
+
+	const payloadLen = icmpv6NALen + targetLLAOptLen
+	frame := make([]byte, ethernetHeaderLen+ipv6HeaderLen+payloadLen)
+	writeEthernetHeader(frame, allNodesMAC, mac, etherTypeIPv6)
+
+	ipv6Header := frame[ethernetHeaderLen : ethernetHeaderLen+ipv6HeaderLen]
+	ipv6Header[0] = 0x60
+	binary.BigEndian.PutUint16(ipv6Header[4:6], payloadLen)
+	ipv6Header[6] = protocolICMPv6
+	ipv6Header[7] = hopLimitNeighborDiscovery
+	copy(ipv6Header[8:24], ip.To16())
+	copy(ipv6Header[24:40], allNodesMulticast)
+
+	icmp := frame[ethernetHeaderLen+ipv6HeaderLen:]
+	icmp[0] = icmpv6TypeNeighborAdvertisement
+	icmp[4] = neighborAdvertisementOverrideBit
+	copy(icmp[8:24], ip.To16())
+	icmp[24] = optionTargetLinkLayerAddress
+	icmp[25] = 1 // The option length is in units of 8 octets
+	copy(icmp[26:32], mac)
+	binary.BigEndian.PutUint16(icmp[2:4], icmpv6Checksum(ip.To16(), allNodesMulticast, icmp))
+
+	return frame, nil
+}
+
+func writeEthernetHeader(frame []byte, dst, src net.HardwareAddr, etherType uint16) {
+	copy(frame[0:6], dst)
+	copy(frame[6:12], src)
+	binary.BigEndian.PutUint16(frame[12:14], etherType)
+}
+
+// icmpv6Checksum calculates the checksum of an ICMPv6 message, including the IPv6 pseudo-header.
+// https://www.rfc-editor.org/rfc/rfc4443#section-2.3
+func icmpv6Checksum(src, dst net.IP, message []byte) uint16 {
+	var sum uint32
+	add := func(b []byte) {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(binary.BigEndian.Uint16(b[i : i+2]))
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+
+	add(src)
+	add(dst)
+	sum += uint32(len(message))
+	sum += protocolICMPv6
+	add(message)
+
+	for sum>>16 != 0 {
+		sum = (sum & 0xffff) + (sum >> 16)
+	}
+	return ^uint16(sum)
+}
+
+func sendFrame(linkName string, dst net.HardwareAddr, frame []byte) error {
+	link, err := net.InterfaceByName(linkName)
+	if err != nil {
+		return fmt.Errorf("failed to find link %s: %v", linkName, err)
+	}
+
+	// A protocol of zero makes the socket a send-only socket.
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open a packet socket: %v", err)
+	}
+	defer closeSocketIgnoringError(fd)
+
+	addr := &unix.SockaddrLinklayer{
+		Ifindex: link.Index,
+		Halen:   uint8(len(dst)),
+	}
+	copy(addr.Addr[:], dst)
+	if err := unix.Sendto(fd, frame, 0, addr); err != nil {
+		return fmt.Errorf("failed to send frame on link %s: %v", linkName, err)
+	}
+	return nil
+}
+
+func closeSocketIgnoringError(fd int) {
+	_ = unix.Close(fd)
+}
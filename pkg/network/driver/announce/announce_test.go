@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package announce
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("announce", func() {
+	guestMAC := net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}
+
+	It("should build a gratuitous ARP request", func() {
+		frame, err := gratuitousARPFrame(guestMAC, net.ParseIP("10.1.1.10"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frame).To(Equal([]byte{
+			// Ethernet
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x01,
+			0x08, 0x06,
+			// ARP
+			0x00, 0x01, 0x08, 0x00, 0x06, 0x04, 0x00, 0x01,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 10, 1, 1, 10,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 10, 1, 1, 10,
+		}))
+	})
+
+	It("should build an unsolicited neighbor advertisement", func() {
+		ip := net.ParseIP("fd10::10")
+		frame, err := unsolicitedNeighborAdvertisementFrame(guestMAC, ip)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frame).To(HaveLen(ethernetHeaderLen + ipv6HeaderLen + icmpv6NALen + targetLLAOptLen))
+
+		Expect(frame[0:ethernetHeaderLen]).To(Equal([]byte{
+			0x33, 0x33, 0x00, 0x00, 0x00, 0x01,
+			0x02, 0x00, 0x00, 0x00, 0x00, 0x01,
+			0x86, 0xdd,
+		}))
+
+		ipv6Header := frame[ethernetHeaderLen : ethernetHeaderLen+ipv6HeaderLen]
+		Expect(ipv6Header[0:8]).To(Equal([]byte{0x60, 0x00, 0x00, 0x00, 0x00, 32, 58, 255}))
+		Expect(net.IP(ipv6Header[8:24]).Equal(ip)).To(BeTrue())
+		Expect(net.IP(ipv6Header[24:40]).Equal(net.IPv6linklocalallnodes)).To(BeTrue())
+
+		icmp := frame[ethernetHeaderLen+ipv6HeaderLen:]
+		Expect(icmp[0]).To(Equal(byte(136)))
+		Expect(icmp[4]).To(Equal(byte(0x20)), "only the override flag should be set")
+		Expect(net.IP(icmp[8:24]).Equal(ip)).To(BeTrue())
+		Expect(icmp[24:32]).To(Equal([]byte{0x02, 0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01}))
+		Expect(icmpv6Checksum(ip, net.IPv6linklocalallnodes, icmp)).To(BeZero(), "the checksum should verify")
+	})
+
+	It("should fail to build a gratuitous ARP request for an IPv6 address", func() {
+		_, err := gratuitousARPFrame(guestMAC, net.ParseIP("fd10::10"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to build a neighbor advertisement for an IPv4 address", func() {
+		_, err := unsolicitedNeighborAdvertisementFrame(guestMAC, net.ParseIP("10.1.1.10"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -50,20 +50,9 @@ func NetBindingPluginSidecarList(vmi *v1.VirtualMachineInstance, config *v1.Kube
 
 func netBindingPluginSidecar(vmi *v1.VirtualMachineInstance, config *v1.KubeVirtConfiguration) (hooks.HookSidecarList, error) {
 	var pluginSidecars hooks.HookSidecarList
-	bindingByName := map[string]v1.InterfaceBindingPlugin{}
-	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
-		if iface.Binding != nil {
-			var exist bool
-			var pluginInfo v1.InterfaceBindingPlugin
-			if config.NetworkConfiguration != nil && config.NetworkConfiguration.Binding != nil {
-				pluginInfo, exist = config.NetworkConfiguration.Binding[iface.Binding.Name]
-				bindingByName[iface.Binding.Name] = pluginInfo
-			}
-
-			if !exist {
-				return nil, fmt.Errorf("couldn't find configuration for network bindining: %s", iface.Binding.Name)
-			}
-		}
+	bindingByName, err := bindingPluginsByName(vmi.Spec.Domain.Devices.Interfaces, config)
+	if err != nil {
+		return nil, err
 	}
 
 	for _, pluginInfo := range bindingByName {
@@ -78,6 +67,62 @@ func netBindingPluginSidecar(vmi *v1.VirtualMachineInstance, config *v1.KubeVirt
 	return pluginSidecars, nil
 }
 
+func bindingPluginsByName(ifaces []v1.Interface, config *v1.KubeVirtConfiguration) (map[string]v1.InterfaceBindingPlugin, error) {
+	bindingByName := map[string]v1.InterfaceBindingPlugin{}
+	for _, iface := range ifaces {
+		if iface.Binding != nil {
+			pluginInfo := ReadNetBindingPluginConfiguration(config, iface.Binding.Name)
+			if pluginInfo == nil {
+				return nil, fmt.Errorf("couldn't find configuration for network bindining: %s", iface.Binding.Name)
+			}
+			bindingByName[iface.Binding.Name] = *pluginInfo
+		}
+	}
+	return bindingByName, nil
+}
+
+// DomainAttachmentByInterfaceName returns the domain attachment type of each interface
+// which is bound by a network binding plugin that declares one.
+func DomainAttachmentByInterfaceName(ifaces []v1.Interface, config *v1.KubeVirtConfiguration) map[string]string {
+	domainAttachmentByInterfaceName := map[string]string{}
+	for _, iface := range ifaces {
+		if iface.Binding == nil {
+			continue
+		}
+		if plugin := ReadNetBindingPluginConfiguration(config, iface.Binding.Name); plugin != nil && plugin.DomainAttachmentType != "" {
+			domainAttachmentByInterfaceName[iface.Name] = string(plugin.DomainAttachmentType)
+		}
+	}
+	return domainAttachmentByInterfaceName
+}
+
+// ComputeResourceOverhead sums the compute resource overhead of the network binding plugins used by the VMI.
+// The overhead of a plugin is accounted once, regardless of the number of interfaces using it.
+func ComputeResourceOverhead(vmi *v1.VirtualMachineInstance, config *v1.KubeVirtConfiguration) k8scorev1.ResourceRequirements {
+	overhead := k8scorev1.ResourceRequirements{
+		Requests: k8scorev1.ResourceList{},
+		Limits:   k8scorev1.ResourceList{},
+	}
+	// Unregistered plugins are reported when the sidecars are rendered.
+	bindingByName, _ := bindingPluginsByName(vmi.Spec.Domain.Devices.Interfaces, config)
+	for _, plugin := range bindingByName {
+		if plugin.ComputeResourceOverhead == nil {
+			continue
+		}
+		addResources(overhead.Requests, plugin.ComputeResourceOverhead.Requests)
+		addResources(overhead.Limits, plugin.ComputeResourceOverhead.Limits)
+	}
+	return overhead
+}
+
+func addResources(dst, src k8scorev1.ResourceList) {
+	for name, quantity := range src {
+		total := dst[name]
+		total.Add(quantity)
+		dst[name] = total
+	}
+}
+
 const (
 	SlirpNetworkBindingPluginName = "slirp"
 	DefaultSlirpPluginImage       = "quay.io/kubevirt/network-slirp-binding:20230830_638c60fc8"
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -232,4 +233,77 @@ var _ = Describe("Network Binding", func() {
 			))
 		})
 	})
+
+	Context("domain attachment", func() {
+		It("should map interfaces bound by plugins with a domain attachment type", func() {
+			ifaces := []v1.Interface{
+				{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}},
+				{Name: testNetworkName2, Binding: &v1.PluginBinding{Name: testBindingName2}},
+				{Name: "net3", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			config := &v1.KubeVirtConfiguration{
+				NetworkConfiguration: &v1.NetworkConfiguration{
+					Binding: map[string]v1.InterfaceBindingPlugin{
+						testBindingName1: {DomainAttachmentType: v1.VhostUser},
+						testBindingName2: {SidecarImage: testSidecarImage2},
+					},
+				},
+			}
+
+			Expect(netbinding.DomainAttachmentByInterfaceName(ifaces, config)).To(Equal(map[string]string{
+				testNetworkName1: string(v1.VhostUser),
+			}))
+		})
+	})
+
+	Context("compute resource overhead", func() {
+		It("should account the overhead of each plugin once", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+				libvmi.WithInterface(v1.Interface{Name: testNetworkName2, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName2}),
+				libvmi.WithInterface(v1.Interface{Name: "net3", Binding: &v1.PluginBinding{Name: testBindingName2}}),
+				libvmi.WithNetwork(&v1.Network{Name: "net3"}),
+			)
+			config := &v1.KubeVirtConfiguration{
+				NetworkConfiguration: &v1.NetworkConfiguration{
+					Binding: map[string]v1.InterfaceBindingPlugin{
+						testBindingName1: {ComputeResourceOverhead: &k8scorev1.ResourceRequirements{
+							Requests: k8scorev1.ResourceList{k8scorev1.ResourceMemory: resource.MustParse("100Mi")},
+							Limits:   k8scorev1.ResourceList{k8scorev1.ResourceMemory: resource.MustParse("200Mi")},
+						}},
+						testBindingName2: {ComputeResourceOverhead: &k8scorev1.ResourceRequirements{
+							Requests: k8scorev1.ResourceList{
+								k8scorev1.ResourceMemory: resource.MustParse("50Mi"),
+								k8scorev1.ResourceCPU:    resource.MustParse("100m"),
+							},
+						}},
+					},
+				},
+			}
+
+			overhead := netbinding.ComputeResourceOverhead(vmi, config)
+			Expect(overhead.Requests.Memory().Cmp(resource.MustParse("150Mi"))).To(BeZero())
+			Expect(overhead.Requests.Cpu().Cmp(resource.MustParse("100m"))).To(BeZero())
+			Expect(overhead.Limits.Memory().Cmp(resource.MustParse("200Mi"))).To(BeZero())
+			Expect(overhead.Limits).ToNot(HaveKey(k8scorev1.ResourceCPU))
+		})
+
+		It("should be empty when no plugin declares an overhead", func() {
+			vmi := libvmi.New(
+				libvmi.WithInterface(v1.Interface{Name: testNetworkName1, Binding: &v1.PluginBinding{Name: testBindingName1}}),
+				libvmi.WithNetwork(&v1.Network{Name: testNetworkName1}),
+			)
+			config := &v1.KubeVirtConfiguration{
+				NetworkConfiguration: &v1.NetworkConfiguration{
+					Binding: map[string]v1.InterfaceBindingPlugin{testBindingName1: {}},
+				},
+			}
+
+			overhead := netbinding.ComputeResourceOverhead(vmi, config)
+			Expect(overhead.Requests).To(BeEmpty())
+			Expect(overhead.Limits).To(BeEmpty())
+		})
+	})
 })
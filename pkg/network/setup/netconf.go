@@ -46,11 +46,16 @@ type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
 
+type clusterConfigurer interface {
+	GetNetworkBindings() map[string]v1.InterfaceBindingPlugin
+}
+
 type NetConf struct {
-	cacheCreator     cacheCreator
-	nsFactory        nsFactory
-	state            map[string]*netpod.State
-	configStateMutex *sync.RWMutex
+	cacheCreator      cacheCreator
+	nsFactory         nsFactory
+	state             map[string]*netpod.State
+	configStateMutex  *sync.RWMutex
+	clusterConfigurer clusterConfigurer
 }
 
 type nsFactory func(int) NSExecutor
@@ -59,11 +64,13 @@ type NSExecutor interface {
 	Do(func() error) error
 }
 
-func NewNetConf() *NetConf {
+func NewNetConf(clusterConfigurer clusterConfigurer) *NetConf {
 	var cacheFactory cache.CacheCreator
-	return NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
+	netConf := NewNetConfWithCustomFactoryAndConfigState(func(pid int) NSExecutor {
 		return netns.New(pid)
 	}, cacheFactory, map[string]*netpod.State{})
+	netConf.clusterConfigurer = clusterConfigurer
+	return netConf
 }
 
 func NewNetConfWithCustomFactoryAndConfigState(nsFactory nsFactory, cacheCreator cacheCreator, state map[string]*netpod.State) *NetConf {
@@ -81,20 +88,9 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		return fmt.Errorf("setup failed at pre-setup stage, err: %w", err)
 	}
 
-	c.configStateMutex.RLock()
-	state, ok := c.state[string(vmi.UID)]
-	c.configStateMutex.RUnlock()
-	if !ok {
-		cache := NewConfigStateCache(string(vmi.UID), c.cacheCreator)
-		configStateCache, err := upgradeConfigStateCache(&cache, networks, c.cacheCreator, string(vmi.UID))
-		if err != nil {
-			return err
-		}
-		ns := c.nsFactory(launcherPid)
-		state = netpod.NewState(configStateCache, ns)
-		c.configStateMutex.Lock()
-		c.state[string(vmi.UID)] = state
-		c.configStateMutex.Unlock()
+	state, err := c.podState(vmi, networks, launcherPid)
+	if err != nil {
+		return err
 	}
 
 	ownerID, _ := strconv.Atoi(netdriver.LibvirtUserAndGroupId)
@@ -112,6 +108,8 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 		state,
 		netpod.WithMasqueradeAdapter(newMasqueradeAdapter(vmi)),
 		netpod.WithCacheCreator(c.cacheCreator),
+		netpod.WithBindingPlugins(c.bindingPlugins()),
+		netpod.WithMigrationSourceIfacesStatus(migrationSourceIfacesStatus(vmi)),
 	)
 
 	if err := netpod.Setup(); err != nil {
@@ -120,6 +118,74 @@ func (c *NetConf) Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, l
 	return nil
 }
 
+// Announce advertises the guest addresses of the migrated interfaces from an existing virt-launcher pod.
+func (c *NetConf) Announce(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	state, err := c.podState(vmi, vmi.Spec.Networks, launcherPid)
+	if err != nil {
+		return err
+	}
+
+	netpod := netpod.NewNetPod(
+		vmi.Spec.Networks,
+		vmi.Spec.Domain.Devices.Interfaces,
+		string(vmi.UID),
+		launcherPid,
+		0,
+		0,
+		state,
+		netpod.WithCacheCreator(c.cacheCreator),
+	)
+
+	if err := netpod.Announce(vmi.Status.Interfaces); err != nil {
+		return fmt.Errorf("announce failed, err: %w", err)
+	}
+	return nil
+}
+
+func (c *NetConf) podState(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int) (*netpod.State, error) {
+	c.configStateMutex.RLock()
+	state, ok := c.state[string(vmi.UID)]
+	c.configStateMutex.RUnlock()
+	if ok {
+		return state, nil
+	}
+
+	cache := NewConfigStateCache(string(vmi.UID), c.cacheCreator)
+	configStateCache, err := upgradeConfigStateCache(&cache, networks, c.cacheCreator, string(vmi.UID))
+	if err != nil {
+		return nil, err
+	}
+	ns := c.nsFactory(launcherPid)
+	state = netpod.NewState(configStateCache, ns)
+	c.configStateMutex.Lock()
+	c.state[string(vmi.UID)] = state
+	c.configStateMutex.Unlock()
+	return state, nil
+}
+
+// migrationSourceIfacesStatus returns the interfaces status reported by the migration source,
+// when the VMI is being migrated and not yet handed over to the target pod.
+// In such a case, the network setup is performed for the target pod.
+func migrationSourceIfacesStatus(vmi *v1.VirtualMachineInstance) []v1.VirtualMachineInstanceNetworkInterface {
+	migrationState := vmi.Status.MigrationState
+	isMigrationTarget := migrationState != nil &&
+		!migrationState.Completed &&
+		!migrationState.Failed &&
+		migrationState.TargetNode != "" &&
+		migrationState.TargetNode != vmi.Status.NodeName
+	if !isMigrationTarget {
+		return nil
+	}
+	return vmi.Status.Interfaces
+}
+
+func (c *NetConf) bindingPlugins() map[string]v1.InterfaceBindingPlugin {
+	if c.clusterConfigurer == nil {
+		return nil
+	}
+	return c.clusterConfigurer.GetNetworkBindings()
+}
+
 func upgradeConfigStateCache(stateCache *ConfigStateCache, networks []v1.Network, cacheCreator cacheCreator, vmiUID string) (*ConfigStateCache, error) {
 	for networkName, podIfaceName := range namescheme.CreateOrdinalNetworkNameScheme(networks) {
 		exists, err := stateCache.Exists(podIfaceName)
@@ -55,6 +55,10 @@ func (n NetPod) discover(currentStatus *nmstate.Status) error {
 				return fmt.Errorf("pod link (%s) is missing", podIfaceName)
 			}
 
+			if sourceIfaceStatus := n.lookupMigrationSourceIfaceStatus(vmiSpecIface.Name); sourceIfaceStatus != nil {
+				podIfaceStatus = withMigrationSourceMAC(podIfaceStatus, *sourceIfaceStatus)
+			}
+
 			if err := n.storePodInterfaceData(vmiSpecIface, podIfaceStatus); err != nil {
 				return err
 			}
@@ -98,6 +102,7 @@ func (n NetPod) discover(currentStatus *nmstate.Status) error {
 		case vmiSpecIface.Binding != nil:
 		case vmiSpecIface.Macvtap != nil:
 		case vmiSpecIface.SRIOV != nil:
+		case vmiSpecIface.VhostUser != nil:
 		default:
 			return fmt.Errorf("undefined binding method: %v", vmiSpecIface)
 		}
@@ -0,0 +1,122 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package netpod
+
+import (
+	"fmt"
+	"net"
+
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
+	"kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+// Announce advertises the guest addresses of the bridge-bound secondary interfaces to the network
+// the pod is connected to, letting it learn the new location of the guest after a migration.
+// Gratuitous ARP requests are sent for IPv4 addresses and unsolicited neighbor advertisements for IPv6 ones.
+func (n NetPod) Announce(ifacesStatus []v1.VirtualMachineInstanceNetworkInterface) error {
+	return n.state.NSExec.Do(func() error {
+		currentStatus, err := n.nmstateAdapter.Read()
+		if err != nil {
+			return err
+		}
+		podIfaceNameByVMINetwork := createNetworkNameScheme(n.vmiSpecNets, currentStatus.Interfaces)
+
+		var announceErrors []error
+		for _, vmiSpecIface := range n.vmiSpecIfaces {
+			if !n.isBridgeBoundSecondaryIface(vmiSpecIface) {
+				continue
+			}
+			ifaceStatus := vmispec.LookupInterfaceStatusByName(ifacesStatus, vmiSpecIface.Name)
+			if ifaceStatus == nil {
+				continue
+			}
+
+			macAddress := ifaceStatus.MAC
+			if vmiSpecIface.MacAddress != "" {
+				macAddress = vmiSpecIface.MacAddress
+			}
+			mac, err := net.ParseMAC(macAddress)
+			if err != nil {
+				announceErrors = append(announceErrors, fmt.Errorf("failed to announce interface %s: %v", vmiSpecIface.Name, err))
+				continue
+			}
+
+			podIfaceName := podIfaceNameByVMINetwork[vmiSpecIface.Name]
+			linkName := link.GenerateNewBridgedVmiInterfaceName(podIfaceName)
+			for _, ip := range ifaceStatus.IPs {
+				if err := n.announce(linkName, mac, net.ParseIP(ip)); err != nil {
+					announceErrors = append(announceErrors, fmt.Errorf("failed to announce interface %s: %v", vmiSpecIface.Name, err))
+				}
+			}
+		}
+		return k8serrors.NewAggregate(announceErrors)
+	})
+}
+
+func (n NetPod) announce(linkName string, mac net.HardwareAddr, ip net.IP) error {
+	switch {
+	case ip == nil || !ip.IsGlobalUnicast():
+		return nil
+	case ip.To4() != nil:
+		log.Log.V(4).Infof("Sending a gratuitous ARP for %s (%s) on %s", ip, mac, linkName)
+		return n.announceAdapter.GratuitousARP(linkName, mac, ip)
+	default:
+		log.Log.V(4).Infof("Sending an unsolicited neighbor advertisement for %s (%s) on %s", ip, mac, linkName)
+		return n.announceAdapter.UnsolicitedNeighborAdvertisement(linkName, mac, ip)
+	}
+}
+
+// lookupMigrationSourceIfaceStatus returns the migration source status of the given interface,
+// when its MAC address should be carried to the migration target.
+// The pod network is not carried, as the guest leases the addresses of the target pod interface once migrated.
+func (n NetPod) lookupMigrationSourceIfaceStatus(ifaceName string) *v1.VirtualMachineInstanceNetworkInterface {
+	if len(n.migrationSourceIfacesStatus) == 0 {
+		return nil
+	}
+	vmiSpecIface := vmispec.LookupInterfaceByName(n.vmiSpecIfaces, ifaceName)
+	if vmiSpecIface == nil || !n.isBridgeBoundSecondaryIface(*vmiSpecIface) {
+		return nil
+	}
+	return vmispec.LookupInterfaceStatusByName(n.migrationSourceIfacesStatus, ifaceName)
+}
+
+func (n NetPod) isBridgeBoundSecondaryIface(vmiSpecIface v1.Interface) bool {
+	if vmiSpecIface.Bridge == nil || vmiSpecIface.State == v1.InterfaceStateAbsent {
+		return false
+	}
+	network := vmispec.LookupNetworkByName(n.vmiSpecNets, vmiSpecIface.Name)
+	return network != nil && network.Multus != nil && !network.Multus.Default
+}
+
+// withMigrationSourceMAC returns the pod interface status with the guest MAC address reported by the migration source.
+// The IP addresses are owned by the IPAM of the network and are kept as assigned to the target pod interface,
+// the guest keeps its addresses when the IPAM assigns the same ones to the target pod.
+func withMigrationSourceMAC(podIfaceStatus nmstate.Interface, sourceIfaceStatus v1.VirtualMachineInstanceNetworkInterface) nmstate.Interface {
+	if sourceIfaceStatus.MAC != "" {
+		podIfaceStatus.MacAddress = sourceIfaceStatus.MAC
+	}
+	return podIfaceStatus
+}
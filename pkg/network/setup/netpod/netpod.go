@@ -30,6 +30,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/driver/announce"
 	"kubevirt.io/kubevirt/pkg/network/driver/nmstate"
 	"kubevirt.io/kubevirt/pkg/network/driver/procsys"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
@@ -55,6 +56,11 @@ type masqueradeAdapter interface {
 	Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface, vmiIface v1.Interface) error
 }
 
+type announceAdapter interface {
+	GratuitousARP(linkName string, mac net.HardwareAddr, ip net.IP) error
+	UnsolicitedNeighborAdvertisement(linkName string, mac net.HardwareAddr, ip net.IP) error
+}
+
 type cacheCreator interface {
 	New(filePath string) *cache.Cache
 }
@@ -73,9 +79,13 @@ type NetPod struct {
 
 	nmstateAdapter    nmstateAdapter
 	masqueradeAdapter masqueradeAdapter
+	announceAdapter   announceAdapter
 
-	cacheCreator cacheCreator
-	state        *State
+	cacheCreator   cacheCreator
+	state          *State
+	bindingPlugins map[string]v1.InterfaceBindingPlugin
+
+	migrationSourceIfacesStatus []v1.VirtualMachineInstanceNetworkInterface
 }
 
 type option func(*NetPod)
@@ -92,6 +102,7 @@ func NewNetPod(vmiNetworks []v1.Network, vmiIfaces []v1.Interface, vmiUID string
 
 		nmstateAdapter:    nmstate.New(),
 		masqueradeAdapter: masquerade.New(),
+		announceAdapter:   announce.Announcer{},
 
 		cacheCreator: cache.CacheCreator{},
 	}
@@ -113,15 +124,36 @@ func WithMasqueradeAdapter(h masqueradeAdapter) option {
 	}
 }
 
+func WithAnnounceAdapter(a announceAdapter) option {
+	return func(n *NetPod) {
+		n.announceAdapter = a
+	}
+}
+
+// WithMigrationSourceIfacesStatus sets the interfaces status reported by the migration source.
+// The guest MAC address of bridge-bound secondary interfaces is carried from it,
+// preserving it on the migration target.
+func WithMigrationSourceIfacesStatus(ifacesStatus []v1.VirtualMachineInstanceNetworkInterface) option {
+	return func(n *NetPod) {
+		n.migrationSourceIfacesStatus = ifacesStatus
+	}
+}
+
 func WithCacheCreator(c cacheCreator) option {
 	return func(n *NetPod) {
 		n.cacheCreator = c
 	}
 }
 
+func WithBindingPlugins(bindingPlugins map[string]v1.InterfaceBindingPlugin) option {
+	return func(n *NetPod) {
+		n.bindingPlugins = bindingPlugins
+	}
+}
+
 func (n NetPod) Setup() error {
 	// Not all network bindings are processed in the network setup.
-	filteredNets, err := filterSupportedBindingNetworks(n.vmiSpecNets, n.vmiSpecIfaces)
+	filteredNets, err := n.filterSupportedBindingNetworks()
 	if err != nil {
 		return err
 	}
@@ -279,8 +311,15 @@ func (n NetPod) composeDesiredSpec(currentStatus *nmstate.Status) (*nmstate.Spec
 			spec.LinuxStack.IPv4.UnprivilegedPortStart = pointer.P(0)
 		case iface.Macvtap != nil:
 		case iface.SRIOV != nil:
+		case iface.VhostUser != nil:
 		case iface.Slirp != nil:
 		case iface.Binding != nil:
+			if n.isManagedTapBinding(iface) {
+				if _, exists := podIfaceStatusByName[podIfaceName]; !exists {
+					return nil, fmt.Errorf("pod link (%s) is missing", podIfaceName)
+				}
+				ifacesSpec = n.managedTapBindingSpec(podIfaceName, ifIndex, podIfaceStatusByName)
+			}
 		default:
 			return nil, fmt.Errorf("undefined binding method: %v", iface)
 		}
@@ -364,6 +403,57 @@ func (n NetPod) bridgeBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceS
 	return []nmstate.Interface{bridgeIface, podIface, tapIface, dummyIface}, nil
 }
 
+// managedTapBindingSpec connects a tap device to the pod interface through a bridge.
+// Unlike the bridge binding, the pod interface addresses are not handed over to the guest,
+// addressing is left to the network binding plugin.
+func (n NetPod) managedTapBindingSpec(podIfaceName string, vmiIfaceIndex int, ifaceStatusByName map[string]nmstate.Interface) []nmstate.Interface {
+	vmiNetworkName := n.vmiSpecIfaces[vmiIfaceIndex].Name
+	podStatusIface := ifaceStatusByName[podIfaceName]
+
+	bridgeIface := nmstate.Interface{
+		Name:     link.GenerateBridgeName(podIfaceName),
+		TypeName: nmstate.TypeBridge,
+		State:    nmstate.IfaceStateUp,
+		MTU:      podStatusIface.MTU,
+		Ethtool:  nmstate.Ethtool{Feature: nmstate.Feature{TxChecksum: pointer.P(false)}},
+		Metadata: &nmstate.IfaceMetadata{NetworkName: vmiNetworkName},
+	}
+
+	podIface := nmstate.Interface{
+		Index:      podStatusIface.Index,
+		Name:       podIfaceName,
+		Controller: bridgeIface.Name,
+		IPv4:       nmstate.IP{Enabled: pointer.P(false)},
+		IPv6:       nmstate.IP{Enabled: pointer.P(false)},
+		LinuxStack: nmstate.LinuxIfaceStack{PortLearning: pointer.P(false)},
+		Metadata:   &nmstate.IfaceMetadata{NetworkName: vmiNetworkName},
+	}
+
+	tapIface := nmstate.Interface{
+		Name:       link.GenerateTapDeviceName(podIfaceName),
+		TypeName:   nmstate.TypeTap,
+		State:      nmstate.IfaceStateUp,
+		MTU:        podStatusIface.MTU,
+		Controller: bridgeIface.Name,
+		Tap: &nmstate.TapDevice{
+			Queues: n.networkQueues(vmiIfaceIndex),
+			UID:    n.ownerID,
+			GID:    n.ownerID,
+		},
+		Metadata: &nmstate.IfaceMetadata{Pid: n.podPID, NetworkName: vmiNetworkName},
+	}
+
+	return []nmstate.Interface{bridgeIface, podIface, tapIface}
+}
+
+func (n NetPod) isManagedTapBinding(iface v1.Interface) bool {
+	if iface.Binding == nil {
+		return false
+	}
+	plugin, exists := n.bindingPlugins[iface.Binding.Name]
+	return exists && plugin.DomainAttachmentType == v1.ManagedTap
+}
+
 func (n NetPod) networkQueues(vmiIfaceIndex int) int {
 	ifaceModel := n.vmiSpecIfaces[vmiIfaceIndex].Model
 	if ifaceModel == "" {
@@ -539,15 +629,15 @@ func includesOrdinalNames(ifaces []nmstate.Interface) bool {
 	return false
 }
 
-func filterSupportedBindingNetworks(specNetworks []v1.Network, specInterfaces []v1.Interface) ([]v1.Network, error) {
+func (n NetPod) filterSupportedBindingNetworks() ([]v1.Network, error) {
 	var networks []v1.Network
-	for _, network := range specNetworks {
-		iface := vmispec.LookupInterfaceByName(specInterfaces, network.Name)
+	for _, network := range n.vmiSpecNets {
+		iface := vmispec.LookupInterfaceByName(n.vmiSpecIfaces, network.Name)
 		if iface == nil {
 			return nil, fmt.Errorf("no iface matching with network %s", network.Name)
 		}
 
-		if iface.Binding != nil || iface.SRIOV != nil || iface.Macvtap != nil {
+		if iface.Binding != nil && !n.isManagedTapBinding(*iface) || iface.SRIOV != nil || iface.Macvtap != nil || iface.VhostUser != nil {
 			continue
 		}
 
@@ -889,6 +889,150 @@ var _ = Describe("netpod", func() {
 		})
 	})
 
+	Context("secondary bridge binding on a migration target", func() {
+		const (
+			secondaryNetworkName      = "secondnetwork"
+			secondaryPodInterfaceName = "pod914f438d88d"
+
+			targetPodIfaceMAC  = "12:34:56:78:90:cd"
+			targetPodIfaceIPv4 = "10.10.0.20"
+			targetPodIfaceIPv6 = "fd10::20"
+			gatewayIPv4        = "10.10.0.1"
+
+			guestMAC  = "02:00:00:00:00:10"
+			guestIPv4 = "10.10.0.10"
+			guestIPv6 = "fd10::10"
+		)
+		var (
+			specNetworks   []v1.Network
+			specInterfaces []v1.Interface
+			sourceStatus   []v1.VirtualMachineInstanceNetworkInterface
+			nmstatestub    nmstateStub
+		)
+
+		BeforeEach(func() {
+			nmstatestub = nmstateStub{status: nmstate.Status{
+				Interfaces: []nmstate.Interface{{
+					Name:       secondaryPodInterfaceName,
+					Index:      1,
+					TypeName:   nmstate.TypeVETH,
+					State:      nmstate.IfaceStateUp,
+					MacAddress: targetPodIfaceMAC,
+					MTU:        1500,
+					IPv4: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: targetPodIfaceIPv4, PrefixLen: 24}},
+					},
+					IPv6: nmstate.IP{
+						Enabled: pointer.P(true),
+						Address: []nmstate.IPAddress{{IP: targetPodIfaceIPv6, PrefixLen: 64}},
+					},
+				}},
+				Routes: nmstate.Routes{Running: []nmstate.Route{
+					{Destination: "0.0.0.0/0", NextHopInterface: secondaryPodInterfaceName, NextHopAddress: gatewayIPv4},
+					{Destination: "10.10.0.0/24", NextHopInterface: secondaryPodInterfaceName, NextHopAddress: targetPodIfaceIPv4},
+				}},
+			}}
+			specNetworks = []v1.Network{{
+				Name:          secondaryNetworkName,
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "somenad"}},
+			}}
+			specInterfaces = []v1.Interface{{
+				Name:                   secondaryNetworkName,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}}
+			sourceStatus = []v1.VirtualMachineInstanceNetworkInterface{{
+				Name: secondaryNetworkName,
+				MAC:  guestMAC,
+				IP:   guestIPv4,
+				IPs:  []string{guestIPv4, guestIPv6},
+			}}
+		})
+
+		It("should carry the guest MAC reported by the migration source and keep the addresses assigned by the IPAM", func() {
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+				netpod.WithMigrationSourceIfacesStatus(sourceStatus),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			Expect(cache.ReadPodInterfaceCache(&baseCacheCreator, vmiUID, secondaryNetworkName)).To(Equal(&cache.PodIfaceCacheData{
+				Iface:  &specInterfaces[0],
+				PodIP:  targetPodIfaceIPv4,
+				PodIPs: []string{targetPodIfaceIPv4, targetPodIfaceIPv6},
+			}))
+
+			targetAddr, err := vishnetlink.ParseAddr(targetPodIfaceIPv4 + "/24")
+			Expect(err).ToNot(HaveOccurred())
+			mac, err := net.ParseMAC(guestMAC)
+			Expect(err).ToNot(HaveOccurred())
+			routes := []vishnetlink.Route{{Gw: net.ParseIP(gatewayIPv4)}}
+			Expect(cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", secondaryPodInterfaceName)).To(Equal(&cache.DHCPConfig{
+				IP:      *targetAddr,
+				MAC:     mac,
+				Routes:  &routes,
+				Gateway: net.ParseIP(gatewayIPv4),
+			}))
+			Expect(cache.ReadDomainInterfaceCache(&baseCacheCreator, "0", secondaryNetworkName)).To(Equal(&api.Interface{
+				MAC: &api.MAC{MAC: guestMAC},
+			}))
+		})
+
+		It("should not carry the MAC and addresses of the pod network", func() {
+			specNetworks[0] = *v1.DefaultPodNetwork()
+			specInterfaces[0].Name = specNetworks[0].Name
+			sourceStatus[0].Name = specNetworks[0].Name
+			nmstatestub.status.Interfaces[0].Name = "eth0"
+			for i := range nmstatestub.status.Routes.Running {
+				nmstatestub.status.Routes.Running[i].NextHopInterface = "eth0"
+			}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithCacheCreator(&baseCacheCreator),
+				netpod.WithMigrationSourceIfacesStatus(sourceStatus),
+			)
+			Expect(netPod.Setup()).To(Succeed())
+
+			dhcpConfig, err := cache.ReadDHCPInterfaceCache(&baseCacheCreator, "0", "eth0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dhcpConfig.IP.IP.String()).To(Equal(targetPodIfaceIPv4))
+			Expect(dhcpConfig.MAC.String()).To(Equal(targetPodIfaceMAC))
+		})
+
+		It("should announce the guest addresses", func() {
+			announcestub := &announceStub{}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithAnnounceAdapter(announcestub),
+			)
+			Expect(netPod.Announce(sourceStatus)).To(Succeed())
+			Expect(announcestub.arps).To(ConsistOf("914f438d88d-nic/" + guestMAC + "/" + guestIPv4))
+			Expect(announcestub.nas).To(ConsistOf("914f438d88d-nic/" + guestMAC + "/" + guestIPv6))
+		})
+
+		It("should report announcement failures", func() {
+			announcestub := &announceStub{err: errors.New("test announce error")}
+			netPod := netpod.NewNetPod(
+				specNetworks,
+				specInterfaces,
+				vmiUID, 0, 0, 0, state,
+				netpod.WithNMStateAdapter(&nmstatestub),
+				netpod.WithAnnounceAdapter(announcestub),
+			)
+			Expect(netPod.Announce(sourceStatus)).To(MatchError(ContainSubstring("test announce error")))
+		})
+	})
+
 	It("setup Passt binding", func() {
 		nmstatestub := nmstateStub{status: nmstate.Status{
 			Interfaces: []nmstate.Interface{{
@@ -974,12 +1118,93 @@ var _ = Describe("netpod", func() {
 		// Not processed by the discovery & config steps.
 		Entry("SR-IOV", v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{}}, nmstate.Spec{}),
 		Entry("Macvtap", v1.InterfaceBindingMethod{Macvtap: &v1.InterfaceMacvtap{}}, nmstate.Spec{}),
+		Entry("VhostUser", v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}}, nmstate.Spec{}),
 		// Processed by the discovery but not by the config step.
 		// When processed by the config step, the nmstate structure will be initialized (e.g. to an empty interface list).
 		// Interfaces will not get populated because the specific binding (slirp) is not treated there.
 		Entry("Slirp", v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}}, nmstate.Spec{Interfaces: []nmstate.Interface{}}),
 	)
 
+	It("setup managedTap network binding plugin", func() {
+		const bindingName = "managed-tap-plugin"
+		nmstatestub := nmstateStub{status: nmstate.Status{
+			Interfaces: []nmstate.Interface{{
+				Name:       "eth0",
+				Index:      0,
+				TypeName:   nmstate.TypeVETH,
+				State:      nmstate.IfaceStateUp,
+				MacAddress: "12:34:56:78:90:ab",
+				MTU:        1500,
+			}},
+		}}
+
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:    defaultPodNetworkName,
+				Binding: &v1.PluginBinding{Name: bindingName},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+			netpod.WithBindingPlugins(map[string]v1.InterfaceBindingPlugin{
+				bindingName: {DomainAttachmentType: v1.ManagedTap},
+			}),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec).To(Equal(
+			nmstate.Spec{
+				Interfaces: []nmstate.Interface{
+					{
+						Name:     "k6t-eth0",
+						TypeName: nmstate.TypeBridge,
+						State:    nmstate.IfaceStateUp,
+						MTU:      1500,
+						Ethtool:  nmstate.Ethtool{Feature: nmstate.Feature{TxChecksum: pointer.P(false)}},
+						Metadata: &nmstate.IfaceMetadata{NetworkName: defaultPodNetworkName},
+					},
+					{
+						Name:       "eth0",
+						Index:      0,
+						Controller: "k6t-eth0",
+						IPv4:       ipDisabled,
+						IPv6:       ipDisabled,
+						LinuxStack: nmstate.LinuxIfaceStack{PortLearning: pointer.P(false)},
+						Metadata:   &nmstate.IfaceMetadata{NetworkName: defaultPodNetworkName},
+					},
+					{
+						Name:       "tap0",
+						TypeName:   nmstate.TypeTap,
+						State:      nmstate.IfaceStateUp,
+						MTU:        1500,
+						Controller: "k6t-eth0",
+						Tap:        &nmstate.TapDevice{Queues: 0, UID: 0, GID: 0},
+						Metadata:   &nmstate.IfaceMetadata{Pid: 0, NetworkName: defaultPodNetworkName},
+					},
+				},
+			}),
+		)
+	})
+
+	It("setup skips network binding plugins without a managedTap domain attachment", func() {
+		nmstatestub := nmstateStub{}
+		netPod := netpod.NewNetPod(
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{{
+				Name:    defaultPodNetworkName,
+				Binding: &v1.PluginBinding{Name: "tap-plugin"},
+			}},
+			vmiUID, 0, 0, 0, state,
+			netpod.WithNMStateAdapter(&nmstatestub),
+			netpod.WithCacheCreator(&baseCacheCreator),
+			netpod.WithBindingPlugins(map[string]v1.InterfaceBindingPlugin{
+				"tap-plugin": {DomainAttachmentType: v1.Tap},
+			}),
+		)
+		Expect(netPod.Setup()).To(Succeed())
+		Expect(nmstatestub.spec).To(Equal(nmstate.Spec{}))
+	})
+
 	Context("setup with plugged networks marked for removal", func() {
 		const (
 			testNet1 = "testnet1"
@@ -1630,6 +1855,22 @@ func (m *masqueradeStub) Setup(bridgeIfaceSpec, podIfaceSpec *nmstate.Interface,
 	return nil
 }
 
+type announceStub struct {
+	err  error
+	arps []string
+	nas  []string
+}
+
+func (a *announceStub) GratuitousARP(linkName string, mac net.HardwareAddr, ip net.IP) error {
+	a.arps = append(a.arps, fmt.Sprintf("%s/%s/%s", linkName, mac, ip))
+	return a.err
+}
+
+func (a *announceStub) UnsolicitedNeighborAdvertisement(linkName string, mac net.HardwareAddr, ip net.IP) error {
+	a.nas = append(a.nas, fmt.Sprintf("%s/%s/%s", linkName, mac, ip))
+	return a.err
+}
+
 type tempCacheCreator struct {
 	once   sync.Once
 	tmpDir string
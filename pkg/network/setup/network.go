@@ -30,13 +30,20 @@ import (
 )
 
 type VMNetworkConfigurator struct {
-	vmi          *v1.VirtualMachineInstance
-	handler      netdriver.NetworkHandler
-	cacheCreator cacheCreator
+	vmi                             *v1.VirtualMachineInstance
+	handler                         netdriver.NetworkHandler
+	cacheCreator                    cacheCreator
+	domainAttachmentByInterfaceName map[string]string
 }
 
 type vmNetConfiguratorOption func(v *VMNetworkConfigurator)
 
+func WithDomainAttachmentByInterfaceName(domainAttachmentByInterfaceName map[string]string) vmNetConfiguratorOption {
+	return func(v *VMNetworkConfigurator) {
+		v.domainAttachmentByInterfaceName = domainAttachmentByInterfaceName
+	}
+}
+
 func NewVMNetworkConfigurator(vmi *v1.VirtualMachineInstance, cacheCreator cacheCreator, opts ...vmNetConfiguratorOption) *VMNetworkConfigurator {
 	v := &VMNetworkConfigurator{
 		vmi:          vmi,
@@ -58,12 +65,15 @@ func (v VMNetworkConfigurator) getPhase2NICs(domain *api.Domain, networks []v1.N
 			return nil, fmt.Errorf("no iface matching with network %s", networks[i].Name)
 		}
 
-		// Binding plugin, SR-IOV and Slirp devices are not part of the phases
-		if iface.Binding != nil || iface.SRIOV != nil || iface.Slirp != nil {
+		domainAttachment := v.domainAttachmentByInterfaceName[iface.Name]
+
+		// Binding plugin, SR-IOV, vhost-user and Slirp devices are not part of the phases,
+		// except for binding plugins which are attached to the domain through a tap device.
+		if iface.Binding != nil && !isTapDomainAttachment(domainAttachment) || iface.SRIOV != nil || iface.VhostUser != nil || iface.Slirp != nil {
 			continue
 		}
 
-		nic, err := newPhase2PodNIC(v.vmi, &networks[i], iface, v.handler, v.cacheCreator, domain)
+		nic, err := newPhase2PodNIC(v.vmi, &networks[i], iface, v.handler, v.cacheCreator, domain, domainAttachment)
 		if err != nil {
 			return nil, err
 		}
@@ -84,3 +94,7 @@ func (n *VMNetworkConfigurator) SetupPodNetworkPhase2(domain *api.Domain, networ
 	}
 	return nil
 }
+
+func isTapDomainAttachment(domainAttachment string) bool {
+	return domainAttachment == string(v1.Tap) || domainAttachment == string(v1.ManagedTap)
+}
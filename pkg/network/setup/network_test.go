@@ -71,6 +71,26 @@ var _ = Describe("VMNetworkConfigurator", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(nics).To(BeEmpty())
 			})
+
+			It("should not process vhost-user networks", func() {
+				vmi := api2.NewMinimalVMIWithNS("testnamespace", "testVmName")
+				const networkName = "vhostuser"
+				vmi.Spec.Networks = []v1.Network{{
+					Name: networkName,
+					NetworkSource: v1.NetworkSource{
+						Multus: &v1.MultusNetwork{NetworkName: "ovs-dpdk-nad"},
+					},
+				}}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name: networkName, InterfaceBindingMethod: v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}},
+				}}
+
+				vmNetworkConfigurator := NewVMNetworkConfigurator(vmi, nil)
+
+				nics, err := vmNetworkConfigurator.getPhase2NICs(&api.Domain{}, vmi.Spec.Networks)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nics).To(BeEmpty())
+			})
 		})
 	})
 })
@@ -49,13 +49,15 @@ type podNIC struct {
 	cacheCreator     cacheCreator
 	dhcpConfigurator dhcpconfigurator.Configurator
 	domainGenerator  domainspec.LibvirtSpecGenerator
+	domainAttachment string
 }
 
-func newPhase2PodNIC(vmi *v1.VirtualMachineInstance, network *v1.Network, iface *v1.Interface, handler netdriver.NetworkHandler, cacheCreator cacheCreator, domain *api.Domain) (*podNIC, error) {
+func newPhase2PodNIC(vmi *v1.VirtualMachineInstance, network *v1.Network, iface *v1.Interface, handler netdriver.NetworkHandler, cacheCreator cacheCreator, domain *api.Domain, domainAttachment string) (*podNIC, error) {
 	podnic, err := newPodNIC(vmi, network, iface, handler, cacheCreator, nil)
 	if err != nil {
 		return nil, err
 	}
+	podnic.domainAttachment = domainAttachment
 
 	ifaceLink, err := link.DiscoverByNetwork(podnic.handler, podnic.vmi.Spec.Networks, *podnic.vmiSpecNetwork)
 	if err != nil {
@@ -155,6 +157,15 @@ func (l *podNIC) newLibvirtSpecGenerator(domain *api.Domain) domainspec.LibvirtS
 	if l.vmiSpecIface.Passt != nil {
 		return domainspec.NewPasstLibvirtSpecGenerator(l.vmiSpecIface, domain, l.podInterfaceName, l.vmi)
 	}
+	if l.vmiSpecIface.Binding != nil {
+		switch l.domainAttachment {
+		case string(v1.Tap):
+			// The binding CNI plugin provides the pod interface as a tap device.
+			return domainspec.NewTapLibvirtSpecGenerator(l.vmiSpecIface, domain, l.podInterfaceName, l.handler)
+		case string(v1.ManagedTap):
+			return domainspec.NewTapLibvirtSpecGenerator(l.vmiSpecIface, domain, link.GenerateTapDeviceName(l.podInterfaceName), l.handler)
+		}
+	}
 	return nil
 }
 
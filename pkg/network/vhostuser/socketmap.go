@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vhostuser
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	networkv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	NetworkSocketMapAnnot = "kubevirt.io/network-vhostuser-socket-map"
+	SocketMapMountPath    = "/etc/podinfo-vhostuser"
+	SocketMapVolumeName   = "network-vhostuser-socket-map-annotation"
+	SocketMapVolumePath   = "network-vhostuser-socket-map"
+)
+
+const (
+	ModeClient = "client"
+	ModeServer = "server"
+)
+
+// Socket describes the vhost-user socket which backs a guest interface.
+// The mode is the one QEMU uses to open the socket.
+type Socket struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+// DefaultSocket returns the socket QEMU serves in the shared sockets directory.
+// It is used when the CNI does not expose the vhost-user socket of the network.
+func DefaultSocket(network v1.Network) Socket {
+	return Socket{
+		Path: SocketPath(namescheme.HashedPodInterfaceName(network)),
+		Mode: ModeServer,
+	}
+}
+
+func CreateNetworkSocketMapAnnotationValue(networks []v1.Network, interfaces []v1.Interface, networkStatusAnnotationValue string) string {
+	networkSocketMap, err := mapNetworkNameToSocket(networks, interfaces, networkStatusAnnotationValue)
+	if err != nil {
+		log.Log.Warningf("failed to create network-vhostuser-socket-map: %v", err)
+		networkSocketMap = map[string]Socket{}
+	}
+
+	networkSocketMapBytes, err := json.Marshal(networkSocketMap)
+	if err != nil {
+		log.Log.Warningf("failed to marshal network-vhostuser-socket-map: %v", err)
+		return ""
+	}
+
+	return string(networkSocketMapBytes)
+}
+
+func mapNetworkNameToSocket(networks []v1.Network, interfaces []v1.Interface,
+	networkStatusAnnotationValue string) (map[string]Socket, error) {
+	multusInterfaceNameToNetworkStatusMap, err := mapMultusInterfaceNameToNetworkStatus(networkStatusAnnotationValue)
+	if err != nil {
+		return nil, err
+	}
+	networkNameScheme := namescheme.CreateNetworkNameSchemeByPodNetworkStatus(networks, multusInterfaceNameToNetworkStatusMap)
+
+	networkSocketMap := map[string]Socket{}
+	for _, vhostUserIface := range vmispec.FilterVhostUserInterfaces(interfaces) {
+		multusInterfaceName := networkNameScheme[vhostUserIface.Name]
+		networkStatusEntry, exist := multusInterfaceNameToNetworkStatusMap[multusInterfaceName]
+		if !exist {
+			continue // The interface is not plugged yet
+		}
+		if networkStatusEntry.DeviceInfo == nil || networkStatusEntry.DeviceInfo.VhostUser == nil {
+			continue // The CNI does not expose the socket, the default one is used
+		}
+
+		vhostDevice := networkStatusEntry.DeviceInfo.VhostUser
+		if vhostDevice.Path == "" {
+			return nil, fmt.Errorf("failed to associate vhost-user socket path to interface %q", vhostUserIface.Name)
+		}
+		if !isInSocketsDir(vhostDevice.Path) {
+			return nil, fmt.Errorf("vhost-user socket path %q of interface %q is not in %s, the only directory shared with the compute container",
+				vhostDevice.Path, vhostUserIface.Name, SocketsDir)
+		}
+		socket := Socket{Path: vhostDevice.Path, Mode: vhostDevice.Mode}
+		if socket.Mode == "" {
+			socket.Mode = ModeServer
+		}
+		if socket.Mode != ModeServer && socket.Mode != ModeClient {
+			return nil, fmt.Errorf("unsupported vhost-user socket mode %q for interface %q", socket.Mode, vhostUserIface.Name)
+		}
+		networkSocketMap[vhostUserIface.Name] = socket
+	}
+	return networkSocketMap, nil
+}
+
+// isInSocketsDir checks the socket path points into the sockets directory, which is the only
+// directory of the compute container the data plane shares its sockets through.
+func isInSocketsDir(path string) bool {
+	return filepath.IsAbs(path) && strings.HasPrefix(filepath.Clean(path), SocketsDir+string(filepath.Separator))
+}
+
+func mapMultusInterfaceNameToNetworkStatus(networkStatusAnnotationValue string) (map[string]networkv1.NetworkStatus, error) {
+	if networkStatusAnnotationValue == "" {
+		return nil, fmt.Errorf("network-status annotation is not present")
+	}
+	var networkStatusList []networkv1.NetworkStatus
+	if err := json.Unmarshal([]byte(networkStatusAnnotationValue), &networkStatusList); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network-status annotation: %v", err)
+	}
+
+	multusInterfaceNameToNetworkStatusMap := map[string]networkv1.NetworkStatus{}
+	for _, networkStatus := range networkStatusList {
+		multusInterfaceNameToNetworkStatusMap[networkStatus.Interface] = networkStatus
+	}
+
+	return multusInterfaceNameToNetworkStatusMap, nil
+}
+
+// ReadNetworkSocketMapFile polls the given file path until populated, then returns the sockets it holds.
+// possible return values are:
+// - file populated - return the sockets in the file.
+// - file empty post-polling (timeout) - return err to fail SyncVMI.
+// - other error reading file (i.e. file not exist) - return an empty map, the default sockets are used.
+func ReadNetworkSocketMapFile(path string) (map[string]Socket, error) {
+	var networkSocketMapBytes []byte
+	err := wait.PollImmediate(100*time.Millisecond, time.Second, func() (bool, error) {
+		var err error
+		networkSocketMapBytes, err = os.ReadFile(path)
+		return len(networkSocketMapBytes) > 0, err
+	})
+	if err != nil {
+		if errors.Is(err, wait.ErrWaitTimeout) && len(networkSocketMapBytes) == 0 {
+			return nil, err
+		}
+		return map[string]Socket{}, nil
+	}
+
+	var networkSocketMap map[string]Socket
+	if err := json.Unmarshal(networkSocketMapBytes, &networkSocketMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal network-vhostuser-socket-map %w", err)
+	}
+	return networkSocketMap, nil
+}
@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vhostuser_test
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
+)
+
+var _ = Describe("vhost-user socket map", func() {
+	const (
+		fooHashedIfaceName = "pod2c26b46b68f"
+		booHashedIfaceName = "pod6446d58d6df"
+	)
+
+	networkStatusFmt := `
+[
+{
+  "name": "kindnet",
+  "interface": "eth0",
+  "ips": [
+    "10.244.2.131"
+  ],
+  "mac": "82:cf:7c:98:43:7e",
+  "default": true,
+  "dns": {}
+},
+{
+  "name": "default/ovs-dpdk",
+  "interface": "%s",
+  "dns": {},
+  "device-info": {
+    "type": "vhost-user",
+    "version": "1.1.0",
+    "vhost-user": {
+      "mode": "%s",
+      "path": "%s"
+    }
+  }
+},
+{
+  "name": "default/ovs-dpdk-no-device-info",
+  "interface": "%s",
+  "dns": {}
+}
+]`
+
+	networks := []v1.Network{
+		*v1.DefaultPodNetwork(),
+		newMultusNetwork("foo", "default/ovs-dpdk"),
+		newMultusNetwork("boo", "default/ovs-dpdk-no-device-info"),
+	}
+	interfaces := []v1.Interface{
+		*v1.DefaultMasqueradeNetworkInterface(),
+		newVhostUserInterface("foo"),
+		newVhostUserInterface("boo"),
+	}
+
+	DescribeTable("should map the vhost-user interfaces to the sockets exposed by the CNI",
+		func(mode, expectedMode string) {
+			networkStatus := fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, mode, "/var/run/kubevirt-vhostuser/foo.sock", booHashedIfaceName)
+			Expect(vhostuser.CreateNetworkSocketMapAnnotationValue(networks, interfaces, networkStatus)).To(Equal(
+				fmt.Sprintf(`{"foo":{"path":"/var/run/kubevirt-vhostuser/foo.sock","mode":"%s"}}`, expectedMode),
+			))
+		},
+		Entry("in server mode", vhostuser.ModeServer, vhostuser.ModeServer),
+		Entry("in client mode", vhostuser.ModeClient, vhostuser.ModeClient),
+		Entry("defaulting to server mode", "", vhostuser.ModeServer),
+	)
+
+	DescribeTable("should create an empty map",
+		func(networkStatus string) {
+			Expect(vhostuser.CreateNetworkSocketMapAnnotationValue(networks, interfaces, networkStatus)).To(Equal("{}"))
+		},
+		Entry("when the network-status annotation is missing", ""),
+		Entry("when the socket path is missing",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "", booHashedIfaceName)),
+		Entry("when the socket mode is not supported",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, "foo", "/var/run/kubevirt-vhostuser/foo.sock", booHashedIfaceName)),
+		Entry("when the socket path is outside of the shared sockets directory",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "/var/run/openvswitch/foo.sock", booHashedIfaceName)),
+		Entry("when the socket path escapes the shared sockets directory",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "/var/run/kubevirt-vhostuser/../foo.sock", booHashedIfaceName)),
+		Entry("when the socket path is the shared sockets directory",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "/var/run/kubevirt-vhostuser", booHashedIfaceName)),
+		Entry("when the socket path is relative",
+			fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "kubevirt-vhostuser/foo.sock", booHashedIfaceName)),
+	)
+
+	It("should default to a socket served by QEMU in the shared sockets directory", func() {
+		Expect(vhostuser.DefaultSocket(newMultusNetwork("foo", "default/ovs-dpdk"))).To(Equal(vhostuser.Socket{
+			Path: "/var/run/kubevirt-vhostuser/" + fooHashedIfaceName + ".sock",
+			Mode: vhostuser.ModeServer,
+		}))
+	})
+
+	Context("with a dummy vhost-user backend", func() {
+		// The backend mounts the shared sockets volume at its own path,
+		// the compute container always mounts it at the sockets directory.
+		var backendSocketsDir string
+		var socketMapPath string
+
+		BeforeEach(func() {
+			backendSocketsDir = GinkgoT().TempDir()
+			socketMapPath = filepath.Join(GinkgoT().TempDir(), vhostuser.SocketMapVolumePath)
+		})
+
+		backendPath := func(computePath string) string {
+			relPath, err := filepath.Rel(vhostuser.SocketsDir, computePath)
+			Expect(err).ToNot(HaveOccurred())
+			return filepath.Join(backendSocketsDir, relPath)
+		}
+
+		// publishSocketMap runs the flow from the network-status reported by the CNI
+		// to the socket map virt-launcher reads
+		publishSocketMap := func(networkStatus string) map[string]vhostuser.Socket {
+			annotation := vhostuser.CreateNetworkSocketMapAnnotationValue(networks, interfaces, networkStatus)
+			Expect(os.WriteFile(socketMapPath, []byte(annotation), 0o600)).To(Succeed())
+			sockets, err := vhostuser.ReadNetworkSocketMapFile(socketMapPath)
+			Expect(err).ToNot(HaveOccurred())
+			return sockets
+		}
+
+		expectConnection := func(listener net.Listener, dialPath string) {
+			accepted := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+				conn, err := listener.Accept()
+				if err == nil {
+					conn.Close()
+				}
+				accepted <- err
+			}()
+
+			conn, err := net.Dial("unix", dialPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(conn.Close()).To(Succeed())
+			Eventually(accepted).Should(Receive(BeNil()))
+		}
+
+		It("should connect QEMU to the socket served by the backend", func() {
+			const computePath = vhostuser.SocketsDir + "/dummy.sock"
+			listener, err := net.Listen("unix", backendPath(computePath))
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			sockets := publishSocketMap(fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeClient, computePath, booHashedIfaceName))
+			Expect(sockets).To(HaveKeyWithValue("foo", vhostuser.Socket{Path: computePath, Mode: vhostuser.ModeClient}))
+
+			// QEMU connects in client mode
+			expectConnection(listener, backendPath(sockets["foo"].Path))
+		})
+
+		It("should connect the backend to the default socket served by QEMU", func() {
+			sockets := publishSocketMap(fmt.Sprintf(networkStatusFmt, fooHashedIfaceName, vhostuser.ModeServer, "/var/run/openvswitch/dummy.sock", booHashedIfaceName))
+			Expect(sockets).To(BeEmpty())
+
+			// the socket outside of the shared directory is rejected, QEMU serves the default socket
+			socket := vhostuser.DefaultSocket(newMultusNetwork("foo", "default/ovs-dpdk"))
+			Expect(socket.Mode).To(Equal(vhostuser.ModeServer))
+			listener, err := net.Listen("unix", backendPath(socket.Path))
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			expectConnection(listener, backendPath(socket.Path))
+		})
+	})
+
+	Context("reading the socket map file", func() {
+		var socketMapPath string
+
+		BeforeEach(func() {
+			socketMapPath = filepath.Join(GinkgoT().TempDir(), vhostuser.SocketMapVolumePath)
+		})
+
+		It("should read the sockets", func() {
+			Expect(os.WriteFile(socketMapPath, []byte(`{"foo":{"path":"/foo.sock","mode":"client"}}`), 0o600)).To(Succeed())
+			Expect(vhostuser.ReadNetworkSocketMapFile(socketMapPath)).To(Equal(map[string]vhostuser.Socket{
+				"foo": {Path: "/foo.sock", Mode: vhostuser.ModeClient},
+			}))
+		})
+
+		It("should return an empty map when the file does not exist", func() {
+			Expect(vhostuser.ReadNetworkSocketMapFile(socketMapPath)).To(BeEmpty())
+		})
+
+		It("should fail when the file remains empty", func() {
+			Expect(os.WriteFile(socketMapPath, nil, 0o600)).To(Succeed())
+			_, err := vhostuser.ReadNetworkSocketMapFile(socketMapPath)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+func newMultusNetwork(name, networkName string) v1.Network {
+	return v1.Network{
+		Name: name,
+		NetworkSource: v1.NetworkSource{
+			Multus: &v1.MultusNetwork{NetworkName: networkName},
+		},
+	}
+}
+
+func newVhostUserInterface(name string) v1.Interface {
+	return v1.Interface{
+		Name:                   name,
+		InterfaceBindingMethod: v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}},
+	}
+}
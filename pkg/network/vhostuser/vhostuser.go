@@ -0,0 +1,35 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vhostuser
+
+import "path/filepath"
+
+const (
+	// VolumeName is the name of the virt-launcher pod volume which holds the vhost-user sockets.
+	// The volume is shared with the data plane the network binding connects the sockets to.
+	VolumeName = "vhostuser-sockets"
+	// SocketsDir is the directory in the compute container which holds the vhost-user sockets.
+	SocketsDir = "/var/run/kubevirt-vhostuser"
+)
+
+// SocketPath returns the path of the vhost-user socket which backs the given pod interface.
+func SocketPath(podIfaceName string) string {
+	return filepath.Join(SocketsDir, podIfaceName+".sock")
+}
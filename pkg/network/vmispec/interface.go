@@ -42,6 +42,25 @@ func SRIOVInterfaceExist(ifaces []v1.Interface) bool {
 	return false
 }
 
+func FilterVhostUserInterfaces(ifaces []v1.Interface) []v1.Interface {
+	var vhostUserIfaces []v1.Interface
+	for _, iface := range ifaces {
+		if iface.VhostUser != nil {
+			vhostUserIfaces = append(vhostUserIfaces, iface)
+		}
+	}
+	return vhostUserIfaces
+}
+
+func VhostUserInterfaceExist(ifaces []v1.Interface) bool {
+	for _, iface := range ifaces {
+		if iface.VhostUser != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func FilterInterfacesSpec(ifaces []v1.Interface, predicate func(i v1.Interface) bool) []v1.Interface {
 	var filteredIfaces []v1.Interface
 	for _, iface := range ifaces {
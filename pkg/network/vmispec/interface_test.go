@@ -92,6 +92,37 @@ var _ = Describe("VMI network spec", func() {
 		})
 	})
 
+	Context("vhost-user", func() {
+		It("finds no vhost-user interfaces in list", func() {
+			ifaces := []v1.Interface{
+				{
+					Name:                   "net0",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				},
+			}
+
+			Expect(netvmispec.FilterVhostUserInterfaces(ifaces)).To(BeEmpty())
+			Expect(netvmispec.VhostUserInterfaceExist(ifaces)).To(BeFalse())
+		})
+
+		It("finds a vhost-user interface in list", func() {
+			vhostUserNet := v1.Interface{
+				Name:                   "vhostuser-net",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}},
+			}
+			ifaces := []v1.Interface{
+				{
+					Name:                   "masq-net0",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				},
+				vhostUserNet,
+			}
+
+			Expect(netvmispec.FilterVhostUserInterfaces(ifaces)).To(Equal([]v1.Interface{vhostUserNet}))
+			Expect(netvmispec.VhostUserInterfaceExist(ifaces)).To(BeTrue())
+		})
+	})
+
 	const iface1, iface2, iface3, iface4, iface5 = "iface1", "iface2", "iface3", "iface4", "iface5"
 
 	Context("pop interface by network", func() {
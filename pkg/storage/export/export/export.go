@@ -31,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -87,6 +88,7 @@ const (
 	blockVolumeMountPath = "/dev/export-volumes"
 	fileSystemMountPath  = "/export-volumes"
 	urlBasePath          = "/volumes"
+	scratchMountPath     = "/scratch"
 
 	// annContentType is an annotation on a PVC indicating the content type. This is populated by CDI.
 	annContentType = "cdi.kubevirt.io/storage.contentType"
@@ -98,6 +100,10 @@ const (
 	caKeyFile     = caDefaultPath + "/tls.key"
 	// name of certificate secret volume in pod
 	certificates = "certificates"
+	// name of the scratch space volume in pod
+	scratch = "scratch"
+	// scratchOverhead is added to the scratch space for the QCOW2 metadata of the converted volumes
+	scratchOverhead = "1Gi"
 
 	exporterPodFailedOrCompletedEvent     = "ExporterPodFailedOrCompleted"
 	exporterPodCreatedEvent               = "ExporterPodCreated"
@@ -153,6 +159,14 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
+func qcow2CompressedURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk-compressed.qcow2", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -161,8 +175,18 @@ func dirURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/dir", urlBasePath, pvc.Name)) + "/"
 }
 
+func checksumsURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/checksums", urlBasePath, pvc.Name))
+}
+
+func changedExtentsURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/changed-extents", urlBasePath, pvc.Name))
+}
+
 type sourceVolumes struct {
-	volumes          []*corev1.PersistentVolumeClaim
+	volumes []*corev1.PersistentVolumeClaim
+	// baseVolumes holds the volumes of the base snapshot keyed by the name of the exported volume
+	baseVolumes      map[string]*corev1.PersistentVolumeClaim
 	inUse            bool
 	isPopulated      bool
 	availableMessage string
@@ -542,7 +566,7 @@ func (ctrl *VMExportController) manageExporterPod(vmExport *exportv1.VirtualMach
 	if !podExists {
 		if sourceVolumes.isSourceAvailable() {
 			if len(sourceVolumes.volumes) > 0 {
-				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes)
+				pod, err = ctrl.createExporterPod(vmExport, service, sourceVolumes.volumes, sourceVolumes.baseVolumes)
 				if err != nil {
 					return nil, err
 				}
@@ -827,14 +851,14 @@ func (ctrl *VMExportController) getExporterPod(vmExport *exportv1.VirtualMachine
 	}
 }
 
-func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	log.Log.V(3).Infof("Checking if pod exists: %s/%s", vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	key := controller.NamespacedKey(vmExport.Namespace, ctrl.getExportPodName(vmExport))
 	if obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(key); err != nil {
 		log.Log.Errorf("error %v", err)
 		return nil, err
 	} else if !exists {
-		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs)
+		manifest, err := ctrl.createExporterPodManifest(vmExport, service, pvcs, basePVCs)
 		if err != nil {
 			return nil, err
 		}
@@ -852,7 +876,7 @@ func (ctrl *VMExportController) createExporterPod(vmExport *exportv1.VirtualMach
 	}
 }
 
-func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
+func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.VirtualMachineExport, service *corev1.Service, pvcs []*corev1.PersistentVolumeClaim, basePVCs map[string]*corev1.PersistentVolumeClaim) (*corev1.Pod, error) {
 	certParams, err := ctrl.getCertParams()
 	if err != nil {
 		return nil, err
@@ -873,30 +897,21 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
 	for i, pvc := range pvcs {
-		var mountPoint string
-		if types.IsPVCBlock(pvc.Spec.VolumeMode) {
-			mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, pvc.Name)
-			podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
-				Name:       pvc.Name,
-				DevicePath: mountPoint,
-			})
-		} else {
-			mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, pvc.Name)
-			podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-				Name:      pvc.Name,
-				ReadOnly:  true,
-				MountPath: mountPoint,
+		mountPoint := addPVCToExporterPod(podManifest, pvc)
+		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
+		if vmExport.Spec.BaseSnapshot != nil && ctrl.isKubevirtContentType(pvc) {
+			podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHANGED_EXTENTS_URI", i),
+				Value: changedExtentsURI(pvc),
 			})
+			// Without a base volume the whole volume is reported as changed
+			if basePVC, ok := basePVCs[pvc.Name]; ok {
+				podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+					Name:  fmt.Sprintf("VOLUME%d_EXPORT_BASE_PATH", i),
+					Value: addPVCToExporterPod(podManifest, basePVC),
+				})
+			}
 		}
-		podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
-			Name: pvc.Name,
-			VolumeSource: corev1.VolumeSource{
-				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-					ClaimName: pvc.Name,
-				},
-			},
-		})
-		ctrl.addVolumeEnvironmentVariables(&podManifest.Spec.Containers[0], pvc, i, mountPoint)
 	}
 
 	// Add token and certs ENV variables
@@ -918,6 +933,10 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	}, corev1.EnvVar{
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
+	}, corev1.EnvVar{
+		// QCOW2 images are converted into scratch space before being served
+		Name:  "TMPDIR",
+		Value: scratchMountPath,
 	})
 
 	tokenSecretRef := ""
@@ -940,6 +959,13 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 				SecretName: tokenSecretRef,
 			},
 		},
+	}, corev1.Volume{
+		Name: scratch,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				SizeLimit: scratchSizeLimit(pvcs),
+			},
+		},
 	})
 
 	podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
@@ -948,6 +974,9 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	}, corev1.VolumeMount{
 		Name:      tokenSecretRef,
 		MountPath: "/token",
+	}, corev1.VolumeMount{
+		Name:      scratch,
+		MountPath: scratchMountPath,
 	})
 
 	if vm, err := ctrl.getVmFromExport(vmExport); err != nil {
@@ -962,6 +991,53 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	return podManifest, nil
 }
 
+// scratchSizeLimit returns the size limit of the scratch space of the exporter pod. The exporter
+// keeps the QCOW2 conversion of one volume at a time, which is not larger than the volume apart
+// from the QCOW2 metadata.
+func scratchSizeLimit(pvcs []*corev1.PersistentVolumeClaim) *resource.Quantity {
+	var largest resource.Quantity
+	for _, pvc := range pvcs {
+		size, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if !ok {
+			size = pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		}
+		if size.Cmp(largest) > 0 {
+			largest = size
+		}
+	}
+	limit := resource.MustParse(scratchOverhead)
+	limit.Add(largest)
+	return &limit
+}
+
+// addPVCToExporterPod adds the PVC as a read only volume to the exporter pod and returns its mount point
+func addPVCToExporterPod(podManifest *corev1.Pod, pvc *corev1.PersistentVolumeClaim) string {
+	var mountPoint string
+	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+		mountPoint = fmt.Sprintf("%s/%s", blockVolumeMountPath, pvc.Name)
+		podManifest.Spec.Containers[0].VolumeDevices = append(podManifest.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+			Name:       pvc.Name,
+			DevicePath: mountPoint,
+		})
+	} else {
+		mountPoint = fmt.Sprintf("%s/%s", fileSystemMountPath, pvc.Name)
+		podManifest.Spec.Containers[0].VolumeMounts = append(podManifest.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      pvc.Name,
+			ReadOnly:  true,
+			MountPath: mountPoint,
+		})
+	}
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: pvc.Name,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+			},
+		},
+	})
+	return mountPoint
+}
+
 func (ctrl *VMExportController) createDataManifestAndAddToPod(vmExport *exportv1.VirtualMachineExport, vm *virtv1.VirtualMachine, podManifest *corev1.Pod, service *corev1.Service) error {
 	vmManifestConfigMap, err := ctrl.createDataManifestConfigMap(vmExport, vm, service)
 	if err != nil {
@@ -1097,6 +1173,15 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_COMPRESSED_URI", index),
+			Value: qcow2CompressedURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHECKSUM_URI", index),
+			Value: checksumsURI(pvc),
 		})
 	} else {
 		if ctrl.isKubevirtContentType(pvc) {
@@ -1106,6 +1191,15 @@ func (ctrl *VMExportController) addVolumeEnvironmentVariables(exportContainer *c
 			}, corev1.EnvVar{
 				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 				Value: rawGzipURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+				Value: qcow2URI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_COMPRESSED_URI", index),
+				Value: qcow2CompressedURI(pvc),
+			}, corev1.EnvVar{
+				Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHECKSUM_URI", index),
+				Value: checksumsURI(pvc),
 			})
 		} else {
 			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
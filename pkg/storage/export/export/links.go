@@ -104,8 +104,27 @@ func (ctrl *VMExportController) getLinks(pvcs []*corev1.PersistentVolumeClaim, e
 							Format: exportv1.KubeVirtGz,
 							Url:    scheme + path.Join(hostAndBase, rawGzipURI(pvc)),
 						},
+						{
+							Format: exportv1.KubeVirtQcow2,
+							Url:    scheme + path.Join(hostAndBase, qcow2URI(pvc)),
+						},
+						{
+							Format: exportv1.KubeVirtQcow2Compressed,
+							Url:    scheme + path.Join(hostAndBase, qcow2CompressedURI(pvc)),
+						},
+						{
+							Format: exportv1.Checksums,
+							Url:    scheme + path.Join(hostAndBase, checksumsURI(pvc)),
+						},
 					},
 				})
+				if export.Spec.BaseSnapshot != nil {
+					volume := &exportLink.Volumes[len(exportLink.Volumes)-1]
+					volume.Formats = append(volume.Formats, exportv1.VirtualMachineExportVolumeFormat{
+						Format: exportv1.ChangedExtents,
+						Url:    scheme + path.Join(hostAndBase, changedExtentsURI(pvc)),
+					})
+				}
 			} else {
 				exportLink.Volumes = append(exportLink.Volumes, exportv1.VirtualMachineExportVolume{
 					Name: getVolumeName(pvc, export),
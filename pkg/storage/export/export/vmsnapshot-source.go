@@ -86,11 +86,23 @@ func (ctrl *VMExportController) getPVCFromSourceVMSnapshot(vmExport *exportv1.Vi
 			return &sourceVolumes{}, err
 		}
 		if len(pvcs) == restoreableSnapshots && restoreableSnapshots > 0 {
+			if vmExport.Spec.BaseSnapshot == nil {
+				return &sourceVolumes{
+					volumes:          pvcs,
+					inUse:            false,
+					isPopulated:      true,
+					availableMessage: ""}, nil
+			}
+			basePVCs, availableMessage, err := ctrl.handleBasePVCsForVirtualMachineSnapshot(vmExport, vmSnapshot)
+			if err != nil {
+				return &sourceVolumes{}, err
+			}
 			return &sourceVolumes{
 				volumes:          pvcs,
+				baseVolumes:      basePVCs,
 				inUse:            false,
-				isPopulated:      true,
-				availableMessage: ""}, nil
+				isPopulated:      availableMessage == "",
+				availableMessage: availableMessage}, nil
 		}
 		if restoreableSnapshots == 0 {
 			return &sourceVolumes{
@@ -128,7 +140,8 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 			sourceVm := content.Spec.Source.VirtualMachine
 			totalVolumes = len(content.Status.VolumeSnapshotStatus)
 			for _, volumeBackup := range content.Spec.VolumeBackups {
-				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, &volumeBackup, sourceVm); err != nil {
+				restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+				if pvc, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, restorePVCName, &volumeBackup, sourceVm); err != nil {
 					return nil, 0, err
 				} else {
 					pvcs = append(pvcs, pvc)
@@ -139,12 +152,61 @@ func (ctrl *VMExportController) handlePVCsForVirtualMachineSnapshot(vmExport *ex
 	return pvcs, totalVolumes, err
 }
 
-func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
+// handleBasePVCsForVirtualMachineSnapshot restores the volumes of the base snapshot that match the exported
+// volumes, the returned map is keyed by the name of the exported PVC. A non empty message is returned
+// if the base snapshot cannot be used yet.
+func (ctrl *VMExportController) handleBasePVCsForVirtualMachineSnapshot(vmExport *exportv1.VirtualMachineExport, vmSnapshot *snapshotv1.VirtualMachineSnapshot) (map[string]*corev1.PersistentVolumeClaim, string, error) {
+	baseName := *vmExport.Spec.BaseSnapshot
+	baseSnapshot, exists, err := ctrl.getVmSnapshot(vmExport.Namespace, baseName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s does not exist", vmExport.Namespace, baseName), nil
+	}
+	if baseSnapshot.Spec.Source.Name != vmSnapshot.Spec.Source.Name {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not a snapshot of VirtualMachine %s", vmExport.Namespace, baseName, vmSnapshot.Spec.Source.Name), nil
+	}
+	if baseSnapshot.Status == nil || baseSnapshot.Status.ReadyToUse == nil || !*baseSnapshot.Status.ReadyToUse ||
+		baseSnapshot.Status.VirtualMachineSnapshotContentName == nil || *baseSnapshot.Status.VirtualMachineSnapshotContentName == "" {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseName), nil
+	}
+	baseContent, exists, err := ctrl.getVmSnapshotContent(baseSnapshot.Namespace, *baseSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil {
+		return nil, "", err
+	}
+	if !exists {
+		return nil, fmt.Sprintf("Base VirtualMachineSnapshot %s/%s is not ready to use", vmExport.Namespace, baseName), nil
+	}
+	content, exists, err := ctrl.getVmSnapshotContent(vmSnapshot.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
+	if err != nil || !exists {
+		return nil, "", err
+	}
+
+	basePVCs := make(map[string]*corev1.PersistentVolumeClaim)
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+		for _, baseVolumeBackup := range baseContent.Spec.VolumeBackups {
+			// Volumes added after the base snapshot have no base, all their content is changed
+			if baseVolumeBackup.VolumeName != volumeBackup.VolumeName {
+				continue
+			}
+			basePVCName := fmt.Sprintf("%s-base-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
+			basePVC, err := ctrl.getOrCreatePVCFromSnapshot(vmExport, basePVCName, &baseVolumeBackup, baseContent.Spec.Source.VirtualMachine)
+			if err != nil {
+				return nil, "", err
+			}
+			basePVCs[restorePVCName] = basePVC
+		}
+	}
+	return basePVCs, "", nil
+}
+
+func (ctrl *VMExportController) getOrCreatePVCFromSnapshot(vmExport *exportv1.VirtualMachineExport, restorePVCName string, volumeBackup *snapshotv1.VolumeBackup, sourceVm *snapshotv1.VirtualMachine) (*corev1.PersistentVolumeClaim, error) {
 	if volumeBackup.VolumeSnapshotName == nil {
 		log.Log.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
 		return nil, fmt.Errorf("missing VolumeSnapshot name")
 	}
-	restorePVCName := fmt.Sprintf("%s-%s", vmExport.Name, volumeBackup.PersistentVolumeClaim.Name)
 
 	if pvc, exists, err := ctrl.getPvc(vmExport.Namespace, restorePVCName); err != nil {
 		return nil, err
@@ -647,6 +647,118 @@ var _ = Describe("VMSnapshot source", func() {
 		Expect(retry).To(BeEquivalentTo(0))
 	})
 
+	It("Should create restored base PVCs and changed extents links from VMSnapshot with a base snapshot", func() {
+		testVMExport := createSnapshotVMExport()
+		testVMExport.Spec.BaseSnapshot = pointer.StringPtr("base-vmsnapshot")
+		restoreName := fmt.Sprintf("%s-%s", testVMExport.Name, testVolumesnapshotName)
+		baseRestoreName := fmt.Sprintf("%s-base-%s", testVMExport.Name, testVolumesnapshotName)
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(1))
+			Expect(vmExport.Status.Links.Internal.Volumes[0].Formats).To(ContainElement(exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.ChangedExtents,
+				Url:    fmt.Sprintf("https://%s-%s.%s.svc/volumes/%s/changed-extents", exportPrefix, vmExport.Name, testNamespace, restoreName),
+			}))
+			Expect(vmExport.Status.Links.External.Volumes).To(HaveLen(1))
+			Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ContainElement(exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.ChangedExtents,
+				Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/v1alpha1/namespaces/%s/virtualmachineexports/%s/volumes/%s/changed-extents", testNamespace, vmExport.Name, restoreName),
+			}))
+			return true, vmExport, nil
+		})
+
+		var createdPVCs []string
+		k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			pvc, ok := create.GetObject().(*k8sv1.PersistentVolumeClaim)
+			Expect(ok).To(BeTrue())
+			Expect(pvc.OwnerReferences).To(HaveLen(1))
+			Expect(pvc.OwnerReferences[0].Name).To(Equal(testVMExport.Name))
+			createdPVCs = append(createdPVCs, pvc.Name)
+			return true, pvc, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exportPod, ok := create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			Expect(exportPod.Spec.Volumes).To(ContainElement(HaveField("Name", baseRestoreName)))
+			Expect(exportPod.Spec.Containers[0].Env).To(ContainElements(
+				k8sv1.EnvVar{
+					Name:  "VOLUME0_EXPORT_CHANGED_EXTENTS_URI",
+					Value: fmt.Sprintf("/volumes/%s/changed-extents", restoreName),
+				},
+				k8sv1.EnvVar{
+					Name:  "VOLUME0_EXPORT_BASE_PATH",
+					Value: fmt.Sprintf("%s/%s", fileSystemMountPath, baseRestoreName),
+				},
+			))
+			exportPod.Status = k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+			}
+			return true, exportPod, nil
+		})
+		controller.RouteCache.Add(routeToHostAndService(components.VirtExportProxyServiceName))
+		vmSnapshotInformer.GetStore().Add(createTestVMSnapshot(true))
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("snapshot-content"))
+		baseSnapshot := createTestVMSnapshot(true)
+		baseSnapshot.Name = "base-vmsnapshot"
+		baseSnapshot.Status.VirtualMachineSnapshotContentName = pointer.StringPtr("base-snapshot-content")
+		vmSnapshotInformer.GetStore().Add(baseSnapshot)
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("base-snapshot-content"))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+		Expect(createdPVCs).To(ConsistOf(restoreName, baseRestoreName))
+	})
+
+	It("Should update status with no links and not ready if base snapshot does not exist", func() {
+		testVMExport := createSnapshotVMExport()
+		testVMExport.Spec.BaseSnapshot = pointer.StringPtr("base-vmsnapshot")
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			readyConditionSet := false
+			for _, condition := range vmExport.Status.Conditions {
+				if condition.Type == exportv1.ConditionReady {
+					readyConditionSet = true
+					Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+					Expect(condition.Reason).To(Equal(inUseReason))
+					Expect(condition.Message).To(Equal(fmt.Sprintf("Base VirtualMachineSnapshot %s/base-vmsnapshot does not exist", testNamespace)))
+				}
+			}
+			Expect(readyConditionSet).To(BeTrue())
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Pending))
+			return true, vmExport, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			pvc, ok := create.GetObject().(*k8sv1.PersistentVolumeClaim)
+			Expect(ok).To(BeTrue())
+			return true, pvc, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("Should not create the exporter pod")
+			return true, nil, nil
+		})
+		vmSnapshotInformer.GetStore().Add(createTestVMSnapshot(true))
+		vmSnapshotContentInformer.GetStore().Add(createTestVMSnapshotContent("snapshot-content"))
+		fakeVolumeSnapshotProvider.Add(createTestVolumeSnapshot(testVolumesnapshotName))
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
 	It("Should update status with no links and not ready if snapshot is not ready", func() {
 		testVMExport := createSnapshotVMExport()
 		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -23,7 +23,9 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	goflag "flag"
@@ -34,10 +36,14 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	flag "github.com/spf13/pflag"
+	"golang.org/x/sys/unix"
 	"sigs.k8s.io/yaml"
 
 	"kubevirt.io/client-go/log"
@@ -69,13 +75,26 @@ const (
 type TokenGetterFunc func() (string, error)
 
 type VolumeInfo struct {
-	Path       string
-	ArchiveURI string
-	DirURI     string
-	RawURI     string
-	RawGzURI   string
-	VMURI      string
-	SecretURI  string
+	Path               string
+	ArchiveURI         string
+	DirURI             string
+	RawURI             string
+	RawGzURI           string
+	Qcow2URI           string
+	Qcow2CompressedURI string
+	VMURI              string
+	SecretURI          string
+	// BasePath is the path of the same volume in the base snapshot, it is empty
+	// if the volume did not exist in the base snapshot
+	BasePath          string
+	ChangedExtentsURI string
+	ChecksumURI       string
+}
+
+// Extent is a range of a volume which changed since the base snapshot
+type Extent struct {
+	Offset int64 `json:"offset"`
+	Length int64 `json:"length"`
 }
 type ExportServerConfig struct {
 	Deadline time.Time
@@ -93,6 +112,9 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string, bool) http.Handler
+	ExtentsHandler     func(string, string) http.Handler
+	ChecksumHandler    func(string) http.Handler
 	VmHandler          func(string, []VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
 
@@ -188,6 +210,28 @@ func (s *exportServer) getHandlerMap(vi VolumeInfo) map[string]http.Handler {
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p, false)
+	}
+
+	if vi.Qcow2CompressedURI != "" {
+		result[vi.Qcow2CompressedURI] = s.Qcow2Handler(p, true)
+	}
+
+	if vi.ChecksumURI != "" {
+		result[vi.ChecksumURI] = s.ChecksumHandler(p)
+	}
+
+	if vi.ChangedExtentsURI != "" {
+		bp := vi.BasePath
+		if bp != "" {
+			if bfi, err := os.Stat(bp); err == nil && bfi.IsDir() {
+				bp = path.Join(bp, "disk.img")
+			}
+		}
+		result[vi.ChangedExtentsURI] = s.ExtentsHandler(p, bp)
+	}
+
 	return result
 }
 
@@ -247,6 +291,18 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
+	if es.ChecksumHandler == nil {
+		es.ChecksumHandler = checksumHandler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = extentsHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -492,6 +548,202 @@ func archiveHandler(mountPoint string) http.Handler {
 	})
 }
 
+// countingWriter discards the data written to it and counts the bytes
+type countingWriter struct {
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	cw.n += int64(len(p))
+	return len(p), nil
+}
+
+// volumeETag returns a strong validator of the content served from the volume. Exported volumes
+// are not modified while the export is running, so the size and modification time identify the
+// content, the representation distinguishes the different encodings of the same volume.
+func volumeETag(f *os.File, representation string) (string, error) {
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	size, err := volumeSize(f)
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("\"%x-%x-%s\"", size, fi.ModTime().UnixNano(), representation), nil
+}
+
+// gzipMemberSize is the amount of raw data compressed into each gzip member. The members are
+// compressed independently, so a range of the gzipped volume is served by compressing the
+// volume from the member holding the start of the range instead of from the beginning.
+var gzipMemberSize int64 = 64 * 1024 * 1024
+
+// writeGzipMembers compresses the volume starting at the given member into w. The output of
+// the gzip writer is deterministic, so the same members are written for every response.
+func writeGzipMembers(w io.Writer, f *os.File, member int64, size int64) error {
+	zw := gzip.NewWriter(w)
+	for offset := member * gzipMemberSize; offset == 0 || offset < size; offset += gzipMemberSize {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		zw.Reset(w)
+		if _, err := io.Copy(zw, io.LimitReader(f, gzipMemberSize)); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// volumeDigest holds the checksum of a volume and the offsets of its gzip members once they are computed
+type volumeDigest struct {
+	done      chan struct{}
+	size      int64
+	checksums []byte
+	// gzipOffsets are the offsets of the gzip members in the gzipped volume
+	gzipOffsets []int64
+	gzipSize    int64
+	err         error
+}
+
+var (
+	volumeDigestsLock sync.Mutex
+	volumeDigests     = map[string]*volumeDigest{}
+)
+
+// getVolumeDigest returns the digest of the volume, reading the whole volume is expensive, so the
+// digest is computed once in the background, starting with the first request which needs it, and
+// is shared by all the handlers of the volume.
+func getVolumeDigest(filePath string) *volumeDigest {
+	volumeDigestsLock.Lock()
+	defer volumeDigestsLock.Unlock()
+	if digest, ok := volumeDigests[filePath]; ok {
+		return digest
+	}
+	digest := &volumeDigest{done: make(chan struct{})}
+	volumeDigests[filePath] = digest
+	go func() {
+		defer close(digest.done)
+		digest.err = digest.compute(filePath)
+	}()
+	return digest
+}
+
+func (d *volumeDigest) compute(filePath string) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if d.size, err = volumeSize(f); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	hash := sha256.New()
+	counter := &countingWriter{}
+	zw := gzip.NewWriter(counter)
+	for offset := int64(0); offset == 0 || offset < d.size; offset += gzipMemberSize {
+		d.gzipOffsets = append(d.gzipOffsets, counter.n)
+		zw.Reset(counter)
+		if _, err := io.Copy(io.MultiWriter(zw, hash), io.LimitReader(f, gzipMemberSize)); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+	}
+	d.gzipSize = counter.n
+	d.checksums, err = json.Marshal(VolumeChecksums{Size: d.size, SHA256: hex.EncodeToString(hash.Sum(nil))})
+	return err
+}
+
+// ready returns true if the digest is computed, otherwise the client is asked to retry later
+func (d *volumeDigest) ready(w http.ResponseWriter, filePath string) bool {
+	select {
+	case <-d.done:
+	default:
+		w.Header().Set("Retry-After", retryAfter)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return false
+	}
+	if d.err != nil {
+		log.Log.Reason(d.err).Errorf("error reading %s", filePath)
+		w.WriteHeader(http.StatusInternalServerError)
+		return false
+	}
+	return true
+}
+
+var errRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// parseSingleRange parses a Range header containing a single byte range. ok is false if the
+// header cannot be served as a single range, in that case the whole content is sent.
+func parseSingleRange(header string, size int64) (start, end int64, ok bool, err error) {
+	if !strings.HasPrefix(header, "bytes=") {
+		return 0, 0, false, nil
+	}
+	spec := strings.TrimPrefix(header, "bytes=")
+	if strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, nil
+	}
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil {
+			return 0, 0, false, nil
+		}
+		if suffix <= 0 || size == 0 {
+			return 0, 0, false, errRangeNotSatisfiable
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, size - 1, true, nil
+	}
+	start, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, nil
+	}
+	end = size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, 0, false, nil
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	if start >= size {
+		return 0, 0, false, errRangeNotSatisfiable
+	}
+	return start, end, true, nil
+}
+
+func writeGzip(w http.ResponseWriter, f *os.File) {
+	size, err := volumeSize(f)
+	if err == nil {
+		counter := &countingWriter{}
+		err = writeGzipMembers(io.MultiWriter(w, counter), f, 0, size)
+		log.Log.Infof("Wrote %d bytes\n", counter.n)
+	}
+	if err != nil {
+		log.Log.Reason(err).Error("error writing response body")
+	}
+}
+
+// gzipHandler serves the gzipped volume. Range requests are served by compressing the volume
+// from the gzip member holding the start of the range, which allows clients to resume a download.
 func gzipHandler(filePath string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -505,9 +757,63 @@ func gzipHandler(filePath string) http.Handler {
 			return
 		}
 		defer f.Close()
-		gzipReader := pipeToGzip(f)
-		defer gzipReader.Close()
-		n, err := io.Copy(w, gzipReader)
+		etag, err := volumeETag(f, "gzip")
+		if err != nil {
+			log.Log.Reason(err).Errorf("error reading %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		rangeHeader := req.Header.Get("Range")
+		if ifRange := req.Header.Get("If-Range"); ifRange != "" && ifRange != etag {
+			rangeHeader = ""
+		}
+		if rangeHeader == "" {
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Accept-Ranges", "bytes")
+			writeGzip(w, f)
+			return
+		}
+
+		digest := getVolumeDigest(filePath)
+		if !digest.ready(w, filePath) {
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Accept-Ranges", "bytes")
+		start, end, ok, err := parseSingleRange(rangeHeader, digest.gzipSize)
+		if err != nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", digest.gzipSize))
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		if !ok {
+			writeGzip(w, f)
+			return
+		}
+
+		// Only the part of the member holding the start of the range is compressed again
+		member := sort.Search(len(digest.gzipOffsets), func(i int) bool { return digest.gzipOffsets[i] > start }) - 1
+		reader, writer := io.Pipe()
+		compressed := make(chan struct{})
+		go func() {
+			defer close(compressed)
+			writer.CloseWithError(writeGzipMembers(writer, f, int64(member), digest.size))
+		}()
+		// The volume is only closed once the compression stopped reading it
+		defer func() {
+			reader.Close()
+			<-compressed
+		}()
+		if _, err := io.CopyN(io.Discard, reader, start-digest.gzipOffsets[member]); err != nil {
+			log.Log.Reason(err).Errorf("error compressing %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, digest.gzipSize))
+		w.Header().Set("Content-Length", strconv.FormatInt(end-start+1, 10))
+		w.WriteHeader(http.StatusPartialContent)
+		n, err := io.CopyN(w, reader, end-start+1)
 		if err != nil {
 			log.Log.Reason(err).Error("error writing response body")
 		}
@@ -515,6 +821,146 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+// convertToQcow2 converts the raw image into a sparse QCOW2 image, only the allocated
+// clusters of the source end up in the destination. QCOW2 cannot be written to a pipe,
+// so the image is converted into a scratch file first.
+var convertToQcow2 = func(ctx context.Context, src, dst string, compress bool) error {
+	args := []string{"convert", "-f", "raw", "-O", "qcow2"}
+	if compress {
+		args = append(args, "-c")
+	}
+	args = append(args, src, dst)
+	cmd := exec.CommandContext(ctx, "/usr/bin/qemu-img", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("qemu-img convert failed: %w, %s", err, stderr.String())
+	}
+	return nil
+}
+
+// qcow2Conversion is the result of converting a volume into scratch space
+type qcow2Conversion struct {
+	done chan struct{}
+	path string
+	err  error
+}
+
+// scratchSlot is held while a converted image is kept in scratch space. The scratch space
+// of the exporter pod is only large enough for the conversion of a single volume.
+var scratchSlot = make(chan struct{}, 1)
+
+// qcow2Cache converts a volume only once for all concurrent requests of the volume. The
+// converted image is removed from scratch space once the last of these requests is done.
+// A failed conversion is retried by the next request.
+type qcow2Cache struct {
+	lock       sync.Mutex
+	conversion *qcow2Conversion
+	readers    int
+}
+
+func (c *qcow2Cache) acquire(ctx context.Context, filePath string, compress bool) (*qcow2Conversion, error) {
+	c.lock.Lock()
+	conversion := c.conversion
+	if conversion == nil {
+		conversion = &qcow2Conversion{done: make(chan struct{})}
+		c.conversion = conversion
+		// The conversion is shared, it must not be canceled together with the request starting it
+		go func() {
+			conversion.path, conversion.err = convertIntoScratch(filePath, compress)
+			if conversion.err != nil {
+				c.lock.Lock()
+				if c.conversion == conversion {
+					c.conversion = nil
+				}
+				c.lock.Unlock()
+			}
+			close(conversion.done)
+		}()
+	}
+	c.readers++
+	c.lock.Unlock()
+
+	select {
+	case <-conversion.done:
+		if conversion.err != nil {
+			c.release(conversion)
+			return nil, conversion.err
+		}
+		return conversion, nil
+	case <-ctx.Done():
+		c.release(conversion)
+		return nil, ctx.Err()
+	}
+}
+
+func (c *qcow2Cache) release(conversion *qcow2Conversion) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.readers--
+	if c.readers > 0 {
+		return
+	}
+	if c.conversion == conversion {
+		c.conversion = nil
+	}
+	go func() {
+		<-conversion.done
+		if conversion.err == nil {
+			removeFromScratch(conversion.path)
+		}
+	}()
+}
+
+func convertIntoScratch(filePath string, compress bool) (string, error) {
+	scratchSlot <- struct{}{}
+	scratchDir, err := os.MkdirTemp("", "qcow2-export")
+	if err != nil {
+		<-scratchSlot
+		return "", err
+	}
+	qcow2Path := filepath.Join(scratchDir, "disk.qcow2")
+	if err := convertToQcow2(context.Background(), filePath, qcow2Path, compress); err != nil {
+		os.RemoveAll(scratchDir)
+		<-scratchSlot
+		return "", err
+	}
+	return qcow2Path, nil
+}
+
+func removeFromScratch(qcow2Path string) {
+	if err := os.RemoveAll(filepath.Dir(qcow2Path)); err != nil {
+		log.Log.Reason(err).Errorf("error removing %s", qcow2Path)
+	}
+	<-scratchSlot
+}
+
+func qcow2Handler(filePath string, compress bool) http.Handler {
+	cache := &qcow2Cache{}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		conversion, err := cache.acquire(req.Context(), filePath, compress)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error converting %s to qcow2", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer cache.release(conversion)
+		f, err := os.Open(conversion.path)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", conversion.path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		// qemu-img converts the same volume into the same image, ServeContent also handles range requests
+		http.ServeContent(w, req, "disk.qcow2", time.Time{}, f)
+	})
+}
+
 func vmHandler(filePath string, vi []VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -640,6 +1086,156 @@ func resourceToBytesYaml(resources []runtime.Object) ([]byte, error) {
 	return data, nil
 }
 
+// extentChunkSize is the granularity used to compare the volume with its base
+var extentChunkSize int64 = 1024 * 1024
+
+// retryAfter is the number of seconds clients are asked to wait while the extents or the digest of a volume are computed
+const retryAfter = "10"
+
+func volumeSize(f *os.File) (int64, error) {
+	// Seeking works for both files and block devices
+	return f.Seek(0, io.SeekEnd)
+}
+
+// allocatedRanges returns the ranges of the volume which hold data, the holes in between read as
+// zeros. Volumes which cannot report their holes, like block devices, are allocated as a whole.
+func allocatedRanges(f *os.File, size int64) []Extent {
+	ranges := []Extent{}
+	fd := int(f.Fd())
+	for offset := int64(0); offset < size; {
+		data, err := unix.Seek(fd, offset, unix.SEEK_DATA)
+		if err == unix.ENXIO {
+			// No data after the offset
+			break
+		} else if err != nil {
+			return []Extent{{Offset: 0, Length: size}}
+		}
+		hole, err := unix.Seek(fd, data, unix.SEEK_HOLE)
+		if err != nil {
+			return []Extent{{Offset: 0, Length: size}}
+		}
+		ranges = append(ranges, Extent{Offset: data, Length: hole - data})
+		offset = hole
+	}
+	return ranges
+}
+
+// isAllocated returns true if the range overlaps with any of the sorted allocated ranges
+func isAllocated(ranges []Extent, offset, length int64) bool {
+	i := sort.Search(len(ranges), func(i int) bool {
+		return ranges[i].Offset+ranges[i].Length > offset
+	})
+	return i < len(ranges) && ranges[i].Offset < offset+length
+}
+
+// getChangedExtents compares the volume with its base and returns the ranges which differ,
+// adjacent changed chunks are coalesced. Chunks which are holes in both volumes are unchanged
+// and are not read. Without a base the allocated ranges of the volume are changed.
+func getChangedExtents(filePath, basePath string) ([]Extent, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	size, err := volumeSize(f)
+	if err != nil {
+		return nil, err
+	}
+	allocated := allocatedRanges(f, size)
+	if basePath == "" {
+		return allocated, nil
+	}
+	base, err := os.Open(basePath)
+	if err != nil {
+		return nil, err
+	}
+	defer base.Close()
+	baseSize, err := volumeSize(base)
+	if err != nil {
+		return nil, err
+	}
+	baseAllocated := allocatedRanges(base, baseSize)
+
+	extents := []Extent{}
+	buf := make([]byte, extentChunkSize)
+	baseBuf := make([]byte, extentChunkSize)
+	for offset := int64(0); offset < size; offset += extentChunkSize {
+		length := extentChunkSize
+		if offset+length > size {
+			length = size - offset
+		}
+		currentAllocated := isAllocated(allocated, offset, length)
+		changed := currentAllocated
+		if offset+length <= baseSize {
+			if !currentAllocated && !isAllocated(baseAllocated, offset, length) {
+				continue
+			}
+			if _, err := f.ReadAt(buf[:length], offset); err != nil {
+				return nil, err
+			}
+			if _, err := base.ReadAt(baseBuf[:length], offset); err != nil {
+				return nil, err
+			}
+			changed = !bytes.Equal(buf[:length], baseBuf[:length])
+		}
+		if !changed {
+			continue
+		}
+		if last := len(extents) - 1; last >= 0 && extents[last].Offset+extents[last].Length == offset {
+			extents[last].Length += length
+		} else {
+			extents = append(extents, Extent{Offset: offset, Length: length})
+		}
+	}
+	return extents, nil
+}
+
+// changedExtents holds the changed extents of a volume once they are computed
+type changedExtents struct {
+	done    chan struct{}
+	extents []byte
+	err     error
+}
+
+// extentsHandler serves the changed extents of the volume. Comparing the volumes takes long,
+// so the extents are computed in the background as soon as the export server starts. The
+// snapshot volumes are never modified, they only have to be computed once.
+func extentsHandler(filePath, basePath string) http.Handler {
+	result := &changedExtents{done: make(chan struct{})}
+	go func() {
+		defer close(result.done)
+		extents, err := getChangedExtents(filePath, basePath)
+		if err != nil {
+			result.err = err
+			return
+		}
+		result.extents, result.err = json.Marshal(extents)
+	}()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		select {
+		case <-result.done:
+		default:
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if result.err != nil {
+			log.Log.Reason(result.err).Errorf("error computing changed extents of %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(result.extents); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
+
 func dirHandler(uri, mountPoint string) http.Handler {
 	return http.StripPrefix(uri, http.FileServer(http.Dir(mountPoint)))
 }
@@ -653,10 +1249,55 @@ func fileHandler(file string) http.Handler {
 			return
 		}
 		defer f.Close()
+		etag, err := volumeETag(f, "raw")
+		if err != nil {
+			log.Log.Reason(err).Errorf("error reading %s", file)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// ServeContent handles Range, If-Range and If-None-Match based on the ETag
+		w.Header().Set("ETag", etag)
 		http.ServeContent(w, r, "disk.img", time.Time{}, f)
 	})
 }
 
+// VolumeChecksums is the checksum manifest of the raw content of a volume
+type VolumeChecksums struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+func checksumHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		etag, err := volumeETag(f, "raw")
+		if err != nil {
+			log.Log.Reason(err).Errorf("error reading %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		digest := getVolumeDigest(filePath)
+		if !digest.ready(w, filePath) {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", etag)
+		if _, err := w.Write(digest.checksums); err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+	})
+}
+
 func getToken(tokenFile string) (string, error) {
 	content, err := os.ReadFile(tokenFile)
 	if err != nil {
@@ -20,15 +20,26 @@
 package virtexportserver
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -61,6 +72,15 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string, bool) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(string, string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ChecksumHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func(string, []VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
@@ -113,6 +133,22 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("compressed qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2CompressedURI: "/volume/v1/disk-compressed.qcow2"},
+			"/volume/v1/disk-compressed.qcow2",
+		),
+		Entry("changed extents URI",
+			VolumeInfo{Path: "/tmp", BasePath: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents"},
+			"/volume/v1/changed-extents",
+		),
+		Entry("checksum URI",
+			VolumeInfo{Path: "/tmp", ChecksumURI: "/volume/v1/checksums"},
+			"/volume/v1/checksums",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/internal/manifest",
@@ -159,6 +195,22 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("compressed qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2CompressedURI: "/volume/v1/disk-compressed.qcow2"},
+			"/volume/v1/disk-compressed.qcow2",
+		),
+		Entry("changed extents URI",
+			VolumeInfo{Path: "/tmp", BasePath: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents"},
+			"/volume/v1/changed-extents",
+		),
+		Entry("checksum URI",
+			VolumeInfo{Path: "/tmp", ChecksumURI: "/volume/v1/checksums"},
+			"/volume/v1/checksums",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/internal/manifest",
@@ -202,6 +254,22 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("compressed qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2CompressedURI: "/volume/v1/disk-compressed.qcow2"},
+			"/volume/v1/disk-compressed.qcow2",
+		),
+		Entry("changed extents URI",
+			VolumeInfo{Path: "/tmp", BasePath: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents"},
+			"/volume/v1/changed-extents",
+		),
+		Entry("checksum URI",
+			VolumeInfo{Path: "/tmp", ChecksumURI: "/volume/v1/checksums"},
+			"/volume/v1/checksums",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/external/manifest",
@@ -244,6 +312,22 @@ var _ = Describe("exportserver", func() {
 			VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("compressed qcow2 URI",
+			VolumeInfo{Path: "/tmp", Qcow2CompressedURI: "/volume/v1/disk-compressed.qcow2"},
+			"/volume/v1/disk-compressed.qcow2",
+		),
+		Entry("changed extents URI",
+			VolumeInfo{Path: "/tmp", BasePath: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents"},
+			"/volume/v1/changed-extents",
+		),
+		Entry("checksum URI",
+			VolumeInfo{Path: "/tmp", ChecksumURI: "/volume/v1/checksums"},
+			"/volume/v1/checksums",
+		),
 		Entry("VM definition URI",
 			VolumeInfo{Path: "/tmp", VMURI: "/manifest"},
 			"/external/manifest",
@@ -254,6 +338,438 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	Context("Qcow2 handler", func() {
+		var orgConvertToQcow2 = convertToQcow2
+
+		AfterEach(func() {
+			convertToQcow2 = orgConvertToQcow2
+		})
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler("/tmp/disk.img", false).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		DescribeTable("should serve the converted image", func(compress bool) {
+			convertToQcow2 = func(_ context.Context, src, dst string, c bool) error {
+				Expect(src).To(Equal("/tmp/disk.img"))
+				Expect(c).To(Equal(compress))
+				return os.WriteFile(dst, []byte("qcow2 image"), 0600)
+			}
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			qcow2Handler("/tmp/disk.img", compress).ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(resp.Body.String()).To(Equal("qcow2 image"))
+		},
+			Entry("uncompressed", false),
+			Entry("compressed", true),
+		)
+
+		It("should return 500 if conversion fails and retry the conversion", func() {
+			conversions := 0
+			convertToQcow2 = func(_ context.Context, _, dst string, _ bool) error {
+				conversions++
+				if conversions == 1 {
+					return fmt.Errorf("conversion failed")
+				}
+				return os.WriteFile(dst, []byte("qcow2 image"), 0600)
+			}
+			handler := qcow2Handler("/tmp/disk.img", false)
+			get := func() *httptest.ResponseRecorder {
+				req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+				Expect(err).ToNot(HaveOccurred())
+				resp := httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+				return resp
+			}
+			Expect(get().Code).To(BeEquivalentTo(http.StatusInternalServerError))
+			Expect(get().Code).To(BeEquivalentTo(http.StatusOK))
+			Expect(conversions).To(Equal(2))
+		})
+
+		It("should convert the volume only once for concurrent requests and remove it afterwards", func() {
+			var conversions int32
+			var scratchFile atomic.Value
+			release := make(chan struct{})
+			convertToQcow2 = func(_ context.Context, _, dst string, _ bool) error {
+				atomic.AddInt32(&conversions, 1)
+				scratchFile.Store(dst)
+				<-release
+				return os.WriteFile(dst, []byte("qcow2 image"), 0600)
+			}
+			handler := qcow2Handler("/tmp/disk.img", false)
+
+			const requests = 3
+			responses := make(chan *httptest.ResponseRecorder, requests)
+			for i := 0; i < requests; i++ {
+				go func() {
+					defer GinkgoRecover()
+					req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+					Expect(err).ToNot(HaveOccurred())
+					resp := httptest.NewRecorder()
+					handler.ServeHTTP(resp, req)
+					responses <- resp
+				}()
+			}
+			Eventually(func() int32 { return atomic.LoadInt32(&conversions) }).Should(BeEquivalentTo(1))
+			close(release)
+			for i := 0; i < requests; i++ {
+				resp := <-responses
+				Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+				Expect(resp.Body.String()).To(Equal("qcow2 image"))
+			}
+			Eventually(func() bool {
+				_, err := os.Stat(scratchFile.Load().(string))
+				return errors.Is(err, os.ErrNotExist)
+			}).Should(BeTrue(), "the converted image should be removed from scratch space")
+
+			By("converting the volume again to resume the download")
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Range", "bytes=6-")
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusPartialContent))
+			Expect(resp.Body.String()).To(Equal("image"))
+			Expect(atomic.LoadInt32(&conversions)).To(BeEquivalentTo(2))
+			Eventually(func() bool {
+				_, err := os.Stat(scratchFile.Load().(string))
+				return errors.Is(err, os.ErrNotExist)
+			}).Should(BeTrue(), "the converted image should be removed from scratch space")
+		})
+
+		It("should keep only one converted volume in scratch space", func() {
+			var converting, conversions int32
+			release := make(chan struct{})
+			convertToQcow2 = func(_ context.Context, _, dst string, _ bool) error {
+				atomic.AddInt32(&converting, 1)
+				atomic.AddInt32(&conversions, 1)
+				<-release
+				atomic.AddInt32(&converting, -1)
+				return os.WriteFile(dst, []byte("qcow2 image"), 0600)
+			}
+
+			responses := make(chan *httptest.ResponseRecorder, 2)
+			for _, handler := range []http.Handler{qcow2Handler("/tmp/disk1.img", false), qcow2Handler("/tmp/disk2.img", false)} {
+				go func(handler http.Handler) {
+					defer GinkgoRecover()
+					req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.qcow2", nil)
+					Expect(err).ToNot(HaveOccurred())
+					resp := httptest.NewRecorder()
+					handler.ServeHTTP(resp, req)
+					responses <- resp
+				}(handler)
+			}
+			Eventually(func() int32 { return atomic.LoadInt32(&converting) }).Should(BeEquivalentTo(1))
+			Consistently(func() int32 { return atomic.LoadInt32(&converting) }, 200*time.Millisecond).Should(BeEquivalentTo(1))
+			close(release)
+			for i := 0; i < 2; i++ {
+				Expect((<-responses).Code).To(BeEquivalentTo(http.StatusOK))
+			}
+			Expect(atomic.LoadInt32(&conversions)).To(BeEquivalentTo(2))
+		})
+	})
+
+	Context("Resumable downloads", func() {
+		const content = "some content of the exported volume, long enough to be compressed"
+		var (
+			tmpDir   string
+			diskPath string
+		)
+
+		BeforeEach(func() {
+			tmpDir = GinkgoT().TempDir()
+			diskPath = filepath.Join(tmpDir, "disk.img")
+			Expect(os.WriteFile(diskPath, []byte(content), 0600)).To(Succeed())
+		})
+
+		serve := func(handler http.Handler, headers map[string]string) *httptest.ResponseRecorder {
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/disk.img", nil)
+			Expect(err).ToNot(HaveOccurred())
+			for k, v := range headers {
+				req.Header.Set(k, v)
+			}
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			return resp
+		}
+
+		It("should serve ranges of the raw volume with an ETag", func() {
+			full := serve(fileHandler(diskPath), nil)
+			Expect(full.Code).To(Equal(http.StatusOK))
+			etag := full.Header().Get("ETag")
+			Expect(etag).ToNot(BeEmpty())
+
+			partial := serve(fileHandler(diskPath), map[string]string{"Range": "bytes=5-", "If-Range": etag})
+			Expect(partial.Code).To(Equal(http.StatusPartialContent))
+			Expect(partial.Body.String()).To(Equal(content[5:]))
+		})
+
+		// serveComputed retries the request until the digest of the volume is computed
+		serveComputed := func(handler http.Handler, headers map[string]string) *httptest.ResponseRecorder {
+			var resp *httptest.ResponseRecorder
+			Eventually(func() int {
+				resp = serve(handler, headers)
+				return resp.Code
+			}).ShouldNot(Equal(http.StatusServiceUnavailable))
+			return resp
+		}
+
+		It("should serve ranges of the gzipped volume", func() {
+			handler := gzipHandler(diskPath)
+			full := serve(handler, nil)
+			Expect(full.Code).To(Equal(http.StatusOK))
+			Expect(full.Header().Get("Accept-Ranges")).To(Equal("bytes"))
+			etag := full.Header().Get("ETag")
+			Expect(etag).ToNot(BeEmpty())
+			compressed := full.Body.Bytes()
+
+			partial := serveComputed(handler, map[string]string{"Range": "bytes=10-", "If-Range": etag})
+			Expect(partial.Code).To(Equal(http.StatusPartialContent))
+			Expect(partial.Header().Get("Content-Range")).To(Equal(fmt.Sprintf("bytes 10-%d/%d", len(compressed)-1, len(compressed))))
+			Expect(partial.Body.Bytes()).To(Equal(compressed[10:]))
+		})
+
+		It("should serve ranges starting in a later gzip member", func() {
+			origMemberSize := gzipMemberSize
+			gzipMemberSize = 16
+			DeferCleanup(func() { gzipMemberSize = origMemberSize })
+
+			handler := gzipHandler(diskPath)
+			compressed := serve(handler, nil).Body.Bytes()
+			digest := getVolumeDigest(diskPath)
+			Eventually(digest.done).Should(BeClosed())
+			Expect(digest.err).ToNot(HaveOccurred())
+			Expect(digest.gzipOffsets).To(HaveLen((len(content) + 15) / 16))
+			Expect(digest.gzipSize).To(BeEquivalentTo(len(compressed)))
+
+			// The members are valid gzip streams which decompress to the whole volume
+			zr, err := gzip.NewReader(bytes.NewReader(compressed))
+			Expect(err).ToNot(HaveOccurred())
+			raw, err := io.ReadAll(zr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal(content))
+
+			start := digest.gzipOffsets[2] + 3
+			end := digest.gzipOffsets[3] + 5
+			partial := serve(handler, map[string]string{"Range": fmt.Sprintf("bytes=%d-%d", start, end)})
+			Expect(partial.Code).To(Equal(http.StatusPartialContent))
+			Expect(partial.Body.Bytes()).To(Equal(compressed[start : end+1]))
+		})
+
+		It("should ask the client to retry while the digest is computed", func() {
+			digest := &volumeDigest{done: make(chan struct{})}
+			resp := httptest.NewRecorder()
+			Expect(digest.ready(resp, diskPath)).To(BeFalse())
+			Expect(resp.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(resp.Header().Get("Retry-After")).To(Equal(retryAfter))
+
+			close(digest.done)
+			Expect(digest.ready(httptest.NewRecorder(), diskPath)).To(BeTrue())
+		})
+
+		It("should compute the digest on the first request which needs it", func() {
+			digestExists := func() bool {
+				volumeDigestsLock.Lock()
+				defer volumeDigestsLock.Unlock()
+				_, exists := volumeDigests[diskPath]
+				return exists
+			}
+			gzip := gzipHandler(diskPath)
+			checksums := checksumHandler(diskPath)
+			Expect(digestExists()).To(BeFalse())
+
+			Expect(serve(gzip, nil).Code).To(Equal(http.StatusOK))
+			Expect(digestExists()).To(BeFalse())
+
+			Expect(serveComputed(checksums, nil).Code).To(Equal(http.StatusOK))
+			Expect(digestExists()).To(BeTrue())
+		})
+
+		It("should serve the whole gzipped volume if the ETag changed", func() {
+			handler := gzipHandler(diskPath)
+			compressed := serve(handler, nil).Body.Bytes()
+
+			resp := serve(handler, map[string]string{"Range": "bytes=10-", "If-Range": `"outdated"`})
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			Expect(resp.Body.Bytes()).To(Equal(compressed))
+		})
+
+		It("should reject a range beyond the end of the gzipped volume", func() {
+			resp := serveComputed(gzipHandler(diskPath), map[string]string{"Range": "bytes=100000-"})
+			Expect(resp.Code).To(Equal(http.StatusRequestedRangeNotSatisfiable))
+		})
+
+		It("should return the checksums of the raw volume", func() {
+			resp := serveComputed(checksumHandler(diskPath), nil)
+			Expect(resp.Code).To(Equal(http.StatusOK))
+			checksums := &VolumeChecksums{}
+			Expect(json.Unmarshal(resp.Body.Bytes(), checksums)).To(Succeed())
+			sum := sha256.Sum256([]byte(content))
+			Expect(checksums.Size).To(BeEquivalentTo(len(content)))
+			Expect(checksums.SHA256).To(Equal(hex.EncodeToString(sum[:])))
+		})
+
+		DescribeTable("should parse ranges", func(header string, expectedStart, expectedEnd int64, expectedOk bool, expectedErr error) {
+			start, end, ok, err := parseSingleRange(header, 100)
+			if expectedErr != nil {
+				Expect(err).To(MatchError(expectedErr))
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(ok).To(Equal(expectedOk))
+			if ok {
+				Expect(start).To(Equal(expectedStart))
+				Expect(end).To(Equal(expectedEnd))
+			}
+		},
+			Entry("open ended", "bytes=10-", int64(10), int64(99), true, nil),
+			Entry("closed", "bytes=10-19", int64(10), int64(19), true, nil),
+			Entry("end beyond the size", "bytes=10-200", int64(10), int64(99), true, nil),
+			Entry("suffix", "bytes=-10", int64(90), int64(99), true, nil),
+			Entry("multiple ranges", "bytes=0-1,5-6", int64(0), int64(0), false, nil),
+			Entry("other unit", "items=0-1", int64(0), int64(0), false, nil),
+			Entry("start beyond the size", "bytes=100-", int64(0), int64(0), false, errRangeNotSatisfiable),
+		)
+	})
+
+	Context("Extents handler", func() {
+		var (
+			orgExtentChunkSize = extentChunkSize
+			tmpDir             string
+		)
+
+		BeforeEach(func() {
+			var err error
+			extentChunkSize = 4
+			tmpDir, err = os.MkdirTemp("", "extents")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			extentChunkSize = orgExtentChunkSize
+			os.RemoveAll(tmpDir)
+		})
+
+		writeImage := func(name, content string) string {
+			p := filepath.Join(tmpDir, name)
+			Expect(os.WriteFile(p, []byte(content), 0600)).To(Succeed())
+			return p
+		}
+
+		// getExtents waits until the extents are computed in the background
+		getExtents := func(handler http.Handler) *httptest.ResponseRecorder {
+			var resp *httptest.ResponseRecorder
+			Eventually(func() int {
+				req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/changed-extents", nil)
+				Expect(err).ToNot(HaveOccurred())
+				resp = httptest.NewRecorder()
+				handler.ServeHTTP(resp, req)
+				return resp.Code
+			}).ShouldNot(Equal(http.StatusServiceUnavailable))
+			return resp
+		}
+
+		DescribeTable("should return error on non GET", func(verb string) {
+			req, err := http.NewRequest(verb, "https://test.blah.invalid/volumes/v1/changed-extents", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			extentsHandler("/tmp/disk.img", "").ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("POST", "POST"),
+			Entry("PUT", "PUT"),
+			Entry("PATCH", "PATCH"),
+			Entry("DELETE", "DELETE"),
+		)
+
+		DescribeTable("should return the changed extents", func(current, base string, noBase bool, expected []Extent) {
+			currentPath := writeImage("disk.img", current)
+			basePath := ""
+			if !noBase {
+				basePath = writeImage("base.img", base)
+			}
+			resp := getExtents(extentsHandler(currentPath, basePath))
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			var extents []Extent
+			Expect(json.Unmarshal(resp.Body.Bytes(), &extents)).To(Succeed())
+			Expect(extents).To(Equal(expected))
+		},
+			Entry("without changes", "aaaabbbbcccc", "aaaabbbbcccc", false, []Extent{}),
+			Entry("with a single changed chunk", "aaaaXbbbcccc", "aaaabbbbcccc", false, []Extent{{Offset: 4, Length: 4}}),
+			Entry("with adjacent changed chunks coalesced", "XaaaXbbbcccc", "aaaabbbbcccc", false, []Extent{{Offset: 0, Length: 8}}),
+			Entry("with separate changed chunks", "XaaabbbbcccX", "aaaabbbbcccc", false, []Extent{{Offset: 0, Length: 4}, {Offset: 8, Length: 4}}),
+			Entry("with the volume grown since the base", "aaaabbbbccccdd", "aaaabbbbcccc", false, []Extent{{Offset: 12, Length: 2}}),
+			Entry("without a base volume", "aaaabbbbcccc", "", true, []Extent{{Offset: 0, Length: 12}}),
+		)
+
+		It("should return 500 if the volume cannot be read", func() {
+			resp := getExtents(extentsHandler(filepath.Join(tmpDir, "missing.img"), ""))
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusInternalServerError))
+		})
+
+		It("should ask the client to retry while the extents are computed", func() {
+			// A FIFO blocks opening it for reading until a writer shows up
+			fifo := filepath.Join(tmpDir, "fifo")
+			Expect(unix.Mkfifo(fifo, 0600)).To(Succeed())
+			handler := extentsHandler(fifo, "")
+
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/volumes/v1/changed-extents", nil)
+			Expect(err).ToNot(HaveOccurred())
+			resp := httptest.NewRecorder()
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusServiceUnavailable))
+			Expect(resp.Header().Get("Retry-After")).ToNot(BeEmpty())
+
+			writer, err := os.OpenFile(fifo, os.O_WRONLY, 0)
+			Expect(err).ToNot(HaveOccurred())
+			writer.Close()
+			Expect(getExtents(handler).Code).ToNot(Equal(http.StatusServiceUnavailable))
+		})
+
+		It("should skip the holes of sparse volumes", func() {
+			const blockSize = 64 * 1024
+			extentChunkSize = blockSize
+			writeSparse := func(name string, data map[int64]string) string {
+				p := filepath.Join(tmpDir, name)
+				f, err := os.Create(p)
+				Expect(err).ToNot(HaveOccurred())
+				defer f.Close()
+				Expect(f.Truncate(8 * blockSize)).To(Succeed())
+				for offset, content := range data {
+					_, err := f.WriteAt([]byte(content), offset)
+					Expect(err).ToNot(HaveOccurred())
+				}
+				return p
+			}
+			currentPath := writeSparse("disk.img", map[int64]string{2 * blockSize: "new", 5 * blockSize: "same"})
+			basePath := writeSparse("base.img", map[int64]string{5 * blockSize: "same", 6 * blockSize: "old"})
+
+			f, err := os.Open(currentPath)
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			if ranges := allocatedRanges(f, 8*blockSize); len(ranges) == 1 && ranges[0].Length == 8*blockSize {
+				Skip("the filesystem does not report holes")
+			}
+
+			resp := getExtents(extentsHandler(currentPath, basePath))
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			var extents []Extent
+			Expect(json.Unmarshal(resp.Body.Bytes(), &extents)).To(Succeed())
+			Expect(extents).To(Equal([]Extent{{Offset: 2 * blockSize, Length: blockSize}, {Offset: 6 * blockSize, Length: blockSize}}))
+		})
+	})
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -0,0 +1,53 @@
+package snapshot
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cron schedule", func() {
+	DescribeTable("should reject invalid expressions", func(spec string) {
+		Expect(ValidateCronSchedule(spec)).ToNot(Succeed())
+	},
+		Entry("with too few fields", "* * * *"),
+		Entry("with too many fields", "* * * * * *"),
+		Entry("with an out of range minute", "60 * * * *"),
+		Entry("with an out of range day of month", "0 0 0 * *"),
+		Entry("with an inverted range", "0 10-5 * * *"),
+		Entry("with a zero step", "*/0 * * * *"),
+		Entry("with a non numeric value", "0 0 * jan *"),
+		Entry("with an unknown descriptor", "@every"),
+	)
+
+	DescribeTable("should compute the next activation", func(spec, from, expected string) {
+		schedule, err := parseCronSchedule(spec)
+		Expect(err).ToNot(HaveOccurred())
+
+		fromTime, err := time.Parse(time.RFC3339, from)
+		Expect(err).ToNot(HaveOccurred())
+		next := schedule.next(fromTime)
+
+		if expected == "" {
+			Expect(next.IsZero()).To(BeTrue())
+			return
+		}
+		expectedTime, err := time.Parse(time.RFC3339, expected)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(next).To(Equal(expectedTime))
+	},
+		Entry("every minute", "* * * * *", "2023-01-01T10:00:30Z", "2023-01-01T10:01:00Z"),
+		Entry("every 15 minutes", "*/15 * * * *", "2023-01-01T10:15:00Z", "2023-01-01T10:30:00Z"),
+		Entry("hourly descriptor", "@hourly", "2023-01-01T10:59:00Z", "2023-01-01T11:00:00Z"),
+		Entry("daily at 2:30 across a month", "30 2 * * *", "2023-01-31T03:00:00Z", "2023-02-01T02:30:00Z"),
+		Entry("at a list of hours", "0 6,18 * * *", "2023-01-01T07:00:00Z", "2023-01-01T18:00:00Z"),
+		Entry("on weekdays", "0 0 * * 1-5", "2023-01-06T12:00:00Z", "2023-01-09T00:00:00Z"),
+		Entry("on sundays using 7", "0 0 * * 7", "2023-01-02T00:00:00Z", "2023-01-08T00:00:00Z"),
+		Entry("on either restricted day field", "0 0 15 * 1", "2023-01-03T00:00:00Z", "2023-01-09T00:00:00Z"),
+		Entry("on a leap day", "0 0 29 2 *", "2023-03-01T00:00:00Z", "2024-02-29T00:00:00Z"),
+		Entry("yearly across a year", "@yearly", "2023-06-01T00:00:00Z", "2024-01-01T00:00:00Z"),
+		Entry("never", "0 0 30 2 *", "2023-01-01T00:00:00Z", ""),
+		Entry("from a non UTC time", "0 12 * * *", "2023-01-01T13:00:00+02:00", "2023-01-01T12:00:00Z"),
+	)
+})
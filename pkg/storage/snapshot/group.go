@@ -0,0 +1,566 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	groupSnapshotMemberCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	groupSnapshotFrozenEvent = "VirtualMachinesFrozen"
+
+	groupSnapshotFreezeErrorEvent = "VirtualMachinesFreezeError"
+)
+
+var groupSnapshotKind = snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineGroupSnapshot")
+
+type errMemberSnapshotMissing struct {
+	name string
+}
+
+func (e errMemberSnapshotMissing) Error() string {
+	return fmt.Sprintf("VirtualMachineSnapshot %s no longer exists", e.name)
+}
+
+// VMGroupSnapshotController snapshots the VMs selected by a VirtualMachineGroupSnapshot
+// at a single crash consistent point in time. It creates a VirtualMachineSnapshot for
+// each VM, and only lets them take their volume snapshots once the file systems of all
+// the running VMs are frozen
+type VMGroupSnapshotController struct {
+	Client kubecli.KubevirtClient
+
+	VMGroupSnapshotInformer   cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	VMIInformer               cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmGroupSnapshotQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the group snapshot controller
+func (ctrl *VMGroupSnapshotController) Init() error {
+	ctrl.vmGroupSnapshotQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-snapshot-group-vmgroupsnapshot")
+
+	_, err := ctrl.VMGroupSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMGroupSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMGroupSnapshot(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotContentInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotContent,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotContent(newObj) },
+		},
+	)
+
+	return err
+}
+
+// Run the controller
+func (ctrl *VMGroupSnapshotController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmGroupSnapshotQueue.ShutDown()
+
+	log.Log.Info("Starting group snapshot controller.")
+	defer log.Log.Info("Shutting down group snapshot controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMGroupSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmGroupSnapshotWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMGroupSnapshotController) vmGroupSnapshotWorker() {
+	for ctrl.processVMGroupSnapshotWorkItem() {
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) processVMGroupSnapshotWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmGroupSnapshotQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmGroupSnapshot worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMGroupSnapshotInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupSnapshot, ok := storeObj.(*snapshotv1.VirtualMachineGroupSnapshot)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMGroupSnapshot(groupSnapshot.DeepCopy())
+	})
+}
+
+func (ctrl *VMGroupSnapshotController) handleVMGroupSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if groupSnapshot, ok := obj.(*snapshotv1.VirtualMachineGroupSnapshot); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(groupSnapshot)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupSnapshot)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmGroupSnapshotQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		ctrl.enqueueGroupSnapshotOf(vmSnapshot)
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) handleVMSnapshotContent(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	content, ok := obj.(*snapshotv1.VirtualMachineSnapshotContent)
+	if !ok || content.Spec.VirtualMachineSnapshotName == nil {
+		return
+	}
+
+	storeObj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(content.Namespace, *content.Spec.VirtualMachineSnapshotName))
+	if !exists || err != nil {
+		return
+	}
+
+	ctrl.enqueueGroupSnapshotOf(storeObj.(*snapshotv1.VirtualMachineSnapshot))
+}
+
+func (ctrl *VMGroupSnapshotController) enqueueGroupSnapshotOf(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+	groupSnapshotName, ok := vmSnapshot.Labels[snapshotv1.GroupSnapshotLabel]
+	if !ok {
+		return
+	}
+
+	objName := cacheKeyFunc(vmSnapshot.Namespace, groupSnapshotName)
+
+	log.Log.V(3).Infof("Handling VMSnapshot %s/%s, GroupSnapshot %s", vmSnapshot.Namespace, vmSnapshot.Name, objName)
+	ctrl.vmGroupSnapshotQueue.Add(objName)
+}
+
+func (ctrl *VMGroupSnapshotController) updateVMGroupSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineGroupSnapshot %s/%s", groupSnapshot.Namespace, groupSnapshot.Name)
+
+	// the snapshots of the VMs are garbage collected along with the group snapshot
+	if groupSnapshot.DeletionTimestamp != nil || groupSnapshotFinished(groupSnapshot) {
+		return 0, nil
+	}
+
+	groupSnapshotOut := groupSnapshot.DeepCopy()
+	if groupSnapshotOut.Status == nil {
+		f := false
+		groupSnapshotOut.Status = &snapshotv1.VirtualMachineGroupSnapshotStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: &f,
+			Frozen:     &f,
+		}
+		updateGroupSnapshotCondition(groupSnapshotOut, newProgressingCondition(corev1.ConditionTrue, "Source locking"))
+		updateGroupSnapshotCondition(groupSnapshotOut, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	}
+
+	if len(groupSnapshotOut.Status.VirtualMachineSnapshots) == 0 {
+		if err := ctrl.createMemberSnapshots(groupSnapshotOut); err != nil {
+			return 0, err
+		}
+		return 0, ctrl.updateStatus(groupSnapshot, groupSnapshotOut)
+	}
+
+	vmSnapshots, err := ctrl.getMemberSnapshots(groupSnapshotOut)
+	if missing, ok := err.(errMemberSnapshotMissing); ok {
+		setGroupSnapshotFailed(groupSnapshotOut, missing.Error())
+		return 0, ctrl.updateStatus(groupSnapshot, groupSnapshotOut)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if vmSnapshots == nil {
+		// the snapshots just created aren't in the cache yet
+		return snapshotRetryInterval, nil
+	}
+
+	if failed := firstFailedSnapshot(vmSnapshots); failed != nil {
+		message := fmt.Sprintf("VirtualMachineSnapshot %s failed", failed.Name)
+		if failed.Status.Error != nil && failed.Status.Error.Message != nil {
+			message = fmt.Sprintf("%s: %s", message, *failed.Status.Error.Message)
+		}
+		setGroupSnapshotFailed(groupSnapshotOut, message)
+		return 0, ctrl.updateStatus(groupSnapshot, groupSnapshotOut)
+	}
+
+	if !groupSnapshotFrozen(groupSnapshotOut) {
+		ready, err := ctrl.membersReadyToFreeze(vmSnapshots)
+		if err != nil || !ready {
+			// the group snapshot is requeued once the missing contents are created
+			return 0, err
+		}
+
+		if err := ctrl.freezeMembers(groupSnapshotOut, vmSnapshots); err != nil {
+			return 0, err
+		}
+
+		t := true
+		groupSnapshotOut.Status.Frozen = &t
+		updateGroupSnapshotCondition(groupSnapshotOut, newProgressingCondition(corev1.ConditionTrue, "Virtual machines frozen"))
+		return 0, ctrl.updateStatus(groupSnapshot, groupSnapshotOut)
+	}
+
+	updateGroupSnapshotProgress(groupSnapshotOut, vmSnapshots)
+
+	return 0, ctrl.updateStatus(groupSnapshot, groupSnapshotOut)
+}
+
+func (ctrl *VMGroupSnapshotController) updateStatus(groupSnapshot, groupSnapshotOut *snapshotv1.VirtualMachineGroupSnapshot) error {
+	if equality.Semantic.DeepEqual(groupSnapshot.Status, groupSnapshotOut.Status) {
+		return nil
+	}
+
+	// since no status subresource can update status directly
+	_, err := ctrl.Client.VirtualMachineGroupSnapshot(groupSnapshotOut.Namespace).Update(context.Background(), groupSnapshotOut, metav1.UpdateOptions{})
+	return err
+}
+
+// createMemberSnapshots creates a snapshot of every VM selected by the group snapshot
+// and records them in its status
+func (ctrl *VMGroupSnapshotController) createMemberSnapshots(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) error {
+	selector, err := metav1.LabelSelectorAsSelector(&groupSnapshot.Spec.Selector)
+	if err != nil {
+		setGroupSnapshotFailed(groupSnapshot, fmt.Sprintf("invalid selector: %v", err))
+		return nil
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, groupSnapshot.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var members []snapshotv1.GroupSnapshotMember
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+
+		vmSnapshot := newGroupMemberSnapshot(groupSnapshot, vm)
+		_, err := ctrl.Client.VirtualMachineSnapshot(groupSnapshot.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to snapshot VM %s: %v", vm.Name, err)
+		}
+
+		if err == nil {
+			ctrl.Recorder.Eventf(
+				groupSnapshot,
+				corev1.EventTypeNormal,
+				groupSnapshotMemberCreateEvent,
+				"Successfully created VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+
+		members = append(members, snapshotv1.GroupSnapshotMember{
+			VirtualMachineName:         vm.Name,
+			VirtualMachineSnapshotName: vmSnapshot.Name,
+		})
+	}
+
+	if len(members) == 0 {
+		setGroupSnapshotFailed(groupSnapshot, "no VirtualMachine matches the selector")
+		return nil
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].VirtualMachineName < members[j].VirtualMachineName
+	})
+	groupSnapshot.Status.VirtualMachineSnapshots = members
+
+	return nil
+}
+
+// getMemberSnapshots returns the snapshots of the VMs of the group, or nil when some of them
+// aren't in the cache yet
+func (ctrl *VMGroupSnapshotController) getMemberSnapshots(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, member := range groupSnapshot.Status.VirtualMachineSnapshots {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(groupSnapshot.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			_, err := ctrl.Client.VirtualMachineSnapshot(groupSnapshot.Namespace).Get(context.Background(), member.VirtualMachineSnapshotName, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				return nil, errMemberSnapshotMissing{name: member.VirtualMachineSnapshotName}
+			}
+			return nil, err
+		}
+
+		vmSnapshots = append(vmSnapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+	}
+
+	return vmSnapshots, nil
+}
+
+// membersReadyToFreeze checks that all the VMs of the group are locked, which is
+// the case once the contents of their snapshots are created
+func (ctrl *VMGroupSnapshotController) membersReadyToFreeze(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	for _, vmSnapshot := range vmSnapshots {
+		_, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, GetVMSnapshotContentName(vmSnapshot)))
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// freezeMembers freezes the file systems of the running VMs of the group which have a guest agent,
+// the VMs already frozen are thawed again when one of them fails to be frozen
+func (ctrl *VMGroupSnapshotController) freezeMembers(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) error {
+	unfreezeTimeout := getGroupFailureDeadline(groupSnapshot)
+
+	var frozen []string
+	for _, vmSnapshot := range vmSnapshots {
+		vmName := vmSnapshot.Spec.Source.Name
+		canFreeze, err := ctrl.canFreeze(groupSnapshot.Namespace, vmName)
+		if err != nil {
+			return err
+		}
+
+		if !canFreeze {
+			log.Log.V(3).Infof("Not freezing vm %s, it isn't running with a guest agent", vmName)
+			continue
+		}
+
+		log.Log.V(3).Infof("Freezing vm %s file system before taking the group snapshot", vmName)
+
+		err = ctrl.Client.VirtualMachineInstance(groupSnapshot.Namespace).Freeze(context.Background(), vmName, unfreezeTimeout)
+		if err != nil {
+			ctrl.Recorder.Eventf(
+				groupSnapshot,
+				corev1.EventTypeWarning,
+				groupSnapshotFreezeErrorEvent,
+				"Failed to freeze vm %s: %v",
+				vmName,
+				err,
+			)
+			ctrl.unfreezeMembers(groupSnapshot.Namespace, frozen)
+			return err
+		}
+
+		frozen = append(frozen, vmName)
+	}
+
+	ctrl.Recorder.Eventf(
+		groupSnapshot,
+		corev1.EventTypeNormal,
+		groupSnapshotFrozenEvent,
+		"Froze %d of the %d VMs of the group",
+		len(frozen),
+		len(vmSnapshots),
+	)
+
+	return nil
+}
+
+func (ctrl *VMGroupSnapshotController) unfreezeMembers(namespace string, vmNames []string) {
+	for _, vmName := range vmNames {
+		if err := ctrl.Client.VirtualMachineInstance(namespace).Unfreeze(context.Background(), vmName); err != nil {
+			log.Log.Warningf("Failed to unfreeze vm %s/%s: %v", namespace, vmName, err)
+		}
+	}
+}
+
+func (ctrl *VMGroupSnapshotController) canFreeze(namespace, vmName string) (bool, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, vmName))
+	if err != nil || !exists {
+		return false, err
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasCondition(obj.(*kubevirtv1.VirtualMachineInstance), kubevirtv1.VirtualMachineInstanceAgentConnected), nil
+}
+
+// updateGroupSnapshotProgress aggregates the status of the snapshots of the VMs of the group
+func updateGroupSnapshotProgress(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	var creationTime *metav1.Time
+	succeeded, ready := true, true
+	for _, vmSnapshot := range vmSnapshots {
+		if !vmSnapshotSucceeded(vmSnapshot) {
+			succeeded = false
+			break
+		}
+
+		if !VmSnapshotReady(vmSnapshot) {
+			ready = false
+		}
+
+		if t := vmSnapshot.Status.CreationTime; t != nil && (creationTime == nil || creationTime.Before(t)) {
+			creationTime = t
+		}
+	}
+
+	if !succeeded {
+		return
+	}
+
+	groupSnapshot.Status.Phase = snapshotv1.Succeeded
+	groupSnapshot.Status.CreationTime = creationTime
+	groupSnapshot.Status.ReadyToUse = &ready
+	updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	if ready {
+		updateGroupSnapshotCondition(groupSnapshot, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+	}
+}
+
+func setGroupSnapshotFailed(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, message string) {
+	groupSnapshot.Status.Phase = snapshotv1.Failed
+	groupSnapshot.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+	updateGroupSnapshotCondition(groupSnapshot, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateGroupSnapshotCondition(groupSnapshot, newFailureCondition(corev1.ConditionTrue, message))
+}
+
+func firstFailedSnapshot(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) *snapshotv1.VirtualMachineSnapshot {
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshotFailed(vmSnapshot) || vmSnapshotDeleting(vmSnapshot) {
+			return vmSnapshot
+		}
+	}
+
+	return nil
+}
+
+func newGroupMemberSnapshot(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, vm *kubevirtv1.VirtualMachine) *snapshotv1.VirtualMachineSnapshot {
+	apiGroup := core.GroupName
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", groupSnapshot.Name, vm.Name),
+			Namespace: groupSnapshot.Namespace,
+			Labels: map[string]string{
+				snapshotv1.GroupSnapshotLabel: groupSnapshot.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(groupSnapshot, groupSnapshotKind),
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			DeletionPolicy:  groupSnapshot.Spec.DeletionPolicy,
+			FailureDeadline: groupSnapshot.Spec.FailureDeadline,
+		},
+	}
+}
+
+func groupSnapshotFinished(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) bool {
+	return groupSnapshot.Status != nil &&
+		(groupSnapshot.Status.Phase == snapshotv1.Succeeded || groupSnapshot.Status.Phase == snapshotv1.Failed)
+}
+
+func groupSnapshotFrozen(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) bool {
+	return groupSnapshot.Status != nil && groupSnapshot.Status.Frozen != nil && *groupSnapshot.Status.Frozen
+}
+
+func getGroupFailureDeadline(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) time.Duration {
+	failureDeadline := snapshotv1.DefaultFailureDeadline
+	if groupSnapshot.Spec.FailureDeadline != nil {
+		failureDeadline = groupSnapshot.Spec.FailureDeadline.Duration
+	}
+
+	return failureDeadline
+}
+
+func updateGroupSnapshotCondition(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, c snapshotv1.Condition) {
+	groupSnapshot.Status.Conditions = updateCondition(groupSnapshot.Status.Conditions, c, false)
+}
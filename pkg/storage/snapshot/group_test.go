@@ -0,0 +1,348 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Group snapshot controller", func() {
+	const (
+		testNamespace = "default"
+		groupName     = "group"
+	)
+
+	var (
+		controller        *VMGroupSnapshotController
+		kubevirtClient    *kubevirtfake.Clientset
+		vmiInterface      *kubecli.MockVirtualMachineInstanceInterface
+		recorder          *record.FakeRecorder
+		vmInformer        cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		snapInformer      cache.SharedIndexInformer
+		contentInformer   cache.SharedIndexInformer
+		groupSnapInformer cache.SharedIndexInformer
+	)
+
+	newGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		return &snapshotv1.VirtualMachineGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupName,
+				Namespace: testNamespace,
+				UID:       "group-uid",
+			},
+			Spec: snapshotv1.VirtualMachineGroupSnapshotSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	inProgressGroupSnapshot := func(frozen bool, vmNames ...string) *snapshotv1.VirtualMachineGroupSnapshot {
+		groupSnapshot := newGroupSnapshot()
+		groupSnapshot.Status = &snapshotv1.VirtualMachineGroupSnapshotStatus{
+			Phase:      snapshotv1.InProgress,
+			ReadyToUse: pointer.Bool(false),
+			Frozen:     pointer.Bool(frozen),
+		}
+		for _, vmName := range vmNames {
+			groupSnapshot.Status.VirtualMachineSnapshots = append(groupSnapshot.Status.VirtualMachineSnapshots, snapshotv1.GroupSnapshotMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: fmt.Sprintf("%s-%s", groupName, vmName),
+			})
+		}
+		return groupSnapshot
+	}
+
+	newVM := func(name string, selected bool) *v1.VirtualMachine {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		if selected {
+			vm.Labels = map[string]string{"app": "db"}
+		}
+		return vm
+	}
+
+	newVMI := func(name string, agentConnected bool) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+		}
+		if agentConnected {
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		}
+		return vmi
+	}
+
+	newMemberSnapshot := func(vmName string, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := newGroupMemberSnapshot(newGroupSnapshot(), newVM(vmName, true))
+		vmSnapshot.UID = types.UID("uid-" + vmName)
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:      phase,
+			ReadyToUse: pointer.Bool(phase == snapshotv1.Succeeded),
+		}
+		if phase == snapshotv1.Succeeded {
+			vmSnapshot.Status.CreationTime = currentTime()
+		}
+		return vmSnapshot
+	}
+
+	newMemberContent := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) *snapshotv1.VirtualMachineSnapshotContent {
+		return &snapshotv1.VirtualMachineSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetVMSnapshotContentName(vmSnapshot),
+				Namespace: testNamespace,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+				VirtualMachineSnapshotName: &vmSnapshot.Name,
+			},
+		}
+	}
+
+	createdSnapshots := func() []*snapshotv1.VirtualMachineSnapshot {
+		var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "create" && action.GetResource().Resource == "virtualmachinesnapshots" {
+				vmSnapshots = append(vmSnapshots, action.(interface{ GetObject() runtime.Object }).GetObject().(*snapshotv1.VirtualMachineSnapshot))
+			}
+		}
+		return vmSnapshots
+	}
+
+	updatedStatus := func() *snapshotv1.VirtualMachineGroupSnapshotStatus {
+		groupSnapshot, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineGroupSnapshots(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return groupSnapshot.Status
+	}
+
+	reconcile := func(groupSnapshot *snapshotv1.VirtualMachineGroupSnapshot, objs ...interface{}) time.Duration {
+		kubevirtClient = kubevirtfake.NewSimpleClientset(groupSnapshot)
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachine:
+				Expect(vmInformer.GetStore().Add(o)).To(Succeed())
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *snapshotv1.VirtualMachineSnapshot:
+				Expect(snapInformer.GetStore().Add(o)).To(Succeed())
+				_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			case *snapshotv1.VirtualMachineSnapshotContent:
+				Expect(contentInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+		kubevirtClient.ClearActions()
+
+		retry, err := controller.updateVMGroupSnapshot(groupSnapshot)
+		Expect(err).ToNot(HaveOccurred())
+		return retry
+	}
+
+	BeforeEach(func() {
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		snapInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		contentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		groupSnapInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(namespace)
+		}).AnyTimes()
+		virtClient.EXPECT().VirtualMachineGroupSnapshot(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineGroupSnapshots(namespace)
+		}).AnyTimes()
+
+		controller = &VMGroupSnapshotController{
+			Client:                    virtClient,
+			VMGroupSnapshotInformer:   groupSnapInformer,
+			VMSnapshotInformer:        snapInformer,
+			VMSnapshotContentInformer: contentInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			Recorder:                  recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	It("should snapshot the selected VMs", func() {
+		groupSnapshot := newGroupSnapshot()
+		groupSnapshot.Spec.FailureDeadline = &metav1.Duration{Duration: time.Minute}
+		reconcile(groupSnapshot, newVM("db-1", true), newVM("db-0", true), newVM("web", false))
+
+		vmSnapshots := createdSnapshots()
+		Expect(vmSnapshots).To(HaveLen(2))
+		for _, vmSnapshot := range vmSnapshots {
+			Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.GroupSnapshotLabel, groupName))
+			Expect(vmSnapshot.OwnerReferences).To(HaveLen(1))
+			Expect(vmSnapshot.OwnerReferences[0].Kind).To(Equal("VirtualMachineGroupSnapshot"))
+			Expect(*vmSnapshot.OwnerReferences[0].Controller).To(BeTrue())
+			Expect(vmSnapshot.Spec.FailureDeadline.Duration).To(Equal(time.Minute))
+		}
+
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(*status.Frozen).To(BeFalse())
+		Expect(status.VirtualMachineSnapshots).To(Equal([]snapshotv1.GroupSnapshotMember{
+			{VirtualMachineName: "db-0", VirtualMachineSnapshotName: "group-db-0"},
+			{VirtualMachineName: "db-1", VirtualMachineSnapshotName: "group-db-1"},
+		}))
+		testutils.ExpectEvent(recorder, groupSnapshotMemberCreateEvent)
+		testutils.ExpectEvent(recorder, groupSnapshotMemberCreateEvent)
+	})
+
+	It("should fail when no VM matches the selector", func() {
+		reconcile(newGroupSnapshot(), newVM("web", false))
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*status.Error.Message).To(Equal("no VirtualMachine matches the selector"))
+	})
+
+	It("should not freeze the VMs before all of them are locked", func() {
+		db0 := newMemberSnapshot("db-0", snapshotv1.InProgress)
+		db1 := newMemberSnapshot("db-1", snapshotv1.InProgress)
+		reconcile(inProgressGroupSnapshot(false, "db-0", "db-1"),
+			db0, db1, newMemberContent(db0), newVMI("db-0", true), newVMI("db-1", true))
+
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should retry while the snapshots of the VMs aren't in the cache", func() {
+		db0 := newMemberSnapshot("db-0", snapshotv1.InProgress)
+		groupSnapshot := inProgressGroupSnapshot(false, "db-0")
+		kubevirtClient = kubevirtfake.NewSimpleClientset(groupSnapshot, db0)
+
+		retry, err := controller.updateVMGroupSnapshot(groupSnapshot)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(snapshotRetryInterval))
+	})
+
+	It("should fail when the snapshot of a VM no longer exists", func() {
+		reconcile(inProgressGroupSnapshot(false, "db-0"))
+
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*status.Error.Message).To(Equal("VirtualMachineSnapshot group-db-0 no longer exists"))
+	})
+
+	It("should freeze the running VMs with a guest agent once all of them are locked", func() {
+		db0 := newMemberSnapshot("db-0", snapshotv1.InProgress)
+		db1 := newMemberSnapshot("db-1", snapshotv1.InProgress)
+		db2 := newMemberSnapshot("db-2", snapshotv1.InProgress)
+		vmiInterface.EXPECT().Freeze(context.Background(), "db-0", snapshotv1.DefaultFailureDeadline).Return(nil)
+		reconcile(inProgressGroupSnapshot(false, "db-0", "db-1", "db-2"),
+			db0, db1, db2, newMemberContent(db0), newMemberContent(db1), newMemberContent(db2),
+			newVMI("db-0", true), newVMI("db-1", false))
+
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.InProgress))
+		Expect(*status.Frozen).To(BeTrue())
+		testutils.ExpectEvent(recorder, groupSnapshotFrozenEvent)
+	})
+
+	It("should unfreeze the frozen VMs when one of them fails to be frozen", func() {
+		db0 := newMemberSnapshot("db-0", snapshotv1.InProgress)
+		db1 := newMemberSnapshot("db-1", snapshotv1.InProgress)
+		groupSnapshot := inProgressGroupSnapshot(false, "db-0", "db-1")
+		kubevirtClient = kubevirtfake.NewSimpleClientset(groupSnapshot)
+		for _, obj := range []interface{}{db0, db1, newMemberContent(db0), newMemberContent(db1)} {
+			switch o := obj.(type) {
+			case *snapshotv1.VirtualMachineSnapshot:
+				Expect(snapInformer.GetStore().Add(o)).To(Succeed())
+			case *snapshotv1.VirtualMachineSnapshotContent:
+				Expect(contentInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+		Expect(vmiInformer.GetStore().Add(newVMI("db-0", true))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("db-1", true))).To(Succeed())
+
+		gomock.InOrder(
+			vmiInterface.EXPECT().Freeze(context.Background(), "db-0", snapshotv1.DefaultFailureDeadline).Return(nil),
+			vmiInterface.EXPECT().Freeze(context.Background(), "db-1", snapshotv1.DefaultFailureDeadline).Return(fmt.Errorf("agent not responding")),
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "db-0").Return(nil),
+		)
+
+		_, err := controller.updateVMGroupSnapshot(groupSnapshot)
+		Expect(err).To(HaveOccurred())
+		Expect(*updatedStatus().Frozen).To(BeFalse())
+		testutils.ExpectEvent(recorder, groupSnapshotFreezeErrorEvent)
+	})
+
+	It("should fail when the snapshot of a VM fails", func() {
+		db0 := newMemberSnapshot("db-0", snapshotv1.Succeeded)
+		db1 := newMemberSnapshot("db-1", snapshotv1.Failed)
+		db1.Status.Error = &snapshotv1.Error{Message: pointer.String("deadline exceeded")}
+		reconcile(inProgressGroupSnapshot(true, "db-0", "db-1"), db0, db1)
+
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.Failed))
+		Expect(*status.Error.Message).To(Equal("VirtualMachineSnapshot group-db-1 failed: deadline exceeded"))
+	})
+
+	It("should wait for the snapshots of all the VMs to succeed", func() {
+		reconcile(inProgressGroupSnapshot(true, "db-0", "db-1"),
+			newMemberSnapshot("db-0", snapshotv1.Succeeded), newMemberSnapshot("db-1", snapshotv1.InProgress))
+
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should succeed once the snapshots of all the VMs succeeded", func() {
+		reconcile(inProgressGroupSnapshot(true, "db-0", "db-1"),
+			newMemberSnapshot("db-0", snapshotv1.Succeeded), newMemberSnapshot("db-1", snapshotv1.Succeeded))
+
+		status := updatedStatus()
+		Expect(status.Phase).To(Equal(snapshotv1.Succeeded))
+		Expect(*status.ReadyToUse).To(BeTrue())
+		Expect(status.CreationTime).ToNot(BeNil())
+		Expect(status.Conditions).To(ContainElement(HaveField("Type", snapshotv1.ConditionReady)))
+	})
+
+	It("should ignore finished group snapshots", func() {
+		groupSnapshot := inProgressGroupSnapshot(true, "db-0")
+		groupSnapshot.Status.Phase = snapshotv1.Succeeded
+		reconcile(groupSnapshot)
+
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+})
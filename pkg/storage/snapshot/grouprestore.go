@@ -0,0 +1,358 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/api/core"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	groupRestoreMemberCreateEvent = "SuccessfulVirtualMachineRestoreCreate"
+
+	groupRestoreCompleteEvent = "VirtualMachineGroupRestoreComplete"
+)
+
+// VMGroupRestoreController restores the VMs of a VirtualMachineGroupSnapshot. It creates
+// a VirtualMachineRestore of every VM from its snapshot in the group, and completes once
+// all of them are complete
+type VMGroupRestoreController struct {
+	Client kubecli.KubevirtClient
+
+	VMGroupRestoreInformer  cache.SharedIndexInformer
+	VMGroupSnapshotInformer cache.SharedIndexInformer
+	VMRestoreInformer       cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmGroupRestoreQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the group restore controller
+func (ctrl *VMGroupRestoreController) Init() error {
+	ctrl.vmGroupRestoreQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-restore-group-vmgrouprestore")
+
+	_, err := ctrl.VMGroupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMGroupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMGroupRestore(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMRestore(newObj) },
+			DeleteFunc: ctrl.handleVMRestore,
+		},
+	)
+
+	return err
+}
+
+// Run the controller
+func (ctrl *VMGroupRestoreController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmGroupRestoreQueue.ShutDown()
+
+	log.Log.Info("Starting group restore controller.")
+	defer log.Log.Info("Shutting down group restore controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMGroupRestoreInformer.HasSynced,
+		ctrl.VMGroupSnapshotInformer.HasSynced,
+		ctrl.VMRestoreInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmGroupRestoreWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMGroupRestoreController) vmGroupRestoreWorker() {
+	for ctrl.processVMGroupRestoreWorkItem() {
+	}
+}
+
+func (ctrl *VMGroupRestoreController) processVMGroupRestoreWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmGroupRestoreQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmGroupRestore worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMGroupRestoreInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupRestore, ok := storeObj.(*snapshotv1.VirtualMachineGroupRestore)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+
+		return ctrl.updateVMGroupRestore(groupRestore.DeepCopy())
+	})
+}
+
+func (ctrl *VMGroupRestoreController) handleVMGroupRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if groupRestore, ok := obj.(*snapshotv1.VirtualMachineGroupRestore); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(groupRestore)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupRestore)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.vmGroupRestoreQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMGroupRestoreController) handleVMRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore)
+	if !ok {
+		return
+	}
+
+	groupRestoreName, ok := vmRestore.Labels[snapshotv1.GroupRestoreLabel]
+	if !ok {
+		return
+	}
+
+	objName := cacheKeyFunc(vmRestore.Namespace, groupRestoreName)
+
+	log.Log.V(3).Infof("Handling VMRestore %s/%s, GroupRestore %s", vmRestore.Namespace, vmRestore.Name, objName)
+	ctrl.vmGroupRestoreQueue.Add(objName)
+}
+
+func (ctrl *VMGroupRestoreController) updateVMGroupRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineGroupRestore %s/%s", groupRestore.Namespace, groupRestore.Name)
+
+	if groupRestore.DeletionTimestamp != nil || groupRestoreComplete(groupRestore) {
+		return 0, nil
+	}
+
+	groupRestoreOut := groupRestore.DeepCopy()
+	if groupRestoreOut.Status == nil {
+		f := false
+		groupRestoreOut.Status = &snapshotv1.VirtualMachineGroupRestoreStatus{
+			Complete: &f,
+		}
+		updateGroupRestoreCondition(groupRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineGroupRestore"))
+		updateGroupRestoreCondition(groupRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineGroupRestore"))
+	}
+
+	if len(groupRestoreOut.Status.VirtualMachineRestores) == 0 {
+		if err := ctrl.createMemberRestores(groupRestoreOut); err != nil {
+			reason := err.Error()
+			updateGroupRestoreCondition(groupRestoreOut, newProgressingCondition(corev1.ConditionFalse, reason))
+			updateGroupRestoreCondition(groupRestoreOut, newReadyCondition(corev1.ConditionFalse, reason))
+			if err2 := ctrl.updateStatus(groupRestore, groupRestoreOut); err2 != nil {
+				return 0, err2
+			}
+			return 0, err
+		}
+		return 0, ctrl.updateStatus(groupRestore, groupRestoreOut)
+	}
+
+	vmRestores, err := ctrl.getMemberRestores(groupRestoreOut)
+	if err != nil {
+		return 0, err
+	}
+	if vmRestores == nil {
+		// the restores just created aren't in the cache yet
+		return snapshotRetryInterval, nil
+	}
+
+	updateGroupRestoreProgress(groupRestoreOut, vmRestores)
+	if groupRestoreComplete(groupRestoreOut) {
+		ctrl.Recorder.Eventf(
+			groupRestoreOut,
+			corev1.EventTypeNormal,
+			groupRestoreCompleteEvent,
+			"Successfully completed VirtualMachineGroupRestore %s",
+			groupRestoreOut.Name,
+		)
+	}
+
+	return 0, ctrl.updateStatus(groupRestore, groupRestoreOut)
+}
+
+func (ctrl *VMGroupRestoreController) updateStatus(groupRestore, groupRestoreOut *snapshotv1.VirtualMachineGroupRestore) error {
+	if equality.Semantic.DeepEqual(groupRestore.Status, groupRestoreOut.Status) {
+		return nil
+	}
+
+	// since no status subresource can update status directly
+	_, err := ctrl.Client.VirtualMachineGroupRestore(groupRestoreOut.Namespace).Update(context.Background(), groupRestoreOut, metav1.UpdateOptions{})
+	return err
+}
+
+// createMemberRestores creates a restore of every VM of the group snapshot from its
+// snapshot, and records them in the status of the group restore
+func (ctrl *VMGroupRestoreController) createMemberRestores(groupRestore *snapshotv1.VirtualMachineGroupRestore) error {
+	groupSnapshot, err := ctrl.getGroupSnapshot(groupRestore.Namespace, groupRestore.Spec.VirtualMachineGroupSnapshotName)
+	if err != nil {
+		return err
+	}
+
+	if groupSnapshot == nil || groupSnapshot.Status == nil || groupSnapshot.Status.ReadyToUse == nil || !*groupSnapshot.Status.ReadyToUse {
+		return fmt.Errorf("VirtualMachineGroupSnapshot %s is not ready to use", groupRestore.Spec.VirtualMachineGroupSnapshotName)
+	}
+
+	var members []snapshotv1.GroupRestoreMember
+	for _, member := range groupSnapshot.Status.VirtualMachineSnapshots {
+		vmRestore := newGroupMemberRestore(groupRestore, member)
+		_, err := ctrl.Client.VirtualMachineRestore(groupRestore.Namespace).Create(context.Background(), vmRestore, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to restore VM %s: %v", member.VirtualMachineName, err)
+		}
+
+		if err == nil {
+			ctrl.Recorder.Eventf(
+				groupRestore,
+				corev1.EventTypeNormal,
+				groupRestoreMemberCreateEvent,
+				"Successfully created VirtualMachineRestore %s",
+				vmRestore.Name,
+			)
+		}
+
+		members = append(members, snapshotv1.GroupRestoreMember{
+			VirtualMachineName:        member.VirtualMachineName,
+			VirtualMachineRestoreName: vmRestore.Name,
+		})
+	}
+
+	groupRestore.Status.VirtualMachineRestores = members
+	updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionTrue, "Restoring VirtualMachines"))
+	updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionFalse, "Waiting for VirtualMachineRestores"))
+
+	return nil
+}
+
+func (ctrl *VMGroupRestoreController) getGroupSnapshot(namespace, name string) (*snapshotv1.VirtualMachineGroupSnapshot, error) {
+	obj, exists, err := ctrl.VMGroupSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*snapshotv1.VirtualMachineGroupSnapshot), nil
+}
+
+// getMemberRestores returns the restores of the VMs of the group, or nil when some of them
+// aren't in the cache yet
+func (ctrl *VMGroupRestoreController) getMemberRestores(groupRestore *snapshotv1.VirtualMachineGroupRestore) ([]*snapshotv1.VirtualMachineRestore, error) {
+	var vmRestores []*snapshotv1.VirtualMachineRestore
+	for _, member := range groupRestore.Status.VirtualMachineRestores {
+		obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(groupRestore.Namespace, member.VirtualMachineRestoreName))
+		if err != nil || !exists {
+			return nil, err
+		}
+
+		vmRestores = append(vmRestores, obj.(*snapshotv1.VirtualMachineRestore))
+	}
+
+	return vmRestores, nil
+}
+
+// updateGroupRestoreProgress aggregates the status of the restores of the VMs of the group
+func updateGroupRestoreProgress(groupRestore *snapshotv1.VirtualMachineGroupRestore, vmRestores []*snapshotv1.VirtualMachineRestore) {
+	var restoreTime *metav1.Time
+	for _, vmRestore := range vmRestores {
+		if VmRestoreProgressing(vmRestore) {
+			return
+		}
+
+		if t := vmRestore.Status.RestoreTime; t != nil && (restoreTime == nil || restoreTime.Before(t)) {
+			restoreTime = t
+		}
+	}
+
+	t := true
+	groupRestore.Status.Complete = &t
+	groupRestore.Status.RestoreTime = restoreTime
+	updateGroupRestoreCondition(groupRestore, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateGroupRestoreCondition(groupRestore, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+}
+
+// newGroupMemberRestore restores a VM of the group into the VM it was snapshotted from, the
+// restore is owned by that VM like any other restore and only labeled with the group restore
+func newGroupMemberRestore(groupRestore *snapshotv1.VirtualMachineGroupRestore, member snapshotv1.GroupSnapshotMember) *snapshotv1.VirtualMachineRestore {
+	apiGroup := core.GroupName
+	return &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", groupRestore.Name, member.VirtualMachineName),
+			Namespace: groupRestore.Namespace,
+			Labels: map[string]string{
+				snapshotv1.GroupRestoreLabel: groupRestore.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     member.VirtualMachineName,
+			},
+			VirtualMachineSnapshotName: member.VirtualMachineSnapshotName,
+		},
+	}
+}
+
+func groupRestoreComplete(groupRestore *snapshotv1.VirtualMachineGroupRestore) bool {
+	return groupRestore.Status != nil && groupRestore.Status.Complete != nil && *groupRestore.Status.Complete
+}
+
+func updateGroupRestoreCondition(groupRestore *snapshotv1.VirtualMachineGroupRestore, c snapshotv1.Condition) {
+	groupRestore.Status.Conditions = updateCondition(groupRestore.Status.Conditions, c, true)
+}
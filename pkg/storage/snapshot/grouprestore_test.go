@@ -0,0 +1,247 @@
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Group restore controller", func() {
+	const (
+		testNamespace     = "default"
+		groupRestoreName  = "group-restore"
+		groupSnapshotName = "group"
+	)
+
+	var (
+		controller           *VMGroupRestoreController
+		kubevirtClient       *kubevirtfake.Clientset
+		recorder             *record.FakeRecorder
+		groupRestoreInformer cache.SharedIndexInformer
+		groupSnapInformer    cache.SharedIndexInformer
+		restoreInformer      cache.SharedIndexInformer
+	)
+
+	newGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		return &snapshotv1.VirtualMachineGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupRestoreName,
+				Namespace: testNamespace,
+				UID:       "group-restore-uid",
+			},
+			Spec: snapshotv1.VirtualMachineGroupRestoreSpec{
+				VirtualMachineGroupSnapshotName: groupSnapshotName,
+			},
+		}
+	}
+
+	inProgressGroupRestore := func(vmNames ...string) *snapshotv1.VirtualMachineGroupRestore {
+		groupRestore := newGroupRestore()
+		groupRestore.Status = &snapshotv1.VirtualMachineGroupRestoreStatus{
+			Complete: pointer.Bool(false),
+		}
+		for _, vmName := range vmNames {
+			groupRestore.Status.VirtualMachineRestores = append(groupRestore.Status.VirtualMachineRestores, snapshotv1.GroupRestoreMember{
+				VirtualMachineName:        vmName,
+				VirtualMachineRestoreName: groupRestoreName + "-" + vmName,
+			})
+		}
+		return groupRestore
+	}
+
+	newReadyGroupSnapshot := func(ready bool, vmNames ...string) *snapshotv1.VirtualMachineGroupSnapshot {
+		groupSnapshot := &snapshotv1.VirtualMachineGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupSnapshotName,
+				Namespace: testNamespace,
+			},
+			Status: &snapshotv1.VirtualMachineGroupSnapshotStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.Bool(ready),
+			},
+		}
+		for _, vmName := range vmNames {
+			groupSnapshot.Status.VirtualMachineSnapshots = append(groupSnapshot.Status.VirtualMachineSnapshots, snapshotv1.GroupSnapshotMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: groupSnapshotName + "-" + vmName,
+			})
+		}
+		return groupSnapshot
+	}
+
+	newMemberRestore := func(vmName string, complete bool, restoreTime *metav1.Time) *snapshotv1.VirtualMachineRestore {
+		vmRestore := newGroupMemberRestore(newGroupRestore(), snapshotv1.GroupSnapshotMember{
+			VirtualMachineName:         vmName,
+			VirtualMachineSnapshotName: groupSnapshotName + "-" + vmName,
+		})
+		vmRestore.Status = &snapshotv1.VirtualMachineRestoreStatus{
+			Complete:    pointer.Bool(complete),
+			RestoreTime: restoreTime,
+		}
+		return vmRestore
+	}
+
+	createdRestores := func() []*snapshotv1.VirtualMachineRestore {
+		var vmRestores []*snapshotv1.VirtualMachineRestore
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "create" && action.GetResource().Resource == "virtualmachinerestores" {
+				vmRestores = append(vmRestores, action.(interface{ GetObject() runtime.Object }).GetObject().(*snapshotv1.VirtualMachineRestore))
+			}
+		}
+		return vmRestores
+	}
+
+	updatedStatus := func() *snapshotv1.VirtualMachineGroupRestoreStatus {
+		groupRestore, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineGroupRestores(testNamespace).Get(context.Background(), groupRestoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return groupRestore.Status
+	}
+
+	reconcile := func(groupRestore *snapshotv1.VirtualMachineGroupRestore, objs ...interface{}) (time.Duration, error) {
+		kubevirtClient = kubevirtfake.NewSimpleClientset(groupRestore)
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *snapshotv1.VirtualMachineGroupSnapshot:
+				Expect(groupSnapInformer.GetStore().Add(o)).To(Succeed())
+			case *snapshotv1.VirtualMachineRestore:
+				Expect(restoreInformer.GetStore().Add(o)).To(Succeed())
+			}
+		}
+		kubevirtClient.ClearActions()
+
+		return controller.updateVMGroupRestore(groupRestore)
+	}
+
+	BeforeEach(func() {
+		groupRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupRestore{})
+		groupSnapInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		restoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachineRestore(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineRestores(namespace)
+		}).AnyTimes()
+		virtClient.EXPECT().VirtualMachineGroupRestore(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineGroupRestores(namespace)
+		}).AnyTimes()
+
+		controller = &VMGroupRestoreController{
+			Client:                  virtClient,
+			VMGroupRestoreInformer:  groupRestoreInformer,
+			VMGroupSnapshotInformer: groupSnapInformer,
+			VMRestoreInformer:       restoreInformer,
+			Recorder:                recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	It("should restore every VM of the group snapshot", func() {
+		_, err := reconcile(newGroupRestore(), newReadyGroupSnapshot(true, "db-0", "db-1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		vmRestores := createdRestores()
+		Expect(vmRestores).To(HaveLen(2))
+		for i, vmName := range []string{"db-0", "db-1"} {
+			Expect(vmRestores[i].Name).To(Equal(groupRestoreName + "-" + vmName))
+			Expect(vmRestores[i].Labels).To(HaveKeyWithValue(snapshotv1.GroupRestoreLabel, groupRestoreName))
+			Expect(vmRestores[i].Spec.Target.Kind).To(Equal("VirtualMachine"))
+			Expect(vmRestores[i].Spec.Target.Name).To(Equal(vmName))
+			Expect(vmRestores[i].Spec.VirtualMachineSnapshotName).To(Equal(groupSnapshotName + "-" + vmName))
+		}
+
+		status := updatedStatus()
+		Expect(*status.Complete).To(BeFalse())
+		Expect(status.VirtualMachineRestores).To(Equal([]snapshotv1.GroupRestoreMember{
+			{VirtualMachineName: "db-0", VirtualMachineRestoreName: "group-restore-db-0"},
+			{VirtualMachineName: "db-1", VirtualMachineRestoreName: "group-restore-db-1"},
+		}))
+		testutils.ExpectEvent(recorder, groupRestoreMemberCreateEvent)
+		testutils.ExpectEvent(recorder, groupRestoreMemberCreateEvent)
+	})
+
+	DescribeTable("should not restore the VMs when the group snapshot", func(objs ...interface{}) {
+		_, err := reconcile(newGroupRestore(), objs...)
+		Expect(err).To(MatchError("VirtualMachineGroupSnapshot group is not ready to use"))
+
+		Expect(createdRestores()).To(BeEmpty())
+		status := updatedStatus()
+		Expect(status.VirtualMachineRestores).To(BeEmpty())
+		Expect(status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Status", corev1.ConditionFalse),
+			HaveField("Reason", "VirtualMachineGroupSnapshot group is not ready to use"),
+		)))
+	},
+		Entry("does not exist"),
+		Entry("is not ready", newReadyGroupSnapshot(false, "db-0")),
+	)
+
+	It("should retry while the restores of the VMs aren't in the cache", func() {
+		retry, err := reconcile(inProgressGroupRestore("db-0"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(Equal(snapshotRetryInterval))
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should wait for the restores of all the VMs to complete", func() {
+		_, err := reconcile(inProgressGroupRestore("db-0", "db-1"),
+			newMemberRestore("db-0", true, currentTime()), newMemberRestore("db-1", false, nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should complete once the restores of all the VMs are complete", func() {
+		first := metav1.NewTime(time.Now().Add(-time.Minute))
+		last := metav1.NewTime(time.Now())
+		_, err := reconcile(inProgressGroupRestore("db-0", "db-1"),
+			newMemberRestore("db-0", true, &last), newMemberRestore("db-1", true, &first))
+		Expect(err).ToNot(HaveOccurred())
+
+		status := updatedStatus()
+		Expect(*status.Complete).To(BeTrue())
+		Expect(status.RestoreTime.Time).To(BeTemporally("==", last.Time))
+		Expect(status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionReady),
+			HaveField("Status", corev1.ConditionTrue),
+		)))
+		testutils.ExpectEvent(recorder, groupRestoreCompleteEvent)
+	})
+
+	It("should ignore complete group restores", func() {
+		groupRestore := inProgressGroupRestore("db-0")
+		groupRestore.Status.Complete = pointer.Bool(true)
+		_, err := reconcile(groupRestore)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should enqueue the group restore of a restore", func() {
+		controller.handleVMRestore(newMemberRestore("db-0", false, nil))
+		Expect(controller.vmGroupRestoreQueue.Len()).To(Equal(1))
+
+		controller.handleVMRestore(&snapshotv1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: testNamespace},
+		})
+		Expect(controller.vmGroupRestoreQueue.Len()).To(Equal(1))
+	})
+})
@@ -50,6 +50,8 @@ import (
 const (
 	restoreNameAnnotation = "restore.kubevirt.io/name"
 
+	restoreNamespaceAnnotation = "restore.kubevirt.io/namespace"
+
 	populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
 
 	lastRestoreAnnotation = "restore.kubevirt.io/lastRestoreUID"
@@ -81,6 +83,13 @@ type vmRestoreTarget struct {
 	vm         *kubevirtv1.VirtualMachine
 }
 
+// volumesRestoreTarget restores the volumes as standalone PVCs, there is nothing
+// else to reconcile once they are created
+type volumesRestoreTarget struct {
+	controller *VMRestoreController
+	vmRestore  *snapshotv1.VirtualMachineRestore
+}
+
 var restoreAnnotationsToDelete = []string{
 	"pv.kubernetes.io",
 	"volume.beta.kubernetes.io",
@@ -98,6 +107,56 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+// restoreVolumePVCName returns the name of the PVC a volume is restored into,
+// the standalone PVCs are named after the target
+func restoreVolumePVCName(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) string {
+	if getRestoreMode(vmRestore) == snapshotv1.VirtualMachineRestoreModeVolumesOnly {
+		return fmt.Sprintf("%s-%s", vmRestore.Spec.Target.Name, volumeName)
+	}
+
+	return restorePVCName(vmRestore, volumeName)
+}
+
+func getRestoreMode(vmRestore *snapshotv1.VirtualMachineRestore) snapshotv1.VirtualMachineRestoreMode {
+	if vmRestore.Spec.Mode == nil {
+		return snapshotv1.VirtualMachineRestoreModeVirtualMachine
+	}
+
+	return *vmRestore.Spec.Mode
+}
+
+// getRestoreTargetNamespace returns the namespace the target is restored into
+func getRestoreTargetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace == nil || *vmRestore.Spec.TargetNamespace == "" {
+		return vmRestore.Namespace
+	}
+
+	return *vmRestore.Spec.TargetNamespace
+}
+
+func isCrossNamespaceRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return getRestoreTargetNamespace(vmRestore) != vmRestore.Namespace
+}
+
+func canOwnRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return !isCrossNamespaceRestore(vmRestore) && getRestoreMode(vmRestore) != snapshotv1.VirtualMachineRestoreModeVolumesOnly
+}
+
+// restoresVolume checks whether a volume is selected for restore, all of them are when none is listed
+func restoresVolume(vmRestore *snapshotv1.VirtualMachineRestore, volumeName string) bool {
+	if len(vmRestore.Spec.Volumes) == 0 {
+		return true
+	}
+
+	for _, name := range vmRestore.Spec.Volumes {
+		if name == volumeName {
+			return true
+		}
+	}
+
+	return false
+}
+
 func VmRestoreProgressing(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status == nil || vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete
 }
@@ -124,7 +183,8 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreOut, err)
 	}
 
-	if len(vmRestoreOut.OwnerReferences) == 0 {
+	// standalone volumes or a target in another namespace can't own the restore, so its conditions are only initialized once
+	if len(vmRestoreOut.OwnerReferences) == 0 && (canOwnRestore(vmRestoreOut) || len(vmRestoreOut.Status.Conditions) == 0) {
 		target.Own(vmRestoreOut)
 		updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
 		updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
@@ -244,7 +304,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 		return false, err
 	}
 
-	noRestore := volumesNotForRestore(content)
+	noRestore := volumesNotForRestore(vmRestore, content)
 
 	var restores []snapshotv1.VolumeRestore
 	for _, vb := range content.Spec.VolumeBackups {
@@ -268,7 +328,7 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 
 			vr := snapshotv1.VolumeRestore{
 				VolumeName:                vb.VolumeName,
-				PersistentVolumeClaimName: restorePVCName(vmRestore, vb.VolumeName),
+				PersistentVolumeClaimName: restoreVolumePVCName(vmRestore, vb.VolumeName),
 				VolumeSnapshotName:        *vb.VolumeSnapshotName,
 			}
 			restores = append(restores, vr)
@@ -287,11 +347,16 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 	createdPVC := false
 	waitingPVC := false
 	for _, restore := range restores {
-		pvc, err := ctrl.getPVC(vmRestore.Namespace, restore.PersistentVolumeClaimName)
+		pvc, err := ctrl.getPVC(getRestoreTargetNamespace(vmRestore), restore.PersistentVolumeClaimName)
 		if err != nil {
 			return false, err
 		}
 
+		if pvc != nil && pvc.Annotations[restoreNameAnnotation] != vmRestore.Name &&
+			getRestoreMode(vmRestore) == snapshotv1.VirtualMachineRestoreModeVolumesOnly {
+			return false, fmt.Errorf("PVC %s/%s already exists", pvc.Namespace, pvc.Name)
+		}
+
 		if pvc == nil {
 			backup, err := getRestoreVolumeBackup(restore.VolumeName, content)
 			if err != nil {
@@ -432,6 +497,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	var newVolumes []kubevirtv1.Volume
 	var deletedDataVolumes []string
 	updatedStatus := false
+	targetNamespace := getRestoreTargetNamespace(t.vmRestore)
 
 	for i, t := range snapshotVM.Spec.DataVolumeTemplates {
 		t.DeepCopyInto(&newTemplates[i])
@@ -439,6 +505,15 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 
 	for _, v := range snapshotVM.Spec.Template.Spec.Volumes {
 		nv := v.DeepCopy()
+		if nv.MemoryDump == nil && !restoresVolume(t.vmRestore, nv.Name) {
+			// the volumes which aren't restored are left untouched
+			if cv := t.currentVolume(nv.Name); cv != nil {
+				nv = cv
+			}
+			newVolumes = append(newVolumes, *nv)
+			continue
+		}
+
 		if nv.DataVolume != nil || nv.PersistentVolumeClaim != nil {
 			for k := range t.vmRestore.Status.Restores {
 				vr := &t.vmRestore.Status.Restores[k]
@@ -446,13 +521,13 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 					continue
 				}
 
-				pvc, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+				pvc, err := t.controller.getPVC(targetNamespace, vr.PersistentVolumeClaimName)
 				if err != nil {
 					return false, err
 				}
 
 				if pvc == nil {
-					return false, fmt.Errorf("pvc %s/%s does not exist and should", t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+					return false, fmt.Errorf("pvc %s/%s does not exist and should", targetNamespace, vr.PersistentVolumeClaimName)
 				}
 
 				if nv.DataVolume != nil {
@@ -515,6 +590,10 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 		newVolumes = append(newVolumes, *nv)
 	}
 
+	if t.doesTargetVMExist() && len(t.vmRestore.Spec.Volumes) > 0 {
+		newTemplates = t.keepUnrestoredDataVolumeTemplates(snapshotVM, newTemplates)
+	}
+
 	if t.doesTargetVMExist() && updatedStatus {
 		// find DataVolumes that will no longer exist
 		for _, cdv := range t.vm.Spec.DataVolumeTemplates {
@@ -539,7 +618,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 		newVM = &kubevirtv1.VirtualMachine{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        t.vmRestore.Spec.Target.Name,
-				Namespace:   t.vmRestore.Namespace,
+				Namespace:   targetNamespace,
 				Labels:      snapshotVM.Labels,
 				Annotations: snapshotVM.Annotations,
 			},
@@ -573,7 +652,7 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", newVM.Name, err)
 		}
-		newVM, err = t.controller.Client.VirtualMachine(t.vmRestore.Namespace).Create(context.Background(), newVM)
+		newVM, err = t.controller.Client.VirtualMachine(targetNamespace).Create(context.Background(), newVM)
 	} else {
 		newVM, err = t.controller.Client.VirtualMachine(newVM.Namespace).Update(context.Background(), newVM)
 	}
@@ -589,6 +668,56 @@ func (t *vmRestoreTarget) reconcileSpec() (bool, error) {
 	return true, nil
 }
 
+// currentVolume returns the volume of the target VM with the given name
+func (t *vmRestoreTarget) currentVolume(name string) *kubevirtv1.Volume {
+	if !t.doesTargetVMExist() || t.vm.Spec.Template == nil {
+		return nil
+	}
+
+	for _, v := range t.vm.Spec.Template.Spec.Volumes {
+		if v.Name == name {
+			return v.DeepCopy()
+		}
+	}
+
+	return nil
+}
+
+// keepUnrestoredDataVolumeTemplates replaces the DataVolumeTemplates of the snapshot which
+// belong to volumes that aren't restored with the ones currently used by the target VM
+func (t *vmRestoreTarget) keepUnrestoredDataVolumeTemplates(snapshotVM *snapshotv1.VirtualMachine, templates []kubevirtv1.DataVolumeTemplateSpec) []kubevirtv1.DataVolumeTemplateSpec {
+	unrestored := sets.NewString()
+	for _, v := range snapshotVM.Spec.Template.Spec.Volumes {
+		if v.DataVolume != nil && !restoresVolume(t.vmRestore, v.Name) {
+			unrestored.Insert(v.DataVolume.Name)
+		}
+	}
+
+	kept := sets.NewString()
+	if t.vm.Spec.Template != nil {
+		for _, v := range t.vm.Spec.Template.Spec.Volumes {
+			if v.DataVolume != nil && !restoresVolume(t.vmRestore, v.Name) {
+				kept.Insert(v.DataVolume.Name)
+			}
+		}
+	}
+
+	var newTemplates []kubevirtv1.DataVolumeTemplateSpec
+	for _, dvt := range templates {
+		if !unrestored.Has(dvt.Name) {
+			newTemplates = append(newTemplates, dvt)
+		}
+	}
+
+	for _, dvt := range t.vm.Spec.DataVolumeTemplates {
+		if kept.Has(dvt.Name) {
+			newTemplates = append(newTemplates, *dvt.DeepCopy())
+		}
+	}
+
+	return newTemplates
+}
+
 func (t *vmRestoreTarget) reconcileDataVolumes() (bool, error) {
 	createdDV := false
 	waitingDV := false
@@ -638,7 +767,8 @@ func (t *vmRestoreTarget) getVirtualMachineSnapshot(namespace, name string) (*sn
 }
 
 func (t *vmRestoreTarget) restoreInstancetypeControllerRevision(vmSnapshotRevisionName, vmSnapshotName string, vm *kubevirtv1.VirtualMachine, isPreference bool) (*appsv1.ControllerRevision, error) {
-	snapshotCR, err := t.getControllerRevision(vm.Namespace, vmSnapshotRevisionName)
+	// the revisions of the snapshot live next to it, which isn't the namespace of the VM when restoring into another one
+	snapshotCR, err := t.getControllerRevision(t.vmRestore.Namespace, vmSnapshotRevisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -752,10 +882,7 @@ func (t *vmRestoreTarget) createDataVolume(dvt kubevirtv1.DataVolumeTemplateSpec
 		return false, fmt.Errorf("Unable to create restore DataVolume manifest: %v", err)
 	}
 
-	if newDataVolume.Annotations == nil {
-		newDataVolume.Annotations = make(map[string]string)
-	}
-	newDataVolume.Annotations[restoreNameAnnotation] = t.vmRestore.Name
+	setRestoreAnnotations(t.vmRestore, newDataVolume)
 
 	if _, err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(t.vm.Namespace).Create(context.Background(), newDataVolume, v1.CreateOptions{}); err != nil {
 		t.controller.Recorder.Eventf(t.vm, corev1.EventTypeWarning, restoreDataVolumeCreateErrorEvent, "Error creating restore DataVolume %s: %v", newDataVolume.Name, err)
@@ -779,7 +906,8 @@ func (t *vmRestoreTarget) createDataVolume(dvt kubevirtv1.DataVolumeTemplateSpec
 }
 
 func (t *vmRestoreTarget) Own(obj metav1.Object) {
-	if !t.doesTargetVMExist() {
+	// owner references can't cross namespaces
+	if !t.doesTargetVMExist() || obj.GetNamespace() != "" && obj.GetNamespace() != t.vm.Namespace {
 		return
 	}
 
@@ -797,15 +925,16 @@ func (t *vmRestoreTarget) Own(obj metav1.Object) {
 }
 
 func (t *vmRestoreTarget) Cleanup() error {
+	namespace := getRestoreTargetNamespace(t.vmRestore)
 	for _, dvName := range t.vmRestore.Status.DeletedDataVolumes {
-		objKey := cacheKeyFunc(t.vmRestore.Namespace, dvName)
+		objKey := cacheKeyFunc(namespace, dvName)
 		_, exists, err := t.controller.DataVolumeInformer.GetStore().GetByKey(objKey)
 		if err != nil {
 			return err
 		}
 
 		if exists {
-			err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(t.vmRestore.Namespace).
+			err = t.controller.Client.CdiClient().CdiV1beta1().DataVolumes(namespace).
 				Delete(context.Background(), dvName, metav1.DeleteOptions{})
 			if err != nil {
 				return err
@@ -820,6 +949,31 @@ func (t *vmRestoreTarget) doesTargetVMExist() bool {
 	return t.vm != nil
 }
 
+func (t *volumesRestoreTarget) Ready() (bool, error) {
+	return true, nil
+}
+
+func (t *volumesRestoreTarget) Reconcile() (bool, error) {
+	return false, nil
+}
+
+func (t *volumesRestoreTarget) Cleanup() error {
+	return nil
+}
+
+// Own leaves the restored PVCs standalone
+func (t *volumesRestoreTarget) Own(obj metav1.Object) {}
+
+func (t *volumesRestoreTarget) UpdateDoneRestore() (bool, error) {
+	return false, nil
+}
+
+func (t *volumesRestoreTarget) UpdateRestoreInProgress() error {
+	return nil
+}
+
+func (t *volumesRestoreTarget) UpdateTarget(obj metav1.Object) {}
+
 func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
 	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
@@ -932,10 +1086,16 @@ func (ctrl *VMRestoreController) getPVC(namespace, name string) (*corev1.Persist
 }
 
 func (ctrl *VMRestoreController) getTarget(vmRestore *snapshotv1.VirtualMachineRestore) (restoreTarget, error) {
-	vmRestore.Spec.Target.DeepCopy()
+	if getRestoreMode(vmRestore) == snapshotv1.VirtualMachineRestoreModeVolumesOnly {
+		return &volumesRestoreTarget{
+			controller: ctrl,
+			vmRestore:  vmRestore,
+		}, nil
+	}
+
 	switch vmRestore.Spec.Target.Kind {
 	case "VirtualMachine":
-		vm, err := ctrl.getVM(vmRestore.Namespace, vmRestore.Spec.Target.Name)
+		vm, err := ctrl.getVM(getRestoreTargetNamespace(vmRestore), vmRestore.Spec.Target.Name)
 		if err != nil {
 			return nil, err
 		}
@@ -974,9 +1134,16 @@ func (ctrl *VMRestoreController) createRestorePVC(
 		return fmt.Errorf("missing volumeRestore")
 	}
 	pvc := CreateRestorePVCDefFromVMRestore(vmRestore.Name, volumeRestore.PersistentVolumeClaimName, volumeSnapshot, volumeBackup, sourceVmName, sourceVmNamespace)
+	pvc.Namespace = getRestoreTargetNamespace(vmRestore)
+	if isCrossNamespaceRestore(vmRestore) {
+		// only dataSourceRef can point to a VolumeSnapshot of another namespace
+		pvc.Spec.DataSource = nil
+		pvc.Spec.DataSourceRef.Namespace = &vmRestore.Namespace
+		setRestoreAnnotations(vmRestore, pvc)
+	}
 	target.Own(pvc)
 
-	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -1069,18 +1236,45 @@ func CreateRestorePVCDefFromVMRestore(vmRestoreName, restorePVCName string, volu
 	return pvc
 }
 
+// setRestoreAnnotations marks an object as created by the restore, along with the namespace
+// of the restore when the object lives in another one
+func setRestoreAnnotations(vmRestore *snapshotv1.VirtualMachineRestore, obj metav1.Object) {
+	if obj.GetAnnotations() == nil {
+		obj.SetAnnotations(make(map[string]string))
+	}
+	obj.GetAnnotations()[restoreNameAnnotation] = vmRestore.Name
+	if isCrossNamespaceRestore(vmRestore) {
+		obj.GetAnnotations()[restoreNamespaceAnnotation] = vmRestore.Namespace
+	}
+}
+
+// getRestoreKey returns the key of the restore which created an object
+func getRestoreKey(obj metav1.Object) (string, bool) {
+	restoreName, ok := obj.GetAnnotations()[restoreNameAnnotation]
+	if !ok {
+		return "", false
+	}
+
+	namespace := obj.GetNamespace()
+	if restoreNamespace, ok := obj.GetAnnotations()[restoreNamespaceAnnotation]; ok {
+		namespace = restoreNamespace
+	}
+
+	return cacheKeyFunc(namespace, restoreName), true
+}
+
 func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Condition) {
 	r.Status.Conditions = updateCondition(r.Status.Conditions, c, true)
 }
 
 // Returns a set of volumes not for restore
-// Currently only memory dump volumes should not be restored
-func volumesNotForRestore(content *snapshotv1.VirtualMachineSnapshotContent) sets.String {
+// Memory dump volumes and the volumes which weren't selected should not be restored
+func volumesNotForRestore(vmRestore *snapshotv1.VirtualMachineRestore, content *snapshotv1.VirtualMachineSnapshotContent) sets.String {
 	volumes := content.Spec.Source.VirtualMachine.Spec.Template.Spec.Volumes
 	noRestore := sets.NewString()
 
 	for _, volume := range volumes {
-		if volume.MemoryDump != nil {
+		if volume.MemoryDump != nil || !restoresVolume(vmRestore, volume.Name) {
 			noRestore.Insert(volume.Name)
 		}
 	}
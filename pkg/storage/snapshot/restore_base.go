@@ -189,13 +189,11 @@ func (ctrl *VMRestoreController) handleDataVolume(obj interface{}) {
 	}
 
 	if dv, ok := obj.(*v1beta1.DataVolume); ok {
-		restoreName, ok := dv.Annotations[restoreNameAnnotation]
+		objName, ok := getRestoreKey(dv)
 		if !ok {
 			return
 		}
 
-		objName := cacheKeyFunc(dv.Namespace, restoreName)
-
 		log.Log.V(3).Infof("Handling DV %s/%s, Restore %s", dv.Namespace, dv.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
 	}
@@ -207,13 +205,11 @@ func (ctrl *VMRestoreController) handlePVC(obj interface{}) {
 	}
 
 	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
-		restoreName, ok := pvc.Annotations[restoreNameAnnotation]
+		objName, ok := getRestoreKey(pvc)
 		if !ok {
 			return
 		}
 
-		objName := cacheKeyFunc(pvc.Namespace, restoreName)
-
 		log.Log.V(3).Infof("Handling PVC %s/%s, Restore %s", pvc.Namespace, pvc.Name, objName)
 		ctrl.vmRestoreQueue.Add(objName)
 	}
@@ -52,6 +52,7 @@ var _ = Describe("Restore controller", func() {
 		timeStamp        = metav1.Now()
 		storageClassName = "sc"
 		vmRestoreName    = "restore"
+		volumesOnlyMode  = snapshotv1.VirtualMachineRestoreModeVolumesOnly
 	)
 
 	timeFunc := func() *metav1.Time {
@@ -531,6 +532,167 @@ var _ = Describe("Restore controller", func() {
 				controller.processVMRestoreWorkItem()
 			})
 
+			It("should create restore PVCs in the target namespace", func() {
+				const targetNamespace = "target-ns"
+				r := createRestore()
+				r.Spec.TargetNamespace = pointer.String(targetNamespace)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					},
+				}
+				addVolumeRestores(r)
+				pvcSize := resource.MustParse("2Gi")
+				vs := createVolumeSnapshot(r.Status.Restores[0].VolumeSnapshotName, pvcSize)
+				fakeVolumeSnapshotProvider.Add(vs)
+				k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					create, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+
+					pvc := create.GetObject().(*corev1.PersistentVolumeClaim)
+					Expect(create.GetNamespace()).To(Equal(targetNamespace))
+					Expect(pvc.Name).To(Equal(r.Status.Restores[0].PersistentVolumeClaimName))
+					Expect(pvc.Namespace).To(Equal(targetNamespace))
+					Expect(pvc.OwnerReferences).To(BeEmpty())
+					Expect(pvc.Spec.DataSource).To(BeNil())
+					Expect(pvc.Spec.DataSourceRef).ToNot(BeNil())
+					Expect(pvc.Spec.DataSourceRef.Name).To(Equal(vs.Name))
+					Expect(pvc.Spec.DataSourceRef.Namespace).To(Equal(pointer.String(testNamespace)))
+					Expect(pvc.Annotations).To(HaveKeyWithValue(restoreNameAnnotation, r.Name))
+					Expect(pvc.Annotations).To(HaveKeyWithValue(restoreNamespaceAnnotation, testNamespace))
+
+					return true, pvc, nil
+				})
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+			})
+
+			It("should name the restore PVCs after the target when restoring only volumes", func() {
+				r := createRestore()
+				r.Spec.Mode = &volumesOnlyMode
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+						newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+					},
+				}
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status.Restores = []snapshotv1.VolumeRestore{
+					{
+						VolumeName:                diskName,
+						PersistentVolumeClaimName: vmName + "-" + diskName,
+						VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk1",
+					},
+				}
+				rc.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+					newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+				}
+				expectVMRestoreUpdate(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+			})
+
+			It("should fail restoring only volumes if a PVC with the same name exists", func() {
+				r := createRestore()
+				r.Spec.Mode = &volumesOnlyMode
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Restores: []snapshotv1.VolumeRestore{
+						{
+							VolumeName:                diskName,
+							PersistentVolumeClaimName: vmName + "-" + diskName,
+							VolumeSnapshotName:        "vmsnapshot-snapshot-uid-volume-disk1",
+						},
+					},
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					},
+				}
+				pvcSource.Add(&corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testNamespace,
+						Name:      vmName + "-" + diskName,
+					},
+				})
+				errorMessage := fmt.Sprintf("PVC %s/%s-%s already exists", testNamespace, vmName, diskName)
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, errorMessage),
+					newReadyCondition(corev1.ConditionFalse, errorMessage),
+				}
+				expectVMRestoreUpdate(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+			})
+
+			It("should complete restoring only volumes when none is selected", func() {
+				r := createRestore()
+				r.Spec.Mode = &volumesOnlyMode
+				r.Spec.Volumes = []string{"other"}
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: &f,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+						newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+					},
+				}
+				rc := r.DeepCopy()
+				rc.ResourceVersion = "1"
+				rc.Status.Complete = &t
+				rc.Status.RestoreTime = timeFunc()
+				rc.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+				expectVMRestoreUpdate(kubevirtClient, rc)
+				addVirtualMachineRestore(r)
+				controller.processVMRestoreWorkItem()
+				testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
+			})
+
+			It("should keep the volumes of the VM which aren't selected", func() {
+				r := createRestoreWithOwner()
+				r.Spec.Volumes = []string{"other"}
+				vm := createModifiedVM()
+				vm.Spec.DataVolumeTemplates[0].Name = "current-dv"
+				vm.Spec.Template.Spec.Volumes[0].DataVolume.Name = "current-dv"
+
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(ctx context.Context, updatedVM *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					Expect(updatedVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+					Expect(updatedVM.Spec.DataVolumeTemplates[0].Name).To(Equal("current-dv"))
+					Expect(updatedVM.Spec.Template.Spec.Volumes).To(HaveLen(1))
+					Expect(updatedVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("current-dv"))
+					Expect(updatedVM.Spec.Template.Spec.Domain.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceRequestsMemory, resource.MustParse("64M")))
+					return updatedVM, nil
+				})
+
+				syncCaches(stop)
+				target := &vmRestoreTarget{
+					controller: controller,
+					vmRestore:  r,
+					vm:         vm,
+				}
+				updated, err := target.reconcileSpec()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updated).To(BeTrue())
+			})
+
+			It("should only create VolumeRestores for the selected volumes", func() {
+				r := createRestoreWithOwner()
+				r.Spec.Volumes = []string{diskName}
+				Expect(volumesNotForRestore(r, sc).List()).To(BeEmpty())
+				r.Spec.Volumes = []string{"other"}
+				Expect(volumesNotForRestore(r, sc).List()).To(ConsistOf(diskName))
+			})
+
 			It("should wait for bound", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
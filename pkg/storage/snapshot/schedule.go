@@ -0,0 +1,504 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"kubevirt.io/api/core"
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	scheduledSnapshotCreateEvent = "SuccessfulScheduledVirtualMachineSnapshotCreate"
+
+	scheduledSnapshotDeleteEvent = "SuccessfulScheduledVirtualMachineSnapshotDelete"
+
+	snapshotScheduleErrorEvent = "VirtualMachineSnapshotScheduleError"
+)
+
+// VMSnapshotScheduleController periodically snapshots the VMs selected by a
+// VirtualMachineSnapshotSchedule and garbage collects the snapshots it took
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+	VMIInformer                cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmSnapshotScheduleQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-snapshot-schedule-vmsnapshotschedule")
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+
+	return err
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.vmSnapshotScheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		scheduleName, ok := vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vmSnapshot.Namespace, scheduleName)
+
+		log.Log.V(3).Infof("Handling VMSnapshot %s/%s, Schedule %s", vmSnapshot.Namespace, vmSnapshot.Name, objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	scheduleOut := schedule.DeepCopy()
+	if scheduleOut.Status == nil {
+		scheduleOut.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cron, err := parseCronSchedule(schedule.Spec.Schedule)
+	if err != nil {
+		scheduleOut.Status.NextScheduleTime = nil
+		ctrl.setScheduleError(scheduleOut, fmt.Errorf("invalid schedule: %v", err))
+		// nothing to do until the schedule is fixed
+		return 0, ctrl.updateStatus(schedule, scheduleOut)
+	}
+
+	if scheduleOut.Status.NextScheduleTime == nil {
+		// clears the error left by a previously invalid schedule
+		scheduleOut.Status.Error = nil
+	}
+
+	now := currentTime().Time
+	var scheduleErr error
+	if due := lastActivation(cron, lastScheduleTime(schedule), now); !due.IsZero() {
+		if schedule.Spec.Suspend == nil || !*schedule.Spec.Suspend {
+			scheduleErr = ctrl.takeSnapshots(scheduleOut, due)
+		}
+		scheduleOut.Status.LastScheduleTime = &metav1.Time{Time: due}
+	}
+
+	vmSnapshots, err := ctrl.getScheduleSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+	if err := ctrl.applyRetention(scheduleOut, vmSnapshots, now); err != nil {
+		scheduleErr = utilerrors.NewAggregate([]error{scheduleErr, err})
+	}
+	updateLastSnapshotStatus(scheduleOut, vmSnapshots)
+
+	if scheduleErr != nil {
+		ctrl.setScheduleError(scheduleOut, scheduleErr)
+	}
+
+	var retry time.Duration
+	if next := cron.next(now); !next.IsZero() {
+		scheduleOut.Status.NextScheduleTime = &metav1.Time{Time: next}
+		retry = next.Sub(now)
+	} else {
+		scheduleOut.Status.NextScheduleTime = nil
+	}
+
+	return retry, ctrl.updateStatus(schedule, scheduleOut)
+}
+
+func (ctrl *VMSnapshotScheduleController) updateStatus(schedule, scheduleOut *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleOut.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleOut.Namespace).UpdateStatus(context.Background(), scheduleOut, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotScheduleController) setScheduleError(schedule *snapshotv1.VirtualMachineSnapshotSchedule, err error) {
+	message := err.Error()
+	if schedule.Status.Error != nil && schedule.Status.Error.Message != nil && *schedule.Status.Error.Message == message {
+		return
+	}
+
+	ctrl.Recorder.Eventf(
+		schedule,
+		corev1.EventTypeWarning,
+		snapshotScheduleErrorEvent,
+		"VirtualMachineSnapshotSchedule encountered error %s",
+		message,
+	)
+
+	schedule.Status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: &message,
+	}
+}
+
+// takeSnapshots creates a snapshot of every VM selected by the schedule
+func (ctrl *VMSnapshotScheduleController) takeSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, due time.Time) error {
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	objs, err := ctrl.VMInformer.GetIndexer().ByIndex(cache.NamespaceIndex, schedule.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, obj := range objs {
+		vm := obj.(*kubevirtv1.VirtualMachine)
+		if !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+
+		if err := ctrl.checkFreeze(schedule, vm); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		vmSnapshot := newScheduledSnapshot(schedule, vm, due)
+		_, err := ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			if !k8serrors.IsAlreadyExists(err) {
+				errs = append(errs, fmt.Errorf("failed to snapshot VM %s: %v", vm.Name, err))
+			}
+			continue
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			scheduledSnapshotCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// checkFreeze fails when the schedule requires the guest file systems to be frozen
+// and the VM is running without a connected guest agent
+func (ctrl *VMSnapshotScheduleController) checkFreeze(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine) error {
+	if schedule.Spec.Freeze == nil || *schedule.Spec.Freeze != snapshotv1.FreezeRequired {
+		return nil
+	}
+
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return err
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(obj.(*kubevirtv1.VirtualMachineInstance), kubevirtv1.VirtualMachineInstanceAgentConnected) {
+		return fmt.Errorf("skipping snapshot of VM %s, its file systems cannot be frozen without a guest agent", vm.Name)
+	}
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) getScheduleSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex("schedule", cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range objs {
+		vmSnapshots = append(vmSnapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+	}
+
+	return vmSnapshots, nil
+}
+
+// applyRetention deletes the successful snapshots of each VM exceeding the retention count or age.
+// Failed snapshots don't count towards the retention, they are deleted once a newer snapshot of the
+// VM completes, so only the outcome of the last attempt is kept around.
+func (ctrl *VMSnapshotScheduleController) applyRetention(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) error {
+	retention := schedule.Spec.Retention
+	if retention == nil {
+		return nil
+	}
+
+	perVM := make(map[string][]*snapshotv1.VirtualMachineSnapshot)
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot.DeletionTimestamp != nil {
+			continue
+		}
+		perVM[vmSnapshot.Spec.Source.Name] = append(perVM[vmSnapshot.Spec.Source.Name], vmSnapshot)
+	}
+
+	var errs []error
+	for _, vmSnapshots := range perVM {
+		sortSnapshotsNewestFirst(vmSnapshots)
+		succeeded := 0
+		newerFinished := false
+		for _, vmSnapshot := range vmSnapshots {
+			if !snapshotFinished(vmSnapshot) {
+				continue
+			}
+
+			var expired bool
+			if vmSnapshot.Status.Phase == snapshotv1.Failed {
+				expired = newerFinished
+			} else {
+				expired = (retention.Age != nil && now.Sub(vmSnapshot.CreationTimestamp.Time) > retention.Age.Duration) ||
+					(retention.Count != nil && succeeded >= int(*retention.Count))
+				succeeded++
+			}
+			newerFinished = true
+			if !expired {
+				continue
+			}
+
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil {
+				if !k8serrors.IsNotFound(err) {
+					errs = append(errs, fmt.Errorf("failed to delete VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err))
+				}
+				continue
+			}
+
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				scheduledSnapshotDeleteEvent,
+				"Successfully deleted VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// updateLastSnapshotStatus reports the last successful snapshot taken by the schedule,
+// the error is replaced by the outcome of any snapshot which completed after it
+func updateLastSnapshotStatus(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	vmSnapshots = append([]*snapshotv1.VirtualMachineSnapshot{}, vmSnapshots...)
+	sortSnapshotsNewestFirst(vmSnapshots)
+
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot.Status != nil && vmSnapshot.Status.Phase == snapshotv1.Succeeded {
+			creationTime := vmSnapshot.CreationTimestamp
+			schedule.Status.LastSuccessTime = &creationTime
+			break
+		}
+	}
+
+	for _, vmSnapshot := range vmSnapshots {
+		if !snapshotFinished(vmSnapshot) {
+			continue
+		}
+
+		completionTime := vmSnapshot.CreationTimestamp
+		if vmSnapshot.Status.Error != nil && vmSnapshot.Status.Error.Time != nil {
+			completionTime = *vmSnapshot.Status.Error.Time
+		}
+		if schedule.Status.Error != nil && schedule.Status.Error.Time != nil && !schedule.Status.Error.Time.Before(&completionTime) {
+			break
+		}
+
+		schedule.Status.Error = nil
+		if vmSnapshot.Status.Phase == snapshotv1.Failed {
+			message := fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name)
+			if vmSnapshot.Status.Error != nil && vmSnapshot.Status.Error.Message != nil {
+				message = fmt.Sprintf("%s: %s", message, *vmSnapshot.Status.Error.Message)
+			}
+			schedule.Status.Error = &snapshotv1.Error{
+				Time:    &completionTime,
+				Message: &message,
+			}
+		}
+		break
+	}
+}
+
+func newScheduledSnapshot(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vm *kubevirtv1.VirtualMachine, due time.Time) *snapshotv1.VirtualMachineSnapshot {
+	apiGroup := core.GroupName
+	return &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%d", schedule.Name, vm.Name, due.Unix()),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				snapshotv1.SnapshotScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     "VirtualMachine",
+				Name:     vm.Name,
+			},
+			DeletionPolicy:  schedule.Spec.DeletionPolicy,
+			FailureDeadline: schedule.Spec.FailureDeadline,
+		},
+	}
+}
+
+func snapshotFinished(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Status != nil &&
+		(vmSnapshot.Status.Phase == snapshotv1.Succeeded || vmSnapshot.Status.Phase == snapshotv1.Failed)
+}
+
+func sortSnapshotsNewestFirst(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	sort.SliceStable(vmSnapshots, func(i, j int) bool {
+		ti, tj := vmSnapshots[i].CreationTimestamp, vmSnapshots[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return vmSnapshots[i].Name > vmSnapshots[j].Name
+		}
+		return tj.Before(&ti)
+	})
+}
+
+func lastScheduleTime(schedule *snapshotv1.VirtualMachineSnapshotSchedule) time.Time {
+	if schedule.Status != nil && schedule.Status.LastScheduleTime != nil {
+		return schedule.Status.LastScheduleTime.Time
+	}
+	return schedule.CreationTimestamp.Time
+}
+
+// lastActivation returns the most recent activation of the schedule after since
+// and not after now, missed activations are not caught up
+func lastActivation(cron *cronSchedule, since, now time.Time) time.Time {
+	var last time.Time
+	for t := cron.next(since); !t.IsZero() && !t.After(now); t = cron.next(t) {
+		last = t
+	}
+	return last
+}
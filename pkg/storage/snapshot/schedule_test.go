@@ -0,0 +1,339 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const (
+		testNamespace = "default"
+		scheduleName  = "schedule"
+	)
+
+	var (
+		controller     *VMSnapshotScheduleController
+		kubevirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		vmInformer     cache.SharedIndexInformer
+		vmiInformer    cache.SharedIndexInformer
+		snapInformer   cache.SharedIndexInformer
+		now            time.Time
+	)
+
+	newSchedule := func(spec string) *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: spec,
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "true"},
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+		}
+	}
+
+	newVM := func(name string, selected bool) *v1.VirtualMachine {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		if selected {
+			vm.Labels = map[string]string{"backup": "true"}
+		}
+		return vm
+	}
+
+	newSnapshot := func(name, vmName string, age time.Duration, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels:            map[string]string{snapshotv1.SnapshotScheduleLabel: scheduleName},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.String(v1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase: phase,
+			},
+		}
+	}
+
+	createdSnapshots := func() []string {
+		var names []string
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "create" && action.GetResource().Resource == "virtualmachinesnapshots" {
+				names = append(names, action.(interface{ GetObject() runtime.Object }).GetObject().(*snapshotv1.VirtualMachineSnapshot).Name)
+			}
+		}
+		return names
+	}
+
+	deletedSnapshots := func() []string {
+		var names []string
+		for _, action := range kubevirtClient.Actions() {
+			if action.GetVerb() == "delete" && action.GetResource().Resource == "virtualmachinesnapshots" {
+				names = append(names, action.(interface{ GetName() string }).GetName())
+			}
+		}
+		return names
+	}
+
+	updatedStatus := func() *snapshotv1.VirtualMachineSnapshotScheduleStatus {
+		schedule, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule.Status
+	}
+
+	reconcile := func(schedule *snapshotv1.VirtualMachineSnapshotSchedule, objs ...interface{}) time.Duration {
+		kubevirtClient = kubevirtfake.NewSimpleClientset(schedule)
+		for _, obj := range objs {
+			switch o := obj.(type) {
+			case *v1.VirtualMachine:
+				Expect(vmInformer.GetStore().Add(o)).To(Succeed())
+			case *v1.VirtualMachineInstance:
+				Expect(vmiInformer.GetStore().Add(o)).To(Succeed())
+			case *snapshotv1.VirtualMachineSnapshot:
+				Expect(snapInformer.GetStore().Add(o)).To(Succeed())
+				_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), o, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+		kubevirtClient.ClearActions()
+
+		retry, err := controller.updateVMSnapshotSchedule(schedule)
+		Expect(err).ToNot(HaveOccurred())
+		return retry
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2023, 1, 1, 10, 30, 0, 0, time.UTC)
+		currentTime = func() *metav1.Time {
+			t := metav1.NewTime(now)
+			return &t
+		}
+
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		snapInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		scheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(namespace)
+		}).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(namespace)
+		}).AnyTimes()
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         snapInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = func() *metav1.Time {
+			t := metav1.Now()
+			return &t
+		}
+	})
+
+	It("should snapshot the selected VMs when the schedule is due", func() {
+		schedule := newSchedule("0 * * * *")
+		retry := reconcile(schedule, newVM("selected", true), newVM("ignored", false))
+
+		due := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+		Expect(createdSnapshots()).To(ConsistOf(fmt.Sprintf("%s-selected-%d", scheduleName, due.Unix())))
+		status := updatedStatus()
+		Expect(status.LastScheduleTime.Time).To(Equal(due))
+		Expect(status.NextScheduleTime.Time).To(Equal(due.Add(time.Hour)))
+		Expect(status.Error).To(BeNil())
+		Expect(retry).To(Equal(30 * time.Minute))
+		testutils.ExpectEvent(recorder, scheduledSnapshotCreateEvent)
+	})
+
+	It("should not snapshot the VMs when the schedule is not due", func() {
+		schedule := newSchedule("0 * * * *")
+		schedule.Status.LastScheduleTime = &metav1.Time{Time: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)}
+		reconcile(schedule, newVM("selected", true))
+
+		Expect(createdSnapshots()).To(BeEmpty())
+	})
+
+	It("should not snapshot the VMs when the schedule is suspended", func() {
+		schedule := newSchedule("0 * * * *")
+		schedule.Spec.Suspend = pointer.Bool(true)
+		reconcile(schedule, newVM("selected", true))
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		Expect(updatedStatus().LastScheduleTime.Time).To(Equal(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)))
+	})
+
+	It("should propagate the deletion policy and failure deadline to the snapshots", func() {
+		schedule := newSchedule("0 * * * *")
+		deletionPolicy := snapshotv1.VirtualMachineSnapshotContentRetain
+		schedule.Spec.DeletionPolicy = &deletionPolicy
+		schedule.Spec.FailureDeadline = &metav1.Duration{Duration: time.Minute}
+		reconcile(schedule, newVM("selected", true))
+
+		vmSnapshots, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmSnapshots.Items).To(HaveLen(1))
+		Expect(vmSnapshots.Items[0].Spec.DeletionPolicy).To(Equal(&deletionPolicy))
+		Expect(vmSnapshots.Items[0].Spec.FailureDeadline.Duration).To(Equal(time.Minute))
+		Expect(vmSnapshots.Items[0].Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+	})
+
+	It("should report an invalid schedule", func() {
+		schedule := newSchedule("0 * *")
+		retry := reconcile(schedule, newVM("selected", true))
+
+		Expect(createdSnapshots()).To(BeEmpty())
+		Expect(retry).To(BeZero())
+		status := updatedStatus()
+		Expect(*status.Error.Message).To(ContainSubstring("invalid schedule"))
+		Expect(status.NextScheduleTime).To(BeNil())
+		testutils.ExpectEvent(recorder, snapshotScheduleErrorEvent)
+	})
+
+	Context("with a required freeze", func() {
+		newVMI := func(agentConnected bool) *v1.VirtualMachineInstance {
+			vmi := &v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "selected",
+					Namespace: testNamespace,
+				},
+			}
+			if agentConnected {
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+					{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: corev1.ConditionTrue,
+					},
+				}
+			}
+			return vmi
+		}
+
+		It("should skip running VMs without a guest agent", func() {
+			schedule := newSchedule("0 * * * *")
+			schedule.Spec.Freeze = &[]snapshotv1.FreezePolicy{snapshotv1.FreezeRequired}[0]
+			reconcile(schedule, newVM("selected", true), newVMI(false))
+
+			Expect(createdSnapshots()).To(BeEmpty())
+			Expect(*updatedStatus().Error.Message).To(ContainSubstring("cannot be frozen"))
+		})
+
+		It("should snapshot running VMs with a guest agent", func() {
+			schedule := newSchedule("0 * * * *")
+			schedule.Spec.Freeze = &[]snapshotv1.FreezePolicy{snapshotv1.FreezeRequired}[0]
+			reconcile(schedule, newVM("selected", true), newVMI(true))
+
+			Expect(createdSnapshots()).To(HaveLen(1))
+		})
+	})
+
+	Context("with a retention", func() {
+		It("should keep the newest snapshots of each VM up to the count", func() {
+			schedule := newSchedule("0 0 1 1 *")
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetention{Count: pointer.Int32(2)}
+			reconcile(schedule,
+				newSnapshot("vm1-newest", "vm1", time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-newer", "vm1", 2*time.Hour, snapshotv1.Failed),
+				newSnapshot("vm1-old", "vm1", 3*time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-in-progress", "vm1", 4*time.Hour, snapshotv1.InProgress),
+				newSnapshot("vm2-old", "vm2", 3*time.Hour, snapshotv1.Succeeded),
+			)
+
+			Expect(deletedSnapshots()).To(ConsistOf("vm1-newer"))
+			testutils.ExpectEvent(recorder, scheduledSnapshotDeleteEvent)
+		})
+
+		It("should not count failed snapshots and keep only the last failure", func() {
+			schedule := newSchedule("0 0 1 1 *")
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetention{Count: pointer.Int32(2)}
+			reconcile(schedule,
+				newSnapshot("vm1-failed", "vm1", time.Hour, snapshotv1.Failed),
+				newSnapshot("vm1-older-failed", "vm1", 2*time.Hour, snapshotv1.Failed),
+				newSnapshot("vm1-succeeded", "vm1", 3*time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-older-succeeded", "vm1", 4*time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-oldest-succeeded", "vm1", 5*time.Hour, snapshotv1.Succeeded),
+			)
+
+			Expect(deletedSnapshots()).To(ConsistOf("vm1-older-failed", "vm1-oldest-succeeded"))
+		})
+
+		It("should delete the completed snapshots older than the age", func() {
+			schedule := newSchedule("0 0 1 1 *")
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetention{Age: &metav1.Duration{Duration: 90 * time.Minute}}
+			reconcile(schedule,
+				newSnapshot("vm1-new", "vm1", time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-old", "vm1", 2*time.Hour, snapshotv1.Succeeded),
+				newSnapshot("vm1-in-progress", "vm1", 2*time.Hour, snapshotv1.InProgress),
+			)
+
+			Expect(deletedSnapshots()).To(ConsistOf("vm1-old"))
+		})
+	})
+
+	It("should report the last successful and failed snapshots", func() {
+		schedule := newSchedule("0 0 1 1 *")
+		failed := newSnapshot("failed", "vm1", time.Hour, snapshotv1.Failed)
+		message := "deadline exceeded"
+		failed.Status.Error = &snapshotv1.Error{Time: &metav1.Time{Time: now.Add(-time.Hour)}, Message: &message}
+		reconcile(schedule,
+			failed,
+			newSnapshot("succeeded", "vm1", 2*time.Hour, snapshotv1.Succeeded),
+		)
+
+		status := updatedStatus()
+		Expect(status.LastSuccessTime.Time).To(Equal(now.Add(-2 * time.Hour)))
+		Expect(*status.Error.Message).To(Equal("VirtualMachineSnapshot failed failed: deadline exceeded"))
+	})
+})
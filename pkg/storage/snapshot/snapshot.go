@@ -335,6 +335,16 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 			}
 
 			if !didFreeze {
+				waiting, err := ctrl.waitingForGroupFreeze(vmSnapshot)
+				if err != nil {
+					return 0, err
+				}
+
+				if waiting {
+					log.Log.V(3).Infof("Not creating snapshot %s until the VMs of the group are frozen", vsName)
+					return snapshotRetryInterval, nil
+				}
+
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
 					return 0, err
@@ -1001,6 +1011,26 @@ func (ctrl *VMSnapshotController) getVMSnapshot(vmSnapshotContent *snapshotv1.Vi
 	return obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy(), nil
 }
 
+// waitingForGroupFreeze returns true when the snapshot is part of a VirtualMachineGroupSnapshot
+// whose VMs aren't all frozen yet, its volumes cannot be snapshotted before then. A group snapshot
+// missing from the cache may not be synced yet, so it is waited for as well.
+func (ctrl *VMSnapshotController) waitingForGroupFreeze(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
+	groupSnapshotName, ok := vmSnapshot.Labels[snapshotv1.GroupSnapshotLabel]
+	if !ok {
+		return false, nil
+	}
+
+	obj, exists, err := ctrl.VMGroupSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, groupSnapshotName))
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return true, nil
+	}
+
+	return !groupSnapshotFrozen(obj.(*snapshotv1.VirtualMachineGroupSnapshot)), nil
+}
+
 func (ctrl *VMSnapshotController) getVMI(vm *kubevirtv1.VirtualMachine) (*kubevirtv1.VirtualMachineInstance, bool, error) {
 	key, err := controller.KeyFunc(vm)
 	if err != nil {
@@ -79,6 +79,7 @@ type VMSnapshotController struct {
 	PodInformer               cache.SharedIndexInformer
 	DVInformer                cache.SharedIndexInformer
 	CRInformer                cache.SharedIndexInformer
+	VMGroupSnapshotInformer   cache.SharedIndexInformer
 
 	Recorder record.EventRecorder
 
@@ -193,6 +194,16 @@ func (ctrl *VMSnapshotController) Init() error {
 		return err
 	}
 
+	_, err = ctrl.VMGroupSnapshotInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMGroupSnapshot(newObj) },
+		},
+		ctrl.ResyncPeriod,
+	)
+	if err != nil {
+		return err
+	}
+
 	_, err = ctrl.PVCInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handlePVC,
@@ -232,6 +243,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		ctrl.PVCInformer.HasSynced,
 		ctrl.DVInformer.HasSynced,
 		ctrl.StorageClassInformer.HasSynced,
+		ctrl.VMGroupSnapshotInformer.HasSynced,
 	) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
@@ -487,6 +499,26 @@ func (ctrl *VMSnapshotController) handleVMI(obj interface{}) {
 	}
 }
 
+func (ctrl *VMSnapshotController) handleVMGroupSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	// the contents of the snapshots of the group are waiting for the VMs to be frozen
+	if groupSnapshot, ok := obj.(*snapshotv1.VirtualMachineGroupSnapshot); ok && groupSnapshotFrozen(groupSnapshot) {
+		for _, member := range groupSnapshot.Status.VirtualMachineSnapshots {
+			storeObj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(groupSnapshot.Namespace, member.VirtualMachineSnapshotName))
+			if !exists || err != nil {
+				continue
+			}
+
+			k := cacheKeyFunc(groupSnapshot.Namespace, GetVMSnapshotContentName(storeObj.(*snapshotv1.VirtualMachineSnapshot)))
+			log.Log.V(3).Infof(enqueuedForSyncFmt, k)
+			ctrl.vmSnapshotContentQueue.Add(k)
+		}
+	}
+}
+
 func (ctrl *VMSnapshotController) handleVolumeSnapshotClass(obj interface{}) {
 	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
 		obj = unknown.Obj
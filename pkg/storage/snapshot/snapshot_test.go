@@ -253,6 +253,8 @@ var _ = Describe("Snapshot controlleer", func() {
 		var crInformer cache.SharedIndexInformer
 		var crSource *framework.FakeControllerSource
 		var dvSource *framework.FakeControllerSource
+		var vmGroupSnapshotInformer cache.SharedIndexInformer
+		var vmGroupSnapshotSource *framework.FakeControllerSource
 		var stop chan struct{}
 		var controller *VMSnapshotController
 		var recorder *record.FakeRecorder
@@ -277,6 +279,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			go podInformer.Run(stop)
 			go dvInformer.Run(stop)
 			go crInformer.Run(stop)
+			go vmGroupSnapshotInformer.Run(stop)
 			Expect(cache.WaitForCacheSync(
 				stop,
 				vmSnapshotInformer.HasSynced,
@@ -289,6 +292,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				podInformer.HasSynced,
 				dvInformer.HasSynced,
 				crInformer.HasSynced,
+				vmGroupSnapshotInformer.HasSynced,
 			)).To(BeTrue())
 		}
 
@@ -311,6 +315,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			crdInformer, crdSource = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 			dvInformer, dvSource = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			vmGroupSnapshotInformer, vmGroupSnapshotSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
@@ -327,6 +332,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				CRDInformer:               crdInformer,
 				DVInformer:                dvInformer,
 				CRInformer:                crInformer,
+				VMGroupSnapshotInformer:   vmGroupSnapshotInformer,
 				Recorder:                  recorder,
 				ResyncPeriod:              60 * time.Second,
 				vmStatusUpdater:           status.NewVMStatusUpdater(virtClient),
@@ -1149,6 +1155,115 @@ var _ = Describe("Snapshot controlleer", func() {
 				testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
 			})
 
+			Context("with a group snapshot", func() {
+				addGroupSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot, frozen bool) {
+					vmSnapshot.Labels = map[string]string{snapshotv1.GroupSnapshotLabel: "group"}
+					vmGroupSnapshotSource.Add(&snapshotv1.VirtualMachineGroupSnapshot{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "group",
+							Namespace: testNamespace,
+						},
+						Status: &snapshotv1.VirtualMachineGroupSnapshotStatus{
+							Phase:  snapshotv1.InProgress,
+							Frozen: &frozen,
+							VirtualMachineSnapshots: []snapshotv1.GroupSnapshotMember{
+								{
+									VirtualMachineName:         vmSnapshot.Spec.Source.Name,
+									VirtualMachineSnapshotName: vmSnapshot.Name,
+								},
+							},
+						},
+					})
+				}
+
+				It("should not create VolumeSnapshot until the VMs of the group are frozen", func() {
+					vm := createLockedVM()
+					storageClass := createStorageClass()
+					vmSnapshot := createVMSnapshotInProgress()
+					volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+					pvcs := createPersistentVolumeClaims()
+					vmSnapshotContent := createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+
+					addGroupSnapshot(vmSnapshot, false)
+					vmSource.Add(vm)
+					storageClassSource.Add(storageClass)
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					Expect(k8sSnapshotClient.Actions()).To(BeEmpty())
+					Expect(vmSnapshotClient.Actions()).To(BeEmpty())
+				})
+
+				It("should not create VolumeSnapshot until the group snapshot is in the cache", func() {
+					vm := createLockedVM()
+					storageClass := createStorageClass()
+					vmSnapshot := createVMSnapshotInProgress()
+					volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+					pvcs := createPersistentVolumeClaims()
+					vmSnapshotContent := createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+
+					vmSnapshot.Labels = map[string]string{snapshotv1.GroupSnapshotLabel: "group"}
+					vmSource.Add(vm)
+					storageClassSource.Add(storageClass)
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					Expect(k8sSnapshotClient.Actions()).To(BeEmpty())
+					Expect(vmSnapshotClient.Actions()).To(BeEmpty())
+				})
+
+				It("should create VolumeSnapshot once the VMs of the group are frozen", func() {
+					vm := createLockedVM()
+					storageClass := createStorageClass()
+					vmSnapshot := createVMSnapshotInProgress()
+					volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+					pvcs := createPersistentVolumeClaims()
+					vmSnapshotContent := createVMSnapshotContent()
+					vmSnapshotContent.UID = contentUID
+
+					updatedContent := vmSnapshotContent.DeepCopy()
+					updatedContent.ResourceVersion = "1"
+					updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						ReadyToUse: &f,
+					}
+
+					volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+					for i := range volumeSnapshots {
+						vss := snapshotv1.VolumeSnapshotStatus{
+							VolumeSnapshotName: volumeSnapshots[i].Name,
+						}
+						updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, vss)
+					}
+
+					addGroupSnapshot(vmSnapshot, true)
+					vmSource.Add(vm)
+					storageClassSource.Add(storageClass)
+					for i := range pvcs {
+						pvcSource.Add(&pvcs[i])
+					}
+
+					expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+					expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+					vmSnapshotContentSource.Add(vmSnapshotContent)
+					vmSnapshotSource.Add(vmSnapshot)
+					addVolumeSnapshotClass(volumeSnapshotClass)
+					controller.processVMSnapshotContentWorkItem()
+					testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+				})
+			})
+
 			It("should create VolumeSnapshot with multiple VolumeSnapshotClasses", func() {
 				vm := createLockedVM()
 				storageClass := createStorageClass()
@@ -0,0 +1,237 @@
+package migrations
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util/pdbs"
+)
+
+// EvacuationAction describes what happens to a VMI when its node is drained
+type EvacuationAction string
+
+const (
+	// EvacuationActionMigrate means the VMI is live migrated to another node
+	EvacuationActionMigrate EvacuationAction = "Migrate"
+	// EvacuationActionShutdown means the VMI is shut down when its pod is evicted
+	EvacuationActionShutdown EvacuationAction = "Shutdown"
+	// EvacuationActionBlock means the VMI blocks the drain because it has to but cannot be migrated
+	EvacuationActionBlock EvacuationAction = "BlockDrain"
+	// EvacuationActionExternal means the eviction of the VMI is handled by an external controller
+	EvacuationActionExternal EvacuationAction = "External"
+)
+
+// EvacuationPlanEntry describes what happens to a single VMI when its node is drained
+type EvacuationPlanEntry struct {
+	VMI    *v1.VirtualMachineInstance
+	Action EvacuationAction
+	Reason string
+}
+
+// EvacuationState holds the state of the cluster an evacuation is planned against
+type EvacuationState struct {
+	// EvictionStrategy is the cluster wide eviction strategy
+	EvictionStrategy *v1.EvictionStrategy
+	// Order is the memory based order the VMIs are migrated in
+	Order *v1.EvacuationOrder
+	// LauncherPods are the virt-launcher pods of the VMIs
+	LauncherPods []*k8sv1.Pod
+	// PodDisruptionBudgets are the PodDisruptionBudgets in the namespaces of the VMIs
+	PodDisruptionBudgets []*policyv1.PodDisruptionBudget
+	// DisruptionBudgets tracks the VirtualMachineDisruptionBudgets of the VMIs
+	DisruptionBudgets *pdbs.DisruptionBudgetTracker
+	// FreeMigrationSlots is the number of migrations which may be started from the node right away,
+	// given the parallel outbound migrations of the node and the parallel migrations of the cluster
+	FreeMigrationSlots int
+}
+
+// EvacuationPriority returns the priority of the virt-launcher pod of a VMI. Kubernetes resolves it
+// from the PriorityClass of the VMI, which only cluster admins can create. Pods without a priority
+// have the priority 0.
+func EvacuationPriority(pod *k8sv1.Pod) int32 {
+	if pod == nil || pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+// SortByEvacuationOrder sorts the VMIs in the order they should be migrated off a drained node.
+// VMIs with a higher priority come first, VMIs with the same priority are ordered by their memory
+// if requested. The order of VMIs which are equal in both is preserved.
+func SortByEvacuationOrder(vmis []*v1.VirtualMachineInstance, order *v1.EvacuationOrder, priority func(*v1.VirtualMachineInstance) int32) {
+	sort.SliceStable(vmis, func(i, j int) bool {
+		if pi, pj := priority(vmis[i]), priority(vmis[j]); pi != pj {
+			return pi > pj
+		}
+		if order == nil {
+			return false
+		}
+		cmp := guestMemory(vmis[i]).Cmp(*guestMemory(vmis[j]))
+		switch *order {
+		case v1.EvacuationOrderLargestMemoryFirst:
+			return cmp > 0
+		case v1.EvacuationOrderLargestMemoryLast:
+			return cmp < 0
+		}
+		return false
+	})
+}
+
+func guestMemory(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		return vmi.Spec.Domain.Memory.Guest
+	}
+	if memory, ok := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	if memory, ok := vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	return resource.NewQuantity(0, resource.BinarySI)
+}
+
+// ParallelOutboundMigrationsForNode returns how many outbound migrations the node may run in parallel.
+// The ParallelOutboundMigrationsAnnotation of the node overrides the cluster wide setting.
+func ParallelOutboundMigrationsForNode(node *k8sv1.Node, clusterWide uint32) int {
+	if node != nil {
+		if value, exists := node.Annotations[v1.ParallelOutboundMigrationsAnnotation]; exists {
+			if parallel, err := strconv.ParseUint(value, 10, 32); err == nil {
+				return int(parallel)
+			}
+		}
+	}
+	return int(clusterWide)
+}
+
+// PlanEvacuation reports what happens to the VMIs when their node is drained. VMIs which are migrated
+// come first, in the order they would be migrated. VMIs which do not get one of the free migration
+// slots or are held back by a VirtualMachineDisruptionBudget are migrated later. VMIs which are shut
+// down block the drain as long as a PodDisruptionBudget does not allow the eviction of their pod.
+// Finalized VMIs and VMIs which are shutting down are not part of the plan.
+func PlanEvacuation(vmis []*v1.VirtualMachineInstance, state *EvacuationState) []EvacuationPlanEntry {
+	var migrations []*v1.VirtualMachineInstance
+	var others []EvacuationPlanEntry
+	for _, vmi := range vmis {
+		if vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+
+		strategy := state.EvictionStrategy
+		if vmi.Spec.EvictionStrategy != nil {
+			strategy = vmi.Spec.EvictionStrategy
+		}
+		if strategy == nil {
+			others = append(others, state.planShutdown(vmi, "no eviction strategy is set"))
+			continue
+		}
+
+		switch *strategy {
+		case v1.EvictionStrategyLiveMigrate, v1.EvictionStrategyLiveMigrateIfPossible:
+			if vmi.IsMigratable() {
+				migrations = append(migrations, vmi)
+				continue
+			}
+			if *strategy == v1.EvictionStrategyLiveMigrate {
+				others = append(others, EvacuationPlanEntry{VMI: vmi, Action: EvacuationActionBlock, Reason: notMigratableReason(vmi)})
+				continue
+			}
+			others = append(others, state.planShutdown(vmi, notMigratableReason(vmi)))
+		case v1.EvictionStrategyExternal:
+			others = append(others, EvacuationPlanEntry{VMI: vmi, Action: EvacuationActionExternal, Reason: "eviction is handled by an external controller"})
+		default:
+			others = append(others, state.planShutdown(vmi, fmt.Sprintf("eviction strategy is %s", *strategy)))
+		}
+	}
+
+	SortByEvacuationOrder(migrations, state.Order, func(vmi *v1.VirtualMachineInstance) int32 {
+		return EvacuationPriority(state.launcherPod(vmi))
+	})
+
+	plan := make([]EvacuationPlanEntry, 0, len(migrations)+len(others))
+	freeSlots := state.FreeMigrationSlots
+	for _, vmi := range migrations {
+		var reasons []string
+		if priority := EvacuationPriority(state.launcherPod(vmi)); priority != 0 {
+			reasons = append(reasons, fmt.Sprintf("priority %d", priority))
+		}
+		if freeSlots <= 0 {
+			reasons = append(reasons, "waits for a free migration slot")
+		} else if state.DisruptionBudgets != nil && !state.DisruptionBudgets.Allow(vmi) {
+			reasons = append(reasons, "held back by a VirtualMachineDisruptionBudget")
+		} else {
+			freeSlots--
+		}
+		plan = append(plan, EvacuationPlanEntry{VMI: vmi, Action: EvacuationActionMigrate, Reason: strings.Join(reasons, ", ")})
+	}
+	return append(plan, others...)
+}
+
+// planShutdown plans the shutdown of a VMI. The drain is blocked if a PodDisruptionBudget
+// does not allow the eviction of the virt-launcher pod of the VMI.
+func (s *EvacuationState) planShutdown(vmi *v1.VirtualMachineInstance, reason string) EvacuationPlanEntry {
+	if pdb := s.blockingPodDisruptionBudget(s.launcherPod(vmi)); pdb != nil {
+		return EvacuationPlanEntry{VMI: vmi, Action: EvacuationActionBlock, Reason: fmt.Sprintf("%s, PodDisruptionBudget %s does not allow the eviction", reason, pdb.Name)}
+	}
+	return EvacuationPlanEntry{VMI: vmi, Action: EvacuationActionShutdown, Reason: reason}
+}
+
+func (s *EvacuationState) launcherPod(vmi *v1.VirtualMachineInstance) *k8sv1.Pod {
+	for _, pod := range s.LauncherPods {
+		owner := metav1.GetControllerOf(pod)
+		if owner == nil || owner.UID != vmi.UID || pod.Spec.NodeName != vmi.Status.NodeName {
+			continue
+		}
+		if pod.Status.Phase != k8sv1.PodSucceeded && pod.Status.Phase != k8sv1.PodFailed {
+			return pod
+		}
+	}
+	return nil
+}
+
+// blockingPodDisruptionBudget returns a PodDisruptionBudget which does not allow the eviction of the pod.
+// The PodDisruptionBudgets KubeVirt creates for VMIs are ignored, evictions of their pods are turned into
+// migrations.
+func (s *EvacuationState) blockingPodDisruptionBudget(pod *k8sv1.Pod) *policyv1.PodDisruptionBudget {
+	if pod == nil {
+		return nil
+	}
+	for _, pdb := range s.PodDisruptionBudgets {
+		if pdb.Namespace != pod.Namespace || pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+		if owner := metav1.GetControllerOf(pdb); owner != nil && owner.Kind == v1.VirtualMachineInstanceGroupVersionKind.Kind {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		return pdb
+	}
+	return nil
+}
+
+func notMigratableReason(vmi *v1.VirtualMachineInstance) string {
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type != v1.VirtualMachineInstanceIsMigratable {
+			continue
+		}
+		if condition.Message != "" {
+			return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		}
+		if condition.Reason != "" {
+			return condition.Reason
+		}
+	}
+	return "VMI is not live migratable"
+}
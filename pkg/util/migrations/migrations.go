@@ -1,6 +1,10 @@
 package migrations
 
 import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
@@ -83,3 +87,110 @@ func VMIMigratableOnEviction(clusterConfig *virtconfig.ClusterConfig, vmi *v1.Vi
 	}
 	return false
 }
+
+// IsStorageMigration returns true if the ongoing migration of the VMI copies
+// volumes to new claims.
+func IsStorageMigration(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationState != nil && len(vmi.Status.MigrationState.MigratedVolumes) > 0
+}
+
+// IsStorageMigrationCompleted returns true if the last migration of the VMI
+// successfully copied volumes to new claims.
+func IsStorageMigrationCompleted(vmi *v1.VirtualMachineInstance) bool {
+	return IsStorageMigration(vmi) && vmi.Status.MigrationState.Completed && !vmi.Status.MigrationState.Failed
+}
+
+// IsBlockMigration returns true if at least one of the VMI disks has to be
+// copied to the target during the migration.
+func IsBlockMigration(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationMethod == v1.BlockMigration || IsStorageMigration(vmi)
+}
+
+// ApplyMigratedVolumes returns a copy of the volumes in which every volume
+// listed in migratedVolumes references its destination claim.
+func ApplyMigratedVolumes(volumes []v1.Volume, migratedVolumes []v1.StorageMigratedVolume) []v1.Volume {
+	destinations := make(map[string]string, len(migratedVolumes))
+	for _, migratedVolume := range migratedVolumes {
+		destinations[migratedVolume.VolumeName] = migratedVolume.DestinationClaim
+	}
+
+	newVolumes := make([]v1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		claimName, exists := destinations[volume.Name]
+		if !exists {
+			newVolumes = append(newVolumes, *volume.DeepCopy())
+			continue
+		}
+		newVolumes = append(newVolumes, v1.Volume{
+			Name: volume.Name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claimName,
+					},
+				},
+			},
+		})
+	}
+	return newVolumes
+}
+
+// RemoveMigratedDataVolumeTemplates returns the DataVolume templates without the templates
+// of the DataVolumes which are replaced by the destination claims of the migrated volumes.
+func RemoveMigratedDataVolumeTemplates(templates []v1.DataVolumeTemplateSpec, volumes []v1.Volume, migratedVolumes []v1.StorageMigratedVolume) []v1.DataVolumeTemplateSpec {
+	migrated := make(map[string]struct{}, len(migratedVolumes))
+	for _, migratedVolume := range migratedVolumes {
+		migrated[migratedVolume.VolumeName] = struct{}{}
+	}
+	replacedDataVolumes := make(map[string]struct{})
+	for _, volume := range volumes {
+		if _, exists := migrated[volume.Name]; exists && volume.DataVolume != nil {
+			replacedDataVolumes[volume.DataVolume.Name] = struct{}{}
+		}
+	}
+
+	newTemplates := make([]v1.DataVolumeTemplateSpec, 0, len(templates))
+	for _, template := range templates {
+		if _, exists := replacedDataVolumes[template.Name]; !exists {
+			newTemplates = append(newTemplates, *template.DeepCopy())
+		}
+	}
+	return newTemplates
+}
+
+// ValidateMigratedVolumeClaim verifies that the data of a volume, whose claim is
+// described by sourceInfo, can be copied to the destination claim. Both claims
+// need the same volume mode and the destination claim needs to be at least as
+// large as the source claim.
+func ValidateMigratedVolumeClaim(sourceInfo *v1.PersistentVolumeClaimInfo, destination *k8sv1.PersistentVolumeClaim) error {
+	if sourceInfo == nil {
+		return fmt.Errorf("the claim of the source volume is unknown")
+	}
+	if isBlockVolumeMode(sourceInfo.VolumeMode) != isBlockVolumeMode(destination.Spec.VolumeMode) {
+		return fmt.Errorf("claim %s has a different volume mode than the source claim", destination.Name)
+	}
+
+	sourceSize := claimSize(sourceInfo.Capacity, sourceInfo.Requests)
+	if requested, exists := sourceInfo.Requests[k8sv1.ResourceStorage]; exists && !isBlockVolumeMode(sourceInfo.VolumeMode) && requested.Cmp(sourceSize) < 0 {
+		// The disk image on a filesystem claim is not larger than the requested size
+		sourceSize = requested
+	}
+	destinationSize := claimSize(destination.Status.Capacity, destination.Spec.Resources.Requests)
+	if destinationSize.Cmp(sourceSize) < 0 {
+		return fmt.Errorf("claim %s with a size of %s is smaller than the source claim with a size of %s",
+			destination.Name, destinationSize.String(), sourceSize.String())
+	}
+	return nil
+}
+
+func isBlockVolumeMode(volumeMode *k8sv1.PersistentVolumeMode) bool {
+	return volumeMode != nil && *volumeMode == k8sv1.PersistentVolumeBlock
+}
+
+// claimSize returns the capacity of a bound claim and the requested size of a claim which is not bound yet
+func claimSize(capacity, requests k8sv1.ResourceList) resource.Quantity {
+	if size, exists := capacity[k8sv1.ResourceStorage]; exists {
+		return size
+	}
+	return requests[k8sv1.ResourceStorage]
+}
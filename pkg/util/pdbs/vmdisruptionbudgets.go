@@ -0,0 +1,117 @@
+package pdbs
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+)
+
+// VMDisruptionBudgetCoversVMI reports whether the VirtualMachineDisruptionBudget covers the VMI.
+// The VirtualMachine of the VMI is looked up in the vmStore to find the pool it belongs to.
+func VMDisruptionBudgetCoversVMI(budget *poolv1.VirtualMachineDisruptionBudget, vmi *virtv1.VirtualMachineInstance, vmStore cache.Store) bool {
+	if budget.Namespace != vmi.Namespace {
+		return false
+	}
+
+	if budget.Spec.VirtualMachinePoolName != "" {
+		vmRef := v1.GetControllerOf(vmi)
+		if vmRef == nil || vmRef.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+			return false
+		}
+		obj, exists, err := vmStore.GetByKey(vmi.Namespace + "/" + vmRef.Name)
+		if err != nil || !exists {
+			return false
+		}
+		vm := obj.(*virtv1.VirtualMachine)
+		return vm.UID == vmRef.UID && IsVMOfPool(vm, budget.Spec.VirtualMachinePoolName)
+	}
+
+	selector, err := v1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(vmi.Labels))
+}
+
+// IsVMOfPool reports whether the VirtualMachine is controlled by the named VirtualMachinePool.
+func IsVMOfPool(vm *virtv1.VirtualMachine, poolName string) bool {
+	poolRef := v1.GetControllerOf(vm)
+	return poolRef != nil && poolRef.Kind == poolv1.VirtualMachinePoolKind && poolRef.Name == poolName
+}
+
+// DisruptionBudgetTracker hands out the disruptions the VirtualMachineDisruptionBudgets allow, so that
+// a controller does not disrupt more VMIs of a group at once than the budget of the group allows.
+// It is meant to be used for a single pass over a set of candidates.
+type DisruptionBudgetTracker struct {
+	budgetIndexer cache.Indexer
+	vmStore       cache.Store
+	remaining     map[string]int32
+}
+
+// NewDisruptionBudgetTracker creates a DisruptionBudgetTracker. The VMIs of the given unfinished
+// migrations are accounted as disrupted, since they are not reflected by the status of the budgets.
+func NewDisruptionBudgetTracker(budgetInformer cache.SharedIndexInformer, vmInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, migrations []*virtv1.VirtualMachineInstanceMigration) *DisruptionBudgetTracker {
+	return NewDisruptionBudgetTrackerFromStores(budgetInformer.GetIndexer(), vmInformer.GetStore(), vmiInformer.GetStore(), migrations)
+}
+
+// NewDisruptionBudgetTrackerFromStores creates a DisruptionBudgetTracker from plain stores. The budgets
+// have to be indexed by namespace.
+func NewDisruptionBudgetTrackerFromStores(budgetIndexer cache.Indexer, vmStore cache.Store, vmiStore cache.Store, migrations []*virtv1.VirtualMachineInstanceMigration) *DisruptionBudgetTracker {
+	t := &DisruptionBudgetTracker{
+		budgetIndexer: budgetIndexer,
+		vmStore:       vmStore,
+		remaining:     map[string]int32{},
+	}
+
+	for _, migration := range migrations {
+		obj, exists, err := vmiStore.GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+		if err != nil || !exists {
+			continue
+		}
+		t.consume(t.budgetsFor(obj.(*virtv1.VirtualMachineInstance)))
+	}
+	return t
+}
+
+// Allow reports whether all budgets covering the VMI allow one more disruption. If they do, the
+// disruption is accounted against them.
+func (t *DisruptionBudgetTracker) Allow(vmi *virtv1.VirtualMachineInstance) bool {
+	budgets := t.budgetsFor(vmi)
+	for _, key := range budgets {
+		if t.remaining[key] <= 0 {
+			return false
+		}
+	}
+	t.consume(budgets)
+	return true
+}
+
+func (t *DisruptionBudgetTracker) consume(budgets []string) {
+	for _, key := range budgets {
+		t.remaining[key]--
+	}
+}
+
+func (t *DisruptionBudgetTracker) budgetsFor(vmi *virtv1.VirtualMachineInstance) []string {
+	objs, err := t.budgetIndexer.ByIndex(cache.NamespaceIndex, vmi.Namespace)
+	if err != nil {
+		return nil
+	}
+
+	var keys []string
+	for _, obj := range objs {
+		budget := obj.(*poolv1.VirtualMachineDisruptionBudget)
+		if budget.DeletionTimestamp != nil || !VMDisruptionBudgetCoversVMI(budget, vmi, t.vmStore) {
+			continue
+		}
+		key := budget.Namespace + "/" + budget.Name
+		if _, exists := t.remaining[key]; !exists {
+			t.remaining[key] = budget.Status.DisruptionsAllowed
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -451,6 +451,41 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Reads(v1.VirtualMachineInstanceGuestExecRequest{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Guestexec").
+			Doc("Execute a command inside the guest via guest agent").
+			Writes(v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestExecResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileReadRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(subws.QueryParameter("path", "Path of the file inside the guest").Required(true)).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"ReadGuestfile").
+			Doc("Read a file from the guest via guest agent").
+			Writes(v1.VirtualMachineInstanceGuestFile{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestFile{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileWriteRequestHandler).
+			Reads(v1.VirtualMachineInstanceGuestFile{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"WriteGuestfile").
+			Doc("Write a file to the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusRequestEntityTooLarge, "Request Entity Too Large", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Reads(v1.AddVolumeOptions{}).
@@ -625,6 +660,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -853,6 +896,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMPoolValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMPool(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMDisruptionBudgetValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMDisruptionBudgets(w, r)
+	})
 	http.HandleFunc(components.VMIPresetValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMIPreset(w, r)
 	})
@@ -868,6 +914,15 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMGroupSnapshotValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMGroupSnapshots(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMGroupRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMGroupRestores(w, r, app.clusterConfig, app.virtCli)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -892,6 +947,9 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.MigrationPolicyCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationPolicies(w, r, app.clusterConfig, app.virtCli)
 	})
+	http.HandleFunc(components.KSMPolicyValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeKSMPolicies(w, r)
+	})
 	http.HandleFunc(components.VMCloneCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVirtualMachineClones(w, r, app.clusterConfig, app.virtCli)
 	})
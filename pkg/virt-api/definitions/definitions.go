@@ -30,10 +30,16 @@ import (
 
 	"kubevirt.io/api/instancetype"
 
+	"kubevirt.io/api/ksm"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
+	"kubevirt.io/api/nodecapabilities"
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
+
 	restful "github.com/emicklei/go-restful/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -62,6 +68,8 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		exportApiServiceDefinitions,
 		instancetypeApiServiceDefinitions,
 		migrationPoliciesApiServiceDefinitions,
+		ksmPoliciesApiServiceDefinitions,
+		nodeCapabilitiesApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
 	} {
@@ -125,6 +133,9 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+	vmgsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinegroupsnapshots")
+	vmgrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinegrouprestores")
 
 	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: snapshotv1.SchemeGroupVersion.Group, Version: snapshotv1.SchemeGroupVersion.Version})
 	if err != nil {
@@ -146,6 +157,21 @@ func snapshotApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmgsGVR, &snapshotv1.VirtualMachineGroupSnapshot{}, "VirtualMachineGroupSnapshot", &snapshotv1.VirtualMachineGroupSnapshotList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericNamespacedResourceProxy(ws, vmgrGVR, &snapshotv1.VirtualMachineGroupRestore{}, "VirtualMachineGroupRestore", &snapshotv1.VirtualMachineGroupRestoreList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
@@ -193,6 +219,46 @@ func migrationPoliciesApiServiceDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func ksmPoliciesApiServiceDefinitions() []*restful.WebService {
+	ksmPolicyGVR := ksmv1alpha1.SchemeGroupVersion.WithResource(ksm.ResourceKSMPolicies)
+
+	ws, err := groupVersionProxyBase(ksmv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, ksmPolicyGVR, &ksmv1alpha1.KSMPolicy{}, ksmv1alpha1.KSMPolicyKind.Kind, &ksmv1alpha1.KSMPolicyList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(ksmPolicyGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
+func nodeCapabilitiesApiServiceDefinitions() []*restful.WebService {
+	nodeCapabilitiesGVR := nodecapabilitiesv1alpha1.SchemeGroupVersion.WithResource(nodecapabilities.ResourceNodeCapabilities)
+
+	ws, err := groupVersionProxyBase(nodecapabilitiesv1alpha1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, nodeCapabilitiesGVR, &nodecapabilitiesv1alpha1.NodeCapabilities{}, nodecapabilitiesv1alpha1.NodeCapabilitiesKind.Kind, &nodecapabilitiesv1alpha1.NodeCapabilitiesList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(nodeCapabilitiesGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func instancetypeApiServiceDefinitions() []*restful.WebService {
 	instancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.PluralResourceName)
 	clusterInstancetypeGVR := instancetypev1beta1.SchemeGroupVersion.WithResource(instancetype.ClusterPluralResourceName)
@@ -234,6 +300,7 @@ func instancetypeApiServiceDefinitions() []*restful.WebService {
 
 func poolApiServiceDefinitions() []*restful.WebService {
 	poolGVR := poolv1alpha1.SchemeGroupVersion.WithResource("virtualmachinepools")
+	vmdbGVR := poolv1alpha1.SchemeGroupVersion.WithResource("virtualmachinedisruptionbudgets")
 
 	ws, err := groupVersionProxyBase(poolv1alpha1.SchemeGroupVersion)
 	if err != nil {
@@ -245,6 +312,11 @@ func poolApiServiceDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws, err = genericNamespacedResourceProxy(ws, vmdbGVR, &poolv1alpha1.VirtualMachineDisruptionBudget{}, poolv1alpha1.VirtualMachineDisruptionBudgetKind, &poolv1alpha1.VirtualMachineDisruptionBudgetList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws2, err := resourceProxyAutodiscovery(poolGVR)
 	if err != nil {
 		panic(err)
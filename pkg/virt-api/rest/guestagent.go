@@ -0,0 +1,233 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// size limit of files transferred from or to the guest
+	guestFileMaxBytes = 1024 * 1024
+	// the guest file write request carries the base64 encoded content and the path
+	guestFileMaxRequestBytes = guestFileMaxBytes/3*4 + 64*1024
+	guestFileTimeoutSeconds  = 60
+
+	// longer stdout and stderr of guest commands is truncated by virt-launcher
+	guestExecMaxOutputBytes        = 1024 * 1024
+	guestExecMaxRequestBytes       = 64 * 1024
+	guestExecMaxTimeoutSeconds     = 300
+	guestExecDefaultTimeoutSeconds = 30
+
+	// virt-handler is given a bit more time than the guest agent, so the guest agent timeout kicks in first
+	guestAgentRequestTimeoutSlack = 10 * time.Second
+)
+
+func validateGuestAgentConnected(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi == nil || vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
+
+// prepareGuestAgentConnection prepares a connection to virt-handler which waits long enough for the guest agent to finish
+func (app *SubresourceAPIApp) prepareGuestAgentConnection(request *restful.Request, getURL URLResolver, timeoutSeconds int32) (string, kubecli.VirtHandlerConn, *errors.StatusError) {
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"), validateGuestAgentConnected)
+	if statusErr != nil {
+		return "", nil, statusErr
+	}
+
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout = time.Duration(timeoutSeconds)*time.Second + guestAgentRequestTimeoutSlack
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, &httpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	handlerURL, err := getURL(vmi, conn)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Unable to retrieve target handler URL")
+		return "", nil, errors.NewBadRequest(err.Error())
+	}
+	return handlerURL, conn, nil
+}
+
+func decodeGuestAgentRequest(request *restful.Request, maxBytes int64, v interface{}) *errors.StatusError {
+	if request.Request.Body == nil {
+		return errors.NewBadRequest("Request with no body")
+	}
+	defer request.Request.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(request.Request.Body, maxBytes+1))
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+	if int64(len(body)) > maxBytes {
+		return errors.NewRequestEntityTooLargeError(fmt.Sprintf("request body exceeds %d bytes", maxBytes))
+	}
+	if err := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(body), 1024).Decode(v); err != nil && err != io.EOF {
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+	return nil
+}
+
+// GuestExecRequestHandler executes a command inside the guest via the guest agent
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	execRequest := &v1.VirtualMachineInstanceGuestExecRequest{}
+	if statusErr := decodeGuestAgentRequest(request, guestExecMaxRequestBytes, execRequest); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if execRequest.Command == "" {
+		writeError(errors.NewBadRequest("command is required"), response)
+		return
+	}
+	if execRequest.TimeoutSeconds == nil {
+		timeoutSeconds := int32(guestExecDefaultTimeoutSeconds)
+		execRequest.TimeoutSeconds = &timeoutSeconds
+	}
+	if *execRequest.TimeoutSeconds <= 0 || *execRequest.TimeoutSeconds > guestExecMaxTimeoutSeconds {
+		writeError(errors.NewBadRequest(fmt.Sprintf("timeoutSeconds must be between 1 and %d", guestExecMaxTimeoutSeconds)), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestExecURI(vmi)
+	}
+	handlerURL, conn, statusErr := app.prepareGuestAgentConnection(request, getURL, *execRequest.TimeoutSeconds)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(execRequest)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	query := url.Values{}
+	query.Set("maxOutputBytes", strconv.Itoa(guestExecMaxOutputBytes))
+	resp, err := conn.Post(handlerURL+"?"+query.Encode(), io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := &v1.VirtualMachineInstanceGuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), result); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(result)
+}
+
+// GuestFileReadRequestHandler reads a file from the guest via the guest agent
+func (app *SubresourceAPIApp) GuestFileReadRequestHandler(request *restful.Request, response *restful.Response) {
+	path := request.QueryParameter("path")
+	if path == "" {
+		writeError(errors.NewBadRequest("path is required"), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileURI(vmi)
+	}
+	handlerURL, conn, statusErr := app.prepareGuestAgentConnection(request, getURL, guestFileTimeoutSeconds)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	query := url.Values{}
+	query.Set("path", path)
+	query.Set("maxBytes", strconv.Itoa(guestFileMaxBytes))
+	query.Set("timeoutSeconds", strconv.Itoa(guestFileTimeoutSeconds))
+	resp, err := conn.Get(handlerURL + "?" + query.Encode())
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	if err := json.Unmarshal([]byte(resp), file); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(file)
+}
+
+// GuestFileWriteRequestHandler writes a file to the guest via the guest agent
+func (app *SubresourceAPIApp) GuestFileWriteRequestHandler(request *restful.Request, response *restful.Response) {
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	if statusErr := decodeGuestAgentRequest(request, guestFileMaxRequestBytes, file); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if file.Path == "" {
+		writeError(errors.NewBadRequest("path is required"), response)
+		return
+	}
+	if len(file.Content) > guestFileMaxBytes {
+		writeError(errors.NewRequestEntityTooLargeError(fmt.Sprintf("file content exceeds %d bytes", guestFileMaxBytes)), response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileURI(vmi)
+	}
+	handlerURL, conn, statusErr := app.prepareGuestAgentConnection(request, getURL, guestFileTimeoutSeconds)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	body, err := json.Marshal(file)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := conn.Put(fmt.Sprintf("%s?timeoutSeconds=%d", handlerURL, guestFileTimeoutSeconds), io.NopCloser(bytes.NewReader(body))); err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
@@ -29,6 +29,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
@@ -1758,6 +1759,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			migrateClient.EXPECT().Create(gomock.Any(), gomock.Any()).Do(
 				func(obj interface{}, opts *k8smetav1.CreateOptions) {
 					Expect(opts.DryRun).To(BeEquivalentTo(migrateOptions.DryRun))
+					Expect(obj.(*v1.VirtualMachineInstanceMigration).Spec.AddedNodeSelector).To(Equal(migrateOptions.AddedNodeSelector))
 				}).Return(&migration, nil)
 			app.MigrateVMRequestHandler(request, response)
 
@@ -1766,6 +1768,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		},
 			Entry("with default", &v1.MigrateOptions{}),
 			Entry("with dry-run option", &v1.MigrateOptions{DryRun: getDryRunOption()}),
+			Entry("with added node selector", &v1.MigrateOptions{AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node02"}}),
 		)
 	})
 
@@ -2077,6 +2080,122 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Guest agent exec and files", func() {
+		setBody := func(obj interface{}) {
+			bytesRepresentation, _ := json.Marshal(obj)
+			request.Request.Body = io.NopCloser(bytes.NewReader(bytesRepresentation))
+		}
+
+		BeforeEach(func() {
+			response.SetRequestAccepts(restful.MIME_JSON)
+		})
+
+		It("Should execute a command with the default timeout", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec", fmt.Sprintf("maxOutputBytes=%d", guestExecMaxOutputBytes)),
+					ghttp.VerifyJSONRepresenting(v1.VirtualMachineInstanceGuestExecRequest{
+						Command:        "/usr/bin/echo",
+						Args:           []string{"hello"},
+						TimeoutSeconds: pointer.Int32(guestExecDefaultTimeoutSeconds),
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, v1.VirtualMachineInstanceGuestExecResult{ExitCode: 1, StdOut: "hello\n", StdErr: "warning\n"}),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			setBody(v1.VirtualMachineInstanceGuestExecRequest{Command: "/usr/bin/echo", Args: []string{"hello"}})
+
+			app.GuestExecRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := v1.VirtualMachineInstanceGuestExecResult{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &result)).To(Succeed())
+			Expect(result).To(Equal(v1.VirtualMachineInstanceGuestExecResult{ExitCode: 1, StdOut: "hello\n", StdErr: "warning\n"}))
+		})
+
+		DescribeTable("Should reject an invalid exec request", func(execRequest v1.VirtualMachineInstanceGuestExecRequest) {
+			setBody(execRequest)
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without command", v1.VirtualMachineInstanceGuestExecRequest{}),
+			Entry("with a negative timeout", v1.VirtualMachineInstanceGuestExecRequest{Command: "/usr/bin/true", TimeoutSeconds: pointer.Int32(-1)}),
+			Entry("with a too long timeout", v1.VirtualMachineInstanceGuestExecRequest{Command: "/usr/bin/true", TimeoutSeconds: pointer.Int32(guestExecMaxTimeoutSeconds + 1)}),
+		)
+
+		It("Should read a guest file", func() {
+			file := v1.VirtualMachineInstanceGuestFile{Path: "/etc/hostname", Content: []byte("testvmi\n")}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile",
+						fmt.Sprintf("maxBytes=%d&path=%%2Fetc%%2Fhostname&timeoutSeconds=%d", guestFileMaxBytes, guestFileTimeoutSeconds)),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, file),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.URL = &url.URL{RawQuery: "path=/etc/hostname"}
+
+			app.GuestFileReadRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			fetchedFile := v1.VirtualMachineInstanceGuestFile{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &fetchedFile)).To(Succeed())
+			Expect(fetchedFile).To(Equal(file))
+		})
+
+		It("Should reject reading a guest file without path", func() {
+			request.Request.URL = &url.URL{}
+
+			app.GuestFileReadRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("Should write a guest file", func() {
+			file := v1.VirtualMachineInstanceGuestFile{Path: "/tmp/test", Content: []byte("test")}
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile", fmt.Sprintf("timeoutSeconds=%d", guestFileTimeoutSeconds)),
+					ghttp.VerifyBody([]byte(`{"path":"/tmp/test","content":"dGVzdA=="}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			setBody(file)
+
+			app.GuestFileWriteRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should reject writing a too large guest file", func() {
+			setBody(v1.VirtualMachineInstanceGuestFile{Path: "/tmp/test", Content: make([]byte, guestFileMaxBytes+1)})
+
+			app.GuestFileWriteRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusRequestEntityTooLarge)
+		})
+
+		DescribeTable("Should fail when the guest agent is not reachable", func(running bool, fn func(*restful.Request, *restful.Response), body interface{}) {
+			expectVMI(running, UnPaused)
+			setBody(body)
+			request.Request.URL = &url.URL{RawQuery: "path=/etc/hostname"}
+
+			fn(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		},
+			Entry("exec with VMI not running", false, app.GuestExecRequestHandler, v1.VirtualMachineInstanceGuestExecRequest{Command: "/usr/bin/true"}),
+			Entry("exec without guest agent", true, app.GuestExecRequestHandler, v1.VirtualMachineInstanceGuestExecRequest{Command: "/usr/bin/true"}),
+			Entry("file read with VMI not running", false, app.GuestFileReadRequestHandler, nil),
+			Entry("file read without guest agent", true, app.GuestFileReadRequestHandler, nil),
+			Entry("file write with VMI not running", false, app.GuestFileWriteRequestHandler, v1.VirtualMachineInstanceGuestFile{Path: "/tmp/test"}),
+			Entry("file write without guest agent", true, app.GuestFileWriteRequestHandler, v1.VirtualMachineInstanceGuestFile{Path: "/tmp/test"}),
+		)
+	})
+
 	Context("Pausing", func() {
 		DescribeTable("Should pause a running, not paused VMI according to options", func(pauseOptions *v1.PauseOptions) {
 
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/log"
 
@@ -67,6 +68,18 @@ var VirtualMachinePoolGroupVersionResource = metav1.GroupVersionResource{
 	Resource: "virtualmachinepools",
 }
 
+var VirtualMachineDisruptionBudgetGroupVersionResource = metav1.GroupVersionResource{
+	Group:    poolv1.SchemeGroupVersion.Group,
+	Version:  poolv1.SchemeGroupVersion.Version,
+	Resource: "virtualmachinedisruptionbudgets",
+}
+
+var KSMPolicyGroupVersionResource = metav1.GroupVersionResource{
+	Group:    ksmv1alpha1.SchemeGroupVersion.Group,
+	Version:  ksmv1alpha1.SchemeGroupVersion.Version,
+	Resource: "ksmpolicies",
+}
+
 var MigrationGroupVersionResource = metav1.GroupVersionResource{
 	Group:    v1.VirtualMachineInstanceMigrationGroupVersionKind.Group,
 	Version:  v1.VirtualMachineInstanceMigrationGroupVersionKind.Version,
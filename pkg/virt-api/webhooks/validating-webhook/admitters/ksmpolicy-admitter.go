@@ -0,0 +1,146 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+// KSMPolicyAdmitter validates KSMPolicies
+type KSMPolicyAdmitter struct{}
+
+// Admit validates an AdmissionReview
+func (admitter *KSMPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	gvr := webhooks.KSMPolicyGroupVersionResource
+	if ar.Request.Resource.Group != gvr.Group || ar.Request.Resource.Resource != gvr.Resource {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	policy := &ksmv1alpha1.KSMPolicy{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, policy); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	causes := ValidateKSMPolicySpec(k8sfield.NewPath("spec"), &policy.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func ValidateKSMPolicySpec(field *k8sfield.Path, spec *ksmv1alpha1.KSMPolicySpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.NodeSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.NodeSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("nodeSelector").String(),
+			})
+		}
+	}
+
+	if spec.FreePercent != nil && (*spec.FreePercent < 0 || *spec.FreePercent > 100) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be between 0 and 100",
+			Field:   field.Child("freePercent").String(),
+		})
+	}
+
+	if spec.SleepMillisecondsBaseline != nil && *spec.SleepMillisecondsBaseline < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be larger than 0",
+			Field:   field.Child("sleepMillisecondsBaseline").String(),
+		})
+	}
+
+	if spec.PagesToScan != nil {
+		causes = append(causes, validateKSMPagesToScan(field.Child("pagesToScan"), spec.PagesToScan)...)
+	}
+
+	return causes
+}
+
+func validateKSMPagesToScan(field *k8sfield.Path, pages *ksmv1alpha1.KSMPagesToScan) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for _, value := range []struct {
+		name  string
+		value *int32
+	}{
+		{"min", pages.Min},
+		{"max", pages.Max},
+		{"init", pages.Init},
+		{"boost", pages.Boost},
+		{"decay", pages.Decay},
+	} {
+		if value.value != nil && *value.value < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be negative",
+				Field:   field.Child(value.name).String(),
+			})
+		}
+	}
+
+	if pages.Min != nil && pages.Max != nil && *pages.Min > *pages.Max {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must not be smaller than min",
+			Field:   field.Child("max").String(),
+		})
+	}
+
+	if pages.Init != nil {
+		if pages.Min != nil && *pages.Init < *pages.Min {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be smaller than min",
+				Field:   field.Child("init").String(),
+			})
+		}
+		if pages.Max != nil && *pages.Init > *pages.Max {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "must not be larger than max",
+				Field:   field.Child("init").String(),
+			})
+		}
+	}
+
+	return causes
+}
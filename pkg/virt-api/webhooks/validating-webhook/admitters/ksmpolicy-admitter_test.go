@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating KSMPolicy Admitter", func() {
+	admitter := &KSMPolicyAdmitter{}
+
+	admit := func(spec ksmv1alpha1.KSMPolicySpec) *admissionv1.AdmissionResponse {
+		policy := &ksmv1alpha1.KSMPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy"},
+			Spec:       spec,
+		}
+		bytes, err := json.Marshal(policy)
+		Expect(err).ToNot(HaveOccurred())
+		return admitter.Admit(&admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Resource:  webhooks.KSMPolicyGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		})
+	}
+
+	DescribeTable("should accept a policy with", func(spec ksmv1alpha1.KSMPolicySpec) {
+		Expect(admit(spec).Allowed).To(BeTrue())
+	},
+		Entry("an empty spec", ksmv1alpha1.KSMPolicySpec{}),
+		Entry("a node selector", ksmv1alpha1.KSMPolicySpec{
+			NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"ksm": "true"}},
+		}),
+		Entry("all values set", ksmv1alpha1.KSMPolicySpec{
+			Priority:                  pointer.Int32(-5),
+			FreePercent:               pointer.Int32(100),
+			SleepMillisecondsBaseline: pointer.Int32(1),
+			PagesToScan: &ksmv1alpha1.KSMPagesToScan{
+				Min:   pointer.Int32(10),
+				Max:   pointer.Int32(10),
+				Init:  pointer.Int32(10),
+				Boost: pointer.Int32(0),
+				Decay: pointer.Int32(0),
+			},
+		}),
+	)
+
+	DescribeTable("should reject a policy with", func(spec ksmv1alpha1.KSMPolicySpec, field string) {
+		resp := admit(spec)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("an invalid node selector", ksmv1alpha1.KSMPolicySpec{
+			NodeSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "ksm", Operator: "Unknown"}},
+			},
+		}, "spec.nodeSelector"),
+		Entry("a negative freePercent", ksmv1alpha1.KSMPolicySpec{FreePercent: pointer.Int32(-1)}, "spec.freePercent"),
+		Entry("a freePercent above 100", ksmv1alpha1.KSMPolicySpec{FreePercent: pointer.Int32(101)}, "spec.freePercent"),
+		Entry("a zero sleepMillisecondsBaseline", ksmv1alpha1.KSMPolicySpec{SleepMillisecondsBaseline: pointer.Int32(0)}, "spec.sleepMillisecondsBaseline"),
+		Entry("a negative decay", ksmv1alpha1.KSMPolicySpec{
+			PagesToScan: &ksmv1alpha1.KSMPagesToScan{Decay: pointer.Int32(-50)},
+		}, "spec.pagesToScan.decay"),
+		Entry("max smaller than min", ksmv1alpha1.KSMPolicySpec{
+			PagesToScan: &ksmv1alpha1.KSMPagesToScan{Min: pointer.Int32(100), Max: pointer.Int32(50)},
+		}, "spec.pagesToScan.max"),
+		Entry("init smaller than min", ksmv1alpha1.KSMPolicySpec{
+			PagesToScan: &ksmv1alpha1.KSMPagesToScan{Min: pointer.Int32(100), Init: pointer.Int32(50)},
+		}, "spec.pagesToScan.init"),
+		Entry("init larger than max", ksmv1alpha1.KSMPolicySpec{
+			PagesToScan: &ksmv1alpha1.KSMPagesToScan{Max: pointer.Int32(100), Init: pointer.Int32(150)},
+		}, "spec.pagesToScan.init"),
+	)
+})
@@ -29,11 +29,14 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -44,16 +47,137 @@ type MigrationCreateAdmitter struct {
 	VirtClient    kubecli.KubevirtClient
 }
 
-func isMigratable(vmi *v1.VirtualMachineInstance) error {
+func isMigratable(vmi *v1.VirtualMachineInstance, migratedVolumes []v1.StorageMigratedVolume) error {
 	for _, c := range vmi.Status.Conditions {
 		if c.Type == v1.VirtualMachineInstanceIsMigratable &&
 			c.Status == k8sv1.ConditionFalse {
+			if c.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable && len(migratedVolumes) > 0 {
+				// The volumes preventing the live migration may be the ones copied to new claims
+				if err := ensureRemainingVolumesShared(vmi, migratedVolumes); err != nil {
+					return fmt.Errorf("Cannot migrate VMI, Reason: %s, Message: %v", c.Reason, err)
+				}
+				continue
+			}
 			return fmt.Errorf("Cannot migrate VMI, Reason: %s, Message: %s", c.Reason, c.Message)
 		}
 	}
 	return nil
 }
 
+// ensureRemainingVolumesShared verifies that all volumes, which are not copied to
+// a new claim, can be accessed from the source and the target of a live migration.
+func ensureRemainingVolumesShared(vmi *v1.VirtualMachineInstance, migratedVolumes []v1.StorageMigratedVolume) error {
+	migrated := make(map[string]bool, len(migratedVolumes))
+	for _, migratedVolume := range migratedVolumes {
+		migrated[migratedVolume.VolumeName] = true
+	}
+	volumeStatuses := make(map[string]v1.VolumeStatus, len(vmi.Status.VolumeStatus))
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		volumeStatuses[volumeStatus.Name] = volumeStatus
+	}
+
+	for _, volume := range vmi.Spec.Volumes {
+		if migrated[volume.Name] {
+			continue
+		}
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil {
+			volumeStatus, exists := volumeStatuses[volume.Name]
+			if !exists || volumeStatus.PersistentVolumeClaimInfo == nil || !storagetypes.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes) {
+				return fmt.Errorf("volume %s is not shared and is not migrated to a new claim", volume.Name)
+			}
+		} else if volume.HostDisk != nil && (volume.HostDisk.Shared == nil || !*volume.HostDisk.Shared) {
+			return fmt.Errorf("volume %s is a non-shared HostDisk", volume.Name)
+		}
+	}
+	return nil
+}
+
+// validateMigratedVolumesForVMI ensures that every migrated volume is a
+// persistent volume of the VMI and is copied to a claim it does not use yet.
+func validateMigratedVolumesForVMI(field *k8sfield.Path, migratedVolumes []v1.StorageMigratedVolume, vmi *v1.VirtualMachineInstance) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	claims := make(map[string]bool)
+	volumes := make(map[string]v1.Volume)
+	for _, volume := range vmi.Spec.Volumes {
+		volumes[volume.Name] = volume
+		if volume.PersistentVolumeClaim != nil {
+			claims[volume.PersistentVolumeClaim.ClaimName] = true
+		} else if volume.DataVolume != nil {
+			claims[volume.DataVolume.Name] = true
+		}
+	}
+	hotplugged := make(map[string]bool)
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil {
+			hotplugged[volumeStatus.Name] = true
+		}
+	}
+
+	for i, migratedVolume := range migratedVolumes {
+		volume, exists := volumes[migratedVolume.VolumeName]
+		if !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s does not exist on the VMI", migratedVolume.VolumeName),
+				Field:   field.Index(i).Child("volumeName").String(),
+			})
+			continue
+		}
+		if (volume.PersistentVolumeClaim == nil && volume.DataVolume == nil) || hotplugged[volume.Name] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is not a PVC or DataVolume which can be migrated", migratedVolume.VolumeName),
+				Field:   field.Index(i).Child("volumeName").String(),
+			})
+		}
+		if claims[migratedVolume.DestinationClaim] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("claim %s is already used by the VMI", migratedVolume.DestinationClaim),
+				Field:   field.Index(i).Child("destinationClaim").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateDestinationClaims ensures that the destination claim of every migrated
+// volume exists and can hold the data of the claim the volume currently uses.
+func (admitter *MigrationCreateAdmitter) validateDestinationClaims(field *k8sfield.Path, migratedVolumes []v1.StorageMigratedVolume, vmi *v1.VirtualMachineInstance) ([]metav1.StatusCause, error) {
+	var causes []metav1.StatusCause
+
+	claimInfos := make(map[string]*v1.PersistentVolumeClaimInfo, len(vmi.Status.VolumeStatus))
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		claimInfos[volumeStatus.Name] = volumeStatus.PersistentVolumeClaimInfo
+	}
+
+	for i, migratedVolume := range migratedVolumes {
+		pvc, err := admitter.VirtClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), migratedVolume.DestinationClaim, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("claim %s does not exist", migratedVolume.DestinationClaim),
+				Field:   field.Index(i).Child("destinationClaim").String(),
+			})
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if err := migrations.ValidateMigratedVolumeClaim(claimInfos[migratedVolume.VolumeName], pvc); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s cannot be migrated: %v", migratedVolume.VolumeName, err),
+				Field:   field.Index(i).Child("destinationClaim").String(),
+			})
+		}
+	}
+
+	return causes, nil
+}
+
 func EnsureNoMigrationConflict(virtClient kubecli.KubevirtClient, vmiName string, namespace string) error {
 	labelSelector, err := labels.Parse(fmt.Sprintf("%s in (%s)", v1.MigrationSelectorLabel, vmiName))
 	if err != nil {
@@ -105,12 +229,29 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrate VMI in finalized state."))
 	}
 
+	causes = validateMigratedVolumesForVMI(k8sfield.NewPath("spec", "migratedVolumes"), migration.Spec.MigratedVolumes, vmi)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	causes, err = admitter.validateDestinationClaims(k8sfield.NewPath("spec", "migratedVolumes"), migration.Spec.MigratedVolumes, vmi)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	} else if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	// Reject migration jobs for non-migratable VMIs
-	err = isMigratable(vmi)
+	err = isMigratable(vmi, migration.Spec.MigratedVolumes)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	causes = validateAddedNodeSelectorForVMI(k8sfield.NewPath("spec", "addedNodeSelector"), migration.Spec.AddedNodeSelector, vmi)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	// Don't allow new migration jobs to be introduced when previous migration jobs
 	// are already in flight.
 	err = EnsureNoMigrationConflict(admitter.VirtClient, migration.Spec.VMIName, migration.Namespace)
@@ -161,5 +302,82 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	for key, value := range spec.AddedNodeSelector {
+		for _, msg := range validation.IsQualifiedName(key) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid addedNodeSelector key %q: %s", key, msg),
+				Field:   field.Child("addedNodeSelector").Key(key).String(),
+			})
+		}
+		for _, msg := range validation.IsValidLabelValue(value) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid addedNodeSelector value %q: %s", value, msg),
+				Field:   field.Child("addedNodeSelector").Key(key).String(),
+			})
+		}
+	}
+
+	volumeNames := make(map[string]bool)
+	claimNames := make(map[string]bool)
+	for i, migratedVolume := range spec.MigratedVolumes {
+		if migratedVolume.VolumeName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "volumeName is missing",
+				Field:   field.Child("migratedVolumes").Index(i).Child("volumeName").String(),
+			})
+		} else if volumeNames[migratedVolume.VolumeName] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is migrated more than once", migratedVolume.VolumeName),
+				Field:   field.Child("migratedVolumes").Index(i).Child("volumeName").String(),
+			})
+		}
+		volumeNames[migratedVolume.VolumeName] = true
+
+		if migratedVolume.DestinationClaim == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "destinationClaim is missing",
+				Field:   field.Child("migratedVolumes").Index(i).Child("destinationClaim").String(),
+			})
+		} else if claimNames[migratedVolume.DestinationClaim] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("claim %s is the destination of more than one volume", migratedVolume.DestinationClaim),
+				Field:   field.Child("migratedVolumes").Index(i).Child("destinationClaim").String(),
+			})
+		}
+		claimNames[migratedVolume.DestinationClaim] = true
+	}
+
+	return causes
+}
+
+// validateAddedNodeSelectorForVMI ensures that the added node selector of a migration
+// only restricts the set of target nodes and does not contradict the VMI's own constraints.
+func validateAddedNodeSelectorForVMI(field *k8sfield.Path, addedNodeSelector map[string]string, vmi *v1.VirtualMachineInstance) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for key, value := range addedNodeSelector {
+		if vmiValue, exists := vmi.Spec.NodeSelector[key]; exists && vmiValue != value {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("addedNodeSelector %s=%s conflicts with the VMI node selector %s=%s", key, value, key, vmiValue),
+				Field:   field.Key(key).String(),
+			})
+		}
+	}
+
+	if nodeName, exists := addedNodeSelector[k8sv1.LabelHostname]; exists && nodeName == vmi.Status.NodeName {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the VMI is already running on node %s", nodeName),
+			Field:   field.Key(k8sv1.LabelHostname).String(),
+		})
+	}
+
 	return causes
 }
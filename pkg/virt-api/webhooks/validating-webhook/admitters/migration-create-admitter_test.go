@@ -28,8 +28,10 @@ import (
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
 
 	"kubevirt.io/client-go/api"
 
@@ -301,6 +303,195 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 		})
 
+		It("should reject an invalid added node selector", func() {
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName: "testmigratevmi5",
+					AddedNodeSelector: map[string]string{
+						"invalid key!": "value",
+					},
+				},
+			}
+			migrationBytes, _ := json.Marshal(&migration)
+
+			enableFeatureGate(virtconfig.LiveMigrationGate)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.MigrationGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: migrationBytes,
+					},
+				},
+			}
+
+			resp := migrationCreateAdmitter.Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).ToNot(BeEmpty())
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.addedNodeSelector[invalid key!]"))
+		})
+
+		DescribeTable("should validate the added node selector against the VMI", func(vmiNodeSelector, addedNodeSelector map[string]string, allowed bool) {
+			vmi := api.NewMinimalVMI("testmigratevmi6")
+			vmi.Status.Phase = v1.Running
+			vmi.Status.NodeName = "node01"
+			vmi.Spec.NodeSelector = vmiNodeSelector
+
+			mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil)
+
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:           vmi.Name,
+					AddedNodeSelector: addedNodeSelector,
+				},
+			}
+			migrationBytes, _ := json.Marshal(&migration)
+
+			enableFeatureGate(virtconfig.LiveMigrationGate)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.MigrationGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: migrationBytes,
+					},
+				},
+			}
+
+			resp := migrationCreateAdmitter.Admit(ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+		},
+			Entry("accept a different target node",
+				nil, map[string]string{k8sv1.LabelHostname: "node02"}, true),
+			Entry("accept a selector matching the VMI node selector",
+				map[string]string{"zone": "a"}, map[string]string{"zone": "a", "rack": "r1"}, true),
+			Entry("reject a selector conflicting with the VMI node selector",
+				map[string]string{"zone": "a"}, map[string]string{"zone": "b"}, false),
+			Entry("reject the node the VMI is currently running on",
+				nil, map[string]string{k8sv1.LabelHostname: "node01"}, false),
+		)
+
+		DescribeTable("should validate the migrated volumes", func(migratedVolumes []v1.StorageMigratedVolume, allowed bool) {
+			vmi := api.NewMinimalVMI("testmigratevmi7")
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "rwo",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "rwo-claim"},
+						},
+					},
+				},
+				{
+					Name: "rwx",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "rwx-claim"},
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "rwo",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+						Capacity:    k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+				{
+					Name: "rwx",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+						Capacity:    k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+
+			newClaim := func(name string, size string, volumeMode k8sv1.PersistentVolumeMode) *k8sv1.PersistentVolumeClaim {
+				return &k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: vmi.Namespace},
+					Spec: k8sv1.PersistentVolumeClaimSpec{
+						VolumeMode: &volumeMode,
+						Resources: k8sv1.ResourceRequirements{
+							Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse(size)},
+						},
+					},
+				}
+			}
+			kubeClient := fake.NewSimpleClientset(
+				newClaim("dst", "10Gi", k8sv1.PersistentVolumeFilesystem),
+				newClaim("dst0", "20Gi", k8sv1.PersistentVolumeFilesystem),
+				newClaim("dst1", "10Gi", k8sv1.PersistentVolumeFilesystem),
+				newClaim("small", "5Gi", k8sv1.PersistentVolumeFilesystem),
+				newClaim("block", "10Gi", k8sv1.PersistentVolumeBlock),
+			)
+			virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:    v1.VirtualMachineInstanceIsMigratable,
+					Status:  k8sv1.ConditionFalse,
+					Reason:  v1.VirtualMachineInstanceReasonDisksNotMigratable,
+					Message: "cannot migrate VMI: PVC rwo-claim is not shared",
+				},
+			}
+
+			mockVMIClient.EXPECT().Get(context.Background(), vmi.Name, gomock.Any()).Return(vmi, nil).AnyTimes()
+
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:         vmi.Name,
+					MigratedVolumes: migratedVolumes,
+				},
+			}
+			migrationBytes, _ := json.Marshal(&migration)
+
+			enableFeatureGate(virtconfig.LiveMigrationGate)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.MigrationGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: migrationBytes,
+					},
+				},
+			}
+
+			resp := migrationCreateAdmitter.Admit(ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+		},
+			Entry("accept moving the non-shared volume to a new claim",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "dst"}}, true),
+			Entry("accept moving all volumes to new claims",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "dst0"}, {VolumeName: "rwx", DestinationClaim: "dst1"}}, true),
+			Entry("reject keeping a non-shared volume",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwx", DestinationClaim: "dst"}}, false),
+			Entry("reject an unknown volume",
+				[]v1.StorageMigratedVolume{{VolumeName: "unknown", DestinationClaim: "dst"}}, false),
+			Entry("reject a destination claim used by the VMI",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "rwx-claim"}}, false),
+			Entry("reject migrating a volume twice",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "dst0"}, {VolumeName: "rwo", DestinationClaim: "dst1"}}, false),
+			Entry("reject a missing destination claim",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo"}}, false),
+			Entry("reject a destination claim which does not exist",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "unknown"}}, false),
+			Entry("reject a destination claim smaller than the source claim",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "small"}}, false),
+			Entry("reject a destination claim with a different volume mode",
+				[]v1.StorageMigratedVolume{{VolumeName: "rwo", DestinationClaim: "block"}}, false),
+		)
+
 		DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse) {
 			input := map[string]interface{}{}
 			json.Unmarshal([]byte(data), &input)
@@ -95,6 +95,14 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		}
 	}
 
+	if spec.ParallelMigrationThreads != nil && *spec.ParallelMigrationThreads <= 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be larger than 1",
+			Field:   sourceField.Child("parallelMigrationThreads").String(),
+		})
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -78,6 +78,14 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.Int64Ptr(-1)},
 		),
+
+		Entry("zero ParallelMigrationThreads",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationThreads: pointer.Uint32(0)},
+		),
+
+		Entry("a single ParallelMigrationThreads",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationThreads: pointer.Uint32(1)},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -104,6 +112,10 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("multiple ParallelMigrationThreads",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationThreads: pointer.Uint32(8)},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
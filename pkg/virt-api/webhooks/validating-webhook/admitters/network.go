@@ -81,5 +81,31 @@ func hasInterfaceBindingMethod(iface v1.Interface) bool {
 		iface.InterfaceBindingMethod.Masquerade != nil ||
 		iface.InterfaceBindingMethod.SRIOV != nil ||
 		iface.InterfaceBindingMethod.Macvtap != nil ||
-		iface.InterfaceBindingMethod.Passt != nil
+		iface.InterfaceBindingMethod.Passt != nil ||
+		iface.InterfaceBindingMethod.VhostUser != nil
+}
+
+func validateVhostUserInterfaces(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for idx, iface := range spec.Domain.Devices.Interfaces {
+		if iface.VhostUser == nil {
+			continue
+		}
+		// The data plane accesses the guest memory directly, it has to be shared and backed by hugepages.
+		if spec.Domain.Memory == nil || spec.Domain.Memory.Hugepages == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%q vhost-user interface requires %s", iface.Name, field.Child("domain", "memory", "hugepages").String()),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("vhostuser").String(),
+			})
+		}
+		if iface.Model != "" && iface.Model != v1.VirtIO {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%q vhost-user interface supports only the %s model", iface.Name, v1.VirtIO),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("model").String(),
+			})
+		}
+	}
+	return causes
 }
@@ -120,4 +120,41 @@ var _ = Describe("Validating VMI network spec", func() {
 		}}
 		Expect(validateInterfaceBinding(k8sfield.NewPath("fake"), &vm.Spec)).To(BeEmpty())
 	})
+
+	Context("vhost-user interface", func() {
+		var vm *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vm = api.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "foo",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}},
+			}}
+		})
+
+		It("is valid when backed by hugepages", func() {
+			Expect(validateVhostUserInterfaces(k8sfield.NewPath("fake"), &vm.Spec)).To(BeEmpty())
+		})
+
+		It("requires hugepages", func() {
+			vm.Spec.Domain.Memory = nil
+			Expect(validateVhostUserInterfaces(k8sfield.NewPath("fake"), &vm.Spec)).To(
+				ConsistOf(metav1.StatusCause{
+					Type:    "FieldValueInvalid",
+					Message: "\"foo\" vhost-user interface requires fake.domain.memory.hugepages",
+					Field:   "fake.domain.devices.interfaces[0].vhostuser",
+				}))
+		})
+
+		It("supports only the virtio model", func() {
+			vm.Spec.Domain.Devices.Interfaces[0].Model = "e1000"
+			Expect(validateVhostUserInterfaces(k8sfield.NewPath("fake"), &vm.Spec)).To(
+				ConsistOf(metav1.StatusCause{
+					Type:    "FieldValueNotSupported",
+					Message: "\"foo\" vhost-user interface supports only the virtio model",
+					Field:   "fake.domain.devices.interfaces[0].model",
+				}))
+		})
+	})
 })
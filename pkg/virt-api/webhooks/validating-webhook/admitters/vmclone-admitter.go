@@ -30,19 +30,33 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	"kubevirt.io/api/clone"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
+const (
+	cloneKindVirtualMachine         = "VirtualMachine"
+	cloneKindVirtualMachineSnapshot = "VirtualMachineSnapshot"
+	cloneKindVirtualMachineExport   = "VirtualMachineExport"
+	cloneKindDataSource             = "DataSource"
+	cloneKindDataVolume             = "DataVolume"
+	cloneKindPersistentVolumeClaim  = "PersistentVolumeClaim"
+)
+
 // VirtualMachineCloneAdmitter validates VirtualMachineClones
 type VirtualMachineCloneAdmitter struct {
 	Config *virtconfig.ClusterConfig
@@ -75,6 +89,9 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ar *admissionv1.AdmissionRevi
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
 	}
+	if vmClone.Namespace == "" {
+		vmClone.Namespace = ar.Request.Namespace
+	}
 
 	var causes []metav1.StatusCause
 
@@ -93,6 +110,22 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ar *admissionv1.AdmissionRevi
 		causes = append(causes, newCauses...)
 	}
 
+	if ar.Request.Operation == admissionv1.Create && isCrossNamespaceClone(vmClone) {
+		newCauses, err := admitter.validateTargetNamespace(ar.Request.UserInfo, vmClone)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		causes = append(causes, newCauses...)
+	}
+
+	if ar.Request.Operation == admissionv1.Create && len(causes) == 0 && getCloneTargetKind(vmClone) != cloneKindVirtualMachine {
+		newCauses, err := admitter.authorizeVolumeClone(ar.Request.UserInfo, vmClone)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -144,8 +177,8 @@ func validateSourceAndTargetKind(vmClone *clonev1alpha1.VirtualMachineClone) []m
 	var causes []metav1.StatusCause = nil
 	sourceField := k8sfield.NewPath("spec")
 
-	supportedSourceTypes := []string{"VirtualMachine", "VirtualMachineSnapshot"}
-	supportedTargetTypes := []string{"VirtualMachine"}
+	supportedSourceTypes := []string{cloneKindVirtualMachine, cloneKindVirtualMachineSnapshot, cloneKindVirtualMachineExport, cloneKindDataSource}
+	supportedTargetTypes := []string{cloneKindVirtualMachine, cloneKindDataVolume, cloneKindPersistentVolumeClaim}
 
 	if !doesSliceContainStr(supportedSourceTypes, vmClone.Spec.Source.Kind) {
 		causes = []metav1.StatusCause{{
@@ -166,9 +199,147 @@ func validateSourceAndTargetKind(vmClone *clonev1alpha1.VirtualMachineClone) []m
 		})
 	}
 
+	if causes == nil && !isSupportedCloneCombination(vmClone) {
+		causes = []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("cloning a %s into a %s is not supported", vmClone.Spec.Source.Kind, getCloneTargetKind(vmClone)),
+			Field:   sourceField.Child("Target").Child("Kind").String(),
+		}}
+	}
+
 	return causes
 }
 
+// isSupportedCloneCombination checks the target can be populated from the source:
+// VMs and snapshots are cloned into VMs, exports and DataSources are cloned into volumes
+func isSupportedCloneCombination(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	isVolumeTarget := getCloneTargetKind(vmClone) != cloneKindVirtualMachine
+
+	switch vmClone.Spec.Source.Kind {
+	case cloneKindVirtualMachineExport, cloneKindDataSource:
+		return isVolumeTarget
+	default:
+		return !isVolumeTarget
+	}
+}
+
+func getCloneTargetKind(vmClone *clonev1alpha1.VirtualMachineClone) string {
+	if vmClone.Spec.Target == nil {
+		return cloneKindVirtualMachine
+	}
+
+	return vmClone.Spec.Target.Kind
+}
+
+func isCrossNamespaceClone(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	return vmClone.Spec.TargetNamespace != nil && *vmClone.Spec.TargetNamespace != "" && *vmClone.Spec.TargetNamespace != vmClone.Namespace
+}
+
+// validateTargetNamespace makes sure the requester is allowed to create the clone target in the target namespace
+func (admitter *VirtualMachineCloneAdmitter) validateTargetNamespace(userInfo authenticationv1.UserInfo, vmClone *clonev1alpha1.VirtualMachineClone) ([]metav1.StatusCause, error) {
+	targetNamespace := *vmClone.Spec.TargetNamespace
+	resourceAttributes := &authv1.ResourceAttributes{
+		Namespace: targetNamespace,
+		Verb:      "create",
+		Group:     core.GroupName,
+		Resource:  "virtualmachines",
+	}
+	if getCloneTargetKind(vmClone) != cloneKindVirtualMachine {
+		// volume targets are populated through a DataVolume
+		resourceAttributes.Group = cdiv1.SchemeGroupVersion.Group
+		resourceAttributes.Resource = "datavolumes"
+	}
+
+	allowed, err := isUserAllowed(admitter.Client, userInfo, resourceAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("User %q is not allowed to create %s in namespace %q", userInfo.Username, resourceAttributes.Resource, targetNamespace),
+				Field:   k8sfield.NewPath("spec", "targetNamespace").String(),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// cloneAuthProxy serves the CDI clone authorization helpers from the API server
+type cloneAuthProxy struct {
+	client kubecli.KubevirtClient
+}
+
+func (p *cloneAuthProxy) CreateSar(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+	return p.client.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
+}
+
+func (p *cloneAuthProxy) GetNamespace(name string) (*corev1.Namespace, error) {
+	return p.client.CoreV1().Namespaces().Get(context.Background(), name, metav1.GetOptions{})
+}
+
+func (p *cloneAuthProxy) GetDataSource(namespace, name string) (*cdiv1.DataSource, error) {
+	return p.client.CdiClient().CdiV1beta1().DataSources(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// authorizeVolumeClone makes sure the requester is allowed to clone the source volume into the target namespace.
+// The target DataVolume is created by virt-controller, so CDI only sees the controller's permissions.
+func (admitter *VirtualMachineCloneAdmitter) authorizeVolumeClone(userInfo authenticationv1.UserInfo, vmClone *clonev1alpha1.VirtualMachineClone) ([]metav1.StatusCause, error) {
+	targetNamespace := vmClone.Namespace
+	if isCrossNamespaceClone(vmClone) {
+		targetNamespace = *vmClone.Spec.TargetNamespace
+	}
+	proxy := &cloneAuthProxy{client: admitter.Client}
+
+	var allowed bool
+	var reason string
+	var err error
+	switch vmClone.Spec.Source.Kind {
+	case cloneKindDataSource:
+		dv := &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: targetNamespace,
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				SourceRef: &cdiv1.DataVolumeSourceRef{
+					Kind:      cdiv1.DataVolumeDataSource,
+					Namespace: &vmClone.Namespace,
+					Name:      vmClone.Spec.Source.Name,
+				},
+			},
+		}
+		var response cdiv1.CloneAuthResponse
+		response, err = dv.AuthorizeUser(vmClone.Namespace, vmClone.Name, proxy, userInfo)
+		if err == cdiv1.ErrNoTokenOkay {
+			err = nil
+		}
+		allowed, reason = response.Allowed, response.Reason
+	case cloneKindVirtualMachineExport:
+		// the exported PVCs are only known once the export is ready, require access to all of them
+		allowed, reason, err = cdiv1.CanUserClonePVC(proxy.CreateSar, vmClone.Namespace, "", targetNamespace, userInfo)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Authorization failed, message is: %s", reason),
+				Field:   k8sfield.NewPath("spec", "source").String(),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
 func validateSource(client kubecli.KubevirtClient, vmClone *clonev1alpha1.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause = nil
 	sourceField := k8sfield.NewPath("spec")
@@ -205,10 +376,16 @@ func validateSource(client kubecli.KubevirtClient, vmClone *clonev1alpha1.Virtua
 	}
 	if source.Kind != "" && source.Name != "" {
 		switch source.Kind {
-		case "VirtualMachine":
+		case cloneKindVirtualMachine:
 			causes = append(causes, validateCloneSourceVM(client, source.Name, vmClone.Namespace, sourceField.Child("Source"))...)
-		case "VirtualMachineSnapshot":
+		case cloneKindVirtualMachineSnapshot:
 			causes = append(causes, validateCloneSourceSnapshot(client, source.Name, vmClone.Namespace, sourceField.Child("Source"))...)
+		case cloneKindVirtualMachineExport:
+			_, err := client.VirtualMachineExport(vmClone.Namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+			causes = append(causes, validateCloneSourceExists(err, sourceField.Child("Source"), cloneKindVirtualMachineExport, source.Name, vmClone.Namespace)...)
+		case cloneKindDataSource:
+			_, err := client.CdiClient().CdiV1beta1().DataSources(vmClone.Namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+			causes = append(causes, validateCloneSourceExists(err, sourceField.Child("Source"), cloneKindDataSource, source.Name, vmClone.Namespace)...)
 		default:
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
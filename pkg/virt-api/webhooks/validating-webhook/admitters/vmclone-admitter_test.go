@@ -28,14 +28,19 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/client-go/testing"
 	"kubevirt.io/api/snapshot/v1alpha1"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	"kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 
 	"github.com/golang/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
 
@@ -43,7 +48,9 @@ import (
 	clonev1lpha1 "kubevirt.io/api/clone/v1alpha1"
 	"kubevirt.io/api/core"
 	v1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -331,6 +338,242 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("volume targets", func() {
+		var cdiClient *cdifake.Clientset
+
+		BeforeEach(func() {
+			cdiClient = cdifake.NewSimpleClientset(&cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "clone-source-datasource",
+					Namespace: util.NamespaceTestDefault,
+				},
+			})
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			virtClient.EXPECT().VirtualMachineExport(util.NamespaceTestDefault).
+				Return(kubevirtClient.ExportV1alpha1().VirtualMachineExports(util.NamespaceTestDefault)).AnyTimes()
+			kubevirtClient.Fake.PrependReactor("get", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				get := action.(testing.GetAction)
+				if get.GetName() != "clone-source-export" {
+					return true, nil, errors.NewNotFound(exportv1.SchemeGroupVersion.WithResource("virtualmachineexports").GroupResource(), get.GetName())
+				}
+				return true, &exportv1.VirtualMachineExport{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      get.GetName(),
+						Namespace: util.NamespaceTestDefault,
+					},
+				}, nil
+			})
+		})
+
+		setSource := func(kind, name string) {
+			vmClone.Spec.Source = &k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.String(cdiv1.SchemeGroupVersion.Group),
+				Kind:     kind,
+				Name:     name,
+			}
+			if kind == "VirtualMachineExport" {
+				vmClone.Spec.Source.APIGroup = pointer.String(exportv1.SchemeGroupVersion.Group)
+			}
+		}
+
+		setTarget := func(kind string) {
+			vmClone.Spec.Target = &k8sv1.TypedLocalObjectReference{
+				Kind: kind,
+				Name: "clone-target-volume",
+			}
+		}
+
+		DescribeTable("should allow cloning into a volume", func(sourceKind, sourceName, targetKind string) {
+			setSource(sourceKind, sourceName)
+			setTarget(targetKind)
+			admitter.admitAndExpect(vmClone, true)
+		},
+			Entry("DataSource into DataVolume", "DataSource", "clone-source-datasource", "DataVolume"),
+			Entry("DataSource into PersistentVolumeClaim", "DataSource", "clone-source-datasource", "PersistentVolumeClaim"),
+			Entry("VirtualMachineExport into DataVolume", "VirtualMachineExport", "clone-source-export", "DataVolume"),
+		)
+
+		DescribeTable("should reject a source that does not exist", func(sourceKind string) {
+			setSource(sourceKind, "does-not-exist")
+			setTarget("DataVolume")
+			admitter.admitAndExpect(vmClone, false)
+		},
+			Entry("DataSource", "DataSource"),
+			Entry("VirtualMachineExport", "VirtualMachineExport"),
+		)
+
+		It("should reject cloning a DataSource into a VirtualMachine", func() {
+			setSource("DataSource", "clone-source-datasource")
+			vmClone.Spec.Target = nil
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		It("should reject cloning a VirtualMachine into a volume", func() {
+			setTarget("DataVolume")
+			admitter.admitAndExpect(vmClone, false)
+		})
+
+		Context("clone authorization", func() {
+			const sourceNamespace = "golden-images"
+
+			var k8sClient *k8sfake.Clientset
+			var sarNamespaces []string
+
+			BeforeEach(func() {
+				sarNamespaces = nil
+				k8sClient = k8sfake.NewSimpleClientset(&k8sv1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: sourceNamespace},
+				})
+				virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+
+				Expect(cdiClient.Tracker().Update(cdiv1.SchemeGroupVersion.WithResource("datasources"), &cdiv1.DataSource{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "clone-source-datasource",
+						Namespace: util.NamespaceTestDefault,
+					},
+					Spec: cdiv1.DataSourceSpec{
+						Source: cdiv1.DataSourceSource{
+							PVC: &cdiv1.DataVolumeSourcePVC{Namespace: sourceNamespace, Name: "golden-pvc"},
+						},
+					},
+				}, util.NamespaceTestDefault)).To(Succeed())
+			})
+
+			admit := func() *admissionv1.AdmissionResponse {
+				ar := createCloneAdmissionReview(vmClone)
+				ar.Request.UserInfo = authenticationv1.UserInfo{Username: "user"}
+				return admitter.Admit(ar)
+			}
+
+			expectCloneSubjectAccessReviews := func(allowed bool) {
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+					Expect(sar.Spec.User).To(Equal("user"))
+					sarNamespaces = append(sarNamespaces, sar.Spec.ResourceAttributes.Namespace)
+					// creating the DataVolume in the target namespace is always allowed here
+					sar.Status.Allowed = allowed || sar.Spec.ResourceAttributes.Subresource == "" && sar.Spec.ResourceAttributes.Resource == "datavolumes"
+					return true, sar, nil
+				})
+			}
+
+			DescribeTable("should check the user can clone the PVC behind the DataSource", func(allowed bool) {
+				setSource("DataSource", "clone-source-datasource")
+				setTarget("DataVolume")
+				expectCloneSubjectAccessReviews(allowed)
+
+				resp := admit()
+				Expect(resp.Allowed).To(Equal(allowed))
+				Expect(sarNamespaces).To(ContainElement(sourceNamespace))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+				}
+			},
+				Entry("allowed", true),
+				Entry("denied", false),
+			)
+
+			DescribeTable("should check the user can clone the exported PVCs into the target namespace", func(allowed bool) {
+				setSource("VirtualMachineExport", "clone-source-export")
+				setTarget("DataVolume")
+				vmClone.Spec.TargetNamespace = pointer.String("target-ns")
+				expectCloneSubjectAccessReviews(allowed)
+
+				resp := admit()
+				Expect(resp.Allowed).To(Equal(allowed))
+				Expect(sarNamespaces).To(ContainElements("target-ns", util.NamespaceTestDefault))
+				if !allowed {
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+				}
+			},
+				Entry("allowed", true),
+				Entry("denied", false),
+			)
+
+			It("should not check the clone permissions of an export in the target namespace", func() {
+				setSource("VirtualMachineExport", "clone-source-export")
+				setTarget("DataVolume")
+
+				Expect(admit().Allowed).To(BeTrue())
+				Expect(k8sClient.Actions()).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("target namespace", func() {
+		var k8sClient *k8sfake.Clientset
+
+		BeforeEach(func() {
+			k8sClient = k8sfake.NewSimpleClientset()
+			vmClone.Spec.TargetNamespace = pointer.String("target-ns")
+		})
+
+		admit := func() *admissionv1.AdmissionResponse {
+			ar := createCloneAdmissionReview(vmClone)
+			ar.Request.UserInfo = authenticationv1.UserInfo{Username: "user", Groups: []string{"group"}}
+			return admitter.Admit(ar)
+		}
+
+		expectSubjectAccessReview := func(group, resource string, allowed bool) {
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1())
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				Expect(sar.Spec.User).To(Equal("user"))
+				Expect(sar.Spec.Groups).To(ConsistOf("group"))
+				Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("target-ns"))
+				Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("create"))
+				Expect(sar.Spec.ResourceAttributes.Group).To(Equal(group))
+				Expect(sar.Spec.ResourceAttributes.Resource).To(Equal(resource))
+				sar.Status.Allowed = allowed
+				return true, sar, nil
+			})
+		}
+
+		DescribeTable("should check the user can create virtual machines in the target namespace", func(allowed bool) {
+			expectSubjectAccessReview(core.GroupName, "virtualmachines", allowed)
+
+			resp := admit()
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+			}
+		},
+			Entry("allowed", true),
+			Entry("denied", false),
+		)
+
+		It("should check the user can create DataVolumes in the target namespace with a volume target", func() {
+			cdiClient := cdifake.NewSimpleClientset(&cdiv1.DataSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "clone-source-datasource",
+					Namespace: util.NamespaceTestDefault,
+				},
+			})
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+			vmClone.Spec.Source = &k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.String(cdiv1.SchemeGroupVersion.Group),
+				Kind:     "DataSource",
+				Name:     "clone-source-datasource",
+			}
+			vmClone.Spec.Target = &k8sv1.TypedLocalObjectReference{
+				Kind: "PersistentVolumeClaim",
+				Name: "clone-target-pvc",
+			}
+			expectSubjectAccessReview(cdiv1.SchemeGroupVersion.Group, "datavolumes", true)
+
+			Expect(admit().Allowed).To(BeTrue())
+		})
+
+		It("should not check access when the target namespace is the clone namespace", func() {
+			vmClone.Spec.TargetNamespace = pointer.String(vmClone.Namespace)
+
+			Expect(admit().Allowed).To(BeTrue())
+		})
+	})
+
 	Context("Annotations and labels filters", func() {
 		testFilter := func(filter string, expectAllowed bool) {
 			vmClone.Spec.LabelFilters = []string{filter}
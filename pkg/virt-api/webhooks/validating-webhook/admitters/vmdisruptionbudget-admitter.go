@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+// VMDisruptionBudgetAdmitter validates VirtualMachineDisruptionBudgets
+type VMDisruptionBudgetAdmitter struct{}
+
+// Admit validates an AdmissionReview
+func (admitter *VMDisruptionBudgetAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	gvr := webhooks.VirtualMachineDisruptionBudgetGroupVersionResource
+	if ar.Request.Resource.Group != gvr.Group || ar.Request.Resource.Resource != gvr.Resource {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	gvk := schema.GroupVersionKind{
+		Group:   gvr.Group,
+		Version: gvr.Version,
+		Kind:    poolv1.VirtualMachineDisruptionBudgetKind,
+	}
+	if resp := webhookutils.ValidateSchema(gvk, ar.Request.Object.Raw); resp != nil {
+		return resp
+	}
+
+	budget := &poolv1.VirtualMachineDisruptionBudget{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, budget); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	causes := ValidateVMDisruptionBudgetSpec(k8sfield.NewPath("spec"), &budget.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func ValidateVMDisruptionBudgetSpec(field *k8sfield.Path, spec *poolv1.VirtualMachineDisruptionBudgetSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch {
+	case spec.Selector == nil && spec.VirtualMachinePoolName == "":
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "either a selector or a virtualMachinePoolName is required.",
+			Field:   field.Child("selector").String(),
+		})
+	case spec.Selector != nil && spec.VirtualMachinePoolName != "":
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "selector and virtualMachinePoolName are mutually exclusive.",
+			Field:   field.Child("selector").String(),
+		})
+	case spec.Selector != nil:
+		if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("selector").String(),
+			})
+		}
+	}
+
+	if spec.MinAvailable != nil && spec.MaxUnavailable != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "minAvailable and maxUnavailable are mutually exclusive.",
+			Field:   field.Child("minAvailable").String(),
+		})
+	}
+
+	_, minAvailableCauses := validatePoolIntOrPercent(field.Child("minAvailable"), spec.MinAvailable, 0)
+	causes = append(causes, minAvailableCauses...)
+	_, maxUnavailableCauses := validatePoolIntOrPercent(field.Child("maxUnavailable"), spec.MaxUnavailable, 1)
+	causes = append(causes, maxUnavailableCauses...)
+
+	return causes
+}
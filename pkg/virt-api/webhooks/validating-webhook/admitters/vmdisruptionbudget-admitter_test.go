@@ -0,0 +1,114 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+)
+
+var _ = Describe("Validating VirtualMachineDisruptionBudget Admitter", func() {
+	admitter := &VMDisruptionBudgetAdmitter{}
+
+	newBudget := func() *poolv1.VirtualMachineDisruptionBudget {
+		maxUnavailable := intstr.FromInt(1)
+		return &poolv1.VirtualMachineDisruptionBudget{
+			Spec: poolv1.VirtualMachineDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "etcd"},
+				},
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+	}
+
+	admit := func(budget *poolv1.VirtualMachineDisruptionBudget) *admissionv1.AdmissionResponse {
+		bytes, err := json.Marshal(budget)
+		Expect(err).ToNot(HaveOccurred())
+		return admitter.Admit(&admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Namespace: "default",
+				Resource:  webhooks.VirtualMachineDisruptionBudgetGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		})
+	}
+
+	It("should accept a budget with a selector", func() {
+		Expect(admit(newBudget()).Allowed).To(BeTrue())
+	})
+
+	It("should accept a budget for a pool with a percentage", func() {
+		budget := newBudget()
+		budget.Spec.Selector = nil
+		budget.Spec.VirtualMachinePoolName = "etcd"
+		minAvailable := intstr.FromString("50%")
+		budget.Spec.MaxUnavailable = nil
+		budget.Spec.MinAvailable = &minAvailable
+
+		Expect(admit(budget).Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject", func(mutate func(*poolv1.VirtualMachineDisruptionBudget), field string) {
+		budget := newBudget()
+		mutate(budget)
+
+		resp := admit(budget)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("a budget without selector and pool", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.Selector = nil
+		}, "spec.selector"),
+		Entry("a budget with selector and pool", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.VirtualMachinePoolName = "etcd"
+		}, "spec.selector"),
+		Entry("an invalid selector", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			budget.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Unknown"}}
+		}, "spec.selector"),
+		Entry("minAvailable along maxUnavailable", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			minAvailable := intstr.FromInt(2)
+			budget.Spec.MinAvailable = &minAvailable
+		}, "spec.minAvailable"),
+		Entry("a negative maxUnavailable", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			maxUnavailable := intstr.FromInt(-1)
+			budget.Spec.MaxUnavailable = &maxUnavailable
+		}, "spec.maxUnavailable"),
+		Entry("an invalid percentage", func(budget *poolv1.VirtualMachineDisruptionBudget) {
+			maxUnavailable := intstr.FromString("one")
+			budget.Spec.MaxUnavailable = &maxUnavailable
+		}, "spec.maxUnavailable"),
+	)
+})
@@ -97,6 +97,7 @@ func (admitter *VMExportAdmitter) Admit(ar *admissionv1.AdmissionReview) *admiss
 				},
 			}
 		}
+		causes = append(causes, admitter.validateBaseSnapshot(k8sfield.NewPath("spec", "baseSnapshot"), vmExport)...)
 
 	case admissionv1.Update:
 		prevObj := &exportv1.VirtualMachineExport{}
@@ -156,6 +157,32 @@ func (admitter *VMExportAdmitter) validatePVCApiGroup(field *k8sfield.Path, apig
 	return []metav1.StatusCause{}
 }
 
+func (admitter *VMExportAdmitter) validateBaseSnapshot(field *k8sfield.Path, vmExport *exportv1.VirtualMachineExport) []metav1.StatusCause {
+	if vmExport.Spec.BaseSnapshot == nil {
+		return []metav1.StatusCause{}
+	}
+	if vmExport.Spec.Source.Kind != vmSnapshotKind {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Base snapshot is only allowed with a VMSnapshot source",
+				Field:   field.String(),
+			},
+		}
+	}
+	if *vmExport.Spec.BaseSnapshot == "" || *vmExport.Spec.BaseSnapshot == vmExport.Spec.Source.Name {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "Base snapshot must not be empty or the source VMSnapshot",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
+
 func (admitter *VMExportAdmitter) validateVMSnapshotName(field *k8sfield.Path, name string) []metav1.StatusCause {
 	if name == "" {
 		return []metav1.StatusCause{
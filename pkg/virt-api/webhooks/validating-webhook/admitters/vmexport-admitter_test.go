@@ -237,6 +237,36 @@ var _ = Describe("Validating VirtualMachineExport Admitter", func() {
 			Entry("virtual machine", kubevirtApiGroup, vmKind),
 		)
 
+		DescribeTable("it should validate the base snapshot", func(kind, baseSnapshot string, allowed bool) {
+			apiGroup := snapshotApiGroup
+			if kind == pvc {
+				apiGroup = ""
+			}
+			export := &exportv1.VirtualMachineExport{
+				Spec: exportv1.VirtualMachineExportSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     kind,
+						Name:     "test",
+					},
+					BaseSnapshot: &baseSnapshot,
+				},
+			}
+
+			ar := createExportAdmissionReview(export)
+			resp := createTestVMExportAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.baseSnapshot"))
+			}
+		},
+			Entry("with an older snapshot", vmSnapshotKind, "base", true),
+			Entry("with the source snapshot", vmSnapshotKind, "test", false),
+			Entry("with an empty name", vmSnapshotKind, "", false),
+			Entry("with a persistent volume claim source", pvc, "base", false),
+		)
+
 		DescribeTable("it should reject invalid apigroups", func(apiGroup, kind string) {
 			export := &exportv1.VirtualMachineExport{
 				Spec: exportv1.VirtualMachineExportSpec{
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMGroupRestoreAdmitter validates VirtualMachineGroupRestores
+type VMGroupRestoreAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMGroupRestoreAdmitter creates a VMGroupRestoreAdmitter
+func NewVMGroupRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMGroupRestoreAdmitter {
+	return &VMGroupRestoreAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMGroupRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinegrouprestores" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Snapshot/Restore feature gate not enabled"))
+	}
+
+	groupRestore := &snapshotv1.VirtualMachineGroupRestore{}
+	err := json.Unmarshal(ar.Request.Object.Raw, groupRestore)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case admissionv1.Create:
+		causes, err = admitter.validateGroupSnapshot(k8sfield.NewPath("spec", "virtualMachineGroupSnapshotName"), ar.Request.Namespace, groupRestore)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+	case admissionv1.Update:
+		prevObj := &snapshotv1.VirtualMachineGroupRestore{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !equality.Semantic.DeepEqual(prevObj.Spec, groupRestore.Spec) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "spec in immutable after creation",
+				Field:   k8sfield.NewPath("spec").String(),
+			})
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := admissionv1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+// validateGroupSnapshot checks that the group snapshot can be restored, and that the VMs
+// of the group which still exist are stopped
+func (admitter *VMGroupRestoreAdmitter) validateGroupSnapshot(field *k8sfield.Path, namespace string, groupRestore *snapshotv1.VirtualMachineGroupRestore) ([]metav1.StatusCause, error) {
+	name := groupRestore.Spec.VirtualMachineGroupSnapshotName
+	groupSnapshot, err := admitter.Client.VirtualMachineGroupSnapshot(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineGroupSnapshot %q does not exist", name),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if groupSnapshot.Status != nil && groupSnapshot.Status.Phase == snapshotv1.Failed {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineGroupSnapshot %q has failed and is invalid to use", name),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	if groupSnapshot.Status == nil || groupSnapshot.Status.ReadyToUse == nil || !*groupSnapshot.Status.ReadyToUse {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineGroupSnapshot %q is not ready to use", name),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	var causes []metav1.StatusCause
+	for _, member := range groupSnapshot.Status.VirtualMachineSnapshots {
+		vm, err := admitter.Client.VirtualMachine(namespace).Get(context.Background(), member.VirtualMachineName, &metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			// the VM is created again by its restore
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		rs, err := vm.RunStrategy()
+		if err != nil {
+			return nil, err
+		}
+
+		if rs != v1.RunStrategyHalted {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachine %q is not stopped", member.VirtualMachineName),
+				Field:   field.String(),
+			})
+		}
+	}
+
+	return causes, nil
+}
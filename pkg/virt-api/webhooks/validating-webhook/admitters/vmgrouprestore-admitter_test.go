@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineGroupRestore Admitter", func() {
+	const groupSnapshotName = "group"
+
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newGroupRestore := func() *snapshotv1.VirtualMachineGroupRestore {
+		return &snapshotv1.VirtualMachineGroupRestore{
+			Spec: snapshotv1.VirtualMachineGroupRestoreSpec{
+				VirtualMachineGroupSnapshotName: groupSnapshotName,
+			},
+		}
+	}
+
+	newGroupSnapshot := func(phase snapshotv1.VirtualMachineSnapshotPhase, vmNames ...string) *snapshotv1.VirtualMachineGroupSnapshot {
+		groupSnapshot := &snapshotv1.VirtualMachineGroupSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      groupSnapshotName,
+				Namespace: "default",
+			},
+			Status: &snapshotv1.VirtualMachineGroupSnapshotStatus{
+				Phase:      phase,
+				ReadyToUse: pointer.Bool(phase == snapshotv1.Succeeded),
+			},
+		}
+		for _, vmName := range vmNames {
+			groupSnapshot.Status.VirtualMachineSnapshots = append(groupSnapshot.Status.VirtualMachineSnapshots, snapshotv1.GroupSnapshotMember{
+				VirtualMachineName:         vmName,
+				VirtualMachineSnapshotName: groupSnapshotName + "-" + vmName,
+			})
+		}
+		return groupSnapshot
+	}
+
+	newVM := func(name string, runStrategy v1.VirtualMachineRunStrategy) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: &runStrategy,
+			},
+		}
+	}
+
+	createGroupRestoreAdmissionReview := func(operation admissionv1.Operation, groupRestore, oldGroupRestore *snapshotv1.VirtualMachineGroupRestore) *admissionv1.AdmissionReview {
+		bytes, _ := json.Marshal(groupRestore)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: operation,
+				Namespace: "default",
+				Resource: metav1.GroupVersionResource{
+					Group:    "snapshot.kubevirt.io",
+					Resource: "virtualmachinegrouprestores",
+				},
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		}
+		if oldGroupRestore != nil {
+			oldBytes, _ := json.Marshal(oldGroupRestore)
+			ar.Request.OldObject = runtime.RawExtension{
+				Raw: oldBytes,
+			}
+		}
+		return ar
+	}
+
+	It("should reject anything without the feature gate enabled", func() {
+		admitter := createTestVMGroupRestoreAdmitter(config)
+		resp := admitter.Admit(createGroupRestoreAdmissionReview(admissionv1.Create, newGroupRestore(), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("Snapshot/Restore feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should accept a ready group snapshot of stopped or deleted VMs", func() {
+			admitter := createTestVMGroupRestoreAdmitter(config,
+				newGroupSnapshot(snapshotv1.Succeeded, "db-0", "db-1"), newVM("db-0", v1.RunStrategyHalted))
+			resp := admitter.Admit(createGroupRestoreAdmissionReview(admissionv1.Create, newGroupRestore(), nil))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		DescribeTable("should reject", func(message string, objs ...runtime.Object) {
+			admitter := createTestVMGroupRestoreAdmitter(config, objs...)
+			resp := admitter.Admit(createGroupRestoreAdmissionReview(admissionv1.Create, newGroupRestore(), nil))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineGroupSnapshotName"))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(message))
+		},
+			Entry("a missing group snapshot", `VirtualMachineGroupSnapshot "group" does not exist`),
+			Entry("a failed group snapshot", `VirtualMachineGroupSnapshot "group" has failed and is invalid to use`,
+				newGroupSnapshot(snapshotv1.Failed, "db-0")),
+			Entry("a group snapshot not ready to use", `VirtualMachineGroupSnapshot "group" is not ready to use`,
+				newGroupSnapshot(snapshotv1.InProgress, "db-0")),
+			Entry("a running VM of the group", `VirtualMachine "db-1" is not stopped`,
+				newGroupSnapshot(snapshotv1.Succeeded, "db-0", "db-1"), newVM("db-0", v1.RunStrategyHalted), newVM("db-1", v1.RunStrategyAlways)),
+		)
+
+		It("should accept an update of the status", func() {
+			oldGroupRestore := newGroupRestore()
+			groupRestore := newGroupRestore()
+			groupRestore.Status = &snapshotv1.VirtualMachineGroupRestoreStatus{
+				Complete: pointer.Bool(false),
+			}
+			admitter := createTestVMGroupRestoreAdmitter(config)
+			resp := admitter.Admit(createGroupRestoreAdmissionReview(admissionv1.Update, groupRestore, oldGroupRestore))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject an update of the spec", func() {
+			oldGroupRestore := newGroupRestore()
+			groupRestore := newGroupRestore()
+			groupRestore.Spec.VirtualMachineGroupSnapshotName = "other"
+			admitter := createTestVMGroupRestoreAdmitter(config)
+			resp := admitter.Admit(createGroupRestoreAdmissionReview(admissionv1.Update, groupRestore, oldGroupRestore))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createTestVMGroupRestoreAdmitter(config *virtconfig.ClusterConfig, objs ...runtime.Object) *VMGroupRestoreAdmitter {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+
+	var snapshotObjs []runtime.Object
+	vms := map[string]*v1.VirtualMachine{}
+	for _, obj := range objs {
+		if vm, ok := obj.(*v1.VirtualMachine); ok {
+			vms[vm.Name] = vm
+			continue
+		}
+		snapshotObjs = append(snapshotObjs, obj)
+	}
+	kubevirtClient := kubevirtfake.NewSimpleClientset(snapshotObjs...)
+
+	virtClient.EXPECT().VirtualMachineGroupSnapshot("default").
+		Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineGroupSnapshots("default")).AnyTimes()
+	virtClient.EXPECT().VirtualMachine("default").Return(vmInterface).AnyTimes()
+
+	vmInterface.EXPECT().Get(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, name string, getOptions *metav1.GetOptions) (*v1.VirtualMachine, error) {
+		if vm, ok := vms[name]; ok {
+			return vm, nil
+		}
+
+		return nil, errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, name)
+	}).AnyTimes()
+
+	return NewVMGroupRestoreAdmitter(config, virtClient)
+}
@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VirtualMachineGroupSnapshot Admitter", func() {
+	config, _, kvInformer := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+
+	newGroupSnapshot := func() *snapshotv1.VirtualMachineGroupSnapshot {
+		return &snapshotv1.VirtualMachineGroupSnapshot{
+			Spec: snapshotv1.VirtualMachineGroupSnapshotSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "db"},
+				},
+			},
+		}
+	}
+
+	createGroupSnapshotAdmissionReview := func(operation admissionv1.Operation, groupSnapshot, oldGroupSnapshot *snapshotv1.VirtualMachineGroupSnapshot) *admissionv1.AdmissionReview {
+		bytes, _ := json.Marshal(groupSnapshot)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: operation,
+				Namespace: "foo",
+				Resource: metav1.GroupVersionResource{
+					Group:    "snapshot.kubevirt.io",
+					Resource: "virtualmachinegroupsnapshots",
+				},
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		}
+		if oldGroupSnapshot != nil {
+			oldBytes, _ := json.Marshal(oldGroupSnapshot)
+			ar.Request.OldObject = runtime.RawExtension{
+				Raw: oldBytes,
+			}
+		}
+		return ar
+	}
+
+	It("should reject anything without the feature gate enabled", func() {
+		resp := NewVMGroupSnapshotAdmitter(config).Admit(createGroupSnapshotAdmissionReview(admissionv1.Create, newGroupSnapshot(), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(Equal("snapshot feature gate not enabled"))
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{"Snapshot"},
+						},
+					},
+				},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{})
+		})
+
+		It("should accept a valid group snapshot", func() {
+			resp := NewVMGroupSnapshotAdmitter(config).Admit(createGroupSnapshotAdmissionReview(admissionv1.Create, newGroupSnapshot(), nil))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject an invalid selector", func() {
+			groupSnapshot := newGroupSnapshot()
+			groupSnapshot.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Bad"}}
+			resp := NewVMGroupSnapshotAdmitter(config).Admit(createGroupSnapshotAdmissionReview(admissionv1.Create, groupSnapshot, nil))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.selector"))
+		})
+
+		It("should accept an update of the status", func() {
+			oldGroupSnapshot := newGroupSnapshot()
+			groupSnapshot := newGroupSnapshot()
+			groupSnapshot.Status = &snapshotv1.VirtualMachineGroupSnapshotStatus{
+				Phase: snapshotv1.InProgress,
+			}
+			resp := NewVMGroupSnapshotAdmitter(config).Admit(createGroupSnapshotAdmissionReview(admissionv1.Update, groupSnapshot, oldGroupSnapshot))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject an update of the spec", func() {
+			oldGroupSnapshot := newGroupSnapshot()
+			groupSnapshot := newGroupSnapshot()
+			groupSnapshot.Spec.Selector.MatchLabels["app"] = "web"
+			resp := NewVMGroupSnapshotAdmitter(config).Admit(createGroupSnapshotAdmissionReview(admissionv1.Update, groupSnapshot, oldGroupSnapshot))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
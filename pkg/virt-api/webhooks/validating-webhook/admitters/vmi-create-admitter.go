@@ -198,6 +198,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateNetworksAssignedToInterfaces(field, spec, networkInterfaceMap)...)
 	causes = append(causes, validateInterfaceStateValue(field, spec)...)
 	causes = append(causes, validateInterfaceBinding(field, spec)...)
+	causes = append(causes, validateVhostUserInterfaces(field, spec)...)
 
 	causes = append(causes, validateInputDevices(field, spec)...)
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
@@ -341,6 +342,10 @@ func validateInterfaceNetworkBasics(field *k8sfield.Path, networkExists bool, id
 		causes = appendStatusCauseForPasstWithoutPodNetwork(field, causes, idx)
 	} else if iface.Passt != nil && numOfInterfaces > 1 {
 		causes = appendStatusCauseForPasstWithMultipleInterfaces(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.VhostUser != nil && !config.VhostUserEnabled() {
+		causes = appendStatusCauseForVhostUserFeatureGateNotEnabled(field, causes, idx)
+	} else if iface.InterfaceBindingMethod.VhostUser != nil && networkData.NetworkSource.Multus == nil {
+		causes = appendStatusCauseForVhostUserOnlyAllowedWithMultus(field, causes, idx)
 	} else if iface.Binding != nil && !config.NetworkBindingPlugingsEnabled() {
 		causes = appendStatusCauseForBindingPluginsFeatureGateNotEnabled(field, causes, idx)
 	}
@@ -641,6 +646,22 @@ func appendStatusCauseForPasstWithMultipleInterfaces(field *k8sfield.Path, cause
 	})
 }
 
+func appendStatusCauseForVhostUserFeatureGateNotEnabled(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	return append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "VhostUser feature gate is not enabled",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+	})
+}
+
+func appendStatusCauseForVhostUserOnlyAllowedWithMultus(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
+	return append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: "VhostUser interface only implemented with Multus network",
+		Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(),
+	})
+}
+
 func appendStatusCauseForBindingPluginsFeatureGateNotEnabled(field *k8sfield.Path, causes []metav1.StatusCause, idx int) []metav1.StatusCause {
 	causes = append(causes, metav1.StatusCause{
 		Type:    metav1.CauseTypeFieldValueInvalid,
@@ -1798,6 +1798,50 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
 			Expect(causes).To(BeEmpty())
 		})
+		Context("with a vhost-user interface", func() {
+			var vm *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vm = api.NewMinimalVMI("testvm")
+				vm.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = resource.MustParse("64Mi")
+				vm.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+				vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name: "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						VhostUser: &v1.InterfaceVhostUser{},
+					},
+				}}
+				vm.Spec.Networks = []v1.Network{
+					{
+						Name:          "default",
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "ovs-dpdk"}},
+					},
+				}
+			})
+
+			It("should reject it when the feature is inactive", func() {
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].name"))
+				Expect(causes[0].Message).To(Equal("VhostUser feature gate is not enabled"))
+			})
+
+			It("should reject it on a network different than multus", func() {
+				vm.Spec.Networks[0].NetworkSource = v1.NetworkSource{Pod: &v1.PodNetwork{}}
+
+				enableFeatureGate(virtconfig.VhostUserGate)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].name"))
+				Expect(causes[0].Message).To(Equal("VhostUser interface only implemented with Multus network"))
+			})
+
+			It("should accept it on a multus network when the feature is active", func() {
+				enableFeatureGate(virtconfig.VhostUserGate)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
+		})
 		It("should reject port out of range", func() {
 			enableSlirpInterface()
 			vm := api.NewMinimalVMI("testvm")
@@ -32,6 +32,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -107,6 +108,11 @@ func admitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 	oldDiskMap := getDiskMap(oldDisks)
 
 	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap)
+	if permanentAr != nil && migrations.IsStorageMigrationCompleted(newVMI) {
+		// Permanent volumes are allowed to switch over to the claims they were migrated to
+		migratedPermanentVolumeMap := getPermanentVolumes(migrations.ApplyMigratedVolumes(oldVolumes, newVMI.Status.MigrationState.MigratedVolumes), volumeStatuses)
+		permanentAr = verifyPermanentVolumes(newPermanentVolumeMap, migratedPermanentVolumeMap, newDiskMap, oldDiskMap)
+	}
 	if permanentAr != nil {
 		return permanentAr
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/onsi/gomega/types"
 	admissionv1 "k8s.io/api/admission/v1"
 	authv1 "k8s.io/api/authentication/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -598,6 +599,43 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeExpected("number of disks (1) does not equal the number of volumes (2)", "")),
 	)
 
+	DescribeTable("should admit switching over permanent volumes after a storage migration", func(migrationState *v1.VirtualMachineInstanceMigrationState, expectAllowed bool) {
+		oldVolumes := makeVolumes(0, 1)
+		newVolumes := makeVolumes(0, 1)
+		newVolumes[0].VolumeSource = v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "dst"},
+			},
+		}
+		newVMI := api.NewMinimalVMI("testvmi")
+		newVMI.Spec.Volumes = newVolumes
+		newVMI.Spec.Domain.Devices.Disks = makeDisks(0, 1)
+		newVMI.Status.MigrationState = migrationState
+
+		result := admitHotplugStorage(newVolumes, oldVolumes, makeDisks(0, 1), makeDisks(0, 1), makeStatus(2, 0), newVMI, vmiUpdateAdmitter.ClusterConfig)
+		if expectAllowed {
+			Expect(result).To(BeNil())
+		} else {
+			Expect(result).ToNot(BeNil())
+			Expect(result.Allowed).To(BeFalse())
+		}
+	},
+		Entry("accept after a completed storage migration", &v1.VirtualMachineInstanceMigrationState{
+			Completed:       true,
+			MigratedVolumes: []v1.StorageMigratedVolume{{VolumeName: "volume-name-0", DestinationClaim: "dst"}},
+		}, true),
+		Entry("reject after a failed storage migration", &v1.VirtualMachineInstanceMigrationState{
+			Completed:       true,
+			Failed:          true,
+			MigratedVolumes: []v1.StorageMigratedVolume{{VolumeName: "volume-name-0", DestinationClaim: "dst"}},
+		}, false),
+		Entry("reject a claim other than the migration destination", &v1.VirtualMachineInstanceMigrationState{
+			Completed:       true,
+			MigratedVolumes: []v1.StorageMigratedVolume{{VolumeName: "volume-name-0", DestinationClaim: "other"}},
+		}, false),
+		Entry("reject without a storage migration", nil, false),
+	)
+
 	DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.CPU = &v1.CPU{}
@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
@@ -116,6 +117,21 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 		})
 	}
 
+	switch spec.ScaleInPolicy {
+	case "", poolv1.VirtualMachinePoolScaleInRandom, poolv1.VirtualMachinePoolScaleInOldestFirst,
+		poolv1.VirtualMachinePoolScaleInNewestFirst, poolv1.VirtualMachinePoolScaleInNotReadyFirst,
+		poolv1.VirtualMachinePoolScaleInLeastLoaded:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("scale-in policy %s is not supported", spec.ScaleInPolicy),
+			Field:   field.Child("scaleInPolicy").String(),
+		})
+	}
+
+	causes = append(causes, validatePoolDataVolumeTemplates(field, spec)...)
+	causes = append(causes, validatePoolUpdateStrategy(field, spec)...)
+
 	if ar.Request.Operation == admissionv1.Update {
 		oldPool := &poolv1.VirtualMachinePool{}
 		if err := json.Unmarshal(ar.Request.OldObject.Raw, oldPool); err != nil {
@@ -135,3 +151,109 @@ func ValidateVMPoolSpec(ar *admissionv1.AdmissionReview, field *k8sfield.Path, p
 	}
 	return causes
 }
+
+func validatePoolDataVolumeTemplates(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	names := map[string]struct{}{}
+	for _, template := range spec.VirtualMachineTemplate.Spec.DataVolumeTemplates {
+		names[template.Name] = struct{}{}
+	}
+
+	for i, template := range spec.DataVolumeTemplates {
+		if template.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "DataVolumeTemplate name is required.",
+				Field:   field.Child("dataVolumeTemplates").Index(i).Child("name").String(),
+			})
+			continue
+		}
+		if _, exists := names[template.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("DataVolumeTemplate name %s is used more than once.", template.Name),
+				Field:   field.Child("dataVolumeTemplates").Index(i).Child("name").String(),
+			})
+		}
+		names[template.Name] = struct{}{}
+	}
+
+	if policy := spec.PersistentVolumeClaimRetentionPolicy; policy != nil {
+		policyField := field.Child("persistentVolumeClaimRetentionPolicy")
+		causes = append(causes, validatePoolRetentionPolicyType(policyField.Child("whenDeleted"), policy.WhenDeleted)...)
+		causes = append(causes, validatePoolRetentionPolicyType(policyField.Child("whenScaled"), policy.WhenScaled)...)
+	}
+
+	return causes
+}
+
+func validatePoolRetentionPolicyType(field *k8sfield.Path, policyType poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType) []metav1.StatusCause {
+	switch policyType {
+	case "", poolv1.RetainPersistentVolumeClaimRetentionPolicyType, poolv1.DeletePersistentVolumeClaimRetentionPolicyType:
+		return nil
+	}
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueNotSupported,
+		Message: fmt.Sprintf("retention policy %s is not supported", policyType),
+		Field:   field.String(),
+	}}
+}
+
+func validatePoolUpdateStrategy(field *k8sfield.Path, spec *poolv1.VirtualMachinePoolSpec) []metav1.StatusCause {
+	if spec.UpdateStrategy == nil || spec.UpdateStrategy.RollingUpdate == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	rollingUpdate := spec.UpdateStrategy.RollingUpdate
+	rollingUpdateField := field.Child("updateStrategy", "rollingUpdate")
+
+	maxUnavailable, unavailableCauses := validatePoolIntOrPercent(rollingUpdateField.Child("maxUnavailable"), rollingUpdate.MaxUnavailable, 1)
+	causes = append(causes, unavailableCauses...)
+	maxSurge, surgeCauses := validatePoolIntOrPercent(rollingUpdateField.Child("maxSurge"), rollingUpdate.MaxSurge, 0)
+	causes = append(causes, surgeCauses...)
+
+	if len(causes) == 0 && maxUnavailable == 0 && maxSurge == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxUnavailable and maxSurge must not both be 0.",
+			Field:   rollingUpdateField.Child("maxUnavailable").String(),
+		})
+	}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("partition %d must not be negative.", *rollingUpdate.Partition),
+			Field:   rollingUpdateField.Child("partition").String(),
+		})
+	}
+
+	return causes
+}
+
+// validatePoolIntOrPercent returns the value scaled to a percentage, so a zero value can be detected
+// independent of the number of replicas.
+func validatePoolIntOrPercent(field *k8sfield.Path, value *intstr.IntOrString, defaultValue int) (int, []metav1.StatusCause) {
+	if value == nil {
+		return defaultValue, nil
+	}
+
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+	if scaled < 0 {
+		return 0, []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be negative.", value.String()),
+			Field:   field.String(),
+		}}
+	}
+	return scaled, nil
+}
@@ -27,11 +27,13 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
@@ -130,6 +132,110 @@ var _ = Describe("Validating Pool Admitter", func() {
 			"spec.virtualMachineTemplate.spec.running",
 			"spec.selector",
 		}),
+		Entry("with unsupported scale-in policy", &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				ScaleInPolicy: "Unknown",
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+			},
+		}, []string{
+			"spec.scaleInPolicy",
+		}),
+		Entry("with duplicate DataVolumeTemplate names and unsupported retention policy", &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "data"}},
+					{},
+				},
+				PersistentVolumeClaimRetentionPolicy: &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: poolv1.DeletePersistentVolumeClaimRetentionPolicyType,
+					WhenScaled:  "Unknown",
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+			},
+		}, []string{
+			"spec.dataVolumeTemplates[1].name",
+			"spec.dataVolumeTemplates[2].name",
+			"spec.persistentVolumeClaimRetentionPolicy.whenScaled",
+		}),
+		Entry("with invalid rolling update", &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"match": "me"},
+				},
+				UpdateStrategy: &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{
+						MaxUnavailable: pointer.P(intstr.FromString("0%")),
+						MaxSurge:       pointer.P(intstr.FromInt(0)),
+						Partition:      pointer.P(int32(-1)),
+					},
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"match": "me"},
+					},
+					Spec: v1.VirtualMachineSpec{
+						RunStrategy: &always,
+						Template: newVirtualMachineBuilder().
+							WithDisk(v1.Disk{
+								Name: "testdisk",
+							}).
+							WithVolume(v1.Volume{
+								Name: "testdisk",
+								VolumeSource: v1.VolumeSource{
+									ContainerDisk: testutils.NewFakeContainerDiskSource(),
+								},
+							}).
+							BuildTemplate(),
+					},
+				},
+			},
+		}, []string{
+			"spec.updateStrategy.rollingUpdate.maxUnavailable",
+			"spec.updateStrategy.rollingUpdate.partition",
+		}),
 	)
 	It("should accept valid vm spec", func() {
 		pool := &poolv1.VirtualMachinePool{
@@ -137,6 +243,7 @@ var _ = Describe("Validating Pool Admitter", func() {
 				Selector: &metav1.LabelSelector{
 					MatchLabels: map[string]string{"match": "me"},
 				},
+				ScaleInPolicy: poolv1.VirtualMachinePoolScaleInLeastLoaded,
 
 				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
 					ObjectMeta: metav1.ObjectMeta{
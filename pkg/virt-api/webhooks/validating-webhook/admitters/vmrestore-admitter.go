@@ -26,10 +26,13 @@ import (
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
@@ -85,6 +88,11 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 		var targetUID *types.UID
 		targetField := k8sfield.NewPath("spec", "target")
 
+		// the namespace may not be set yet on the object, it's needed to resolve the target namespace
+		if vmRestore.Namespace == "" {
+			vmRestore.Namespace = ar.Request.Namespace
+		}
+
 		if vmRestore.Spec.Target.APIGroup == nil {
 			causes = []metav1.StatusCause{
 				{
@@ -125,7 +133,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 		snapshotCauses, err := admitter.validateSnapshot(
 			k8sfield.NewPath("spec", "virtualMachineSnapshotName"),
 			ar.Request.Namespace,
-			vmRestore.Spec.VirtualMachineSnapshotName,
+			vmRestore,
 			targetUID,
 			targetVMExists,
 		)
@@ -133,6 +141,16 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 			return webhookutils.ToAdmissionResponseError(err)
 		}
 
+		causes = append(causes, validateRestoreModeAndVolumes(k8sfield.NewPath("spec"), vmRestore, targetVMExists)...)
+
+		if getTargetNamespace(vmRestore) != ar.Request.Namespace {
+			namespaceCauses, err := admitter.validateTargetNamespace(k8sfield.NewPath("spec", "targetNamespace"), ar.Request.UserInfo, vmRestore, targetVMExists)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+			causes = append(causes, namespaceCauses...)
+		}
+
 		objects, err := admitter.VMRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ar.Request.Namespace)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
@@ -141,6 +159,7 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 		for _, obj := range objects {
 			r := obj.(*snapshotv1.VirtualMachineRestore)
 			if equality.Semantic.DeepEqual(r.Spec.Target, vmRestore.Spec.Target) &&
+				getTargetNamespace(r) == getTargetNamespace(vmRestore) &&
 				(r.Status == nil || r.Status.Complete == nil || !*r.Status.Complete) {
 				cause := metav1.StatusCause{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -185,10 +204,15 @@ func (admitter *VMRestoreAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 
 func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore) (causes []metav1.StatusCause, uid *types.UID, targetVMExists bool, err error) {
 	vmName := vmRestore.Spec.Target.Name
-	namespace := vmRestore.Namespace
+	namespace := getTargetNamespace(vmRestore)
 
 	causes = admitter.validatePatches(vmRestore.Spec.Patches, field.Child("patches"))
 
+	if getRestoreMode(vmRestore) == snapshotv1.VirtualMachineRestoreModeVolumesOnly {
+		// only standalone PVCs are restored, the VM isn't involved
+		return causes, nil, false, nil
+	}
+
 	vm, err := admitter.Client.VirtualMachine(namespace).Get(context.Background(), vmName, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// If the target VM does not exist it would be automatically created by the restore controller
@@ -263,7 +287,8 @@ func (admitter *VMRestoreAdmitter) validatePatches(patches []string, field *k8sf
 	return causes
 }
 
-func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namespace, name string, targetUID *types.UID, targetVMExists bool) ([]metav1.StatusCause, error) {
+func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namespace string, vmRestore *snapshotv1.VirtualMachineRestore, targetUID *types.UID, targetVMExists bool) ([]metav1.StatusCause, error) {
+	name := vmRestore.Spec.VirtualMachineSnapshotName
 	snapshot, err := admitter.Client.VirtualMachineSnapshot(namespace).Get(context.Background(), name, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		return []metav1.StatusCause{
@@ -309,5 +334,135 @@ func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namesp
 		causes = append(causes, cause)
 	}
 
+	if snapshot.Status != nil && snapshot.Status.SnapshotVolumes != nil {
+		included := sets.NewString(snapshot.Status.SnapshotVolumes.IncludedVolumes...)
+		for i, volumeName := range vmRestore.Spec.Volumes {
+			if !included.Has(volumeName) {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("volume %q is not included in VirtualMachineSnapshot %q", volumeName, name),
+					Field:   k8sfield.NewPath("spec", "volumes").Index(i).String(),
+				})
+			}
+		}
+	}
+
 	return causes, nil
 }
+
+func validateRestoreModeAndVolumes(field *k8sfield.Path, vmRestore *snapshotv1.VirtualMachineRestore, targetVMExists bool) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	switch getRestoreMode(vmRestore) {
+	case snapshotv1.VirtualMachineRestoreModeVirtualMachine:
+		if len(vmRestore.Spec.Volumes) > 0 && !targetVMExists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "volumes can only be selected when restoring into an existing VirtualMachine or when restoring only volumes",
+				Field:   field.Child("volumes").String(),
+			})
+		}
+	case snapshotv1.VirtualMachineRestoreModeVolumesOnly:
+		if len(vmRestore.Spec.Patches) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "patches are not supported when restoring only volumes",
+				Field:   field.Child("patches").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("invalid mode %q", *vmRestore.Spec.Mode),
+			Field:   field.Child("mode").String(),
+		})
+	}
+
+	return causes
+}
+
+// validateTargetNamespace makes sure the requester is allowed to create the restored objects in the target namespace,
+// and to update the target VM when it is restored over an existing one
+func (admitter *VMRestoreAdmitter) validateTargetNamespace(field *k8sfield.Path, userInfo authenticationv1.UserInfo, vmRestore *snapshotv1.VirtualMachineRestore, targetVMExists bool) ([]metav1.StatusCause, error) {
+	targetNamespace := getTargetNamespace(vmRestore)
+	resourceAttributes := []*authv1.ResourceAttributes{
+		{
+			Namespace: targetNamespace,
+			Verb:      "create",
+			Group:     core.GroupName,
+			Resource:  "virtualmachines",
+		},
+	}
+	if getRestoreMode(vmRestore) == snapshotv1.VirtualMachineRestoreModeVolumesOnly {
+		resourceAttributes[0].Group = ""
+		resourceAttributes[0].Resource = "persistentvolumeclaims"
+	} else if targetVMExists {
+		resourceAttributes = append(resourceAttributes, &authv1.ResourceAttributes{
+			Namespace: targetNamespace,
+			Verb:      "update",
+			Group:     core.GroupName,
+			Resource:  "virtualmachines",
+			Name:      vmRestore.Spec.Target.Name,
+		})
+	}
+
+	for _, attributes := range resourceAttributes {
+		allowed, err := isUserAllowed(admitter.Client, userInfo, attributes)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allowed {
+			return []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("User %q is not allowed to %s %s in namespace %q", userInfo.Username, attributes.Verb, attributes.Resource, targetNamespace),
+					Field:   field.String(),
+				},
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isUserAllowed runs a SubjectAccessReview for the requesting user against the given resource attributes
+func isUserAllowed(client kubecli.KubevirtClient, userInfo authenticationv1.UserInfo, resourceAttributes *authv1.ResourceAttributes) (bool, error) {
+	extra := make(map[string]authv1.ExtraValue)
+	for k, v := range userInfo.Extra {
+		extra[k] = authv1.ExtraValue(v)
+	}
+
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			Extra:              extra,
+			UID:                userInfo.UID,
+			ResourceAttributes: resourceAttributes,
+		},
+	}
+
+	sar, err := client.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return sar.Status.Allowed, nil
+}
+
+func getRestoreMode(vmRestore *snapshotv1.VirtualMachineRestore) snapshotv1.VirtualMachineRestoreMode {
+	if vmRestore.Spec.Mode == nil {
+		return snapshotv1.VirtualMachineRestoreModeVirtualMachine
+	}
+
+	return *vmRestore.Spec.Mode
+}
+
+func getTargetNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.TargetNamespace == nil || *vmRestore.Spec.TargetNamespace == "" {
+		return vmRestore.Namespace
+	}
+
+	return *vmRestore.Spec.TargetNamespace
+}
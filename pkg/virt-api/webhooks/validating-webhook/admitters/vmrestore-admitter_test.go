@@ -29,12 +29,17 @@ import (
 	. "github.com/onsi/gomega"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
@@ -58,6 +63,7 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 	t := true
 	f := false
 	runStrategyManual := v1.RunStrategyManual
+	volumesOnlyMode := snapshotv1.VirtualMachineRestoreModeVolumesOnly
 
 	snapshot := &snapshotv1.VirtualMachineSnapshot{
 		ObjectMeta: metav1.ObjectMeta{
@@ -606,6 +612,167 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 				})
 			})
 
+			Context("when restoring volumes or into another namespace", func() {
+
+				var restore *snapshotv1.VirtualMachineRestore
+
+				BeforeEach(func() {
+					vm.Spec.Running = &f
+					restore = &snapshotv1.VirtualMachineRestore{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore",
+							Namespace: "default",
+						},
+						Spec: snapshotv1.VirtualMachineRestoreSpec{
+							Target: corev1.TypedLocalObjectReference{
+								APIGroup: &apiGroup,
+								Kind:     "VirtualMachine",
+								Name:     vmName,
+							},
+							VirtualMachineSnapshotName: vmSnapshotName,
+						},
+					}
+				})
+
+				snapshotWithVolumes := func() *snapshotv1.VirtualMachineSnapshot {
+					s := snapshot.DeepCopy()
+					s.Status.SnapshotVolumes = &snapshotv1.SnapshotVolumesLists{
+						IncludedVolumes: []string{"disk1", "disk2"},
+					}
+					return s
+				}
+
+				It("should allow selecting volumes of the snapshot when the VM exists", func() {
+					restore.Spec.Volumes = []string{"disk2"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes()).Admit(ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject selecting volumes which aren't in the snapshot", func() {
+					restore.Spec.Volumes = []string{"disk1", "disk3"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes()).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumes[1]"))
+				})
+
+				It("should reject selecting volumes when the VM does not exist", func() {
+					restore.Spec.Volumes = []string{"disk1"}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, nil, snapshotWithVolumes()).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumes"))
+				})
+
+				It("should allow restoring only volumes regardless of the VM", func() {
+					restore.Spec.Mode = &volumesOnlyMode
+					restore.Spec.Volumes = []string{"disk1"}
+					vm.Spec.Running = &t
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshotWithVolumes()).Admit(ar)
+					Expect(resp.Allowed).To(BeTrue())
+				})
+
+				It("should reject patches when restoring only volumes", func() {
+					restore.Spec.Mode = &volumesOnlyMode
+					restore.Spec.Patches = []string{`{"op": "replace", "path": "/spec/running", "value": "false"}`}
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.patches"))
+				})
+
+				It("should reject an invalid mode", func() {
+					invalidMode := snapshotv1.VirtualMachineRestoreMode("Invalid")
+					restore.Spec.Mode = &invalidMode
+
+					ar := createRestoreAdmissionReview(restore)
+					resp := createTestVMRestoreAdmitter(config, vm, snapshot).Admit(ar)
+					Expect(resp.Allowed).To(BeFalse())
+					Expect(resp.Result.Details.Causes).To(HaveLen(1))
+					Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.mode"))
+				})
+
+				DescribeTable("should check the requester can create the restored objects in the target namespace", func(mode snapshotv1.VirtualMachineRestoreMode, group, resource string, allowed bool) {
+					restore.Spec.Mode = &mode
+					restore.Spec.TargetNamespace = pointer.String("target-ns")
+
+					ar := createRestoreAdmissionReview(restore)
+					ar.Request.UserInfo = authenticationv1.UserInfo{
+						Username: "user",
+						Groups:   []string{"group"},
+					}
+					admitter := createTestVMRestoreAdmitter(config, nil, snapshot)
+					k8sClient := k8sfake.NewSimpleClientset()
+					admitter.Client.(*kubecli.MockKubevirtClient).EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1())
+					k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+						Expect(sar.Spec.User).To(Equal("user"))
+						Expect(sar.Spec.Groups).To(ConsistOf("group"))
+						Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("target-ns"))
+						Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("create"))
+						Expect(sar.Spec.ResourceAttributes.Group).To(Equal(group))
+						Expect(sar.Spec.ResourceAttributes.Resource).To(Equal(resource))
+						sar.Status.Allowed = allowed
+						return true, sar, nil
+					})
+
+					resp := admitter.Admit(ar)
+					Expect(resp.Allowed).To(Equal(allowed))
+					if !allowed {
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.targetNamespace"))
+					}
+				},
+					Entry("allow creating the VM", snapshotv1.VirtualMachineRestoreModeVirtualMachine, "kubevirt.io", "virtualmachines", true),
+					Entry("reject when the VM can't be created", snapshotv1.VirtualMachineRestoreModeVirtualMachine, "kubevirt.io", "virtualmachines", false),
+					Entry("allow creating the PVCs", snapshotv1.VirtualMachineRestoreModeVolumesOnly, "", "persistentvolumeclaims", true),
+					Entry("reject when the PVCs can't be created", snapshotv1.VirtualMachineRestoreModeVolumesOnly, "", "persistentvolumeclaims", false),
+				)
+
+				DescribeTable("should check the requester can update the existing VM in the target namespace", func(updateAllowed bool) {
+					restore.Spec.TargetNamespace = pointer.String("target-ns")
+
+					ar := createRestoreAdmissionReview(restore)
+					ar.Request.UserInfo = authenticationv1.UserInfo{Username: "user"}
+					admitter := createTestVMRestoreAdmitter(config, vm, snapshot)
+					k8sClient := k8sfake.NewSimpleClientset()
+					admitter.Client.(*kubecli.MockKubevirtClient).EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+					var verbs []string
+					k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+						Expect(sar.Spec.ResourceAttributes.Namespace).To(Equal("target-ns"))
+						Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("virtualmachines"))
+						verbs = append(verbs, sar.Spec.ResourceAttributes.Verb)
+						sar.Status.Allowed = sar.Spec.ResourceAttributes.Verb == "create" || updateAllowed
+						if sar.Spec.ResourceAttributes.Verb == "update" {
+							Expect(sar.Spec.ResourceAttributes.Name).To(Equal(vm.Name))
+						}
+						return true, sar, nil
+					})
+
+					resp := admitter.Admit(ar)
+					Expect(verbs).To(Equal([]string{"create", "update"}))
+					Expect(resp.Allowed).To(Equal(updateAllowed))
+					if !updateAllowed {
+						Expect(resp.Result.Details.Causes).To(HaveLen(1))
+						Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("not allowed to update virtualmachines"))
+					}
+				},
+					Entry("allow updating the VM", true),
+					Entry("reject when the VM can't be updated", false),
+				)
+			})
+
 		})
 	})
 })
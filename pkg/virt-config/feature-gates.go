@@ -43,6 +43,7 @@ const (
 	VirtIOFSGate               = "ExperimentalVirtiofsSupport"
 	MacvtapGate                = "Macvtap"
 	PasstGate                  = "Passt"
+	VhostUserGate              = "VhostUser"
 	DownwardMetricsFeatureGate = "DownwardMetrics"
 	NonRoot                    = "NonRoot"
 	Root                       = "Root"
@@ -188,6 +189,10 @@ func (config *ClusterConfig) PasstEnabled() bool {
 	return config.isFeatureGateEnabled(PasstGate)
 }
 
+func (config *ClusterConfig) VhostUserEnabled() bool {
+	return config.isFeatureGateEnabled(VhostUserGate)
+}
+
 func (config *ClusterConfig) HostDevicesPassthroughEnabled() bool {
 	return config.isFeatureGateEnabled(HostDevicesGate)
 }
@@ -241,6 +241,37 @@ func WithNetworkResources(networkToResourceMap map[string]string) ResourceRender
 	}
 }
 
+// WithNetBindingPluginsOverhead adds the compute resource overhead of the network binding plugins.
+// Limits are raised only for resources which are already limited, by the requested overhead
+// when the plugins specify no limit overhead.
+func WithNetBindingPluginsOverhead(overhead k8sv1.ResourceRequirements) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		for name, quantity := range overhead.Requests {
+			addResourceOverhead(renderer.vmRequests, renderer.calculatedRequests, name, quantity)
+		}
+		for name := range renderer.Limits() {
+			quantity, exists := overhead.Limits[name]
+			if !exists {
+				if quantity, exists = overhead.Requests[name]; !exists {
+					continue
+				}
+			}
+			addResourceOverhead(renderer.vmLimits, renderer.calculatedLimits, name, quantity)
+		}
+	}
+}
+
+func addResourceOverhead(vmResources, calculatedResources k8sv1.ResourceList, name k8sv1.ResourceName, overhead resource.Quantity) {
+	if quantity, exists := vmResources[name]; exists {
+		quantity.Add(overhead)
+		vmResources[name] = quantity
+		return
+	}
+	quantity := calculatedResources[name]
+	quantity.Add(overhead)
+	calculatedResources[name] = quantity
+}
+
 func WithGPUs(gpus []v1.GPU) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
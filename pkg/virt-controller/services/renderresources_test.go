@@ -126,6 +126,44 @@ var _ = Describe("Resource pod spec renderer", func() {
 		})
 	})
 
+	Context("WithNetBindingPluginsOverhead option", func() {
+		baseMemory := resource.MustParse("64M")
+		memOverhead := resource.MustParse("32M")
+		cpuOverhead := resource.MustParse("100m")
+
+		It("the overhead is added to requests and to the limited resources only", func() {
+			rr = NewResourceRenderer(
+				kubev1.ResourceList{kubev1.ResourceMemory: baseMemory},
+				kubev1.ResourceList{kubev1.ResourceMemory: baseMemory},
+				WithNetBindingPluginsOverhead(kubev1.ResourceRequirements{
+					Requests: kubev1.ResourceList{
+						kubev1.ResourceMemory: memOverhead,
+						kubev1.ResourceCPU:    cpuOverhead,
+					},
+				}),
+			)
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceMemory, addResources(baseMemory, memOverhead)))
+			requests := rr.Requests()
+			Expect(requests.Cpu().MilliValue()).To(Equal(cpuOverhead.MilliValue()))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceMemory, addResources(baseMemory, memOverhead)))
+			Expect(rr.Limits()).ToNot(HaveKey(kubev1.ResourceCPU))
+		})
+
+		It("the limits overhead is preferred over the requests overhead", func() {
+			limitOverhead := resource.MustParse("48M")
+			rr = NewResourceRenderer(
+				kubev1.ResourceList{kubev1.ResourceMemory: baseMemory},
+				kubev1.ResourceList{kubev1.ResourceMemory: baseMemory},
+				WithNetBindingPluginsOverhead(kubev1.ResourceRequirements{
+					Requests: kubev1.ResourceList{kubev1.ResourceMemory: memOverhead},
+					Limits:   kubev1.ResourceList{kubev1.ResourceMemory: limitOverhead},
+				}),
+			)
+			Expect(rr.Requests()).To(HaveKeyWithValue(kubev1.ResourceMemory, addResources(baseMemory, memOverhead)))
+			Expect(rr.Limits()).To(HaveKeyWithValue(kubev1.ResourceMemory, addResources(baseMemory, limitOverhead)))
+		})
+	})
+
 	Context("WithAutoMemoryLimits option", func() {
 		const customRatioNamespace = "custom-memory-ratio-ns"
 		const customRatioValue = 3.2
@@ -17,6 +17,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/sriov"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
@@ -446,6 +447,25 @@ func withVirioFS() VolumeRendererOption {
 	}
 }
 
+func withVhostUserSockets() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuser.VolumeName, vhostuser.SocketsDir))
+		renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(vhostuser.VolumeName))
+		return nil
+	}
+}
+
+func withVhostUserSocketMapAnnotation() VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuser.SocketMapVolumeName, vhostuser.SocketMapMountPath))
+		renderer.podVolumes = append(renderer.podVolumes,
+			downwardAPIDirVolume(
+				vhostuser.SocketMapVolumeName, vhostuser.SocketMapVolumePath, fmt.Sprintf("metadata.annotations['%s']", vhostuser.NetworkSocketMapAnnot)),
+		)
+		return nil
+	}
+}
+
 func withHugepages() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		hugepagesBasePath := "/dev/hugepages"
@@ -102,6 +102,7 @@ const (
 
 	launcherImage       = "virt-launcher"
 	exporterImage       = "virt-exportserver"
+	libguestfsImage     = "libguestfs-tools"
 	launcherQemuTimeout = 240
 
 	imagePullSecret = ""
@@ -155,6 +156,11 @@ type VirtControllerApp struct {
 
 	pdbInformer cache.SharedIndexInformer
 
+	vmDisruptionBudgetController *disruptionbudget.VMDisruptionBudgetController
+	vmdbInformer                 cache.SharedIndexInformer
+
+	ksmPolicyInformer cache.SharedIndexInformer
+
 	persistentVolumeClaimCache    cache.Store
 	persistentVolumeClaimInformer cache.SharedIndexInformer
 
@@ -185,6 +191,9 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	groupSnapshotController      *snapshot.VMGroupSnapshotController
+	groupRestoreController       *snapshot.VMGroupRestoreController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -192,13 +201,17 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmGroupSnapshotInformer      cache.SharedIndexInformer
+	vmGroupRestoreInformer       cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
 
 	crdInformer cache.SharedIndexInformer
 
-	migrationPolicyInformer cache.SharedIndexInformer
+	migrationPolicyInformer         cache.SharedIndexInformer
+	migrationPolicyStatusController *MigrationPolicyStatusController
 
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clone.VMCloneController
@@ -212,6 +225,7 @@ type VirtControllerApp struct {
 
 	launcherImage              string
 	exporterImage              string
+	libguestfsImage            string
 	launcherQemuTimeout        int
 	imagePullSecret            string
 	virtShareDir               string
@@ -245,6 +259,9 @@ type VirtControllerApp struct {
 	exportControllerThreads           int
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	snapshotScheduleControllerThreads int
+	groupSnapshotControllerThreads    int
+	groupRestoreControllerThreads     int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
 
@@ -364,6 +381,8 @@ func Execute() {
 	app.persistentVolumeClaimCache = app.persistentVolumeClaimInformer.GetStore()
 
 	app.pdbInformer = app.informerFactory.K8SInformerFactory().Policy().V1().PodDisruptionBudgets().Informer()
+	app.vmdbInformer = app.informerFactory.VirtualMachineDisruptionBudget()
+	app.ksmPolicyInformer = app.informerFactory.KSMPolicy()
 
 	app.vmInformer = app.informerFactory.VirtualMachine()
 
@@ -375,6 +394,9 @@ func Execute() {
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmGroupSnapshotInformer = app.informerFactory.VirtualMachineGroupSnapshot()
+	app.vmGroupRestoreInformer = app.informerFactory.VirtualMachineGroupRestore()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -431,6 +453,9 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initGroupSnapshotController()
+	app.initGroupRestoreController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -535,12 +560,14 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
+		go vca.vmDisruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.migrationPolicyStatusController.Run(stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -551,6 +578,21 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotScheduleControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.groupSnapshotController.Run(vca.groupSnapshotControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the group snapshot controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.groupRestoreController.Run(vca.groupRestoreControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the group restore controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -630,7 +672,7 @@ func (vca *VirtControllerApp) initCommon() {
 	}
 
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-controller")
-	vca.nodeController, err = NewNodeController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
+	vca.nodeController, err = NewNodeController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, vca.ksmPolicyInformer, recorder)
 	if err != nil {
 		panic(err)
 	}
@@ -644,6 +686,7 @@ func (vca *VirtControllerApp) initCommon() {
 		vca.pdbInformer,
 		vca.migrationPolicyInformer,
 		vca.resourceQuotaInformer,
+		vca.namespaceStore,
 		vca.vmiRecorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -652,6 +695,16 @@ func (vca *VirtControllerApp) initCommon() {
 		panic(err)
 	}
 
+	vca.migrationPolicyStatusController, err = NewMigrationPolicyStatusController(
+		vca.clientSet,
+		vca.migrationPolicyInformer,
+		vca.vmiInformer,
+		vca.namespaceInformer,
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
 }
 
@@ -672,6 +725,8 @@ func (vca *VirtControllerApp) initPool() {
 		vca.vmInformer,
 		vca.poolInformer,
 		vca.controllerRevisionInformer,
+		vca.dataVolumeInformer,
+		vca.persistentVolumeClaimInformer,
 		recorder,
 		controller.BurstReplicas)
 	if err != nil {
@@ -725,6 +780,21 @@ func (vca *VirtControllerApp) initDisruptionBudgetController() {
 	if err != nil {
 		panic(err)
 	}
+
+	vmdbRecorder := vca.newRecorder(k8sv1.NamespaceAll, "vm-disruptionbudget-controller")
+	vca.vmDisruptionBudgetController, err = disruptionbudget.NewVMDisruptionBudgetController(
+		vca.vmdbInformer,
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.poolInformer,
+		vca.pdbInformer,
+		vmdbRecorder,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+	if err != nil {
+		panic(err)
+	}
 }
 
 func (vca *VirtControllerApp) initWorkloadUpdaterController() {
@@ -736,6 +806,10 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.vmInformer,
+		vca.vmdbInformer,
+		vca.nodeInformer,
+		vca.namespaceInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -752,6 +826,8 @@ func (vca *VirtControllerApp) initEvacuationController() {
 		vca.migrationInformer,
 		vca.nodeInformer,
 		vca.kvPodInformer,
+		vca.vmInformer,
+		vca.vmdbInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -775,6 +851,7 @@ func (vca *VirtControllerApp) initSnapshotController() {
 		PodInformer:               vca.allPodInformer,
 		DVInformer:                vca.dataVolumeInformer,
 		CRInformer:                vca.controllerRevisionInformer,
+		VMGroupSnapshotInformer:   vca.vmGroupSnapshotInformer,
 		Recorder:                  recorder,
 		ResyncPeriod:              vca.snapshotControllerResyncPeriod,
 	}
@@ -804,6 +881,51 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		VMIInformer:                vca.vmiInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initGroupSnapshotController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "group-snapshot-controller")
+	vca.groupSnapshotController = &snapshot.VMGroupSnapshotController{
+		Client:                    vca.clientSet,
+		VMGroupSnapshotInformer:   vca.vmGroupSnapshotInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMInformer:                vca.vmInformer,
+		VMIInformer:               vca.vmiInformer,
+		Recorder:                  recorder,
+	}
+	if err := vca.groupSnapshotController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initGroupRestoreController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "group-restore-controller")
+	vca.groupRestoreController = &snapshot.VMGroupRestoreController{
+		Client:                  vca.clientSet,
+		VMGroupRestoreInformer:  vca.vmGroupRestoreInformer,
+		VMGroupSnapshotInformer: vca.vmGroupSnapshotInformer,
+		VMRestoreInformer:       vca.vmRestoreInformer,
+		Recorder:                recorder,
+	}
+	if err := vca.groupRestoreController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -843,7 +965,8 @@ func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clone.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer,
+		vca.dataSourceInformer, vca.vmExportInformer, vca.dataVolumeInformer, vca.kvPodInformer, recorder, vca.libguestfsImage,
 	)
 	if err != nil {
 		panic(err)
@@ -886,6 +1009,9 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.StringVar(&vca.exporterImage, "exporter-image", exporterImage,
 		"Container for exporting VMs and VM images")
 
+	flag.StringVar(&vca.libguestfsImage, "libguestfs-image", libguestfsImage,
+		"Container running libguestfs tools against VM images")
+
 	flag.IntVar(&vca.launcherQemuTimeout, "launcher-qemu-timeout", launcherQemuTimeout,
 		"Amount of time to wait for qemu")
 
@@ -941,6 +1067,15 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.snapshotScheduleControllerThreads, "snapshot-schedule-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for snapshot schedule controller")
+
+	flag.IntVar(&vca.groupSnapshotControllerThreads, "group-snapshot-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for group snapshot controller")
+
+	flag.IntVar(&vca.groupRestoreControllerThreads, "group-restore-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for group restore controller")
+
 	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for virtual machine export controller")
 
@@ -43,7 +43,9 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1alpha1"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -85,11 +87,14 @@ var _ = Describe("Application", func() {
 		vmSnapshotContentInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 		migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
+		ksmPolicyInformer, _ := testutils.NewFakeInformerFor(&ksmv1alpha1.KSMPolicy{})
 		recorder := record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 		pdbInformer, _ := testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
+		vmdbInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachineDisruptionBudget{})
+		poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
 		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
@@ -104,6 +109,9 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmGroupSnapshotInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupSnapshot{})
+		vmGroupRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineGroupRestore{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -123,9 +131,10 @@ var _ = Describe("Application", func() {
 		app.vmiInformer = vmiInformer
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
-		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient, config)
-		app.nodeController, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder)
+		app.vmDisruptionBudgetController, _ = disruptionbudget.NewVMDisruptionBudgetController(vmdbInformer, vmInformer, vmiInformer, poolInformer, pdbInformer, recorder, virtClient, config)
+		app.nodeController, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, ksmPolicyInformer, recorder)
 		app.vmiController, _ = NewVMIController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
 			vmiInformer,
 			vmInformer,
@@ -161,10 +170,12 @@ var _ = Describe("Application", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer.GetStore(),
 			recorder,
 			virtClient,
 			config,
 		)
+		app.migrationPolicyStatusController, _ = NewMigrationPolicyStatusController(virtClient, migrationPolicyInformer, vmiInformer, namespaceInformer)
 		app.snapshotController = &snapshot.VMSnapshotController{
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
@@ -176,6 +187,7 @@ var _ = Describe("Application", func() {
 			PVCInformer:               pvcInformer,
 			CRDInformer:               crdInformer,
 			DVInformer:                dvInformer,
+			VMGroupSnapshotInformer:   vmGroupSnapshotInformer,
 			Recorder:                  recorder,
 			ResyncPeriod:              60 * time.Second,
 		}
@@ -193,6 +205,33 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.groupSnapshotController = &snapshot.VMGroupSnapshotController{
+			Client:                    virtClient,
+			VMGroupSnapshotInformer:   vmGroupSnapshotInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			Recorder:                  recorder,
+		}
+		_ = app.groupSnapshotController.Init()
+		app.groupRestoreController = &snapshot.VMGroupRestoreController{
+			Client:                  virtClient,
+			VMGroupRestoreInformer:  vmGroupRestoreInformer,
+			VMGroupSnapshotInformer: vmGroupSnapshotInformer,
+			VMRestoreInformer:       vmRestoreInformer,
+			Recorder:                recorder,
+		}
+		_ = app.groupRestoreController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			TemplateService:             services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -229,7 +268,12 @@ var _ = Describe("Application", func() {
 			vmRestoreInformer,
 			vmInformer,
 			vmSnapshotContentInformer,
+			dataSourceInformer,
+			vmExportInformer,
+			dataVolumeInformer,
+			podInformer,
 			recorder,
+			"libguestfs-tools",
 		)
 
 		app.readyChan = make(chan bool)
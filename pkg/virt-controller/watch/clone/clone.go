@@ -37,22 +37,28 @@ import (
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	k6tv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 type cloneSourceType string
 
 const (
-	sourceTypeVM       cloneSourceType = "VirtualMachine"
-	sourceTypeSnapshot cloneSourceType = "VirtualMachineSnapshot"
+	sourceTypeVM         cloneSourceType = "VirtualMachine"
+	sourceTypeSnapshot   cloneSourceType = "VirtualMachineSnapshot"
+	sourceTypeExport     cloneSourceType = "VirtualMachineExport"
+	sourceTypeDataSource cloneSourceType = "DataSource"
 )
 
 type cloneTargetType string
 
 const (
-	targetTypeVM cloneTargetType = "VirtualMachine"
-	defaultType  cloneTargetType = targetTypeVM
+	targetTypeVM         cloneTargetType = "VirtualMachine"
+	targetTypeDataVolume cloneTargetType = "DataVolume"
+	targetTypePVC        cloneTargetType = "PersistentVolumeClaim"
+	defaultType          cloneTargetType = targetTypeVM
 )
 
 type syncInfoType struct {
@@ -64,6 +70,11 @@ type syncInfoType struct {
 	targetVMName    string
 	targetVMCreated bool
 
+	targetVolumeName  string
+	targetVolumeReady bool
+
+	sysprepDone bool
+
 	isCloneFailing bool
 	failEvent      Event
 	failReason     string
@@ -133,6 +144,28 @@ func (ctrl *VMCloneController) sync(vmClone *clonev1alpha1.VirtualMachineClone)
 		syncInfo = ctrl.syncSourceSnapshot(sourceSnapshot, vmClone)
 		return syncInfo, nil
 
+	case sourceTypeExport:
+		sourceExportObj, err := ctrl.getSource(vmClone, sourceInfo.Name, vmClone.Namespace, string(sourceTypeExport), ctrl.exportInformer.GetStore())
+		if err != nil {
+			return syncInfo, err
+		}
+
+		sourceExport := sourceExportObj.(*exportv1.VirtualMachineExport)
+
+		syncInfo = ctrl.syncSourceExport(sourceExport, vmClone)
+		return syncInfo, nil
+
+	case sourceTypeDataSource:
+		sourceDataSourceObj, err := ctrl.getSource(vmClone, sourceInfo.Name, vmClone.Namespace, string(sourceTypeDataSource), ctrl.dataSourceInformer.GetStore())
+		if err != nil {
+			return syncInfo, err
+		}
+
+		sourceDataSource := sourceDataSourceObj.(*cdiv1.DataSource)
+
+		syncInfo = ctrl.syncSourceDataSource(sourceDataSource, vmClone)
+		return syncInfo, nil
+
 	default:
 		return syncInfo, fmt.Errorf("clone %s is defined with an unknown source type %s", vmClone.Name, sourceInfo.Kind)
 	}
@@ -220,6 +253,12 @@ func (ctrl *VMCloneController) syncSourceVMTargetVM(source *k6tv1.VirtualMachine
 			return syncInfo
 		}
 
+		fallthrough
+
+	case clonev1alpha1.SysprepInProgress:
+
+		syncInfo = ctrl.syncSysprep(vmClone, syncInfo)
+
 	default:
 		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
 	}
@@ -272,6 +311,12 @@ func (ctrl *VMCloneController) syncSourceSnapshotTargetVM(source *snapshotv1alph
 			return syncInfo
 		}
 
+		fallthrough
+
+	case clonev1alpha1.SysprepInProgress:
+
+		syncInfo = ctrl.syncSysprep(vmClone, syncInfo)
+
 	default:
 		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
 	}
@@ -303,7 +348,11 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 	)
 
 	if isInPhase(vmClone, clonev1alpha1.PhaseUnset) {
-		assignPhase(clonev1alpha1.SnapshotInProgress)
+		if isVolumeTarget(ctrl.getTargetType(vmClone)) {
+			assignPhase(clonev1alpha1.CreatingTargetVolume)
+		} else {
+			assignPhase(clonev1alpha1.SnapshotInProgress)
+		}
 	}
 	if isInPhase(vmClone, clonev1alpha1.SnapshotInProgress) {
 		if snapshotName := syncInfo.snapshotName; snapshotName != "" {
@@ -331,8 +380,25 @@ func (ctrl *VMCloneController) updateStatus(origClone *clonev1alpha1.VirtualMach
 		if syncInfo.targetVMCreated {
 			vmClone.Status.SnapshotName = nil
 			vmClone.Status.RestoreName = nil
+			if needsSysprep(vmClone) {
+				assignPhase(clonev1alpha1.SysprepInProgress)
+			} else {
+				assignPhase(clonev1alpha1.Succeeded)
+			}
+		}
+	}
+	if isInPhase(vmClone, clonev1alpha1.SysprepInProgress) {
+		if syncInfo.sysprepDone {
 			assignPhase(clonev1alpha1.Succeeded)
+		}
+	}
+	if isInPhase(vmClone, clonev1alpha1.CreatingTargetVolume) {
+		if targetVolumeName := syncInfo.targetVolumeName; targetVolumeName != "" {
+			vmClone.Status.TargetName = pointer.String(targetVolumeName)
+		}
 
+		if syncInfo.targetVolumeReady {
+			assignPhase(clonev1alpha1.Succeeded)
 		}
 	}
 	if isInPhase(vmClone, clonev1alpha1.Succeeded) {
@@ -413,7 +479,7 @@ func (ctrl *VMCloneController) getSnapshot(vmClone *clonev1alpha1.VirtualMachine
 
 func (ctrl *VMCloneController) createRestoreFromVm(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, snapshotName string, syncInfo syncInfoType) syncInfoType {
 	patches := generatePatches(vm, &vmClone.Spec)
-	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, vmClone.Name, snapshotName, vmClone.UID, patches)
+	restore := generateRestore(vmClone.Spec.Target, vm.Name, vmClone.Namespace, getTargetNamespace(vmClone), vmClone.Name, snapshotName, vmClone.UID, patches)
 	syncInfo.logger.Infof("creating restore %s for clone %s", restore.Name, vmClone.Name)
 
 	restore, syncInfo.err = ctrl.client.VirtualMachineRestore(restore.Namespace).Create(context.Background(), restore, v1.CreateOptions{})
@@ -455,7 +521,7 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clonev1alpha1.Virtual
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmInformer.GetStore().GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmInformer.GetStore().GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
 		return addErrorToSyncInfo(syncInfo, fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 	} else if err != nil {
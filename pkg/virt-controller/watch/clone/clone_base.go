@@ -7,6 +7,8 @@ import (
 	"kubevirt.io/api/clone"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -16,6 +18,7 @@ import (
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/util/status"
 )
@@ -32,8 +35,17 @@ const (
 	RestoreReady    Event = "RestoreReady"
 	TargetVMCreated Event = "TargetVMCreated"
 
+	TargetVolumeCreated Event = "TargetVolumeCreated"
+	TargetVolumeReady   Event = "TargetVolumeReady"
+	TargetVolumeFailed  Event = "TargetVolumeFailed"
+
 	SnapshotDeleted    Event = "SnapshotDeleted"
 	SourceDoesNotExist Event = "SourceDoesNotExist"
+	SourceNotSupported Event = "SourceNotSupported"
+
+	SysprepStarted   Event = "SysprepStarted"
+	SysprepCompleted Event = "SysprepCompleted"
+	SysprepFailed    Event = "SysprepFailed"
 )
 
 type VMCloneController struct {
@@ -43,14 +55,19 @@ type VMCloneController struct {
 	restoreInformer         cache.SharedIndexInformer
 	vmInformer              cache.SharedIndexInformer
 	snapshotContentInformer cache.SharedIndexInformer
+	dataSourceInformer      cache.SharedIndexInformer
+	exportInformer          cache.SharedIndexInformer
+	dataVolumeInformer      cache.SharedIndexInformer
+	podInformer             cache.SharedIndexInformer
 	recorder                record.EventRecorder
+	libguestfsImage         string
 
 	vmCloneQueue       workqueue.RateLimitingInterface
 	vmStatusUpdater    *status.VMStatusUpdater
 	cloneStatusUpdater *status.CloneStatusUpdater
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, dataSourceInformer, exportInformer, dataVolumeInformer, podInformer cache.SharedIndexInformer, recorder record.EventRecorder, libguestfsImage string) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:                  client,
 		vmCloneInformer:         vmCloneInformer,
@@ -58,7 +75,12 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		restoreInformer:         restoreInformer,
 		vmInformer:              vmInformer,
 		snapshotContentInformer: snapshotContentInformer,
+		dataSourceInformer:      dataSourceInformer,
+		exportInformer:          exportInformer,
+		dataVolumeInformer:      dataVolumeInformer,
+		podInformer:             podInformer,
 		recorder:                recorder,
+		libguestfsImage:         libguestfsImage,
 		vmCloneQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-vmclone"),
 		vmStatusUpdater:         status.NewVMStatusUpdater(client),
 		cloneStatusUpdater:      status.NewCloneStatusUpdater(client),
@@ -96,6 +118,30 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		},
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ctrl.dataVolumeInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleDataVolume,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleDataVolume(newObj) },
+			DeleteFunc: ctrl.handleDataVolume,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ctrl.podInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePod,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePod(newObj) },
+			DeleteFunc: ctrl.handlePod,
+		},
+	)
+
 	if err != nil {
 		return nil, err
 	}
@@ -193,6 +239,46 @@ func (ctrl *VMCloneController) handleRestore(obj interface{}) {
 	}
 }
 
+func (ctrl *VMCloneController) handleDataVolume(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	dataVolume, ok := obj.(*cdiv1.DataVolume)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "datavolume")
+		return
+	}
+
+	ctrl.enqueueAnnotatedClone(dataVolume)
+}
+
+func (ctrl *VMCloneController) handlePod(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		log.Log.Errorf(unknownTypeErrFmt, "pod")
+		return
+	}
+
+	ctrl.enqueueAnnotatedClone(pod)
+}
+
+// enqueueAnnotatedClone enqueues the clone an object was created for, objects created in the target
+// namespace cannot be owned by the clone so they reference it through annotations
+func (ctrl *VMCloneController) enqueueAnnotatedClone(obj metav1.Object) {
+	cloneName, hasName := obj.GetAnnotations()[cloneNameAnnotation]
+	cloneNamespace, hasNamespace := obj.GetAnnotations()[cloneNamespaceAnnotation]
+	if !hasName || !hasNamespace {
+		return
+	}
+
+	ctrl.vmCloneQueue.AddRateLimited(getKey(cloneName, cloneNamespace))
+}
+
 func (ctrl *VMCloneController) Run(threadiness int, stopCh <-chan struct{}) error {
 	defer utilruntime.HandleCrash()
 	defer ctrl.vmCloneQueue.ShutDown()
@@ -206,6 +292,10 @@ func (ctrl *VMCloneController) Run(threadiness int, stopCh <-chan struct{}) erro
 		ctrl.snapshotInformer.HasSynced,
 		ctrl.restoreInformer.HasSynced,
 		ctrl.vmInformer.HasSynced,
+		ctrl.dataSourceInformer.HasSynced,
+		ctrl.exportInformer.HasSynced,
+		ctrl.dataVolumeInformer.HasSynced,
+		ctrl.podInformer.HasSynced,
 	) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
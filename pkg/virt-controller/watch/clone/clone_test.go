@@ -19,6 +19,7 @@
 package clone
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -28,8 +29,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -40,9 +43,12 @@ import (
 	"kubevirt.io/api/clone"
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/tests"
@@ -54,6 +60,7 @@ const (
 	snapshotResource        = "virtualmachinesnapshots"
 	restoreResource         = "virtualmachinerestores"
 	snapshotContentResource = "virtualmachinesnapshotcontents"
+	dataVolumeResource      = "datavolumes"
 	vmAPIGroup              = "kubevirt.io"
 	snapshotAPIGroup        = "snapshot.kubevirt.io"
 )
@@ -67,6 +74,10 @@ var _ = Describe("Clone", func() {
 	var snapshotInformer cache.SharedIndexInformer
 	var restoreInformer cache.SharedIndexInformer
 	var snapshotContentInformer cache.SharedIndexInformer
+	var dataSourceInformer cache.SharedIndexInformer
+	var exportInformer cache.SharedIndexInformer
+	var dataVolumeInformer cache.SharedIndexInformer
+	var podInformer cache.SharedIndexInformer
 
 	var cloneInformer cache.SharedIndexInformer
 	var cloneSource *framework.FakeControllerSource
@@ -77,6 +88,7 @@ var _ = Describe("Clone", func() {
 	var mockQueue *testutils.MockWorkQueue
 	var client *kubevirtfake.Clientset
 	var k8sClient *k8sfake.Clientset
+	var cdiClient *cdifake.Clientset
 
 	var testNamespace string
 
@@ -88,8 +100,13 @@ var _ = Describe("Clone", func() {
 		go snapshotInformer.Run(stop)
 		go restoreInformer.Run(stop)
 		go cloneInformer.Run(stop)
+		go dataSourceInformer.Run(stop)
+		go exportInformer.Run(stop)
+		go dataVolumeInformer.Run(stop)
+		go podInformer.Run(stop)
 		Expect(cache.WaitForCacheSync(stop, vmInformer.HasSynced, snapshotInformer.HasSynced,
-			restoreInformer.HasSynced, cloneInformer.HasSynced)).To(BeTrue())
+			restoreInformer.HasSynced, cloneInformer.HasSynced, dataSourceInformer.HasSynced,
+			exportInformer.HasSynced, dataVolumeInformer.HasSynced, podInformer.HasSynced)).To(BeTrue())
 	}
 
 	addVM := func(vm *virtv1.VirtualMachine) {
@@ -142,6 +159,51 @@ var _ = Describe("Clone", func() {
 		})
 	}
 
+	addDataSource := func(dataSource *cdiv1.DataSource) {
+		err := dataSourceInformer.GetStore().Add(dataSource)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	addExport := func(export *exportv1.VirtualMachineExport) {
+		err := exportInformer.GetStore().Add(export)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	addDataVolume := func(dv *cdiv1.DataVolume) {
+		err := dataVolumeInformer.GetStore().Add(dv)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	addPod := func(pod *k8sv1.Pod) {
+		err := podInformer.GetStore().Add(pod)
+		Expect(err).ShouldNot(HaveOccurred())
+	}
+
+	expectDataVolumeCreate := func(namespace string, validate func(dv *cdiv1.DataVolume)) {
+		cdiClient.Fake.PrependReactor("create", dataVolumeResource, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			Expect(create.GetNamespace()).To(Equal(namespace))
+
+			dv := create.GetObject().(*cdiv1.DataVolume)
+			Expect(dv.Annotations).To(HaveKeyWithValue(cloneNameAnnotation, vmClone.Name))
+			Expect(dv.Annotations).To(HaveKeyWithValue(cloneNamespaceAnnotation, vmClone.Namespace))
+			validate(dv)
+
+			return true, create.GetObject(), nil
+		})
+	}
+
+	setVolumeTarget := func(vmClone *clonev1alpha1.VirtualMachineClone, kind, name string) {
+		vmClone.Spec.Target = &k8sv1.TypedLocalObjectReference{
+			Kind: kind,
+			Name: name,
+		}
+		if kind == string(targetTypeDataVolume) {
+			vmClone.Spec.Target.APIGroup = pointer.String(cdiv1.SchemeGroupVersion.Group)
+		}
+	}
+
 	expectSnapshotDelete := func(snapshotName string) {
 		client.Fake.PrependReactor("delete", snapshotResource, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
 			create, ok := action.(testing.DeleteAction)
@@ -221,6 +283,10 @@ var _ = Describe("Clone", func() {
 		restoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1alpha1.VirtualMachineRestore{})
 		cloneInformer, cloneSource = testutils.NewFakeInformerFor(&clonev1alpha1.VirtualMachineClone{})
 		snapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1alpha1.VirtualMachineSnapshotContent{})
+		dataSourceInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataSource{})
+		exportInformer, _ = testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		dataVolumeInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -232,7 +298,12 @@ var _ = Describe("Clone", func() {
 			restoreInformer,
 			vmInformer,
 			snapshotContentInformer,
-			recorder)
+			dataSourceInformer,
+			exportInformer,
+			dataVolumeInformer,
+			podInformer,
+			recorder,
+			"libguestfs-tools")
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
 
@@ -255,6 +326,14 @@ var _ = Describe("Clone", func() {
 			return true, nil, nil
 		})
 		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		cdiClient = cdifake.NewSimpleClientset()
+		cdiClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
 		syncCaches(stop)
 	})
@@ -447,6 +526,170 @@ var _ = Describe("Clone", func() {
 				controller.Execute()
 				expectEvent(SnapshotDeleted)
 			})
+
+			Context("with sysprep", func() {
+				const targetPVCName = "target-pvc"
+
+				var targetVM *virtv1.VirtualMachine
+
+				BeforeEach(func() {
+					vmClone.Spec.GuestIdentity = &clonev1alpha1.GuestIdentity{
+						Sysprep: &clonev1alpha1.Sysprep{
+							Operations: []string{"machine-id", "ssh-hostkeys"},
+						},
+					}
+
+					targetVM = sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.Annotations = map[string]string{sysprepRunStrategyAnnotation: string(virtv1.RunStrategyAlways)}
+					targetVM.Spec.Template.Spec.Volumes = []virtv1.Volume{
+						{
+							Name: "disk0",
+							VolumeSource: virtv1.VolumeSource{
+								DataVolume: &virtv1.DataVolumeSource{Name: targetPVCName},
+							},
+						},
+						{
+							Name: "cloudinit",
+							VolumeSource: virtv1.VolumeSource{
+								CloudInitNoCloud: &virtv1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+							},
+						},
+					}
+				})
+
+				sysprepPod := func(phase k8sv1.PodPhase) *k8sv1.Pod {
+					return &k8sv1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      getSysprepPodName(targetVM.Name),
+							Namespace: targetVM.Namespace,
+						},
+						Status: k8sv1.PodStatus{Phase: phase},
+					}
+				}
+
+				It("should start sysprep once the target VM is created", func() {
+					snapshot := createVirtualMachineSnapshot(sourceVM)
+					snapshot.Status.ReadyToUse = pointer.Bool(true)
+
+					restore := createVirtualMachineRestore(sourceVM, snapshot.Name)
+					restore.Status.Complete = pointer.Bool(true)
+
+					vmClone.Status.SnapshotName = pointer.String(snapshot.Name)
+					vmClone.Status.RestoreName = pointer.String(restore.Name)
+					vmClone.Status.Phase = clonev1alpha1.CreatingTargetVM
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addRestore(restore)
+
+					expectCloneUpdate(clonev1alpha1.SysprepInProgress)
+					expectSnapshotDelete(snapshot.Name)
+					expectRestoreDelete(restore.Name)
+
+					k8sClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+						get := action.(testing.GetAction)
+						Expect(get.GetName()).To(Equal(targetPVCName))
+
+						volumeMode := k8sv1.PersistentVolumeBlock
+						return true, &k8sv1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{Name: targetPVCName, Namespace: testNamespace},
+							Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: &volumeMode},
+						}, nil
+					})
+					k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+						pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+						Expect(pod.Name).To(Equal(getSysprepPodName(targetVM.Name)))
+						Expect(pod.Annotations).To(HaveKeyWithValue(cloneNameAnnotation, vmClone.Name))
+						Expect(pod.Spec.Containers).To(HaveLen(1))
+
+						container := pod.Spec.Containers[0]
+						Expect(container.Image).To(Equal("libguestfs-tools"))
+						Expect(container.Command).To(Equal([]string{"virt-sysprep"}))
+						Expect(container.Args).To(Equal([]string{"-a", "/dev/disk-0", "--operations", "machine-id,ssh-hostkeys"}))
+						Expect(container.VolumeDevices).To(ConsistOf(k8sv1.VolumeDevice{Name: "disk-0", DevicePath: "/dev/disk-0"}))
+
+						return true, pod, nil
+					})
+
+					controller.Execute()
+					expectEvent(TargetVMCreated)
+					expectEvent(SysprepStarted)
+				})
+
+				It("should wait for the sysprep pod to complete", func() {
+					vmClone.Status.Phase = clonev1alpha1.SysprepInProgress
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addPod(sysprepPod(k8sv1.PodRunning))
+					addClone(vmClone)
+
+					controller.Execute()
+				})
+
+				It("should restore the run strategy of the target VM once sysprep succeeded", func() {
+					vmClone.Status.Phase = clonev1alpha1.SysprepInProgress
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addPod(sysprepPod(k8sv1.PodSucceeded))
+					addClone(vmClone)
+
+					vmInterface.EXPECT().Patch(context.Background(), targetVM.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachine, error) {
+							patch, err := jsonpatch.DecodePatch(data)
+							Expect(err).ToNot(HaveOccurred())
+							marshalledVM, err := json.Marshal(targetVM)
+							Expect(err).ToNot(HaveOccurred())
+							modifiedMarshalledVM, err := patch.Apply(marshalledVM)
+							Expect(err).ToNot(HaveOccurred())
+
+							patchedVM := virtv1.VirtualMachine{}
+							Expect(json.Unmarshal(modifiedMarshalledVM, &patchedVM)).To(Succeed())
+							Expect(patchedVM.Annotations).ToNot(HaveKey(sysprepRunStrategyAnnotation))
+							Expect(patchedVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyAlways)))
+							return &patchedVM, nil
+						})
+					k8sClient.Fake.PrependReactor("delete", "pods", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+						Expect(action.(testing.DeleteAction).GetName()).To(Equal(getSysprepPodName(targetVM.Name)))
+						return true, nil, nil
+					})
+					expectCloneUpdate(clonev1alpha1.Succeeded)
+
+					controller.Execute()
+					expectEvent(SysprepCompleted)
+				})
+
+				It("should fail the clone when sysprep failed", func() {
+					vmClone.Status.Phase = clonev1alpha1.SysprepInProgress
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addPod(sysprepPod(k8sv1.PodFailed))
+					addClone(vmClone)
+
+					expectCloneUpdate(clonev1alpha1.Failed)
+
+					controller.Execute()
+					expectEvent(SysprepFailed)
+				})
+
+				It("should succeed when the run strategy was already restored", func() {
+					vmClone.Status.Phase = clonev1alpha1.SysprepInProgress
+					targetVM.Annotations = nil
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addClone(vmClone)
+
+					expectCloneUpdate(clonev1alpha1.Succeeded)
+
+					controller.Execute()
+				})
+			})
 		})
 
 		Context("with source snapshot", func() {
@@ -467,6 +710,280 @@ var _ = Describe("Clone", func() {
 			})
 		})
 
+		Context("with target in another namespace", func() {
+			const targetNamespace = "target-namespace"
+
+			It("should create the restore with the target namespace", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.Bool(true)
+
+				vmClone.Spec.TargetNamespace = pointer.String(targetNamespace)
+				vmClone.Status.SnapshotName = pointer.String(snapshot.Name)
+				vmClone.Status.Phase = clonev1alpha1.SnapshotInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+
+				expectCloneUpdate(clonev1alpha1.RestoreInProgress)
+				client.Fake.PrependReactor("create", restoreResource, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+					create, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+
+					restore := create.GetObject().(*snapshotv1alpha1.VirtualMachineRestore)
+					Expect(restore.Namespace).To(Equal(vmClone.Namespace))
+					Expect(restore.Spec.TargetNamespace).To(HaveValue(Equal(targetNamespace)))
+
+					return true, create.GetObject(), nil
+				})
+
+				controller.Execute()
+				expectEvent(SnapshotReady)
+				expectEvent(RestoreCreated)
+			})
+
+			It("should look for the target VM in the target namespace", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.Bool(true)
+
+				restore := createVirtualMachineRestore(sourceVM, snapshot.Name)
+				restore.Status.Complete = pointer.Bool(true)
+
+				vmClone.Spec.TargetNamespace = pointer.String(targetNamespace)
+				vmClone.Status.SnapshotName = pointer.String(snapshot.Name)
+				vmClone.Status.RestoreName = pointer.String(restore.Name)
+				vmClone.Status.Phase = clonev1alpha1.CreatingTargetVM
+
+				targetVM := sourceVM.DeepCopy()
+				targetVM.Name = vmClone.Spec.Target.Name
+				targetVM.Namespace = targetNamespace
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addRestore(restore)
+
+				expectCloneUpdate(clonev1alpha1.Succeeded)
+				expectSnapshotDelete(snapshot.Name)
+				expectRestoreDelete(restore.Name)
+
+				controller.Execute()
+				expectEvent(TargetVMCreated)
+			})
+		})
+
+		Context("with volume target", func() {
+			const targetNamespace = "target-namespace"
+
+			var dataSource *cdiv1.DataSource
+
+			BeforeEach(func() {
+				dataSource = createDataSource(testNamespace)
+				vmClone.Spec.Source = &k8sv1.TypedLocalObjectReference{
+					APIGroup: pointer.String(cdiv1.SchemeGroupVersion.Group),
+					Kind:     "DataSource",
+					Name:     dataSource.Name,
+				}
+				vmClone.Spec.TargetNamespace = pointer.String(targetNamespace)
+			})
+
+			It("should create a DataVolume from a DataSource", func() {
+				setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+
+				addDataSource(dataSource)
+				addClone(vmClone)
+
+				expectDataVolumeCreate(targetNamespace, func(dv *cdiv1.DataVolume) {
+					Expect(dv.Name).To(Equal("target-dv"))
+					Expect(dv.Annotations).ToNot(HaveKey(deleteAfterCompletionAnnotation))
+					Expect(dv.Spec.SourceRef).ToNot(BeNil())
+					Expect(dv.Spec.SourceRef.Kind).To(Equal(cdiv1.DataVolumeDataSource))
+					Expect(dv.Spec.SourceRef.Name).To(Equal(dataSource.Name))
+					Expect(dv.Spec.SourceRef.Namespace).To(HaveValue(Equal(testNamespace)))
+				})
+				client.Fake.PrependReactor("update", clone.ResourceVMClonePlural, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+					update := action.(testing.UpdateAction)
+					vmClone := update.GetObject().(*clonev1alpha1.VirtualMachineClone)
+					Expect(vmClone.Status.Phase).To(Equal(clonev1alpha1.CreatingTargetVolume))
+					Expect(vmClone.Status.TargetName).To(HaveValue(Equal("target-dv")))
+
+					return true, update.GetObject(), nil
+				})
+
+				controller.Execute()
+				expectEvent(TargetVolumeCreated)
+			})
+
+			It("should ask CDI to delete the DataVolume after completion with a PVC target", func() {
+				setVolumeTarget(vmClone, string(targetTypePVC), "target-pvc")
+
+				addDataSource(dataSource)
+				addClone(vmClone)
+
+				expectDataVolumeCreate(targetNamespace, func(dv *cdiv1.DataVolume) {
+					Expect(dv.Name).To(Equal("target-pvc"))
+					Expect(dv.Annotations).To(HaveKeyWithValue(deleteAfterCompletionAnnotation, "true"))
+				})
+				expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+				controller.Execute()
+				expectEvent(TargetVolumeCreated)
+			})
+
+			It("should not create the DataVolume again when it is in the cache", func() {
+				setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+
+				addDataSource(dataSource)
+				addDataVolume(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "target-dv",
+						Namespace: targetNamespace,
+						Annotations: map[string]string{
+							cloneNameAnnotation:      vmClone.Name,
+							cloneNamespaceAnnotation: vmClone.Namespace,
+						},
+					},
+				})
+				addClone(vmClone)
+
+				expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+				controller.Execute()
+				Expect(cdiClient.Actions()).To(BeEmpty())
+			})
+
+			It("should fail when a DataVolume of something else has the target name", func() {
+				setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+
+				addDataSource(dataSource)
+				addDataVolume(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "target-dv",
+						Namespace: targetNamespace,
+					},
+				})
+				addClone(vmClone)
+
+				expectCloneUpdate(clonev1alpha1.Failed)
+
+				controller.Execute()
+				expectEvent(TargetVolumeFailed)
+				Expect(cdiClient.Actions()).To(BeEmpty())
+			})
+
+			It("should retry when the DataVolume exists but is not in the cache yet", func() {
+				setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+
+				addDataSource(dataSource)
+				addClone(vmClone)
+
+				cdiClient.Fake.PrependReactor("create", dataVolumeResource, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+					return true, nil, errors.NewAlreadyExists(cdiv1.SchemeGroupVersion.WithResource(dataVolumeResource).GroupResource(), "target-dv")
+				})
+				expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+				controller.Execute()
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			DescribeTable("should follow the DataVolume phase", func(phase cdiv1.DataVolumePhase, expectedPhase clonev1alpha1.VirtualMachineClonePhase, expectedEvent Event) {
+				setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+				vmClone.Status.Phase = clonev1alpha1.CreatingTargetVolume
+				vmClone.Status.TargetName = pointer.String("target-dv")
+
+				addDataSource(dataSource)
+				addDataVolume(&cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "target-dv",
+						Namespace: targetNamespace,
+					},
+					Status: cdiv1.DataVolumeStatus{
+						Phase: phase,
+					},
+				})
+				addClone(vmClone)
+
+				expectCloneUpdate(expectedPhase)
+
+				controller.Execute()
+				expectEvent(expectedEvent)
+			},
+				Entry("succeeded", cdiv1.Succeeded, clonev1alpha1.Succeeded, TargetVolumeReady),
+				Entry("failed", cdiv1.Failed, clonev1alpha1.Failed, TargetVolumeFailed),
+			)
+
+			Context("from an export", func() {
+				var export *exportv1.VirtualMachineExport
+
+				BeforeEach(func() {
+					export = createExport(testNamespace)
+					vmClone.Spec.Source = &k8sv1.TypedLocalObjectReference{
+						APIGroup: pointer.String(exportv1.SchemeGroupVersion.Group),
+						Kind:     "VirtualMachineExport",
+						Name:     export.Name,
+					}
+					setVolumeTarget(vmClone, string(targetTypeDataVolume), "target-dv")
+				})
+
+				It("should clone the PVC of the exported volume", func() {
+					addExport(export)
+					addClone(vmClone)
+
+					expectDataVolumeCreate(targetNamespace, func(dv *cdiv1.DataVolume) {
+						Expect(dv.Spec.Source).ToNot(BeNil())
+						Expect(dv.Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: testNamespace, Name: "disk"}))
+					})
+					expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+					controller.Execute()
+					expectEvent(TargetVolumeCreated)
+					Expect(k8sClient.Actions()).To(BeEmpty())
+				})
+
+				It("should clone the PVC restored by the export of a snapshot", func() {
+					export.Spec.Source = k8sv1.TypedLocalObjectReference{
+						APIGroup: pointer.String(snapshotv1alpha1.SchemeGroupVersion.Group),
+						Kind:     "VirtualMachineSnapshot",
+						Name:     "snapshot",
+					}
+					addExport(export)
+					addClone(vmClone)
+
+					expectDataVolumeCreate(targetNamespace, func(dv *cdiv1.DataVolume) {
+						Expect(dv.Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: testNamespace, Name: export.Name + "-disk"}))
+					})
+					expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+					controller.Execute()
+					expectEvent(TargetVolumeCreated)
+				})
+
+				It("should wait for the export to be ready", func() {
+					export.Status.Phase = exportv1.Pending
+
+					addExport(export)
+					addClone(vmClone)
+
+					expectCloneUpdate(clonev1alpha1.CreatingTargetVolume)
+
+					controller.Execute()
+				})
+
+				It("should fail when the export has more than one volume", func() {
+					export.Status.Links.Internal.Volumes = append(export.Status.Links.Internal.Volumes, export.Status.Links.Internal.Volumes[0])
+
+					addExport(export)
+					addClone(vmClone)
+
+					expectCloneUpdate(clonev1alpha1.Failed)
+
+					controller.Execute()
+					expectEvent(SourceNotSupported)
+				})
+			})
+		})
+
 	})
 
 	Context("generation of target VM", func() {
@@ -745,6 +1262,78 @@ var _ = Describe("Clone", func() {
 
 		})
 
+		Context("Guest identity", func() {
+
+			expectPatchedVM := func(validate func(patchedVM *virtv1.VirtualMachine)) {
+				client.Fake.PrependReactor("create", restoreResource, func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+					create, ok := action.(testing.CreateAction)
+					Expect(ok).To(BeTrue())
+
+					restore := create.GetObject().(*snapshotv1alpha1.VirtualMachineRestore)
+					patchedVM, err := offlinePatchVM(sourceVM, restore.Spec.Patches)
+					Expect(err).ToNot(HaveOccurred())
+					validate(&patchedVM)
+
+					return true, create.GetObject(), nil
+				})
+			}
+
+			It("should not regenerate the guest identity if not requested", func() {
+				sourceVM.Spec.Template.Spec.Hostname = "source-hostname"
+				addClone(vmClone)
+
+				expectPatchedVM(func(patchedVM *virtv1.VirtualMachine) {
+					Expect(patchedVM.Spec.Template.Spec.Hostname).To(Equal("source-hostname"))
+					Expect(patchedVM.Spec.Template.Spec.Domain.Firmware).To(BeNil())
+				})
+				controller.Execute()
+			})
+
+			DescribeTable("should regenerate firmware UUID and hostname", func(firmware *virtv1.Firmware, newHostname *string, expectedHostname string) {
+				sourceVM.Spec.Template.Spec.Hostname = "source-hostname"
+				sourceVM.Spec.Template.Spec.Domain.Firmware = firmware
+				vmClone.Spec.GuestIdentity = &clonev1alpha1.GuestIdentity{
+					Regenerate:  true,
+					NewHostname: newHostname,
+				}
+				addClone(vmClone)
+
+				expectPatchedVM(func(patchedVM *virtv1.VirtualMachine) {
+					Expect(patchedVM.Spec.Template.Spec.Hostname).To(Equal(expectedHostname))
+					Expect(patchedVM.Spec.Template.Spec.Domain.Firmware).ToNot(BeNil())
+					Expect(patchedVM.Spec.Template.Spec.Domain.Firmware.UUID).ToNot(BeEmpty())
+					Expect(patchedVM.Spec.Template.Spec.Domain.Firmware.UUID).ToNot(Equal(types.UID("source-fake-uuid")))
+				})
+				controller.Execute()
+			},
+				Entry("without firmware on the source", nil, nil, ""),
+				Entry("with firmware on the source", &virtv1.Firmware{UUID: "source-fake-uuid"}, nil, ""),
+				Entry("with a new hostname", nil, pointer.String("new-hostname"), "new-hostname"),
+			)
+
+			DescribeTable("should keep the target halted for sysprep", func(running *bool, runStrategy *virtv1.VirtualMachineRunStrategy, annotations map[string]string, expectedRunStrategy virtv1.VirtualMachineRunStrategy) {
+				sourceVM.Spec.Running = running
+				sourceVM.Spec.RunStrategy = runStrategy
+				sourceVM.Annotations = annotations
+				vmClone.Spec.GuestIdentity = &clonev1alpha1.GuestIdentity{
+					Sysprep: &clonev1alpha1.Sysprep{},
+				}
+				addClone(vmClone)
+
+				expectPatchedVM(func(patchedVM *virtv1.VirtualMachine) {
+					Expect(patchedVM.Spec.Running).To(BeNil())
+					Expect(patchedVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+					Expect(patchedVM.Annotations).To(HaveKeyWithValue(sysprepRunStrategyAnnotation, string(expectedRunStrategy)))
+				})
+				controller.Execute()
+			},
+				Entry("with running", pointer.Bool(true), nil, nil, virtv1.RunStrategyAlways),
+				Entry("with run strategy", nil, runStrategyPtr(virtv1.RunStrategyRerunOnFailure), nil, virtv1.RunStrategyRerunOnFailure),
+				Entry("with existing annotations", nil, runStrategyPtr(virtv1.RunStrategyAlways), map[string]string{"key": "value"}, virtv1.RunStrategyAlways),
+			)
+
+		})
+
 	})
 
 	Context("different sources", func() {
@@ -802,6 +1391,50 @@ func createVirtualMachineRestore(vm *virtv1.VirtualMachine, snapshotName string)
 	}
 }
 
+func createDataSource(namespace string) *cdiv1.DataSource {
+	return &cdiv1.DataSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-datasource",
+			Namespace: namespace,
+		},
+		Spec: cdiv1.DataSourceSpec{
+			Source: cdiv1.DataSourceSource{
+				PVC: &cdiv1.DataVolumeSourcePVC{
+					Name:      "test-pvc",
+					Namespace: namespace,
+				},
+			},
+		},
+	}
+}
+
+func createExport(namespace string) *exportv1.VirtualMachineExport {
+	return &exportv1.VirtualMachineExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-export",
+			Namespace: namespace,
+		},
+		Status: &exportv1.VirtualMachineExportStatus{
+			Phase:          exportv1.Ready,
+			TokenSecretRef: pointer.String("export-token"),
+			Links: &exportv1.VirtualMachineExportLinks{
+				Internal: &exportv1.VirtualMachineExportLink{
+					Cert: "export-cert",
+					Volumes: []exportv1.VirtualMachineExportVolume{
+						{
+							Name: "disk",
+							Formats: []exportv1.VirtualMachineExportVolumeFormat{
+								{Format: exportv1.KubeVirtGz, Url: "https://internal/disk.img.gz"},
+								{Format: exportv1.KubeVirtRaw, Url: "https://internal/disk.img"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func validateOwnerReference(ownerRef metav1.OwnerReference, expectedOwner metav1.Object) {
 	const err = "owner reference verification failed"
 
@@ -814,3 +1447,7 @@ func validateOwnerReference(ownerRef metav1.OwnerReference, expectedOwner metav1
 	Expect(ownerRef.Controller).ToNot(BeNil(), err)
 	Expect(*ownerRef.Controller).To(BeTrue(), err)
 }
+
+func runStrategyPtr(runStrategy virtv1.VirtualMachineRunStrategy) *virtv1.VirtualMachineRunStrategy {
+	return &runStrategy
+}
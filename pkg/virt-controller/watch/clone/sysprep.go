@@ -0,0 +1,282 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const (
+	sysprepAppLabelValue = "virt-sysprep"
+	sysprepContainerName = "virt-sysprep"
+
+	sysprepApplianceDir = "/usr/local/lib/guestfs/appliance"
+	sysprepTmpDir       = "/tmp/guestfs"
+	sysprepHomeDir      = "/home/guestfs"
+	sysprepDiskDir      = "/disks"
+	sysprepDeviceDir    = "/dev"
+
+	sysprepTmpVolumeName  = "libguestfs-tmp-dir"
+	sysprepHomeVolumeName = "guestfs"
+
+	// qemu user and group of the libguestfs image
+	sysprepUser int64 = 107
+)
+
+func needsSysprep(vmClone *clonev1alpha1.VirtualMachineClone) bool {
+	return vmClone.Spec.GuestIdentity != nil && vmClone.Spec.GuestIdentity.Sysprep != nil
+}
+
+func getSysprepPodName(vmName string) string {
+	return fmt.Sprintf("%s-sysprep", vmName)
+}
+
+// syncSysprep runs virt-sysprep against the volumes of the target VM. The target VM keeps the run
+// strategy of the source in an annotation until virt-sysprep completed, its removal marks the end of the process.
+func (ctrl *VMCloneController) syncSysprep(vmClone *clonev1alpha1.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	if !needsSysprep(vmClone) {
+		return syncInfo
+	}
+
+	namespace := getTargetNamespace(vmClone)
+	vmName := vmClone.Spec.Target.Name
+
+	obj, exists, err := ctrl.vmInformer.GetStore().GetByKey(getKey(vmName, namespace))
+	if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("error getting VM %s/%s from cache for clone %s: %v", namespace, vmName, vmClone.Name, err))
+	} else if !exists {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("target VM %s/%s does not exist for clone %s", namespace, vmName, vmClone.Name))
+	}
+	vm := obj.(*k6tv1.VirtualMachine)
+
+	if _, stashed := vm.Annotations[sysprepRunStrategyAnnotation]; !stashed {
+		syncInfo.sysprepDone = true
+		return syncInfo
+	}
+
+	podName := getSysprepPodName(vm.Name)
+	obj, exists, err = ctrl.podInformer.GetStore().GetByKey(getKey(podName, namespace))
+	if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("error getting pod %s/%s from cache for clone %s: %v", namespace, podName, vmClone.Name, err))
+	}
+
+	if !exists {
+		pvcs, err := ctrl.getVMPersistentVolumeClaims(vm)
+		if err != nil {
+			return addErrorToSyncInfo(syncInfo, err)
+		}
+
+		if len(pvcs) == 0 {
+			syncInfo.logger.Infof("target VM %s/%s has no volumes to sysprep", namespace, vm.Name)
+			return ctrl.completeSysprep(vmClone, vm, nil, syncInfo)
+		}
+
+		pod := ctrl.generateSysprepPod(vmClone, vm, podName, pvcs)
+		_, err = ctrl.client.CoreV1().Pods(namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return addErrorToSyncInfo(syncInfo, fmt.Errorf("failed creating sysprep pod %s/%s for clone %s: %v", namespace, podName, vmClone.Name, err))
+		}
+
+		ctrl.logAndRecord(vmClone, SysprepStarted, fmt.Sprintf("started sysprep of target VM %s/%s for clone %s", namespace, vm.Name, vmClone.Name))
+		return syncInfo
+	}
+
+	pod := obj.(*corev1.Pod)
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return ctrl.completeSysprep(vmClone, vm, pod, syncInfo)
+	case corev1.PodFailed:
+		syncInfo.isCloneFailing = true
+		syncInfo.failEvent = SysprepFailed
+		syncInfo.failReason = fmt.Sprintf("sysprep of target VM %s/%s failed, see pod %s for details", namespace, vm.Name, pod.Name)
+	default:
+		syncInfo.logger.V(defaultVerbosityLevel).Infof("sysprep pod %s/%s for clone %s is in phase %s", namespace, pod.Name, vmClone.Name, pod.Status.Phase)
+	}
+
+	return syncInfo
+}
+
+// completeSysprep restores the run strategy of the target VM and cleans up the sysprep pod
+func (ctrl *VMCloneController) completeSysprep(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, pod *corev1.Pod, syncInfo syncInfoType) syncInfoType {
+	const patchPattern = `[{"op": "test", "path": "/metadata/annotations/%[1]s", "value": "%[2]s"}, {"op": "remove", "path": "/metadata/annotations/%[1]s"}, {"op": "add", "path": "/spec/runStrategy", "value": "%[2]s"}]`
+
+	runStrategy := vm.Annotations[sysprepRunStrategyAnnotation]
+	patch := fmt.Sprintf(patchPattern, addKeyEscapeCharacters(sysprepRunStrategyAnnotation), runStrategy)
+
+	_, err := ctrl.client.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, []byte(patch), &metav1.PatchOptions{})
+	if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("failed restoring the run strategy of target VM %s/%s for clone %s: %v", vm.Namespace, vm.Name, vmClone.Name, err))
+	}
+
+	if pod != nil {
+		err = ctrl.client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return addErrorToSyncInfo(syncInfo, fmt.Errorf("cannot clean up sysprep pod %s/%s for clone %s: %v", pod.Namespace, pod.Name, vmClone.Name, err))
+		}
+	}
+
+	ctrl.logAndRecord(vmClone, SysprepCompleted, fmt.Sprintf("completed sysprep of target VM %s/%s for clone %s", vm.Namespace, vm.Name, vmClone.Name))
+	syncInfo.sysprepDone = true
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) getVMPersistentVolumeClaims(vm *k6tv1.VirtualMachine) ([]*corev1.PersistentVolumeClaim, error) {
+	var pvcs []*corev1.PersistentVolumeClaim
+
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		var claimName string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.Name
+		default:
+			continue
+		}
+
+		pvc, err := ctrl.client.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.Background(), claimName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed getting PVC %s/%s of volume %s: %v", vm.Namespace, claimName, volume.Name, err)
+		}
+		pvcs = append(pvcs, pvc)
+	}
+
+	return pvcs, nil
+}
+
+// generateSysprepPod generates a libguestfs pod running virt-sysprep against all the volumes of the VM at once,
+// so operations relying on the layout of the guest (e.g. mount points spread over several disks) work
+func (ctrl *VMCloneController) generateSysprepPod(vmClone *clonev1alpha1.VirtualMachineClone, vm *k6tv1.VirtualMachine, name string, pvcs []*corev1.PersistentVolumeClaim) *corev1.Pod {
+	container := corev1.Container{
+		Name:            sysprepContainerName,
+		Image:           ctrl.libguestfsImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"virt-sysprep"},
+		// LIBGUESTFS_BACKEND sets libguestfs to directly use qemu
+		// LIBGUESTFS_PATH sets the path where the root, initrd and the kernel are located
+		// LIBGUESTFS_TMPDIR sets the path where temporary files generated by libguestfs are stored
+		Env: []corev1.EnvVar{
+			{Name: "LIBGUESTFS_BACKEND", Value: "direct"},
+			{Name: "LIBGUESTFS_PATH", Value: sysprepApplianceDir},
+			{Name: "LIBGUESTFS_TMPDIR", Value: sysprepTmpDir},
+			{Name: "HOME", Value: sysprepHomeDir},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: sysprepTmpVolumeName, MountPath: sysprepTmpDir},
+			{Name: sysprepHomeVolumeName, MountPath: sysprepHomeDir},
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				services.KvmDevice: resource.MustParse("1"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		},
+	}
+
+	volumes := []corev1.Volume{
+		{Name: sysprepTmpVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+		{Name: sysprepHomeVolumeName, VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+	}
+
+	for i, pvc := range pvcs {
+		volumeName := fmt.Sprintf("disk-%d", i)
+		volumes = append(volumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvc.Name,
+				},
+			},
+		})
+
+		var diskPath string
+		if storagetypes.IsPVCBlock(pvc.Spec.VolumeMode) {
+			diskPath = fmt.Sprintf("%s/%s", sysprepDeviceDir, volumeName)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       volumeName,
+				DevicePath: diskPath,
+			})
+		} else {
+			mountPath := fmt.Sprintf("%s/%s", sysprepDiskDir, volumeName)
+			diskPath = fmt.Sprintf("%s/disk.img", mountPath)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: mountPath,
+			})
+		}
+		container.Args = append(container.Args, "-a", diskPath)
+	}
+
+	if operations := vmClone.Spec.GuestIdentity.Sysprep.Operations; len(operations) > 0 {
+		container.Args = append(container.Args, "--operations", strings.Join(operations, ","))
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vm.Namespace,
+			Labels: map[string]string{
+				k6tv1.AppLabel: sysprepAppLabelValue,
+			},
+			Annotations: map[string]string{
+				cloneNameAnnotation:      vmClone.Name,
+				cloneNamespaceAnnotation: vmClone.Namespace,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, k6tv1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: pointer.Bool(true),
+				RunAsUser:    pointer.Int64(sysprepUser),
+				RunAsGroup:   pointer.Int64(sysprepUser),
+				FSGroup:      pointer.Int64(sysprepUser),
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Containers: []corev1.Container{container},
+			Volumes:    volumes,
+		},
+	}
+}
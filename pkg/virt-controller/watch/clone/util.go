@@ -22,6 +22,12 @@ import (
 const (
 	vmKind           = "VirtualMachine"
 	kubevirtApiGroup = "kubevirt.io"
+
+	cloneNameAnnotation      = "clone.kubevirt.io/name"
+	cloneNamespaceAnnotation = "clone.kubevirt.io/namespace"
+
+	// sysprepRunStrategyAnnotation stashes the run strategy of the target VM while it is kept halted for sysprep
+	sysprepRunStrategyAnnotation = "clone.kubevirt.io/sysprep-run-strategy"
 )
 
 // variable so can be overridden in tests
@@ -72,6 +78,23 @@ func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
 
+func generateTargetName(sourceName string) string {
+	return generateNameWithRandomSuffix(sourceName, "clone")
+}
+
+// getTargetNamespace returns the namespace the target is created in
+func getTargetNamespace(vmClone *clonev1alpha1.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace == nil || *vmClone.Spec.TargetNamespace == "" {
+		return vmClone.Namespace
+	}
+
+	return *vmClone.Spec.TargetNamespace
+}
+
+func isVolumeTarget(targetType cloneTargetType) bool {
+	return targetType == targetTypeDataVolume || targetType == targetTypePVC
+}
+
 func isInPhase(vmClone *clonev1alpha1.VirtualMachineClone, phase clonev1alpha1.VirtualMachineClonePhase) bool {
 	return vmClone.Status.Phase == phase
 }
@@ -95,12 +118,17 @@ func generateSnapshot(vmClone *clonev1alpha1.VirtualMachineClone, sourceVM *v1.V
 	}
 }
 
-func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *v1alpha1.VirtualMachineRestore {
+func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName, namespace, targetNamespace, cloneName, snapshotName string, cloneUID types.UID, patches []string) *v1alpha1.VirtualMachineRestore {
 	targetInfo = targetInfo.DeepCopy()
 	if targetInfo.Name == "" {
 		targetInfo.Name = generateVMName(sourceVMName)
 	}
 
+	var restoreTargetNamespace *string
+	if targetNamespace != namespace {
+		restoreTargetNamespace = pointer.String(targetNamespace)
+	}
+
 	return &v1alpha1.VirtualMachineRestore{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      generateRestoreName(cloneName, sourceVMName),
@@ -111,6 +139,7 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 		},
 		Spec: v1alpha1.VirtualMachineRestoreSpec{
 			Target:                     *targetInfo,
+			TargetNamespace:            restoreTargetNamespace,
 			VirtualMachineSnapshotName: snapshotName,
 			Patches:                    patches,
 		},
@@ -24,6 +24,8 @@ import (
 	"regexp"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/util/uuid"
+
 	"kubevirt.io/client-go/log"
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
@@ -47,6 +49,9 @@ func generatePatches(source *k6tv1.VirtualMachine, cloneSpec *clonev1alpha1.Virt
 	firmwareUUIDPatches := generateFirmwareUUIDPatches(source.Spec.Template.Spec.Domain.Firmware)
 	patches = append(patches, firmwareUUIDPatches...)
 
+	guestIdentityPatches := generateGuestIdentityPatches(source, cloneSpec.GuestIdentity)
+	patches = append(patches, guestIdentityPatches...)
+
 	log.Log.V(defaultVerbosityLevel).Object(source).Infof("patches generated for vm %s clone: %v", source.Name, patches)
 	return patches
 }
@@ -179,3 +184,55 @@ func generateFirmwareUUIDPatches(firmware *k6tv1.Firmware) (patches []string) {
 
 	return []string{firmwareUUIDPatch}
 }
+
+// generateGuestIdentityPatches regenerates the identity of the guest: a new firmware UUID, which cloud-init
+// derives its instance-id from, and a new hostname. When sysprep is requested the target is kept halted
+// until it completes, the original run strategy is stashed in an annotation to be restored afterwards.
+func generateGuestIdentityPatches(source *k6tv1.VirtualMachine, guestIdentity *clonev1alpha1.GuestIdentity) (patches []string) {
+	const (
+		hostnamePatchPattern     = `{"op": "add", "path": "/spec/template/spec/hostname", "value": "%s"}`
+		firmwareUUIDPatchPattern = `{"op": "add", "path": "/spec/template/spec/domain/firmware/uuid", "value": "%s"}`
+		firmwarePatchPattern     = `{"op": "add", "path": "/spec/template/spec/domain/firmware", "value": {"uuid": "%s"}}`
+		annotationsPatch         = `{"op": "add", "path": "/metadata/annotations", "value": {}}`
+		runStrategyPatchPattern  = `{"op": "add", "path": "/metadata/annotations/%s", "value": "%s"}`
+		removeRunningPatch       = `{"op": "remove", "path": "/spec/running"}`
+		haltPatchPattern         = `{"op": "add", "path": "/spec/runStrategy", "value": "%s"}`
+	)
+
+	if guestIdentity == nil {
+		return nil
+	}
+
+	if guestIdentity.Regenerate || guestIdentity.NewHostname != nil {
+		// An empty hostname defaults to the name of the target
+		hostname := ""
+		if guestIdentity.NewHostname != nil {
+			hostname = *guestIdentity.NewHostname
+		}
+		patches = append(patches, fmt.Sprintf(hostnamePatchPattern, hostname))
+
+		if source.Spec.Template.Spec.Domain.Firmware == nil {
+			patches = append(patches, fmt.Sprintf(firmwarePatchPattern, uuid.NewUUID()))
+		} else {
+			patches = append(patches, fmt.Sprintf(firmwareUUIDPatchPattern, uuid.NewUUID()))
+		}
+	}
+
+	if guestIdentity.Sysprep != nil {
+		runStrategy, err := source.RunStrategy()
+		if err != nil {
+			log.Log.Object(source).Reason(err).Error("cannot get run strategy of the source, using the default one for the target")
+		}
+
+		if len(source.Annotations) == 0 {
+			patches = append(patches, annotationsPatch)
+		}
+		patches = append(patches, fmt.Sprintf(runStrategyPatchPattern, addKeyEscapeCharacters(sysprepRunStrategyAnnotation), runStrategy))
+		if source.Spec.Running != nil {
+			patches = append(patches, removeRunningPatch)
+		}
+		patches = append(patches, fmt.Sprintf(haltPatchPattern, k6tv1.RunStrategyHalted))
+	}
+
+	return patches
+}
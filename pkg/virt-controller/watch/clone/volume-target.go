@@ -0,0 +1,243 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	exportv1 "kubevirt.io/api/export/v1alpha1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+const deleteAfterCompletionAnnotation = "cdi.kubevirt.io/storage.deleteAfterCompletion"
+
+// generateDataVolume generates the DataVolume populating the target volume in the target namespace
+type generateDataVolume func(name, namespace string) (*cdiv1.DataVolume, syncInfoType)
+
+func (ctrl *VMCloneController) syncSourceDataSource(source *cdiv1.DataSource, vmClone *clonev1alpha1.VirtualMachineClone) syncInfoType {
+	targetType := ctrl.getTargetType(vmClone)
+
+	switch targetType {
+	case targetTypeDataVolume, targetTypePVC:
+		return ctrl.syncTargetVolume(vmClone, source.Name, func(name, namespace string) (*cdiv1.DataVolume, syncInfoType) {
+			return generateDataVolumeFromDataSource(source, name, namespace), syncInfoType{}
+		})
+
+	default:
+		return syncInfoType{err: fmt.Errorf("target type is unknown: %s", targetType)}
+	}
+}
+
+func (ctrl *VMCloneController) syncSourceExport(source *exportv1.VirtualMachineExport, vmClone *clonev1alpha1.VirtualMachineClone) syncInfoType {
+	targetType := ctrl.getTargetType(vmClone)
+
+	switch targetType {
+	case targetTypeDataVolume, targetTypePVC:
+		return ctrl.syncTargetVolume(vmClone, source.Name, func(name, namespace string) (*cdiv1.DataVolume, syncInfoType) {
+			return generateDataVolumeFromExport(vmClone, source, name, namespace)
+		})
+
+	default:
+		return syncInfoType{err: fmt.Errorf("target type is unknown: %s", targetType)}
+	}
+}
+
+func (ctrl *VMCloneController) syncTargetVolume(vmClone *clonev1alpha1.VirtualMachineClone, sourceName string, generate generateDataVolume) syncInfoType {
+	syncInfo := syncInfoType{logger: log.Log.Object(vmClone)}
+
+	switch vmClone.Status.Phase {
+	case clonev1alpha1.PhaseUnset, clonev1alpha1.CreatingTargetVolume:
+
+		if vmClone.Status.TargetName == nil {
+			return ctrl.createTargetDataVolume(vmClone, sourceName, generate, syncInfo)
+		}
+
+		return ctrl.verifyTargetVolumeReady(vmClone, *vmClone.Status.TargetName, syncInfo)
+
+	default:
+		log.Log.Object(vmClone).Infof("clone %s is in phase %s - nothing to do", vmClone.Name, string(vmClone.Status.Phase))
+	}
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) createTargetDataVolume(vmClone *clonev1alpha1.VirtualMachineClone, sourceName string, generate generateDataVolume, syncInfo syncInfoType) syncInfoType {
+	name := vmClone.Spec.Target.Name
+	if name == "" {
+		name = generateTargetName(sourceName)
+	}
+	namespace := getTargetNamespace(vmClone)
+
+	// the DataVolume may have been created by a previous sync whose status update failed
+	obj, exists, err := ctrl.dataVolumeInformer.GetStore().GetByKey(getKey(name, namespace))
+	if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("error getting DataVolume %s/%s from cache for clone %s: %v", namespace, name, vmClone.Name, err))
+	}
+	if exists {
+		dv := obj.(*cdiv1.DataVolume)
+		if dv.Annotations[cloneNameAnnotation] != vmClone.Name || dv.Annotations[cloneNamespaceAnnotation] != vmClone.Namespace {
+			syncInfo.isCloneFailing = true
+			syncInfo.failEvent = TargetVolumeFailed
+			syncInfo.failReason = fmt.Sprintf("target DataVolume %s/%s already exists", namespace, name)
+			return syncInfo
+		}
+		syncInfo.targetVolumeName = name
+		return syncInfo
+	}
+
+	dv, generateSyncInfo := generate(name, namespace)
+	if generateSyncInfo.toReenqueue() {
+		generateSyncInfo.logger = syncInfo.logger
+		return generateSyncInfo
+	}
+
+	dv.Annotations = map[string]string{
+		cloneNameAnnotation:      vmClone.Name,
+		cloneNamespaceAnnotation: vmClone.Namespace,
+	}
+	if ctrl.getTargetType(vmClone) == targetTypePVC {
+		// only the PVC is left once the DataVolume is done
+		dv.Annotations[deleteAfterCompletionAnnotation] = "true"
+	}
+	syncInfo.logger.Infof("creating DataVolume %s/%s for clone %s", namespace, name, vmClone.Name)
+
+	_, err = ctrl.client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		// the cache is behind, the DataVolume is checked on the next sync
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("DataVolume %s/%s for clone %s is not in the cache yet", namespace, name, vmClone.Name))
+	} else if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("failed creating DataVolume %s/%s for clone %s: %v", namespace, name, vmClone.Name, err))
+	}
+
+	ctrl.logAndRecord(vmClone, TargetVolumeCreated, fmt.Sprintf("created target DataVolume %s/%s for clone %s", namespace, name, vmClone.Name))
+	syncInfo.targetVolumeName = name
+
+	return syncInfo
+}
+
+func (ctrl *VMCloneController) verifyTargetVolumeReady(vmClone *clonev1alpha1.VirtualMachineClone, name string, syncInfo syncInfoType) syncInfoType {
+	namespace := getTargetNamespace(vmClone)
+
+	obj, exists, err := ctrl.dataVolumeInformer.GetStore().GetByKey(getKey(name, namespace))
+	if err != nil {
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("error getting DataVolume %s/%s from cache for clone %s: %v", namespace, name, vmClone.Name, err))
+	}
+
+	if !exists {
+		if ctrl.getTargetType(vmClone) == targetTypePVC {
+			// the DataVolume is garbage collected once it succeeded
+			_, err := ctrl.client.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), name, metav1.GetOptions{})
+			if err == nil {
+				ctrl.logAndRecord(vmClone, TargetVolumeReady, fmt.Sprintf("target PVC %s/%s for clone %s is ready", namespace, name, vmClone.Name))
+				syncInfo.targetVolumeReady = true
+				return syncInfo
+			} else if !errors.IsNotFound(err) {
+				return addErrorToSyncInfo(syncInfo, err)
+			}
+		}
+
+		return addErrorToSyncInfo(syncInfo, fmt.Errorf("target DataVolume %s/%s is not created yet for clone %s", namespace, name, vmClone.Name))
+	}
+
+	dv := obj.(*cdiv1.DataVolume)
+	switch dv.Status.Phase {
+	case cdiv1.Succeeded:
+		ctrl.logAndRecord(vmClone, TargetVolumeReady, fmt.Sprintf("target DataVolume %s/%s for clone %s is ready", namespace, name, vmClone.Name))
+		syncInfo.targetVolumeReady = true
+	case cdiv1.Failed:
+		syncInfo.isCloneFailing = true
+		syncInfo.failEvent = TargetVolumeFailed
+		syncInfo.failReason = fmt.Sprintf("target DataVolume %s/%s failed", namespace, name)
+	default:
+		syncInfo.logger.V(defaultVerbosityLevel).Infof("target DataVolume %s/%s for clone %s is in phase %s", namespace, name, vmClone.Name, dv.Status.Phase)
+	}
+
+	return syncInfo
+}
+
+func generateDataVolumeFromDataSource(source *cdiv1.DataSource, name, namespace string) *cdiv1.DataVolume {
+	return &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			SourceRef: &cdiv1.DataVolumeSourceRef{
+				Kind:      cdiv1.DataVolumeDataSource,
+				Namespace: pointer.String(source.Namespace),
+				Name:      source.Name,
+			},
+			// CDI infers the size from the source
+			Storage: &cdiv1.StorageSpec{},
+		},
+	}
+}
+
+// generateDataVolumeFromExport generates a DataVolume cloning the PVC behind the single volume of the
+// export. CDI clones it across namespaces, the requester was authorized to do so when the clone was admitted.
+func generateDataVolumeFromExport(vmClone *clonev1alpha1.VirtualMachineClone, export *exportv1.VirtualMachineExport, name, namespace string) (*cdiv1.DataVolume, syncInfoType) {
+	var syncInfo syncInfoType
+
+	if export.Status == nil || export.Status.Phase != exportv1.Ready || export.Status.Links == nil || export.Status.Links.Internal == nil {
+		return nil, addErrorToSyncInfo(syncInfo, fmt.Errorf("export %s is not ready yet for clone %s", export.Name, vmClone.Name))
+	}
+
+	volumes := export.Status.Links.Internal.Volumes
+	if len(volumes) != 1 {
+		syncInfo.isCloneFailing = true
+		syncInfo.failEvent = SourceNotSupported
+		syncInfo.failReason = fmt.Sprintf("export %s has %d volumes, only exports of a single volume can be cloned", export.Name, len(volumes))
+		return nil, syncInfo
+	}
+
+	return &cdiv1.DataVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				PVC: &cdiv1.DataVolumeSourcePVC{
+					Namespace: export.Namespace,
+					Name:      getExportVolumePVCName(export, volumes[0]),
+				},
+			},
+			// CDI infers the size from the source
+			Storage: &cdiv1.StorageSpec{},
+		},
+	}, syncInfo
+}
+
+// getExportVolumePVCName returns the name of the PVC exported as the volume, the volumes of a
+// VirtualMachineSnapshot are exported from PVCs restored under the name of the export
+func getExportVolumePVCName(export *exportv1.VirtualMachineExport, volume exportv1.VirtualMachineExportVolume) string {
+	if export.Spec.Source.Kind == "VirtualMachineSnapshot" {
+		return fmt.Sprintf("%s-%s", export.Name, volume.Name)
+	}
+
+	return volume.Name
+}
@@ -560,8 +560,12 @@ func (c *DisruptionBudgetController) vmiNeedsEvictionPDB(vmiExists bool, vmi *vi
 	if !vmiExists || vmi.DeletionTimestamp != nil {
 		return false
 	}
+	return needsEvictionPDB(c.clusterConfig, vmi)
+}
 
-	evictionStrategy := migrations.VMIEvictionStrategy(c.clusterConfig, vmi)
+// needsEvictionPDB reports whether the eviction strategy of the VMI requires a PodDisruptionBudget of its own.
+func needsEvictionPDB(clusterConfig *virtconfig.ClusterConfig, vmi *virtv1.VirtualMachineInstance) bool {
+	evictionStrategy := migrations.VMIEvictionStrategy(clusterConfig, vmi)
 	if evictionStrategy == nil {
 		return false
 	}
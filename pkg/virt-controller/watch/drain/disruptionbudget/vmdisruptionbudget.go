@@ -0,0 +1,457 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package disruptionbudget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/pdbs"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const vmDisruptionBudgetPDBPrefix = "kubevirt-vm-disruption-budget-"
+
+// VMDisruptionBudgetController computes the status of VirtualMachineDisruptionBudgets and maintains
+// a PodDisruptionBudget which protects the covered VMIs from pod evictions. VMIs which have a
+// PodDisruptionBudget of their own because of their eviction strategy are left out of it, since the
+// eviction API refuses to evict pods which are selected by more than one PodDisruptionBudget.
+type VMDisruptionBudgetController struct {
+	clientset      kubecli.KubevirtClient
+	clusterConfig  *virtconfig.ClusterConfig
+	Queue          workqueue.RateLimitingInterface
+	budgetInformer cache.SharedIndexInformer
+	vmInformer     cache.SharedIndexInformer
+	vmiInformer    cache.SharedIndexInformer
+	poolInformer   cache.SharedIndexInformer
+	pdbInformer    cache.SharedIndexInformer
+	recorder       record.EventRecorder
+}
+
+func NewVMDisruptionBudgetController(
+	budgetInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	poolInformer cache.SharedIndexInformer,
+	pdbInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*VMDisruptionBudgetController, error) {
+
+	c := &VMDisruptionBudgetController{
+		Queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-vm-disruption-budget"),
+		budgetInformer: budgetInformer,
+		vmInformer:     vmInformer,
+		vmiInformer:    vmiInformer,
+		poolInformer:   poolInformer,
+		pdbInformer:    pdbInformer,
+		recorder:       recorder,
+		clientset:      clientset,
+		clusterConfig:  clusterConfig,
+	}
+
+	_, err := c.budgetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueBudget,
+		DeleteFunc: c.enqueueBudget,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueBudget(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{c.vmInformer, c.vmiInformer, c.poolInformer} {
+		_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueBudgetsInNamespace,
+			DeleteFunc: c.enqueueBudgetsInNamespace,
+			UpdateFunc: func(_, curr interface{}) { c.enqueueBudgetsInNamespace(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = c.pdbInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueBudgetOfPDB,
+		DeleteFunc: c.enqueueBudgetOfPDB,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueBudgetOfPDB(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *VMDisruptionBudgetController) enqueueBudget(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from virtualmachinedisruptionbudget.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *VMDisruptionBudgetController) enqueueBudgetsInNamespace(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	o, ok := obj.(v1.Object)
+	if !ok {
+		log.Log.Reason(fmt.Errorf("unexpected object %+v", obj)).Error(deleteNotifFail)
+		return
+	}
+
+	budgets, err := c.budgetInformer.GetIndexer().ByIndex(cache.NamespaceIndex, o.GetNamespace())
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to fetch virtualmachinedisruptionbudgets for namespace from cache.")
+		return
+	}
+	for _, budget := range budgets {
+		c.enqueueBudget(budget)
+	}
+}
+
+func (c *VMDisruptionBudgetController) enqueueBudgetOfPDB(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pdb, ok := obj.(*policyv1.PodDisruptionBudget)
+	if !ok {
+		log.Log.Reason(fmt.Errorf("tombstone contained object that is not a pdb %#v", obj)).Error(deleteNotifFail)
+		return
+	}
+
+	controllerRef := v1.GetControllerOf(pdb)
+	if controllerRef == nil || controllerRef.Kind != poolv1.VirtualMachineDisruptionBudgetKind {
+		return
+	}
+	c.Queue.Add(pdb.Namespace + "/" + controllerRef.Name)
+}
+
+// Run runs the passed in VMDisruptionBudgetController.
+func (c *VMDisruptionBudgetController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting vm disruption budget controller.")
+
+	cache.WaitForCacheSync(stopCh, c.budgetInformer.HasSynced, c.vmInformer.HasSynced, c.vmiInformer.HasSynced, c.poolInformer.HasSynced, c.pdbInformer.HasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping vm disruption budget controller.")
+}
+
+func (c *VMDisruptionBudgetController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *VMDisruptionBudgetController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	err := c.execute(key.(string))
+
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineDisruptionBudget %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineDisruptionBudget %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *VMDisruptionBudgetController) execute(key string) error {
+	obj, exists, err := c.budgetInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	// The PodDisruptionBudget of a removed budget is garbage collected
+	if !exists {
+		return nil
+	}
+
+	budget := obj.(*poolv1.VirtualMachineDisruptionBudget)
+	if budget.DeletionTimestamp != nil {
+		return nil
+	}
+	return c.sync(budget)
+}
+
+func (c *VMDisruptionBudgetController) sync(budget *poolv1.VirtualMachineDisruptionBudget) error {
+	vmis, err := c.coveredVMIs(budget)
+	if err != nil {
+		return err
+	}
+
+	expected, err := c.expectedVirtualMachines(budget, vmis)
+	if err != nil {
+		return err
+	}
+
+	var healthy int32
+	for _, vmi := range vmis {
+		if isHealthy(vmi) {
+			healthy++
+		}
+	}
+	desired := desiredHealthy(budget, expected)
+
+	status := poolv1.VirtualMachineDisruptionBudgetStatus{
+		ObservedGeneration:      budget.Generation,
+		ExpectedVirtualMachines: expected,
+		CurrentHealthy:          healthy,
+		DesiredHealthy:          desired,
+	}
+	if healthy > desired {
+		status.DisruptionsAllowed = healthy - desired
+	}
+
+	pdbName, syncErr := c.syncPDB(budget, vmis, desired)
+	status.PodDisruptionBudgetName = pdbName
+
+	if !equality.Semantic.DeepEqual(budget.Status, status) {
+		budgetCopy := budget.DeepCopy()
+		budgetCopy.Status = status
+		if _, err := c.clientset.VirtualMachineDisruptionBudget(budget.Namespace).UpdateStatus(context.Background(), budgetCopy, v1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+func (c *VMDisruptionBudgetController) coveredVMIs(budget *poolv1.VirtualMachineDisruptionBudget) ([]*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.vmiInformer.GetIndexer().ByIndex(cache.NamespaceIndex, budget.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() || !pdbs.VMDisruptionBudgetCoversVMI(budget, vmi, c.vmInformer.GetStore()) {
+			continue
+		}
+		vmis = append(vmis, vmi)
+	}
+	return vmis, nil
+}
+
+// expectedVirtualMachines counts the VirtualMachines which are expected to run. For a pool these are
+// its replicas, for a selector the VirtualMachines which are not halted. VMIs without such a
+// VirtualMachine are counted on their own.
+func (c *VMDisruptionBudgetController) expectedVirtualMachines(budget *poolv1.VirtualMachineDisruptionBudget, vmis []*virtv1.VirtualMachineInstance) (int32, error) {
+	if budget.Spec.VirtualMachinePoolName != "" {
+		obj, exists, err := c.poolInformer.GetStore().GetByKey(budget.Namespace + "/" + budget.Spec.VirtualMachinePoolName)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			return int32(len(vmis)), nil
+		}
+		pool := obj.(*poolv1.VirtualMachinePool)
+		if pool.Spec.Replicas == nil {
+			return 1, nil
+		}
+		return *pool.Spec.Replicas, nil
+	}
+
+	selector, err := v1.LabelSelectorAsSelector(budget.Spec.Selector)
+	if err != nil {
+		return 0, err
+	}
+	objs, err := c.vmInformer.GetIndexer().ByIndex(cache.NamespaceIndex, budget.Namespace)
+	if err != nil {
+		return 0, err
+	}
+
+	counted := map[string]bool{}
+	for _, obj := range objs {
+		vm := obj.(*virtv1.VirtualMachine)
+		if vm.DeletionTimestamp != nil || vm.Spec.Template == nil || !selector.Matches(labels.Set(vm.Spec.Template.ObjectMeta.Labels)) {
+			continue
+		}
+		runStrategy, err := vm.RunStrategy()
+		if err != nil || runStrategy == virtv1.RunStrategyHalted {
+			continue
+		}
+		counted[vm.Name] = true
+	}
+
+	expected := int32(len(counted))
+	for _, vmi := range vmis {
+		if vmRef := v1.GetControllerOf(vmi); vmRef != nil && counted[vmRef.Name] {
+			continue
+		}
+		expected++
+	}
+	return expected, nil
+}
+
+func desiredHealthy(budget *poolv1.VirtualMachineDisruptionBudget, expected int32) int32 {
+	if budget.Spec.MinAvailable != nil {
+		minAvailable, err := intstr.GetScaledValueFromIntOrPercent(budget.Spec.MinAvailable, int(expected), true)
+		if err != nil {
+			return expected
+		}
+		return int32(minAvailable)
+	}
+
+	maxUnavailable := intstr.FromInt(1)
+	if budget.Spec.MaxUnavailable != nil {
+		maxUnavailable = *budget.Spec.MaxUnavailable
+	}
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, int(expected), true)
+	if err != nil {
+		return expected
+	}
+	if desired := expected - int32(unavailable); desired > 0 {
+		return desired
+	}
+	return 0
+}
+
+func isHealthy(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.DeletionTimestamp == nil && vmi.IsRunning() &&
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, corev1.ConditionTrue)
+}
+
+// syncPDB maintains the PodDisruptionBudget of the covered VMIs which do not have one of their own.
+// The healthy VMIs with their own PodDisruptionBudget count towards the desired healthy VMIs, the
+// remainder has to stay available among the selected pods.
+func (c *VMDisruptionBudgetController) syncPDB(budget *poolv1.VirtualMachineDisruptionBudget, vmis []*virtv1.VirtualMachineInstance, desired int32) (string, error) {
+	var uids []string
+	minAvailable := int(desired)
+	for _, vmi := range vmis {
+		if !needsEvictionPDB(c.clusterConfig, vmi) {
+			if vmi.DeletionTimestamp == nil {
+				uids = append(uids, string(vmi.UID))
+			}
+		} else if isHealthy(vmi) {
+			minAvailable--
+		}
+	}
+	if minAvailable < 0 {
+		minAvailable = 0
+	}
+	sort.Strings(uids)
+
+	name := vmDisruptionBudgetPDBPrefix + budget.Name
+	obj, exists, err := c.pdbInformer.GetStore().GetByKey(budget.Namespace + "/" + name)
+	if err != nil {
+		return "", err
+	}
+	var pdb *policyv1.PodDisruptionBudget
+	if exists {
+		pdb = obj.(*policyv1.PodDisruptionBudget)
+		if controllerRef := v1.GetControllerOf(pdb); controllerRef == nil || controllerRef.UID != budget.UID {
+			return "", fmt.Errorf("PodDisruptionBudget %s/%s is not controlled by VirtualMachineDisruptionBudget %s", pdb.Namespace, pdb.Name, budget.Name)
+		}
+	}
+
+	if len(uids) == 0 {
+		if pdb == nil || pdb.DeletionTimestamp != nil {
+			return "", nil
+		}
+		err := c.clientset.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Delete(context.Background(), pdb.Name, v1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			c.recorder.Eventf(budget, corev1.EventTypeWarning, FailedDeletePodDisruptionBudgetReason, "Error deleting the PodDisruptionBudget %s: %v", pdb.Name, err)
+			return pdb.Name, err
+		}
+		c.recorder.Eventf(budget, corev1.EventTypeNormal, SuccessfulDeletePodDisruptionBudgetReason, "Deleted PodDisruptionBudget %s", pdb.Name)
+		return "", nil
+	}
+
+	minAvailableValue := intstr.FromInt(minAvailable)
+	spec := policyv1.PodDisruptionBudgetSpec{
+		MinAvailable: &minAvailableValue,
+		Selector: &v1.LabelSelector{
+			MatchExpressions: []v1.LabelSelectorRequirement{{
+				Key:      virtv1.CreatedByLabel,
+				Operator: v1.LabelSelectorOpIn,
+				Values:   uids,
+			}},
+		},
+	}
+
+	if pdb == nil {
+		_, err := c.clientset.PolicyV1().PodDisruptionBudgets(budget.Namespace).Create(context.Background(), &policyv1.PodDisruptionBudget{
+			ObjectMeta: v1.ObjectMeta{
+				Name: name,
+				OwnerReferences: []v1.OwnerReference{
+					*v1.NewControllerRef(budget, poolv1.SchemeGroupVersion.WithKind(poolv1.VirtualMachineDisruptionBudgetKind)),
+				},
+			},
+			Spec: spec,
+		}, v1.CreateOptions{})
+		if err != nil {
+			c.recorder.Eventf(budget, corev1.EventTypeWarning, FailedCreatePodDisruptionBudgetReason, "Error creating a PodDisruptionBudget: %v", err)
+			return "", err
+		}
+		c.recorder.Eventf(budget, corev1.EventTypeNormal, SuccessfulCreatePodDisruptionBudgetReason, "Created PodDisruptionBudget %s", name)
+		return name, nil
+	}
+
+	if equality.Semantic.DeepEqual(pdb.Spec.MinAvailable, spec.MinAvailable) && equality.Semantic.DeepEqual(pdb.Spec.Selector, spec.Selector) {
+		return name, nil
+	}
+	patchBytes, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"minAvailable": spec.MinAvailable,
+			"selector":     spec.Selector,
+		},
+	})
+	if err != nil {
+		return name, err
+	}
+	if _, err := c.clientset.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Patch(context.Background(), pdb.Name, types.MergePatchType, patchBytes, v1.PatchOptions{}); err != nil {
+		c.recorder.Eventf(budget, corev1.EventTypeWarning, FailedUpdatePodDisruptionBudgetReason, "Error updating the PodDisruptionBudget %s: %v", name, err)
+		return name, err
+	}
+	return name, nil
+}
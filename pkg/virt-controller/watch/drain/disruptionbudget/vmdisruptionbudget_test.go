@@ -0,0 +1,319 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package disruptionbudget_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
+)
+
+var _ = Describe("VirtualMachineDisruptionBudget", func() {
+
+	const pdbName = "kubevirt-vm-disruption-budget-etcd"
+
+	var virtClient *kubecli.MockKubevirtClient
+	var kubeClient *fake.Clientset
+	var kubevirtClient *kubevirtfake.Clientset
+	var budgetInformer cache.SharedIndexInformer
+	var vmInformer cache.SharedIndexInformer
+	var vmiInformer cache.SharedIndexInformer
+	var poolInformer cache.SharedIndexInformer
+	var pdbInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var controller *disruptionbudget.VMDisruptionBudgetController
+
+	initController := func(kvConfig *v1.KubeVirtConfiguration) {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(kvConfig)
+		var err error
+		controller, err = disruptionbudget.NewVMDisruptionBudgetController(budgetInformer, vmInformer, vmiInformer, poolInformer, pdbInformer, recorder, virtClient, config)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineDisruptionBudget(metav1.NamespaceDefault).Return(
+			kubevirtClient.PoolV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault)).AnyTimes()
+
+		budgetInformer, _ = testutils.NewFakeInformerFor(&poolv1.VirtualMachineDisruptionBudget{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		poolInformer, _ = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+		pdbInformer, _ = testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+		initController(&v1.KubeVirtConfiguration{})
+	})
+
+	addBudget := func(budget *poolv1.VirtualMachineDisruptionBudget) {
+		Expect(budgetInformer.GetStore().Add(budget)).To(Succeed())
+		_, err := kubevirtClient.PoolV1alpha1().VirtualMachineDisruptionBudgets(budget.Namespace).Create(context.Background(), budget, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMIs := func(vmis ...*v1.VirtualMachineInstance) {
+		for _, vmi := range vmis {
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		}
+	}
+
+	execute := func(budget *poolv1.VirtualMachineDisruptionBudget) *poolv1.VirtualMachineDisruptionBudget {
+		key, err := cache.MetaNamespaceKeyFunc(budget)
+		Expect(err).ToNot(HaveOccurred())
+		controller.Queue.Add(key)
+		controller.Execute()
+
+		updated, err := kubevirtClient.PoolV1alpha1().VirtualMachineDisruptionBudgets(budget.Namespace).Get(context.Background(), budget.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated
+	}
+
+	getPDB := func() *policyv1.PodDisruptionBudget {
+		pdb, err := kubeClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceDefault).Get(context.Background(), pdbName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pdb
+	}
+
+	It("should compute the status and protect the covered VMIs with a PodDisruptionBudget", func() {
+		budget := newVMDisruptionBudget(nil)
+		addBudget(budget)
+		addVMIs(
+			newCoveredVMI("etcd-0", true),
+			newCoveredVMI("etcd-1", true),
+			newCoveredVMI("etcd-2", false),
+		)
+
+		updated := execute(budget)
+
+		Expect(updated.Status.ExpectedVirtualMachines).To(BeEquivalentTo(3))
+		Expect(updated.Status.CurrentHealthy).To(BeEquivalentTo(2))
+		Expect(updated.Status.DesiredHealthy).To(BeEquivalentTo(2))
+		Expect(updated.Status.DisruptionsAllowed).To(BeEquivalentTo(0))
+		Expect(updated.Status.PodDisruptionBudgetName).To(Equal(pdbName))
+
+		pdb := getPDB()
+		Expect(pdb.Spec.MinAvailable.IntValue()).To(Equal(2))
+		Expect(pdb.Spec.Selector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+			Key:      v1.CreatedByLabel,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{"etcd-0-uid", "etcd-1-uid", "etcd-2-uid"},
+		}))
+		Expect(metav1.IsControlledBy(pdb, budget)).To(BeTrue())
+		testutils.ExpectEvent(recorder, disruptionbudget.SuccessfulCreatePodDisruptionBudgetReason)
+	})
+
+	It("should count the stopped VirtualMachines matching the selector as expected", func() {
+		budget := newVMDisruptionBudget(pointer.P(intstr.FromString("50%")))
+		addBudget(budget)
+		addVMIs(newCoveredVMI("etcd-0", true))
+		for _, runStrategy := range []v1.VirtualMachineRunStrategy{v1.RunStrategyAlways, v1.RunStrategyHalted, v1.RunStrategyManual} {
+			vm := &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm-" + string(runStrategy), Namespace: metav1.NamespaceDefault},
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: pointer.P(runStrategy),
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "etcd"}},
+					},
+				},
+			}
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}
+
+		updated := execute(budget)
+
+		// Two VirtualMachines which are not halted and the VMI without a VirtualMachine
+		Expect(updated.Status.ExpectedVirtualMachines).To(BeEquivalentTo(3))
+		Expect(updated.Status.DesiredHealthy).To(BeEquivalentTo(2))
+		Expect(updated.Status.DisruptionsAllowed).To(BeEquivalentTo(0))
+	})
+
+	It("should use the replicas of the pool as expected VirtualMachines", func() {
+		budget := newVMDisruptionBudget(nil)
+		budget.Spec.Selector = nil
+		budget.Spec.VirtualMachinePoolName = "etcd"
+		addBudget(budget)
+		Expect(poolInformer.GetStore().Add(&poolv1.VirtualMachinePool{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd", Namespace: metav1.NamespaceDefault},
+			Spec:       poolv1.VirtualMachinePoolSpec{Replicas: pointer.P(int32(4))},
+		})).To(Succeed())
+
+		for i, name := range []string{"etcd-0", "etcd-1", "etcd-2", "etcd-3"} {
+			vm := &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+					UID:       types.UID(name + "-vm-uid"),
+					OwnerReferences: []metav1.OwnerReference{{
+						Kind:       poolv1.VirtualMachinePoolKind,
+						Name:       "etcd",
+						Controller: pointer.P(true),
+					}},
+				},
+			}
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+			vmi := newCoveredVMI(name, i < 3)
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+			addVMIs(vmi)
+		}
+		// A VMI with the labels which is not part of the pool
+		addVMIs(newCoveredVMI("other", true))
+
+		updated := execute(budget)
+
+		Expect(updated.Status.ExpectedVirtualMachines).To(BeEquivalentTo(4))
+		Expect(updated.Status.CurrentHealthy).To(BeEquivalentTo(3))
+		Expect(updated.Status.DesiredHealthy).To(BeEquivalentTo(3))
+		Expect(getPDB().Spec.Selector.MatchExpressions[0].Values).To(HaveLen(4))
+	})
+
+	It("should leave VMIs with a PodDisruptionBudget of their own out", func() {
+		initController(&v1.KubeVirtConfiguration{EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate)})
+		budget := newVMDisruptionBudget(nil)
+		addBudget(budget)
+		vmi := newCoveredVMI("etcd-2", true)
+		vmi.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyNone)
+		addVMIs(newCoveredVMI("etcd-0", true), newCoveredVMI("etcd-1", true), vmi)
+
+		updated := execute(budget)
+
+		Expect(updated.Status.DesiredHealthy).To(BeEquivalentTo(2))
+		Expect(updated.Status.DisruptionsAllowed).To(BeEquivalentTo(1))
+		pdb := getPDB()
+		Expect(pdb.Spec.MinAvailable.IntValue()).To(Equal(0))
+		Expect(pdb.Spec.Selector.MatchExpressions[0].Values).To(ConsistOf("etcd-2-uid"))
+	})
+
+	It("should update an outdated PodDisruptionBudget", func() {
+		budget := newVMDisruptionBudget(nil)
+		addBudget(budget)
+		addVMIs(newCoveredVMI("etcd-0", true), newCoveredVMI("etcd-1", true))
+		minAvailable := intstr.FromInt(5)
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            pdbName,
+				Namespace:       metav1.NamespaceDefault,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(budget, poolv1.SchemeGroupVersion.WithKind(poolv1.VirtualMachineDisruptionBudgetKind))},
+			},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+				Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      v1.CreatedByLabel,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   []string{"etcd-0-uid"},
+					}},
+				},
+			},
+		}
+		Expect(pdbInformer.GetStore().Add(pdb)).To(Succeed())
+		_, err := kubeClient.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(context.Background(), pdb, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		execute(budget)
+
+		updated := getPDB()
+		Expect(updated.Spec.MinAvailable.IntValue()).To(Equal(1))
+		Expect(updated.Spec.Selector.MatchExpressions[0].Values).To(ConsistOf("etcd-0-uid", "etcd-1-uid"))
+	})
+
+	It("should remove the PodDisruptionBudget if no VMI needs it", func() {
+		initController(&v1.KubeVirtConfiguration{EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate)})
+		budget := newVMDisruptionBudget(nil)
+		addBudget(budget)
+		addVMIs(newCoveredVMI("etcd-0", true))
+		pdb := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            pdbName,
+				Namespace:       metav1.NamespaceDefault,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(budget, poolv1.SchemeGroupVersion.WithKind(poolv1.VirtualMachineDisruptionBudgetKind))},
+			},
+		}
+		Expect(pdbInformer.GetStore().Add(pdb)).To(Succeed())
+		_, err := kubeClient.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Create(context.Background(), pdb, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		updated := execute(budget)
+
+		Expect(updated.Status.PodDisruptionBudgetName).To(BeEmpty())
+		_, err = kubeClient.PolicyV1().PodDisruptionBudgets(pdb.Namespace).Get(context.Background(), pdbName, metav1.GetOptions{})
+		Expect(err).To(HaveOccurred())
+		testutils.ExpectEvent(recorder, disruptionbudget.SuccessfulDeletePodDisruptionBudgetReason)
+	})
+})
+
+func newVMDisruptionBudget(minAvailable *intstr.IntOrString) *poolv1.VirtualMachineDisruptionBudget {
+	return &poolv1.VirtualMachineDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "etcd",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "etcd-budget-uid",
+		},
+		Spec: poolv1.VirtualMachineDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "etcd"},
+			},
+			MinAvailable: minAvailable,
+		},
+	}
+}
+
+func newCoveredVMI(name string, ready bool) *v1.VirtualMachineInstance {
+	vmi := &v1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{"app": "etcd"},
+		},
+		Status: v1.VirtualMachineInstanceStatus{
+			Phase: v1.Running,
+		},
+	}
+	if ready {
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceReady,
+			Status: corev1.ConditionTrue,
+		}}
+	}
+	return vmi
+}
@@ -16,6 +16,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/pdbs"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -43,6 +44,8 @@ type EvacuationController struct {
 	vmiInformer           cache.SharedIndexInformer
 	vmiPodInformer        cache.SharedIndexInformer
 	migrationInformer     cache.SharedIndexInformer
+	vmInformer            cache.SharedIndexInformer
+	vmdbInformer          cache.SharedIndexInformer
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	nodeInformer          cache.SharedIndexInformer
@@ -54,6 +57,8 @@ func NewEvacuationController(
 	migrationInformer cache.SharedIndexInformer,
 	nodeInformer cache.SharedIndexInformer,
 	vmiPodInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmdbInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -65,6 +70,8 @@ func NewEvacuationController(
 		migrationInformer:     migrationInformer,
 		nodeInformer:          nodeInformer,
 		vmiPodInformer:        vmiPodInformer,
+		vmInformer:            vmInformer,
+		vmdbInformer:          vmdbInformer,
 		recorder:              recorder,
 		clientset:             clientset,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -283,7 +290,7 @@ func (c *EvacuationController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting evacuation controller.")
 
 	// Wait for cache sync before we start the node controller
-	cache.WaitForCacheSync(stopCh, c.migrationInformer.HasSynced, c.vmiInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.migrationInformer.HasSynced, c.vmiInformer.HasSynced, c.vmInformer.HasSynced, c.vmdbInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -393,8 +400,7 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 
 	runningMigrations := migrationutils.FilterRunningMigrations(activeMigrations)
 	activeMigrationsFromThisSourceNode := c.numOfVMIMForThisSourceNode(vmisOnNode, runningMigrations)
-	maxParallelMigrationsPerOutboundNode :=
-		int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+	maxParallelMigrationsPerOutboundNode := migrationutils.ParallelOutboundMigrationsForNode(node, *c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
 	maxParallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
 	freeSpotsPerCluster := maxParallelMigrations - len(runningMigrations)
 	freeSpotsPerThisSourceNode := maxParallelMigrationsPerOutboundNode - activeMigrationsFromThisSourceNode
@@ -426,11 +432,20 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 		return nil
 	}
 
-	// TODO: should the order be randomized?
-	selectedCandidates := migrationCandidates[0:diff]
+	migrationutils.SortByEvacuationOrder(migrationCandidates, c.clusterConfig.GetMigrationConfiguration().EvacuationOrder, c.evacuationPriority)
+	selectedCandidates, heldBack := c.selectCandidates(migrationCandidates, activeMigrations, diff)
 
 	log.DefaultLogger().Infof("node: %v, migrations: %v, candidates: %v, selected: %v", node.Name, len(activeMigrations), len(migrationCandidates), len(selectedCandidates))
 
+	if heldBack {
+		// VirtualMachineDisruptionBudgets prevent some migrations, check again once the VMIs recovered
+		c.Queue.AddAfter(node.Name, 5*time.Second)
+	}
+	diff = len(selectedCandidates)
+	if diff == 0 {
+		return nil
+	}
+
 	wg := &sync.WaitGroup{}
 	wg.Add(diff)
 
@@ -462,6 +477,32 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	return nil
 }
 
+// selectCandidates selects up to count candidates whose VirtualMachineDisruptionBudgets allow a migration.
+// It reports whether candidates were held back by a budget.
+func (c *EvacuationController) selectCandidates(candidates []*virtv1.VirtualMachineInstance, activeMigrations []*virtv1.VirtualMachineInstanceMigration, count int) (selected []*virtv1.VirtualMachineInstance, heldBack bool) {
+	tracker := pdbs.NewDisruptionBudgetTracker(c.vmdbInformer, c.vmInformer, c.vmiInformer, activeMigrations)
+	for _, vmi := range candidates {
+		if len(selected) == count {
+			break
+		}
+		if !tracker.Allow(vmi) {
+			heldBack = true
+			continue
+		}
+		selected = append(selected, vmi)
+	}
+	return selected, heldBack
+}
+
+// evacuationPriority returns the priority of the current virt-launcher pod of the VMI
+func (c *EvacuationController) evacuationPriority(vmi *virtv1.VirtualMachineInstance) int32 {
+	pod, err := controller.CurrentVMIPod(vmi, c.vmiPodInformer)
+	if err != nil {
+		return 0
+	}
+	return migrationutils.EvacuationPriority(pod)
+}
+
 func hasMigratedOnEviction(vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi.Status.NodeName != vmi.Status.EvacuationNodeName
 }
@@ -6,8 +6,10 @@ import (
 
 	"github.com/golang/mock/gomock"
 	v12 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -17,6 +19,7 @@ import (
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -41,6 +44,8 @@ var _ = Describe("Evacuation", func() {
 	var migrationSource *framework.FakeControllerSource
 	var podInformer cache.SharedIndexInformer
 	var podSource *framework.FakeControllerSource
+	var vmInformer cache.SharedIndexInformer
+	var vmdbInformer cache.SharedIndexInformer
 	var recorder *record.FakeRecorder
 	var mockQueue *testutils.MockWorkQueue
 	var kubeClient *fake.Clientset
@@ -84,11 +89,13 @@ var _ = Describe("Evacuation", func() {
 		migrationInformer, migrationSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		nodeInformer, nodeSource = testutils.NewFakeInformerFor(&v12.Node{})
 		podInformer, podSource = testutils.NewFakeInformerFor(&v12.Pod{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmdbInformer, _ = testutils.NewFakeInformerFor(&poolv1.VirtualMachineDisruptionBudget{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
-		controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
 		migrationFeeder = testutils.NewMigrationFeeder(mockQueue, migrationSource)
@@ -318,6 +325,8 @@ var _ = Describe("Evacuation", func() {
 					migrationInformer,
 					nodeInformer,
 					podInformer,
+					vmInformer,
+					vmdbInformer,
 					recorder,
 					virtClient,
 					config)
@@ -406,7 +415,7 @@ var _ = Describe("Evacuation", func() {
 			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 				EvictionStrategy: newEvictionStrategyLiveMigrate(),
 			})
-			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
 
 			node := newNode("testnode")
 			node1 := newNode("anothernode")
@@ -427,7 +436,7 @@ var _ = Describe("Evacuation", func() {
 			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 				EvictionStrategy: newEvictionStrategyLiveMigrate(),
 			})
-			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
 
 			node := newNode("testnode")
 			node1 := newNode("anothernode")
@@ -460,6 +469,8 @@ var _ = Describe("Evacuation", func() {
 					migrationInformer,
 					nodeInformer,
 					podInformer,
+					vmInformer,
+					vmdbInformer,
 					recorder,
 					virtClient,
 					config)
@@ -510,6 +521,8 @@ var _ = Describe("Evacuation", func() {
 					migrationInformer,
 					nodeInformer,
 					podInformer,
+					vmInformer,
+					vmdbInformer,
 					recorder,
 					virtClient,
 					config)
@@ -540,6 +553,147 @@ var _ = Describe("Evacuation", func() {
 			testutils.ExpectEvent(recorder, evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason)
 
 		})
+
+		It("should not create more migrations than the VirtualMachineDisruptionBudget allows", func() {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				MigrationConfiguration: &v1.MigrationConfiguration{
+					ParallelMigrationsPerCluster:      pointer.P(uint32(10)),
+					ParallelOutboundMigrationsPerNode: pointer.P(uint32(10)),
+				},
+			})
+			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
+			mockQueue = testutils.NewMockWorkQueue(controller.Queue)
+			controller.Queue = mockQueue
+			migrationFeeder = testutils.NewMigrationFeeder(mockQueue, migrationSource)
+			vmiFeeder = testutils.NewVirtualMachineFeeder(mockQueue, vmiSource)
+
+			nodeName := "node01"
+			addNode(newNode(nodeName))
+
+			Expect(vmdbInformer.GetStore().Add(&poolv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: v13.ObjectMeta{Name: "etcd", Namespace: v12.NamespaceDefault},
+				Spec: poolv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &v13.LabelSelector{MatchLabels: map[string]string{"app": "etcd"}},
+				},
+				Status: poolv1.VirtualMachineDisruptionBudgetStatus{DisruptionsAllowed: 2},
+			})).To(Succeed())
+
+			By("Creating a running migration of a VMI covered by the budget")
+			vmi := newVirtualMachineMarkedForEviction("etcd-0", nodeName)
+			vmi.Labels = map[string]string{"app": "etcd"}
+			vmiFeeder.Add(vmi)
+			migrationFeeder.Add(newMigration("mig0", vmi.Name, v1.MigrationRunning))
+
+			By("Creating three candidates covered by the budget")
+			for i := 1; i <= 3; i++ {
+				vmi := newVirtualMachineMarkedForEviction(fmt.Sprintf("etcd-%d", i), nodeName)
+				vmi.Labels = map[string]string{"app": "etcd"}
+				vmiFeeder.Add(vmi)
+			}
+
+			By("Expecting only the one migration the budget still allows")
+			migrationInterface.
+				EXPECT().
+				Create(gomock.Any(), &v13.CreateOptions{}).
+				Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).
+				Times(1)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+	})
+
+	Context("evacuation order and concurrency", func() {
+		var migratedVMIs []string
+
+		newControllerWithMigrationConfig := func(migrationConfig *v1.MigrationConfiguration) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				MigrationConfiguration: migrationConfig,
+			})
+			controller, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, vmInformer, vmdbInformer, recorder, virtClient, config)
+			mockQueue = testutils.NewMockWorkQueue(controller.Queue)
+			controller.Queue = mockQueue
+			migrationFeeder = testutils.NewMigrationFeeder(mockQueue, migrationSource)
+			vmiFeeder = testutils.NewVirtualMachineFeeder(mockQueue, vmiSource)
+		}
+
+		expectMigrations := func(count int) {
+			migratedVMIs = nil
+			migrationInterface.
+				EXPECT().
+				Create(gomock.Any(), &v13.CreateOptions{}).
+				DoAndReturn(func(migration *v1.VirtualMachineInstanceMigration, _ *v13.CreateOptions) (*v1.VirtualMachineInstanceMigration, error) {
+					migratedVMIs = append(migratedVMIs, migration.Spec.VMIName)
+					return &v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil
+				}).
+				Times(count)
+		}
+
+		addVMIWithMemory := func(name, nodeName, memory string, priority *int32) {
+			vmi := newVirtualMachineMarkedForEviction(name, nodeName)
+			vmi.UID = types.UID(name)
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse(memory))}
+			if priority != nil {
+				pod := newPod(vmi, name+"-launcher", v12.PodRunning, true)
+				pod.OwnerReferences = []v13.OwnerReference{*v13.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)}
+				pod.Spec.NodeName = nodeName
+				pod.Spec.Priority = priority
+				Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+			}
+			vmiFeeder.Add(vmi)
+		}
+
+		DescribeTable("should migrate VMIs in evacuation order", func(order *v1.EvacuationOrder, expectedVMIs ...string) {
+			newControllerWithMigrationConfig(&v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster:      pointer.P(uint32(10)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(2)),
+				EvacuationOrder:                   order,
+			})
+
+			nodeName := "node01"
+			addNode(newNode(nodeName))
+			addVMIWithMemory("small", nodeName, "1Gi", nil)
+			addVMIWithMemory("medium", nodeName, "4Gi", pointer.P(int32(0)))
+			addVMIWithMemory("large", nodeName, "8Gi", nil)
+			addVMIWithMemory("prioritized", nodeName, "2Gi", pointer.P(int32(1000)))
+
+			expectMigrations(2)
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason, evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			Expect(migratedVMIs).To(ConsistOf(expectedVMIs))
+		},
+			Entry("with the largest memory first", pointer.P(v1.EvacuationOrderLargestMemoryFirst), "prioritized", "large"),
+			Entry("with the largest memory last", pointer.P(v1.EvacuationOrderLargestMemoryLast), "prioritized", "small"),
+		)
+
+		It("should respect the parallel outbound migrations of the node", func() {
+			newControllerWithMigrationConfig(&v1.MigrationConfiguration{
+				ParallelMigrationsPerCluster:      pointer.P(uint32(10)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(2)),
+			})
+
+			nodeName := "node01"
+			node := newNode(nodeName)
+			node.Annotations = map[string]string{v1.ParallelOutboundMigrationsAnnotation: "4"}
+			addNode(node)
+
+			for i := 0; i < 6; i++ {
+				vmiFeeder.Add(newVirtualMachineMarkedForEviction(fmt.Sprintf("testvmi%d", i), nodeName))
+			}
+			migrationFeeder.Add(newMigration("mig0", "testvmi0", v1.MigrationRunning))
+
+			expectMigrations(3)
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder,
+				evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason,
+				evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason,
+				evacuation.SuccessfulCreateVirtualMachineInstanceMigrationReason,
+			)
+		})
 	})
 
 	AfterEach(func() {
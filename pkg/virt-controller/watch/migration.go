@@ -70,6 +70,9 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	successfulSwitchOverMigratedVolumesReason = "SuccessfulSwitchOverMigratedVolumes"
+	failedSwitchOverMigratedVolumesReason     = "FailedSwitchOverMigratedVolumes"
+	failedDestinationClaimReason              = "FailedDestinationClaim"
 )
 
 // This is the timeout used when a target pod is stuck in
@@ -103,6 +106,7 @@ type MigrationController struct {
 	pdbInformer             cache.SharedIndexInformer
 	migrationPolicyInformer cache.SharedIndexInformer
 	resourceQuotaInformer   cache.SharedIndexInformer
+	namespaceStore          cache.Store
 	recorder                record.EventRecorder
 	podExpectations         *controller.UIDTrackingControllerExpectations
 	migrationStartLock      *sync.Mutex
@@ -127,6 +131,7 @@ func NewMigrationController(templateService services.TemplateService,
 	pdbInformer cache.SharedIndexInformer,
 	migrationPolicyInformer cache.SharedIndexInformer,
 	resourceQuotaInformer cache.SharedIndexInformer,
+	namespaceStore cache.Store,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -143,6 +148,7 @@ func NewMigrationController(templateService services.TemplateService,
 		pdbInformer:             pdbInformer,
 		resourceQuotaInformer:   resourceQuotaInformer,
 		migrationPolicyInformer: migrationPolicyInformer,
+		namespaceStore:          namespaceStore,
 		recorder:                recorder,
 		clientset:               clientset,
 		podExpectations:         controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -635,7 +641,18 @@ func setTargetPodSELinuxLevel(pod *k8sv1.Pod, vmiSeContext string) error {
 }
 
 func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, sourcePod *k8sv1.Pod) error {
-	templatePod, err := c.templateService.RenderMigrationManifest(vmi, sourcePod)
+	renderVMI := vmi
+	if len(migration.Spec.MigratedVolumes) > 0 {
+		if err := c.validateDestinationClaims(migration, vmi); err != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedDestinationClaimReason, "Invalid destination claim: %v", err)
+			return err
+		}
+		// The target pod mounts the claims the volumes are copied to
+		renderVMI = vmi.DeepCopy()
+		renderVMI.Spec.Volumes = migrations.ApplyMigratedVolumes(vmi.Spec.Volumes, migration.Spec.MigratedVolumes)
+	}
+
+	templatePod, err := c.templateService.RenderMigrationManifest(renderVMI, sourcePod)
 	if err != nil {
 		return fmt.Errorf("failed to render launch manifest: %v", err)
 	}
@@ -679,6 +696,10 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 		}
 	}
 
+	if len(migration.Spec.AddedNodeSelector) > 0 {
+		applyAddedNodeSelector(templatePod, migration.Spec.AddedNodeSelector)
+	}
+
 	matchLevelOnTarget := c.clusterConfig.GetMigrationConfiguration().MatchSELinuxLevelOnMigration
 	if matchLevelOnTarget == nil || *matchLevelOnTarget {
 		err = setTargetPodSELinuxLevel(templatePod, vmi.Status.SelinuxContext)
@@ -857,10 +878,11 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 
 	vmiCopy := vmi.DeepCopy()
 	vmiCopy.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
-		MigrationUID: migration.UID,
-		TargetNode:   pod.Spec.NodeName,
-		SourceNode:   vmi.Status.NodeName,
-		TargetPod:    pod.Name,
+		MigrationUID:    migration.UID,
+		TargetNode:      pod.Spec.NodeName,
+		SourceNode:      vmi.Status.NodeName,
+		TargetPod:       pod.Name,
+		MigratedVolumes: migration.Spec.MigratedVolumes,
 	}
 
 	// By setting this label, virt-handler on the target node will receive
@@ -918,6 +940,147 @@ func (c *MigrationController) handleTargetPodHandoff(migration *virtv1.VirtualMa
 	return nil
 }
 
+// validateDestinationClaims ensures that the destination claims of the migrated volumes
+// still exist and can hold the data of the claims the volumes currently use.
+func (c *MigrationController) validateDestinationClaims(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	claimInfos := make(map[string]*virtv1.PersistentVolumeClaimInfo, len(vmi.Status.VolumeStatus))
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		claimInfos[volumeStatus.Name] = volumeStatus.PersistentVolumeClaimInfo
+	}
+
+	for _, migratedVolume := range migration.Spec.MigratedVolumes {
+		obj, exists, err := c.pvcInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", vmi.Namespace, migratedVolume.DestinationClaim))
+		if err != nil {
+			return err
+		} else if !exists {
+			return fmt.Errorf("claim %s does not exist", migratedVolume.DestinationClaim)
+		}
+		if err := migrations.ValidateMigratedVolumeClaim(claimInfos[migratedVolume.VolumeName], obj.(*k8sv1.PersistentVolumeClaim)); err != nil {
+			return fmt.Errorf("volume %s cannot be migrated: %v", migratedVolume.VolumeName, err)
+		}
+	}
+	return nil
+}
+
+// switchOverMigratedVolumes points the VMI and the VirtualMachine owning it to
+// the claims the volumes were copied to during a completed storage migration.
+func (c *MigrationController) switchOverMigratedVolumes(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	migratedVolumes := vmi.Status.MigrationState.MigratedVolumes
+	newVolumes := migrations.ApplyMigratedVolumes(vmi.Spec.Volumes, migratedVolumes)
+	if equality.Semantic.DeepEqual(vmi.Spec.Volumes, newVolumes) {
+		// already switched over
+		return nil
+	}
+
+	// The VirtualMachine is updated first, so that the VMI volumes
+	// only change once the VirtualMachine would start with the new claims.
+	if owner := v1.GetControllerOf(vmi); owner != nil && owner.Kind == virtv1.VirtualMachineGroupVersionKind.Kind {
+		vm, err := c.clientset.VirtualMachine(vmi.Namespace).Get(context.Background(), owner.Name, &v1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get VirtualMachine %s/%s: %v", vmi.Namespace, owner.Name, err)
+		}
+		if vm.Spec.Template != nil {
+			var patchOps []string
+			newTemplateVolumes := migrations.ApplyMigratedVolumes(vm.Spec.Template.Spec.Volumes, migratedVolumes)
+			if !equality.Semantic.DeepEqual(vm.Spec.Template.Spec.Volumes, newTemplateVolumes) {
+				ops, err := generateTestAndReplaceOps("/spec/template/spec/volumes", vm.Spec.Template.Spec.Volumes, newTemplateVolumes)
+				if err != nil {
+					return err
+				}
+				patchOps = append(patchOps, ops...)
+			}
+			// The DataVolumes of the migrated volumes must not be recreated once the VirtualMachine restarts
+			newTemplates := migrations.RemoveMigratedDataVolumeTemplates(vm.Spec.DataVolumeTemplates, vm.Spec.Template.Spec.Volumes, migratedVolumes)
+			if len(newTemplates) != len(vm.Spec.DataVolumeTemplates) {
+				ops, err := generateTestAndReplaceOps("/spec/dataVolumeTemplates", vm.Spec.DataVolumeTemplates, newTemplates)
+				if err != nil {
+					return err
+				}
+				patchOps = append(patchOps, ops...)
+			}
+			if len(patchOps) > 0 {
+				if _, err := c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, controller.GeneratePatchBytes(patchOps), &v1.PatchOptions{}); err != nil {
+					return fmt.Errorf("failed to switch over volumes of VirtualMachine %s/%s: %v", vm.Namespace, vm.Name, err)
+				}
+			}
+		}
+	}
+
+	patchOps, err := generateTestAndReplaceOps("/spec/volumes", vmi.Spec.Volumes, newVolumes)
+	if err != nil {
+		return err
+	}
+	if newVolumeStatus := c.migratedVolumeStatus(vmi, migratedVolumes); newVolumeStatus != nil {
+		ops, err := generateTestAndReplaceOps("/status/volumeStatus", vmi.Status.VolumeStatus, newVolumeStatus)
+		if err != nil {
+			return err
+		}
+		patchOps = append(patchOps, ops...)
+	}
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(patchOps), &v1.PatchOptions{}); err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedSwitchOverMigratedVolumesReason, "Failed to switch over migrated volumes: %v", err)
+		return err
+	}
+
+	log.Log.Object(vmi).Infof("Switched over migrated volumes of vmi %s/%s", vmi.Namespace, vmi.Name)
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, successfulSwitchOverMigratedVolumesReason, "Switched over migrated volumes to their destination claims")
+	return nil
+}
+
+// migratedVolumeStatus returns the volume status of the VMI with the claim information
+// of the destination claims, or nil if none of the destination claims is known.
+func (c *MigrationController) migratedVolumeStatus(vmi *virtv1.VirtualMachineInstance, migratedVolumes []virtv1.StorageMigratedVolume) []virtv1.VolumeStatus {
+	destinations := make(map[string]string, len(migratedVolumes))
+	for _, migratedVolume := range migratedVolumes {
+		destinations[migratedVolume.VolumeName] = migratedVolume.DestinationClaim
+	}
+
+	changed := false
+	newVolumeStatus := make([]virtv1.VolumeStatus, 0, len(vmi.Status.VolumeStatus))
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		volumeStatus := *volumeStatus.DeepCopy()
+		if claimName, exists := destinations[volumeStatus.Name]; exists {
+			obj, exists, _ := c.pvcInformer.GetStore().GetByKey(fmt.Sprintf("%s/%s", vmi.Namespace, claimName))
+			if exists {
+				pvc := obj.(*k8sv1.PersistentVolumeClaim)
+				claimInfo := &virtv1.PersistentVolumeClaimInfo{
+					AccessModes:  pvc.Spec.AccessModes,
+					VolumeMode:   pvc.Spec.VolumeMode,
+					Capacity:     pvc.Status.Capacity,
+					Requests:     pvc.Spec.Resources.Requests,
+					Preallocated: storagetypes.IsPreallocated(pvc.ObjectMeta.Annotations),
+				}
+				if volumeStatus.PersistentVolumeClaimInfo != nil {
+					// The overhead is refreshed by the VMI controller
+					claimInfo.FilesystemOverhead = volumeStatus.PersistentVolumeClaimInfo.FilesystemOverhead
+				}
+				volumeStatus.PersistentVolumeClaimInfo = claimInfo
+				changed = true
+			}
+		}
+		newVolumeStatus = append(newVolumeStatus, volumeStatus)
+	}
+	if !changed {
+		return nil
+	}
+	return newVolumeStatus
+}
+
+func generateTestAndReplaceOps(path string, oldValue, newValue interface{}) ([]string, error) {
+	oldJson, err := json.Marshal(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newJson, err := json.Marshal(newValue)
+	if err != nil {
+		return nil, err
+	}
+	return []string{
+		fmt.Sprintf(`{ "op": "test", "path": "%s", "value": %s }`, path, string(oldJson)),
+		fmt.Sprintf(`{ "op": "replace", "path": "%s", "value": %s }`, path, string(newJson)),
+	}, nil
+}
+
 func (c *MigrationController) markMigrationAbortInVmiStatus(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 
 	if vmi.Status.MigrationState == nil {
@@ -1000,7 +1163,12 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		return err
 	}
 
-	if outboundMigrations >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
+	var sourceNode *k8sv1.Node
+	if obj, exists, _ := c.nodeInformer.GetStore().GetByKey(vmi.Status.NodeName); exists {
+		sourceNode = obj.(*k8sv1.Node)
+	}
+
+	if outboundMigrations >= migrations.ParallelOutboundMigrationsForNode(sourceNode, *c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode) {
 		// Let's ensure that we only have two outbound migrations per node
 		// XXX: Make this configurable, thinkg about inbound migration limit, bandwidh per migration, and so on.
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
@@ -1008,6 +1176,22 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		return nil
 	}
 
+	policy, err := c.getMatchedMigrationPolicy(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to match migration policy: %v", err)
+	}
+	if policy != nil && policy.Spec.MaxParallelMigrations != nil {
+		policyMigrations, err := c.runningMigrationsMatchingPolicy(policy.Name, runningMigrations)
+		if err != nil {
+			return err
+		}
+		if policyMigrations >= int(*policy.Spec.MaxParallelMigrations) {
+			log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migrations [%d] matched by migration policy %s has hit the policy limit.", vmi.Namespace, vmi.Name, policyMigrations, policy.Name)
+			c.Queue.AddAfter(key, time.Second*5)
+			return nil
+		}
+	}
+
 	// migration was accepted into the system, now see if we
 	// should create the target pod
 	if vmi.IsRunning() {
@@ -1222,6 +1406,9 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 
 	if migrationFinalizedOnVMI := vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID &&
 		vmi.Status.MigrationState.EndTimestamp != nil; migrationFinalizedOnVMI {
+		if migrations.IsStorageMigrationCompleted(vmi) {
+			return c.switchOverMigratedVolumes(migration, vmi)
+		}
 		return nil
 	}
 
@@ -1900,6 +2087,20 @@ func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, source
 	return nil
 }
 
+// applyAddedNodeSelector restricts the target pod to the nodes matching the additional
+// selector of the migration. Keys already present on the pod are preserved so that
+// the added selector can only restrict, but never bypass, the constraints of the VMI.
+func applyAddedNodeSelector(pod *k8sv1.Pod, addedNodeSelector map[string]string) {
+	if pod.Spec.NodeSelector == nil {
+		pod.Spec.NodeSelector = make(map[string]string, len(addedNodeSelector))
+	}
+	for key, value := range addedNodeSelector {
+		if _, exists := pod.Spec.NodeSelector[key]; !exists {
+			pod.Spec.NodeSelector[key] = value
+		}
+	}
+}
+
 func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
 	for key, value := range requiredNodeLabels {
 		nodeValue, ok := node.Labels[key]
@@ -1913,25 +2114,14 @@ func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels ma
 }
 
 func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
-	vmiNamespace, err := c.clientset.CoreV1().Namespaces().Get(context.Background(), vmi.Namespace, v1.GetOptions{})
+	// Override cluster-wide migration configuration if migration policy is matched
+	matchedPolicy, err := c.getMatchedMigrationPolicy(vmi)
 	if err != nil {
 		return err
 	}
 
-	// Fetch cluster policies
-	var policies []v1alpha1.MigrationPolicy
-	migrationInterfaceList := c.migrationPolicyInformer.GetStore().List()
-	for _, obj := range migrationInterfaceList {
-		policy := obj.(*v1alpha1.MigrationPolicy)
-		policies = append(policies, *policy)
-	}
-	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
-
-	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := MatchPolicy(&policiesListObj, vmi, vmiNamespace)
-
 	if matchedPolicy == nil {
-		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
+		log.Log.Object(vmi).Infof("no migration policy matched for VMI %s", vmi.Name)
 		return nil
 	}
 
@@ -1949,6 +2139,52 @@ func (c *MigrationController) matchMigrationPolicy(vmi *virtv1.VirtualMachineIns
 	return nil
 }
 
+// getMatchedMigrationPolicy returns the migration policy applying to the VMI, or nil if no policy matches it.
+func (c *MigrationController) getMatchedMigrationPolicy(vmi *virtv1.VirtualMachineInstance) (*v1alpha1.MigrationPolicy, error) {
+	// Fetch cluster policies
+	var policies []v1alpha1.MigrationPolicy
+	migrationInterfaceList := c.migrationPolicyInformer.GetStore().List()
+	for _, obj := range migrationInterfaceList {
+		policy := obj.(*v1alpha1.MigrationPolicy)
+		policies = append(policies, *policy)
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	vmiNamespace := &k8sv1.Namespace{}
+	obj, exists, err := c.namespaceStore.GetByKey(vmi.Namespace)
+	if err != nil {
+		return nil, err
+	} else if exists {
+		vmiNamespace = obj.(*k8sv1.Namespace)
+	}
+
+	return MatchPolicy(&v1alpha1.MigrationPolicyList{Items: policies}, vmi, vmiNamespace), nil
+}
+
+// runningMigrationsMatchingPolicy counts the running migrations of VMIs matched by the given migration policy.
+func (c *MigrationController) runningMigrationsMatchingPolicy(policyName string, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, error) {
+	sum := 0
+	for _, migration := range runningMigrations {
+		obj, exists, err := c.vmiInformer.GetStore().GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			continue
+		}
+		policy, err := c.getMatchedMigrationPolicy(obj.(*virtv1.VirtualMachineInstance))
+		if err != nil {
+			return 0, err
+		}
+		if policy != nil && policy.Name == policyName {
+			sum++
+		}
+	}
+	return sum, nil
+}
+
 func (c *MigrationController) isMigrationPolicyMatched(vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi == nil {
 		return false
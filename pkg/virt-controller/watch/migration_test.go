@@ -304,6 +304,7 @@ var _ = Describe("Migration watcher", func() {
 			pdbInformer,
 			migrationPolicyInformer,
 			resourceQuotaInformer,
+			namespaceInformer.GetStore(),
 			recorder,
 			virtClient,
 			config,
@@ -352,12 +353,10 @@ var _ = Describe("Migration watcher", func() {
 			ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault},
 		}
 
+		Expect(namespaceInformer.GetStore().Add(&namespace)).To(Succeed())
+
 		// Make sure that all unexpected calls to kubeClient will fail
 		kubeClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
-			if action.GetVerb() == "get" && action.GetResource().Resource == "namespaces" {
-				return true, &namespace, nil
-			}
-
 			Expect(action).To(BeNil())
 			return true, nil, nil
 		})
@@ -719,6 +718,57 @@ var _ = Describe("Migration watcher", func() {
 			controller.Execute()
 		})
 
+		It("should not run more migrations in parallel than allowed by the matched migration policy", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			policy := generatePolicyAndAlignVMI(vmi)
+			policy.Spec.MaxParallelMigrations = pointer.Uint32(2)
+			addMigrationPolicies(*policy)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			// Ensure that 2 migrations of VMIs matched by the same policy are in non-final state
+			for i := 0; i < 2; i++ {
+				matchedVMI := newVirtualMachine(fmt.Sprintf("testvmi%v", i), virtv1.Running)
+				matchedVMI.Labels = vmi.Labels
+				matchedVMI.Status.NodeName = fmt.Sprintf("node%v", i)
+				migration := newMigration(fmt.Sprintf("testmigration%v", i), matchedVMI.Name, virtv1.MigrationScheduling)
+
+				addMigration(migration)
+				addVirtualMachineInstance(matchedVMI)
+			}
+
+			controller.Execute()
+		})
+
+		It("should create target pod if migrations running in parallel are not matched by the migration policy", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			policy := generatePolicyAndAlignVMI(vmi)
+			policy.Spec.MaxParallelMigrations = pointer.Uint32(2)
+			addMigrationPolicies(*policy)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			// Ensure that 2 migrations of VMIs not matched by the policy are in non-final state
+			for i := 0; i < 2; i++ {
+				unmatchedVMI := newVirtualMachine(fmt.Sprintf("testvmi%v", i), virtv1.Running)
+				unmatchedVMI.Status.NodeName = fmt.Sprintf("node%v", i)
+				migration := newMigration(fmt.Sprintf("testmigration%v", i), unmatchedVMI.Name, virtv1.MigrationScheduling)
+
+				addMigration(migration)
+				addVirtualMachineInstance(unmatchedVMI)
+			}
+
+			shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
 		It("should not overload the cluster and detect pending migrations as running if they have a target pod", func() {
 			// It should create a pod for this one if we would not limit migrations
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
@@ -794,6 +844,29 @@ var _ = Describe("Migration watcher", func() {
 			controller.Execute()
 		})
 
+		It("should allow more outbound migrations if the node overrides the limit", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+
+			node := newNode(vmi.Status.NodeName)
+			node.Annotations = map[string]string{virtv1.ParallelOutboundMigrationsAnnotation: "3"}
+			addNode(node)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			for i := 0; i < 2; i++ {
+				vmi := newVirtualMachine(fmt.Sprintf("testvmi%v", i), virtv1.Running)
+				migration := newMigration(fmt.Sprintf("testmigration%v", i), vmi.Name, virtv1.MigrationScheduling)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+			}
+
+			shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
 		It("should create target pod and not override existing affinity rules", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			antiAffinityTerm := k8sv1.PodAffinityTerm{
@@ -1415,6 +1488,82 @@ var _ = Describe("Migration watcher", func() {
 
 			controller.Execute()
 		})
+		It("should switch over the migrated volumes on a completed storage migration", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Status.NodeName = "node02"
+			vmi.Spec.Volumes = []virtv1.Volume{
+				{
+					Name: "disk0",
+					VolumeSource: virtv1.VolumeSource{
+						DataVolume: &virtv1.DataVolumeSource{Name: "src"},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{
+				{
+					Name: "disk0",
+					PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+						Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+			vm := &virtv1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace, UID: "vm-uid"},
+				Spec: virtv1.VirtualMachineSpec{
+					DataVolumeTemplates: []virtv1.DataVolumeTemplateSpec{
+						{ObjectMeta: metav1.ObjectMeta{Name: "src"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+					},
+					Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+						Spec: *vmi.Spec.DeepCopy(),
+					},
+				},
+			}
+			Expect(pvcInformer.GetStore().Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "dst", Namespace: vmi.Namespace},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("20Gi")},
+				},
+			})).To(Succeed())
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)}
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationSucceeded)
+			migratedVolumes := []virtv1.StorageMigratedVolume{{VolumeName: "disk0", DestinationClaim: "dst"}}
+			migration.Spec.MigratedVolumes = migratedVolumes
+
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{
+				MigrationUID:    migration.UID,
+				TargetNode:      "node01",
+				SourceNode:      "node02",
+				StartTimestamp:  now(),
+				EndTimestamp:    now(),
+				Completed:       true,
+				MigratedVolumes: migratedVolumes,
+			}
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+			virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			vmInterface.EXPECT().Get(context.Background(), vm.Name, &metav1.GetOptions{}).Return(vm, nil)
+			vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachine, error) {
+					Expect(string(data)).To(ContainSubstring(`"path": "/spec/template/spec/volumes"`))
+					Expect(string(data)).To(ContainSubstring(`"claimName":"dst"`))
+					Expect(string(data)).To(ContainSubstring(`{ "op": "replace", "path": "/spec/dataVolumeTemplates", "value": [{"metadata":{"name":"other"`))
+					return vm, nil
+				})
+			vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachineInstance, error) {
+					Expect(string(data)).To(ContainSubstring(`"path": "/spec/volumes"`))
+					Expect(string(data)).To(ContainSubstring(`"claimName":"dst"`))
+					Expect(string(data)).To(ContainSubstring(`{ "op": "replace", "path": "/status/volumeStatus", "value": [{"name":"disk0","target":"","persistentVolumeClaimInfo":{"capacity":{"storage":"20Gi"}`))
+					return vmi, nil
+				})
+			shouldExpectMigrationStateUpdatedAndFinalizerRemoved(migration, vmi.Status.MigrationState)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, successfulSwitchOverMigratedVolumesReason)
+		})
 		It("should delete itself if VMI no longer exists", func() {
 			migration := newMigration("testmigration", "somevmi", virtv1.MigrationRunning)
 			addMigration(migration)
@@ -1575,6 +1724,133 @@ var _ = Describe("Migration watcher", func() {
 			Entry("host-model should be targeted only to nodes which support the model", true),
 			Entry("non-host-model should not be targeted to nodes which support the model", false),
 		)
+
+		DescribeTable("with an added node selector", func(vmiNodeSelector, addedNodeSelector, expectedNodeSelector map[string]string) {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.NodeSelector = vmiNodeSelector
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.AddedNodeSelector = addedNodeSelector
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				creation, ok := action.(testing.CreateAction)
+				Expect(ok).To(BeTrue())
+				pod := creation.GetObject().(*k8sv1.Pod)
+				for key, value := range expectedNodeSelector {
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(key, value))
+				}
+				return true, creation.GetObject(), nil
+			})
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		},
+			Entry("should target the selected node",
+				nil,
+				map[string]string{k8sv1.LabelHostname: "node02"},
+				map[string]string{k8sv1.LabelHostname: "node02"},
+			),
+			Entry("should merge with the node selector of the VMI",
+				map[string]string{"zone": "a"},
+				map[string]string{k8sv1.LabelHostname: "node02"},
+				map[string]string{"zone": "a", k8sv1.LabelHostname: "node02"},
+			),
+			Entry("should not override the node selector of the VMI",
+				map[string]string{"zone": "a"},
+				map[string]string{"zone": "b", "rack": "r1"},
+				map[string]string{"zone": "a", "rack": "r1"},
+			),
+		)
+
+		It("should mount the destination claims of migrated volumes in the target pod", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.Volumes = []virtv1.Volume{
+				{
+					Name: "disk0",
+					VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "src"},
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{
+				{
+					Name: "disk0",
+					PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+						Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.MigratedVolumes = []virtv1.StorageMigratedVolume{{VolumeName: "disk0", DestinationClaim: "dst"}}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			Expect(pvcInformer.GetStore().Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "dst", Namespace: vmi.Namespace},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+				},
+			})).To(Succeed())
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				creation, ok := action.(testing.CreateAction)
+				Expect(ok).To(BeTrue())
+				pod := creation.GetObject().(*k8sv1.Pod)
+				var claimNames []string
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim != nil {
+						claimNames = append(claimNames, volume.PersistentVolumeClaim.ClaimName)
+					}
+				}
+				Expect(claimNames).To(ConsistOf("dst"))
+				return true, creation.GetObject(), nil
+			})
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should not create the target pod if a destination claim is smaller than the source claim", func() {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.Volumes = []virtv1.Volume{
+				{
+					Name: "disk0",
+					VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "src"},
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []virtv1.VolumeStatus{
+				{
+					Name: "disk0",
+					PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+						Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+					},
+				},
+			}
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			migration.Spec.MigratedVolumes = []virtv1.StorageMigratedVolume{{VolumeName: "disk0", DestinationClaim: "dst"}}
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			Expect(pvcInformer.GetStore().Add(&k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: "dst", Namespace: vmi.Namespace},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Capacity: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("5Gi")},
+				},
+			})).To(Succeed())
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, failedDestinationClaimReason)
+			Expect(kubeClient.Actions()).To(BeEmpty())
+		})
 	})
 
 	Context("Migration with protected VMI (PDB)", func() {
@@ -1740,6 +2016,30 @@ var _ = Describe("Migration watcher", func() {
 				Expect(matchedPolicy).To(BeNil())
 			})
 
+			It("policy with higher priority should be matched even if it is less detailed", func() {
+				lessDetailedPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 1, 0)
+				moreDetailedPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 3, 2)
+				lessDetailedPolicy.Spec.Priority = pointer.Int32(10)
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(*moreDetailedPolicy, *lessDetailedPolicy)
+
+				matchedPolicy := MatchPolicy(policyList, vmi, &namespace)
+				Expect(matchedPolicy).ToNot(BeNil())
+				Expect(matchedPolicy.Name).To(Equal(lessDetailedPolicy.Name))
+			})
+
+			It("policies with an unset priority should be treated as having priority zero", func() {
+				negativePriorityPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 3, 2)
+				defaultPriorityPolicy := preparePolicyAndVMIWithNSAndVMILabels(vmi, &namespace, 1, 0)
+				negativePriorityPolicy.Spec.Priority = pointer.Int32(-1)
+
+				policyList := kubecli.NewMinimalMigrationPolicyList(*negativePriorityPolicy, *defaultPriorityPolicy)
+
+				matchedPolicy := MatchPolicy(policyList, vmi, &namespace)
+				Expect(matchedPolicy).ToNot(BeNil())
+				Expect(matchedPolicy.Name).To(Equal(defaultPriorityPolicy.Name))
+			})
+
 			It("VMI labels should have precedence over namespace labels", func() {
 				numberOfLabels := rand.Intn(5) + 1
 
@@ -1816,6 +2116,30 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set progress timeout",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ProgressTimeout = &stubNumber },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ProgressTimeout).ToNot(BeNil())
+					Expect(*c.ProgressTimeout).To(Equal(stubNumber))
+				},
+				true,
+			),
+			Entry("set parallel migration threads",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelMigrationThreads = pointer.Uint32(4) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelMigrationThreads).ToNot(BeNil())
+					Expect(*c.ParallelMigrationThreads).To(Equal(uint32(4)))
+				},
+				true,
+			),
+			Entry("disable TLS",
+				func(p *migrationsv1.MigrationPolicySpec) { p.DisableTLS = pointer.BoolPtr(true) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.DisableTLS).ToNot(BeNil())
+					Expect(*c.DisableTLS).To(BeTrue())
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
@@ -1,6 +1,8 @@
 package watch
 
 import (
+	"sort"
+
 	k8sv1 "k8s.io/api/core/v1"
 
 	k6tv1 "kubevirt.io/api/core/v1"
@@ -8,16 +10,22 @@ import (
 )
 
 type migrationPolicyMatchScore struct {
+	priority          int32
 	matchingVMILabels int
 	matchingNSLabels  int
 }
 
 func (score migrationPolicyMatchScore) equals(otherScore migrationPolicyMatchScore) bool {
-	return score.matchingVMILabels == otherScore.matchingVMILabels &&
+	return score.priority == otherScore.priority &&
+		score.matchingVMILabels == otherScore.matchingVMILabels &&
 		score.matchingNSLabels == otherScore.matchingNSLabels
 }
 
 func (score migrationPolicyMatchScore) greaterThan(otherScore migrationPolicyMatchScore) bool {
+	if score.priority != otherScore.priority {
+		return score.priority > otherScore.priority
+	}
+
 	thisTotalScore := score.matchingNSLabels + score.matchingVMILabels
 	otherTotalScore := otherScore.matchingNSLabels + otherScore.matchingVMILabels
 
@@ -34,49 +42,44 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 
 // MatchPolicy returns the policy that is matched to the vmi, or nil of no policy is matched.
 //
-// Since every policy can specify VMI and Namespace labels to match to, matching is done by returning the most
-// detailed policy, meaning the policy that matches the VMI and specifies the most labels that matched either
-// the VMI or its namespace labels.
-//
-// If two policies are matched and have the same level of details (i.e. same number of matching labels) the matched
-// policy is chosen by policies' names ordered by lexicographic order. The reason is to create a rather arbitrary yet
-// deterministic way of matching policies.
+// Policies are matched in the following order of precedence:
+//  1. The policy with the highest priority.
+//  2. The most detailed policy, meaning the policy that specifies the most labels that matched either
+//     the VMI or its namespace labels.
+//  3. The policy that specifies the most labels that matched the VMI labels.
+//  4. The policy whose name comes first in lexicographic order. The reason is to create a rather arbitrary
+//     yet deterministic way of matching policies. Such policies are considered to be conflicting.
 func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) *v1alpha1.MigrationPolicy {
-	var mathingPolicies []v1alpha1.MigrationPolicy
-	bestScore := migrationPolicyMatchScore{}
+	matchingPolicies := matchPolicyCandidates(policyList, vmi, vmiNamespace)
+	if len(matchingPolicies) == 0 {
+		return nil
+	}
+	return &matchingPolicies[0]
+}
+
+// matchPolicyCandidates returns all the policies that match the vmi with the best score, ordered by name.
+// If more than one policy is returned, the first one is applied and the others are conflicting with it.
+func matchPolicyCandidates(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) []v1alpha1.MigrationPolicy {
+	var matchingPolicies []v1alpha1.MigrationPolicy
+	var bestScore migrationPolicyMatchScore
 
 	for _, policy := range policyList.Items {
 		doesMatch, curScore := countMatchingLabels(&policy, vmi.Labels, vmiNamespace.Labels)
 
-		if !doesMatch || curScore.lessThan(bestScore) {
+		if !doesMatch || (len(matchingPolicies) > 0 && curScore.lessThan(bestScore)) {
 			continue
-		} else if curScore.greaterThan(bestScore) {
+		} else if len(matchingPolicies) == 0 || curScore.greaterThan(bestScore) {
 			bestScore = curScore
-			mathingPolicies = []v1alpha1.MigrationPolicy{policy}
+			matchingPolicies = []v1alpha1.MigrationPolicy{policy}
 		} else {
-			mathingPolicies = append(mathingPolicies, policy)
+			matchingPolicies = append(matchingPolicies, policy)
 		}
 	}
 
-	if len(mathingPolicies) == 0 {
-		return nil
-	} else if len(mathingPolicies) == 1 {
-		return &mathingPolicies[0]
-	}
-
-	// If more than one policy is matched with the same number of matching labels it will be chosen by policies names'
-	// lexicographic order
-	firstPolicyNameLexicographicOrder := mathingPolicies[0].Name
-	var firstPolicyNameLexicographicOrderIdx int
-
-	for idx, matchingPolicy := range mathingPolicies {
-		if matchingPolicy.Name < firstPolicyNameLexicographicOrder {
-			firstPolicyNameLexicographicOrder = matchingPolicy.Name
-			firstPolicyNameLexicographicOrderIdx = idx
-		}
-	}
-
-	return &mathingPolicies[firstPolicyNameLexicographicOrderIdx]
+	sort.Slice(matchingPolicies, func(i, j int) bool {
+		return matchingPolicies[i].Name < matchingPolicies[j].Name
+	})
+	return matchingPolicies
 }
 
 // countMatchingLabels checks if a policy matches to a VMI and the number of matching labels.
@@ -116,6 +119,9 @@ func countMatchingLabels(policy *v1alpha1.MigrationPolicy, vmiLabels, namespaceL
 
 	if doesMatch {
 		score = migrationPolicyMatchScore{matchingVMILabels: matchingVMILabels, matchingNSLabels: matchingNSLabels}
+		if policy.Spec.Priority != nil {
+			score.priority = *policy.Spec.Priority
+		}
 	}
 
 	return doesMatch, score
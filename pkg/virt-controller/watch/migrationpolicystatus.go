@@ -0,0 +1,243 @@
+package watch
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// migrationPolicyStatusKey is the only key of the queue, since a change of any policy,
+// VMI or namespace may affect the status of every policy.
+const migrationPolicyStatusKey = "migrationpolicies"
+
+// MigrationPolicyStatusController reports the VMIs matched by every migration policy
+// and the policies conflicting with each other.
+type MigrationPolicyStatusController struct {
+	clientset               kubecli.KubevirtClient
+	Queue                   workqueue.RateLimitingInterface
+	migrationPolicyInformer cache.SharedIndexInformer
+	vmiInformer             cache.SharedIndexInformer
+	namespaceInformer       cache.SharedIndexInformer
+}
+
+// NewMigrationPolicyStatusController creates a new instance of the MigrationPolicyStatusController struct.
+func NewMigrationPolicyStatusController(clientset kubecli.KubevirtClient, migrationPolicyInformer, vmiInformer, namespaceInformer cache.SharedIndexInformer) (*MigrationPolicyStatusController, error) {
+	c := &MigrationPolicyStatusController{
+		clientset:               clientset,
+		Queue:                   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-migration-policy-status"),
+		migrationPolicyInformer: migrationPolicyInformer,
+		vmiInformer:             vmiInformer,
+		namespaceInformer:       namespaceInformer,
+	}
+
+	_, err := c.migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateMigrationPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateVMI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		DeleteFunc: c.enqueue,
+		UpdateFunc: c.updateLabels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *MigrationPolicyStatusController) enqueue(_ interface{}) {
+	c.Queue.Add(migrationPolicyStatusKey)
+}
+
+func (c *MigrationPolicyStatusController) updateMigrationPolicy(old, curr interface{}) {
+	oldPolicy := old.(*v1alpha1.MigrationPolicy)
+	currPolicy := curr.(*v1alpha1.MigrationPolicy)
+	if !equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		c.enqueue(curr)
+	}
+}
+
+func (c *MigrationPolicyStatusController) updateLabels(old, curr interface{}) {
+	oldObj := old.(metav1.Object)
+	currObj := curr.(metav1.Object)
+	if !equality.Semantic.DeepEqual(oldObj.GetLabels(), currObj.GetLabels()) {
+		c.enqueue(curr)
+	}
+}
+
+func (c *MigrationPolicyStatusController) updateVMI(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if oldVMI.Status.Phase != currVMI.Status.Phase || isMigrating(oldVMI) != isMigrating(currVMI) {
+		c.enqueue(curr)
+		return
+	}
+	c.updateLabels(old, curr)
+}
+
+// Run runs the passed in MigrationPolicyStatusController.
+func (c *MigrationPolicyStatusController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting migration policy status controller.")
+
+	// Wait for cache sync before we start the migration policy status controller
+	cache.WaitForCacheSync(stopCh, c.migrationPolicyInformer.HasSynced, c.vmiInformer.HasSynced, c.namespaceInformer.HasSynced)
+
+	// All policies are processed at once, a single worker is sufficient
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy status controller.")
+}
+
+func (c *MigrationPolicyStatusController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *MigrationPolicyStatusController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	err := c.execute()
+
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *MigrationPolicyStatusController) execute() error {
+	policyList := &v1alpha1.MigrationPolicyList{}
+	for _, obj := range c.migrationPolicyInformer.GetStore().List() {
+		policyList.Items = append(policyList.Items, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	if len(policyList.Items) == 0 {
+		return nil
+	}
+
+	statuses := calculateMigrationPolicyStatuses(policyList, c.vmiInformer.GetStore(), c.namespaceInformer.GetStore())
+
+	for _, policy := range policyList.Items {
+		status := statuses[policy.Name]
+		if equality.Semantic.DeepEqual(policy.Status, status) {
+			continue
+		}
+		policyCopy := policy.DeepCopy()
+		policyCopy.Status = status
+		_, err := c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// calculateMigrationPolicyStatuses returns the status of every policy, indexed by the policy name.
+func calculateMigrationPolicyStatuses(policyList *v1alpha1.MigrationPolicyList, vmiStore, namespaceStore cache.Store) map[string]v1alpha1.MigrationPolicyStatus {
+	conflicts := make(map[string]map[string]bool)
+	statuses := make(map[string]v1alpha1.MigrationPolicyStatus, len(policyList.Items))
+	for _, policy := range policyList.Items {
+		statuses[policy.Name] = v1alpha1.MigrationPolicyStatus{}
+		conflicts[policy.Name] = make(map[string]bool)
+	}
+
+	for _, obj := range vmiStore.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() {
+			continue
+		}
+
+		namespace := &k8sv1.Namespace{}
+		if nsObj, exists, _ := namespaceStore.GetByKey(vmi.Namespace); exists {
+			namespace = nsObj.(*k8sv1.Namespace)
+		}
+
+		candidates := matchPolicyCandidates(policyList, vmi, namespace)
+		if len(candidates) == 0 {
+			continue
+		}
+
+		status := statuses[candidates[0].Name]
+		status.MatchedVirtualMachineInstancesCount++
+		if isMigrating(vmi) {
+			status.MigratingVirtualMachineInstancesCount++
+		}
+		status.MatchedVirtualMachineInstances = append(status.MatchedVirtualMachineInstances, v1alpha1.MatchedVirtualMachineInstance{
+			Namespace: vmi.Namespace,
+			Name:      vmi.Name,
+		})
+		statuses[candidates[0].Name] = status
+
+		for _, candidate := range candidates {
+			for _, other := range candidates {
+				if candidate.Name != other.Name {
+					conflicts[candidate.Name][other.Name] = true
+				}
+			}
+		}
+	}
+
+	for name, status := range statuses {
+		sort.Slice(status.MatchedVirtualMachineInstances, func(i, j int) bool {
+			a, b := status.MatchedVirtualMachineInstances[i], status.MatchedVirtualMachineInstances[j]
+			if a.Namespace != b.Namespace {
+				return a.Namespace < b.Namespace
+			}
+			return a.Name < b.Name
+		})
+		if len(status.MatchedVirtualMachineInstances) > v1alpha1.MaxMatchedVirtualMachineInstances {
+			status.MatchedVirtualMachineInstances = status.MatchedVirtualMachineInstances[:v1alpha1.MaxMatchedVirtualMachineInstances]
+		}
+		for conflictingPolicy := range conflicts[name] {
+			status.ConflictingPolicies = append(status.ConflictingPolicies, conflictingPolicy)
+		}
+		sort.Strings(status.ConflictingPolicies)
+		statuses[name] = status
+	}
+
+	return statuses
+}
+
+func isMigrating(vmi *virtv1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed
+}
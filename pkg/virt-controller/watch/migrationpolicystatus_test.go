@@ -0,0 +1,207 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration policy status", func() {
+	var (
+		policyInformer    cache.SharedIndexInformer
+		vmiInformer       cache.SharedIndexInformer
+		namespaceInformer cache.SharedIndexInformer
+		migrationsClient  *kubevirtfake.Clientset
+		controller        *MigrationPolicyStatusController
+	)
+
+	newPolicy := func(name string, vmiLabels map[string]string) *migrationsv1.MigrationPolicy {
+		policy := kubecli.NewMinimalMigrationPolicy(name)
+		policy.Spec.Selectors = &migrationsv1.Selectors{
+			VirtualMachineInstanceSelector: migrationsv1.LabelSelector(vmiLabels),
+		}
+		return policy
+	}
+
+	newVMI := func(name string, labels map[string]string) *virtv1.VirtualMachineInstance {
+		vmi := newVirtualMachine(name, virtv1.Running)
+		vmi.Labels = labels
+		return vmi
+	}
+
+	addPolicies := func(policies ...*migrationsv1.MigrationPolicy) {
+		for _, policy := range policies {
+			Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+			_, err := migrationsClient.MigrationsV1alpha1().MigrationPolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	addVMIs := func(vmis ...*virtv1.VirtualMachineInstance) {
+		for _, vmi := range vmis {
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+		}
+	}
+
+	getPolicyStatus := func(name string) migrationsv1.MigrationPolicyStatus {
+		policy, err := migrationsClient.MigrationsV1alpha1().MigrationPolicies().Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		migrationsClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().MigrationPolicy().Return(migrationsClient.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+
+		policyInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+
+		var err error
+		controller, err = NewMigrationPolicyStatusController(virtClient, policyInformer, vmiInformer, namespaceInformer)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault},
+		})).To(Succeed())
+	})
+
+	It("should report the VMIs matched by every policy", func() {
+		addPolicies(
+			newPolicy("fast", map[string]string{"speed": "fast"}),
+			newPolicy("slow", map[string]string{"speed": "slow"}),
+		)
+		addVMIs(
+			newVMI("vmi-b", map[string]string{"speed": "fast"}),
+			newVMI("vmi-a", map[string]string{"speed": "fast"}),
+			newVMI("vmi-c", map[string]string{"speed": "slow"}),
+			newVMI("vmi-d", map[string]string{"speed": "medium"}),
+		)
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getPolicyStatus("fast")).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstancesCount: 2,
+			MatchedVirtualMachineInstances: []migrationsv1.MatchedVirtualMachineInstance{
+				{Namespace: metav1.NamespaceDefault, Name: "vmi-a"},
+				{Namespace: metav1.NamespaceDefault, Name: "vmi-b"},
+			},
+		}))
+		Expect(getPolicyStatus("slow")).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstancesCount: 1,
+			MatchedVirtualMachineInstances: []migrationsv1.MatchedVirtualMachineInstance{
+				{Namespace: metav1.NamespaceDefault, Name: "vmi-c"},
+			},
+		}))
+	})
+
+	It("should not report final VMIs", func() {
+		addPolicies(newPolicy("fast", map[string]string{"speed": "fast"}))
+		vmi := newVMI("vmi-a", map[string]string{"speed": "fast"})
+		vmi.Status.Phase = virtv1.Succeeded
+		addVMIs(vmi)
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getPolicyStatus("fast")).To(Equal(migrationsv1.MigrationPolicyStatus{}))
+	})
+
+	It("should report policies matching a VMI with the same precedence as conflicting", func() {
+		addPolicies(
+			newPolicy("aa", map[string]string{"speed": "fast"}),
+			newPolicy("bb", map[string]string{"tier": "gold"}),
+		)
+		addVMIs(newVMI("vmi-a", map[string]string{"speed": "fast", "tier": "gold"}))
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getPolicyStatus("aa")).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstancesCount: 1,
+			MatchedVirtualMachineInstances: []migrationsv1.MatchedVirtualMachineInstance{
+				{Namespace: metav1.NamespaceDefault, Name: "vmi-a"},
+			},
+			ConflictingPolicies: []string{"bb"},
+		}))
+		Expect(getPolicyStatus("bb")).To(Equal(migrationsv1.MigrationPolicyStatus{
+			ConflictingPolicies: []string{"aa"},
+		}))
+	})
+
+	It("should not report policies with different priorities as conflicting", func() {
+		highPriorityPolicy := newPolicy("bb", map[string]string{"tier": "gold"})
+		highPriorityPolicy.Spec.Priority = pointer.Int32(1)
+		addPolicies(newPolicy("aa", map[string]string{"speed": "fast"}), highPriorityPolicy)
+		addVMIs(newVMI("vmi-a", map[string]string{"speed": "fast", "tier": "gold"}))
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getPolicyStatus("aa")).To(Equal(migrationsv1.MigrationPolicyStatus{}))
+		Expect(getPolicyStatus("bb")).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstancesCount: 1,
+			MatchedVirtualMachineInstances: []migrationsv1.MatchedVirtualMachineInstance{
+				{Namespace: metav1.NamespaceDefault, Name: "vmi-a"},
+			},
+		}))
+	})
+
+	It("should count all matched VMIs but list only a limited number of them", func() {
+		addPolicies(newPolicy("fast", map[string]string{"speed": "fast"}))
+		for i := 0; i < migrationsv1.MaxMatchedVirtualMachineInstances+5; i++ {
+			addVMIs(newVMI(fmt.Sprintf("vmi-%03d", i), map[string]string{"speed": "fast"}))
+		}
+
+		Expect(controller.execute()).To(Succeed())
+
+		status := getPolicyStatus("fast")
+		Expect(status.MatchedVirtualMachineInstancesCount).To(BeEquivalentTo(migrationsv1.MaxMatchedVirtualMachineInstances + 5))
+		Expect(status.MatchedVirtualMachineInstances).To(HaveLen(migrationsv1.MaxMatchedVirtualMachineInstances))
+		Expect(status.MatchedVirtualMachineInstances[0].Name).To(Equal("vmi-000"))
+	})
+
+	It("should count the matched VMIs which are migrating", func() {
+		addPolicies(newPolicy("fast", map[string]string{"speed": "fast"}))
+		migrating := newVMI("vmi-a", map[string]string{"speed": "fast"})
+		migrating.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+		migrated := newVMI("vmi-b", map[string]string{"speed": "fast"})
+		migrated.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{Completed: true}
+		addVMIs(migrating, migrated)
+
+		Expect(controller.execute()).To(Succeed())
+
+		status := getPolicyStatus("fast")
+		Expect(status.MatchedVirtualMachineInstancesCount).To(BeEquivalentTo(2))
+		Expect(status.MigratingVirtualMachineInstancesCount).To(BeEquivalentTo(1))
+	})
+
+	DescribeTable("should enqueue the policies on VMI updates", func(update func(vmi *virtv1.VirtualMachineInstance), expectEnqueue bool) {
+		old := newVMI("vmi-a", map[string]string{"speed": "fast"})
+		curr := old.DeepCopy()
+		update(curr)
+
+		controller.updateVMI(old, curr)
+		Expect(controller.Queue.Len()).To(Equal(map[bool]int{true: 1, false: 0}[expectEnqueue]))
+	},
+		Entry("when the labels change", func(vmi *virtv1.VirtualMachineInstance) { vmi.Labels["speed"] = "slow" }, true),
+		Entry("when the phase changes", func(vmi *virtv1.VirtualMachineInstance) { vmi.Status.Phase = virtv1.Succeeded }, true),
+		Entry("when a migration starts", func(vmi *virtv1.VirtualMachineInstance) {
+			vmi.Status.MigrationState = &virtv1.VirtualMachineInstanceMigrationState{}
+		}, true),
+		Entry("when nothing relevant changes", func(vmi *virtv1.VirtualMachineInstance) { vmi.Status.NodeName = "node02" }, false),
+	)
+})
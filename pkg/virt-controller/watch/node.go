@@ -8,6 +8,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -33,25 +35,27 @@ const (
 
 // NodeController is the main NodeController struct.
 type NodeController struct {
-	clientset        kubecli.KubevirtClient
-	Queue            workqueue.RateLimitingInterface
-	nodeInformer     cache.SharedIndexInformer
-	vmiInformer      cache.SharedIndexInformer
-	recorder         record.EventRecorder
-	heartBeatTimeout time.Duration
-	recheckInterval  time.Duration
+	clientset         kubecli.KubevirtClient
+	Queue             workqueue.RateLimitingInterface
+	nodeInformer      cache.SharedIndexInformer
+	vmiInformer       cache.SharedIndexInformer
+	ksmPolicyInformer cache.SharedIndexInformer
+	recorder          record.EventRecorder
+	heartBeatTimeout  time.Duration
+	recheckInterval   time.Duration
 }
 
 // NewNodeController creates a new instance of the NodeController struct.
-func NewNodeController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*NodeController, error) {
+func NewNodeController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, ksmPolicyInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*NodeController, error) {
 	c := &NodeController{
-		clientset:        clientset,
-		Queue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-node"),
-		nodeInformer:     nodeInformer,
-		vmiInformer:      vmiInformer,
-		recorder:         recorder,
-		heartBeatTimeout: 5 * time.Minute,
-		recheckInterval:  1 * time.Minute,
+		clientset:         clientset,
+		Queue:             workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-node"),
+		nodeInformer:      nodeInformer,
+		vmiInformer:       vmiInformer,
+		ksmPolicyInformer: ksmPolicyInformer,
+		recorder:          recorder,
+		heartBeatTimeout:  5 * time.Minute,
+		recheckInterval:   1 * time.Minute,
 	}
 
 	_, err := c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -72,6 +76,15 @@ func NewNodeController(clientset kubecli.KubevirtClient, nodeInformer cache.Shar
 		return nil, err
 	}
 
+	_, err = c.ksmPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addKSMPolicy,
+		DeleteFunc: func(_ interface{}) { /* nothing to do */ },
+		UpdateFunc: c.updateKSMPolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return c, nil
 }
 
@@ -112,6 +125,24 @@ func (c *NodeController) updateVirtualMachine(_, curr interface{}) {
 	}
 }
 
+func (c *NodeController) addKSMPolicy(obj interface{}) {
+	c.enqueueDeletedKSMPolicyNodes(obj.(*ksmv1alpha1.KSMPolicy))
+}
+
+func (c *NodeController) updateKSMPolicy(_, curr interface{}) {
+	c.enqueueDeletedKSMPolicyNodes(curr.(*ksmv1alpha1.KSMPolicy))
+}
+
+// enqueueDeletedKSMPolicyNodes enqueues the nodes in the status of the KSMPolicy which do not exist anymore,
+// so that they are removed from the status
+func (c *NodeController) enqueueDeletedKSMPolicyNodes(policy *ksmv1alpha1.KSMPolicy) {
+	for nodeName := range policy.Status.Nodes {
+		if _, exists, _ := c.nodeInformer.GetStore().GetByKey(nodeName); !exists {
+			c.Queue.Add(nodeName)
+		}
+	}
+}
+
 // Run runs the passed in NodeController.
 func (c *NodeController) Run(threadiness int, stopCh <-chan struct{}) {
 	defer controller.HandlePanic()
@@ -119,7 +150,7 @@ func (c *NodeController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting node controller.")
 
 	// Wait for cache sync before we start the node controller
-	cache.WaitForCacheSync(stopCh, c.nodeInformer.HasSynced, c.vmiInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.nodeInformer.HasSynced, c.vmiInformer.HasSynced, c.ksmPolicyInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -181,6 +212,10 @@ func (c *NodeController) execute(key string) error {
 			logger = logger.With(params)
 		}
 
+		if err := c.removeNodeFromKSMPolicies(key); err != nil {
+			logger.Reason(err).Error("Failed to remove the node from the status of the KSMPolicies")
+			return err
+		}
 	}
 
 	unresponsive, err := isNodeUnresponsive(node, c.heartBeatTimeout)
@@ -207,6 +242,33 @@ func (c *NodeController) execute(key string) error {
 	return nil
 }
 
+// removeNodeFromKSMPolicies removes a deleted node from the status of the KSMPolicies, since
+// virt-handler is not around anymore to do it
+func (c *NodeController) removeNodeFromKSMPolicies(nodeName string) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"nodes": map[string]interface{}{
+				nodeName: nil,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range c.ksmPolicyInformer.GetStore().List() {
+		policy := obj.(*ksmv1alpha1.KSMPolicy)
+		if _, exists := policy.Status.Nodes[nodeName]; !exists {
+			continue
+		}
+		_, err := c.clientset.KSMPolicy().Patch(context.Background(), policy.Name, types.MergePatchType, data, metav1.PatchOptions{}, "status")
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func nodeIsSchedulable(node *v1.Node) bool {
 	if node == nil {
 		return false
@@ -25,6 +25,8 @@ import (
 	"kubevirt.io/client-go/api"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -39,6 +41,7 @@ var _ = Describe("Node controller with", func() {
 	var nodeInformer cache.SharedIndexInformer
 	var vmiSource *framework.FakeControllerSource
 	var vmiInformer cache.SharedIndexInformer
+	var ksmPolicyInformer cache.SharedIndexInformer
 	var stop chan struct{}
 	var controller *NodeController
 	var recorder *record.FakeRecorder
@@ -50,7 +53,8 @@ var _ = Describe("Node controller with", func() {
 	syncCaches := func(stop chan struct{}) {
 		go nodeInformer.Run(stop)
 		go vmiInformer.Run(stop)
-		Expect(cache.WaitForCacheSync(stop, nodeInformer.HasSynced, vmiInformer.HasSynced)).To(BeTrue())
+		go ksmPolicyInformer.Run(stop)
+		Expect(cache.WaitForCacheSync(stop, nodeInformer.HasSynced, vmiInformer.HasSynced, ksmPolicyInformer.HasSynced)).To(BeTrue())
 	}
 
 	BeforeEach(func() {
@@ -61,10 +65,11 @@ var _ = Describe("Node controller with", func() {
 
 		nodeInformer, nodeSource = testutils.NewFakeInformerFor(&k8sv1.Node{})
 		vmiInformer, vmiSource = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		ksmPolicyInformer, _ = testutils.NewFakeInformerFor(&ksmv1alpha1.KSMPolicy{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
-		controller, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder)
+		controller, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, ksmPolicyInformer, recorder)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
@@ -266,6 +271,40 @@ var _ = Describe("Node controller with", func() {
 			controller.Execute()
 			testutils.ExpectEvent(recorder, NodeUnresponsiveReason)
 		})
+		It("should remove a deleted node from the status of the KSMPolicies", func() {
+			policy := &ksmv1alpha1.KSMPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "policy"},
+				Status: ksmv1alpha1.KSMPolicyStatus{
+					Nodes: map[string]ksmv1alpha1.KSMNodeStatus{
+						"testnode":  {Running: true},
+						"othernode": {Running: true},
+					},
+				},
+			}
+			Expect(nodeInformer.GetStore().Add(NewHealthyNode("othernode"))).To(Succeed())
+			Expect(ksmPolicyInformer.GetStore().Add(policy)).To(Succeed())
+			ksmClient := kubevirtfake.NewSimpleClientset(policy)
+			virtClient.EXPECT().KSMPolicy().Return(ksmClient.KsmV1alpha1().KSMPolicies())
+
+			mockQueue.ExpectAdds(1)
+			controller.addKSMPolicy(policy)
+			mockQueue.Wait()
+			Expect(controller.Queue.Len()).To(Equal(1))
+
+			kubeClient.Fake.PrependReactor("list", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, &k8sv1.PodList{}, nil
+			})
+			vmiInterface.EXPECT().List(context.Background(), gomock.Any()).Return(&virtv1.VirtualMachineInstanceList{}, nil)
+
+			controller.Execute()
+			Expect(ksmClient.Actions()).To(HaveLen(1))
+			Expect(ksmClient.Actions()[0].GetVerb()).To(Equal("patch"))
+			Expect(ksmClient.Actions()[0].GetSubresource()).To(Equal("status"))
+			updated, err := ksmClient.KsmV1alpha1().KSMPolicies().Get(context.Background(), policy.Name, v1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.Nodes).To(HaveKey("othernode"))
+			Expect(updated.Status.Nodes).ToNot(HaveKey("testnode"))
+		})
 		It("should set a vmi without a pod to failed state, triggered by vmi modify event", func() {
 			node := NewUnhealthyNode("testnode")
 			vmi := NewRunningVirtualMachine("vmi1", node)
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
@@ -22,6 +24,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -34,22 +37,28 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
 )
 
 // PoolController is the main PoolController struct.
 type PoolController struct {
-	clientset        kubecli.KubevirtClient
-	queue            workqueue.RateLimitingInterface
-	vmInformer       cache.SharedIndexInformer
-	vmiInformer      cache.SharedIndexInformer
-	poolInformer     cache.SharedIndexInformer
-	revisionInformer cache.SharedIndexInformer
-	recorder         record.EventRecorder
-	expectations     *controller.UIDTrackingControllerExpectations
-	burstReplicas    uint
-	statusUpdater    *status.VMPStatusUpdater
+	clientset          kubecli.KubevirtClient
+	queue              workqueue.RateLimitingInterface
+	vmInformer         cache.SharedIndexInformer
+	vmiInformer        cache.SharedIndexInformer
+	poolInformer       cache.SharedIndexInformer
+	revisionInformer   cache.SharedIndexInformer
+	dataVolumeInformer cache.SharedIndexInformer
+	pvcInformer        cache.SharedIndexInformer
+	recorder           record.EventRecorder
+	expectations       *controller.UIDTrackingControllerExpectations
+	burstReplicas      uint
+	statusUpdater      *status.VMPStatusUpdater
 }
 
 const (
@@ -64,6 +73,7 @@ const (
 	FailedScaleInReason         = "FailedScaleIn"
 	FailedUpdateReason          = "FailedUpdate"
 	FailedRevisionPruningReason = "FailedRevisionPruning"
+	FailedDataVolumeOwnerReason = "FailedDataVolumeOwner"
 
 	SuccessfulPausedPoolReason = "SuccessfulPaused"
 	SuccessfulResumePoolReason = "SuccessfulResume"
@@ -77,19 +87,23 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	poolInformer cache.SharedIndexInformer,
 	revisionInformer cache.SharedIndexInformer,
+	dataVolumeInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	burstReplicas uint) (*PoolController, error) {
 	c := &PoolController{
-		clientset:        clientset,
-		queue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-pool"),
-		poolInformer:     poolInformer,
-		vmiInformer:      vmiInformer,
-		vmInformer:       vmInformer,
-		revisionInformer: revisionInformer,
-		recorder:         recorder,
-		expectations:     controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
-		burstReplicas:    burstReplicas,
-		statusUpdater:    status.NewVMPStatusUpdater(clientset),
+		clientset:          clientset,
+		queue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-pool"),
+		poolInformer:       poolInformer,
+		vmiInformer:        vmiInformer,
+		vmInformer:         vmInformer,
+		revisionInformer:   revisionInformer,
+		dataVolumeInformer: dataVolumeInformer,
+		pvcInformer:        pvcInformer,
+		recorder:           recorder,
+		expectations:       controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		burstReplicas:      burstReplicas,
+		statusUpdater:      status.NewVMPStatusUpdater(clientset),
 	}
 
 	_, err := c.poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -121,6 +135,7 @@ func NewPoolController(clientset kubecli.KubevirtClient,
 	_, err = c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.addVMIHandler,
 		UpdateFunc: c.updateVMIHandler,
+		DeleteFunc: c.deleteVMIHandler,
 	})
 	if err != nil {
 		return nil, err
@@ -198,6 +213,47 @@ func (c *PoolController) updateVMIHandler(old, cur interface{}) {
 }
 
 // When a revision is created, enqueue the pool that manages it and update its expectations.
+// When a vmi is deleted, enqueue the pool of its vm and update the restart expectations.
+// obj could be an *v1.VirtualMachineInstance, or a DeletionFinalStateUnknown marker item.
+func (c *PoolController) deleteVMIHandler(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vmi %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+
+	vmiControllerRef := metav1.GetControllerOf(vmi)
+	if vmiControllerRef == nil {
+		return
+	}
+	vm := c.resolveVMIControllerRef(vmi.Namespace, vmiControllerRef)
+	if vm == nil {
+		return
+	}
+	vmControllerRef := metav1.GetControllerOf(vm)
+	if vmControllerRef == nil {
+		return
+	}
+	pool := c.resolveControllerRef(vm.Namespace, vmControllerRef)
+	if pool == nil {
+		return
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return
+	}
+	c.expectations.DeletionObserved(poolKey, controller.VirtualMachineInstanceKey(vmi))
+	c.enqueuePool(pool)
+}
+
 func (c *PoolController) addRevisionHandler(obj interface{}) {
 	cr := obj.(*appsv1.ControllerRevision)
 
@@ -442,7 +498,7 @@ func (c *PoolController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting pool controller.")
 
 	// Wait for cache sync before we start the pool controller
-	cache.WaitForCacheSync(stopCh, c.poolInformer.HasSynced, c.vmInformer.HasSynced, c.vmiInformer.HasSynced, c.revisionInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.poolInformer.HasSynced, c.vmInformer.HasSynced, c.vmiInformer.HasSynced, c.revisionInformer.HasSynced, c.dataVolumeInformer.HasSynced, c.pvcInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -471,12 +527,109 @@ func (c *PoolController) listVMsFromNamespace(namespace string) ([]*virtv1.Virtu
 }
 
 func (c *PoolController) calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
-	wantedReplicas := int32(1)
+	wantedReplicas := poolWantedReplicas(pool)
+	if c.isUpdateInProgress(pool, vms) {
+		// surge VMs are only kept around while outdated VMs are being updated
+		wantedReplicas += poolMaxSurge(pool)
+	}
+
+	return len(vms) - wantedReplicas
+}
+
+func poolWantedReplicas(pool *poolv1.VirtualMachinePool) int {
 	if pool.Spec.Replicas != nil {
-		wantedReplicas = *pool.Spec.Replicas
+		return int(*pool.Spec.Replicas)
+	}
+	return 1
+}
+
+func poolRollingUpdate(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePoolRollingUpdate {
+	if pool.Spec.UpdateStrategy == nil {
+		return nil
+	}
+	return pool.Spec.UpdateStrategy.RollingUpdate
+}
+
+func poolPartition(pool *poolv1.VirtualMachinePool) int {
+	rollingUpdate := poolRollingUpdate(pool)
+	if rollingUpdate == nil || rollingUpdate.Partition == nil {
+		return 0
 	}
+	return int(*rollingUpdate.Partition)
+}
 
-	return len(vms) - int(wantedReplicas)
+// poolMaxSurge resolves the maxSurge of the rolling update against the wanted replicas, rounding up.
+func poolMaxSurge(pool *poolv1.VirtualMachinePool) int {
+	rollingUpdate := poolRollingUpdate(pool)
+	if rollingUpdate == nil || rollingUpdate.MaxSurge == nil {
+		return 0
+	}
+	surge, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, poolWantedReplicas(pool), true)
+	if err != nil || surge < 0 {
+		return 0
+	}
+	return surge
+}
+
+// poolMaxUnavailable resolves the maxUnavailable of the rolling update against the wanted replicas, rounding down.
+// At least one VM is allowed to be unavailable if no surge VMs are created, otherwise the update could never proceed.
+func poolMaxUnavailable(pool *poolv1.VirtualMachinePool) int {
+	rollingUpdate := poolRollingUpdate(pool)
+	maxUnavailable := 1
+	if rollingUpdate != nil && rollingUpdate.MaxUnavailable != nil {
+		value, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxUnavailable, poolWantedReplicas(pool), false)
+		if err == nil && value >= 0 {
+			maxUnavailable = value
+		}
+	}
+	if maxUnavailable == 0 && poolMaxSurge(pool) == 0 {
+		maxUnavailable = 1
+	}
+	return maxUnavailable
+}
+
+// isPartitioned returns true if the VM is excluded from updates by the partition of the rolling update.
+func isPartitioned(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	partition := poolPartition(pool)
+	if partition == 0 {
+		return false
+	}
+	index, err := indexFromName(vm.Name)
+	if err != nil {
+		return false
+	}
+	return index < partition
+}
+
+// isUpdatedVM returns true if the VM runs the current pool template, or will start from it when it is stopped.
+func (c *PoolController) isUpdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) bool {
+	outdated, err := c.isOutdatedVM(pool, vm)
+	if err != nil || outdated {
+		return false
+	}
+
+	obj, exists, _ := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return true
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.Labels[virtv1.VirtualMachinePoolRevisionName] == vm.Labels[virtv1.VirtualMachinePoolRevisionName]
+}
+
+// isUpdateInProgress returns true if any VM which is not excluded by the partition still has to be updated.
+func (c *PoolController) isUpdateInProgress(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) bool {
+	if poolRollingUpdate(pool) == nil {
+		return false
+	}
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil || isPartitioned(pool, vm) {
+			continue
+		}
+		if !c.isUpdatedVM(pool, vm) {
+			return true
+		}
+	}
+	return false
 }
 
 func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
@@ -492,9 +645,7 @@ func filterDeletingVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
 
 // filterReadyVMs takes a list of VMs and returns all VMs which are in ready state.
 func (c *PoolController) filterReadyVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
-	return filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
-		return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
-	})
+	return filterVMs(vms, isVMReady)
 }
 
 func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine) bool) []*virtv1.VirtualMachine {
@@ -507,6 +658,66 @@ func filterVMs(vms []*virtv1.VirtualMachine, f func(vmi *virtv1.VirtualMachine)
 	return filtered
 }
 
+// sortVMsForScaleIn orders the VMs according to the scale-in policy, the VMs to remove first are at the front.
+func sortVMsForScaleIn(policy poolv1.VirtualMachinePoolScaleInPolicy, vms []*virtv1.VirtualMachine) {
+	var less func(a, b *virtv1.VirtualMachine) bool
+
+	switch policy {
+	case poolv1.VirtualMachinePoolScaleInOldestFirst:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolScaleInNewestFirst:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return b.CreationTimestamp.Before(&a.CreationTimestamp)
+		}
+	case poolv1.VirtualMachinePoolScaleInNotReadyFirst:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			return !isVMReady(a) && isVMReady(b)
+		}
+	case poolv1.VirtualMachinePoolScaleInLeastLoaded:
+		less = func(a, b *virtv1.VirtualMachine) bool {
+			if a.Status.Created != b.Status.Created {
+				return !a.Status.Created
+			}
+			return vmDeletionCost(a) < vmDeletionCost(b)
+		}
+	default:
+		// random delete strategy
+		rand.Shuffle(len(vms), func(i, j int) {
+			vms[i], vms[j] = vms[j], vms[i]
+		})
+		return
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		if less(vms[i], vms[j]) {
+			return true
+		} else if less(vms[j], vms[i]) {
+			return false
+		}
+		return vms[i].Name < vms[j].Name
+	})
+}
+
+func isVMReady(vm *virtv1.VirtualMachine) bool {
+	return controller.NewVirtualMachineConditionManager().HasConditionWithStatus(vm, virtv1.VirtualMachineConditionType(k8score.PodReady), k8score.ConditionTrue)
+}
+
+// vmDeletionCost returns the deletion cost of the VM, VMs without a valid cost are considered to have no cost.
+func vmDeletionCost(vm *virtv1.VirtualMachine) int {
+	value, exists := vm.Annotations[poolv1.VirtualMachinePoolDeletionCostAnnotation]
+	if !exists {
+		return 0
+	}
+
+	cost, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return cost
+}
+
 func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) error {
 
 	poolKey, err := controller.KeyFunc(pool)
@@ -525,10 +736,7 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 		count = len(elgibleVMs)
 	}
 
-	// random delete strategy
-	rand.Shuffle(len(elgibleVMs), func(i, j int) {
-		elgibleVMs[i], elgibleVMs[j] = elgibleVMs[j], elgibleVMs[i]
-	})
+	sortVMsForScaleIn(pool.Spec.ScaleInPolicy, elgibleVMs)
 
 	log.Log.Object(pool).Infof("Removing %d VMs from pool", count)
 
@@ -553,6 +761,13 @@ func (c *PoolController) scaleIn(pool *poolv1.VirtualMachinePool, vms []*virtv1.
 			}
 			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Deleted VM %s/%s with uid %v from pool", vm.Namespace, vm.Name, vm.ObjectMeta.UID)
 			log.Log.Object(pool).Infof("Deleted vm %s/%s from pool", vm.Namespace, vm.Name)
+
+			if poolDataVolumeRetentionWhenScaled(pool) == poolv1.DeletePersistentVolumeClaimRetentionPolicyType {
+				if err := c.deletePoolDataVolumes(pool, vm.Name); err != nil {
+					log.Log.Object(pool).Reason(err).Errorf("Failed to delete DataVolumes of vm %s/%s", vm.Namespace, vm.Name)
+					errChan <- err
+				}
+			}
 		}(i)
 	}
 
@@ -615,9 +830,15 @@ func indexFromName(name string) (int, error) {
 	return strconv.Atoi(slice[len(slice)-1])
 }
 
-func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachineSpec {
+// poolDataVolumeName returns the name of the DataVolume created from a DataVolumeTemplate of the pool for an index
+func poolDataVolumeName(templateName string, poolName string, idx int) string {
+	return fmt.Sprintf("%s-%s-%d", templateName, poolName, idx)
+}
+
+func indexVMSpec(pool *poolv1.VirtualMachinePool, idx int) *virtv1.VirtualMachineSpec {
+	spec := pool.Spec.VirtualMachineTemplate.Spec.DeepCopy()
 
-	if len(spec.DataVolumeTemplates) == 0 {
+	if len(spec.DataVolumeTemplates) == 0 && len(pool.Spec.DataVolumeTemplates) == 0 {
 		return spec
 	}
 
@@ -630,6 +851,10 @@ func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachin
 		spec.DataVolumeTemplates[i].Name = indexName
 	}
 
+	for _, template := range pool.Spec.DataVolumeTemplates {
+		dvNameMap[template.Name] = poolDataVolumeName(template.Name, pool.Name, idx)
+	}
+
 	for i, volume := range spec.Template.Spec.Volumes {
 		if volume.VolumeSource.PersistentVolumeClaim != nil {
 			indexName, ok := dvNameMap[volume.VolumeSource.PersistentVolumeClaim.ClaimName]
@@ -647,6 +872,190 @@ func indexVMSpec(spec *virtv1.VirtualMachineSpec, idx int) *virtv1.VirtualMachin
 	return spec
 }
 
+func poolDataVolumeRetentionWhenDeleted(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType {
+	if pool.Spec.PersistentVolumeClaimRetentionPolicy == nil || pool.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted == "" {
+		return poolv1.RetainPersistentVolumeClaimRetentionPolicyType
+	}
+	return pool.Spec.PersistentVolumeClaimRetentionPolicy.WhenDeleted
+}
+
+func poolDataVolumeRetentionWhenScaled(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType {
+	if pool.Spec.PersistentVolumeClaimRetentionPolicy == nil || pool.Spec.PersistentVolumeClaimRetentionPolicy.WhenScaled == "" {
+		return poolv1.RetainPersistentVolumeClaimRetentionPolicyType
+	}
+	return pool.Spec.PersistentVolumeClaimRetentionPolicy.WhenScaled
+}
+
+// ensurePoolDataVolumes creates the DataVolumes of an index from the DataVolumeTemplates of the pool.
+// DataVolumes whose DataVolume or claim already exists are not recreated, so the data of an index survives
+// the recreation of its VM as well as the garbage collection of completed DataVolumes.
+func (c *PoolController) ensurePoolDataVolumes(pool *poolv1.VirtualMachinePool, idx int) error {
+	priorityClassName := ""
+	if pool.Spec.VirtualMachineTemplate.Spec.Template != nil {
+		priorityClassName = pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.PriorityClassName
+	}
+
+	for _, template := range pool.Spec.DataVolumeTemplates {
+		name := poolDataVolumeName(template.Name, pool.Name, idx)
+		key := controller.NamespacedKey(pool.Namespace, name)
+
+		_, dvExists, err := c.dataVolumeInformer.GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		_, pvcExists, err := c.pvcInformer.GetStore().GetByKey(key)
+		if err != nil {
+			return err
+		}
+		if dvExists || pvcExists {
+			continue
+		}
+
+		template.Name = name
+		dv, err := storagetypes.GenerateDataVolumeFromTemplate(c.clientset, template, pool.Namespace, priorityClassName)
+		if err != nil {
+			return err
+		}
+		dv.Namespace = pool.Namespace
+		if poolDataVolumeRetentionWhenDeleted(pool) == poolv1.DeletePersistentVolumeClaimRetentionPolicyType {
+			dv.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+		}
+
+		_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(pool.Namespace).Create(context.Background(), dv, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return err
+		} else if err == nil {
+			log.Log.Object(pool).Infof("Created DataVolume %s/%s for pool", dv.Namespace, dv.Name)
+		}
+	}
+
+	return nil
+}
+
+// isPoolDataVolumeName checks whether a DataVolume or claim was created from a DataVolumeTemplate of the pool
+func isPoolDataVolumeName(pool *poolv1.VirtualMachinePool, name string) bool {
+	for _, template := range pool.Spec.DataVolumeTemplates {
+		prefix := fmt.Sprintf("%s-%s-", template.Name, pool.Name)
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if _, err := strconv.Atoi(name[len(prefix):]); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasOwnerRef(obj metav1.Object, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// poolOwnerRefsPatch returns the patch adding or removing the owner reference of the pool,
+// or nil when the owner references are already as expected
+func poolOwnerRefsPatch(pool *poolv1.VirtualMachinePool, obj metav1.Object, owned bool) ([]byte, error) {
+	if hasOwnerRef(obj, pool.UID) == owned {
+		return nil, nil
+	}
+
+	oldRefs := obj.GetOwnerReferences()
+	newRefs := []metav1.OwnerReference{}
+	for _, ref := range oldRefs {
+		if ref.UID != pool.UID {
+			newRefs = append(newRefs, ref)
+		}
+	}
+	if owned {
+		newRefs = append(newRefs, poolOwnerRef(pool))
+	}
+
+	if len(oldRefs) == 0 {
+		return patch.GeneratePatchPayload(patch.PatchOperation{
+			Op:    patch.PatchAddOp,
+			Path:  "/metadata/ownerReferences",
+			Value: newRefs,
+		})
+	}
+	return patch.GenerateTestReplacePatch("/metadata/ownerReferences", oldRefs, newRefs)
+}
+
+// reconcilePoolDataVolumeOwners makes the ownership of the DataVolumes created from the DataVolumeTemplates
+// follow the WhenDeleted retention policy of the pool. Claims whose DataVolume has been garbage collected
+// are owned directly.
+func (c *PoolController) reconcilePoolDataVolumeOwners(pool *poolv1.VirtualMachinePool) syncError {
+	if len(pool.Spec.DataVolumeTemplates) == 0 {
+		return nil
+	}
+	owned := poolDataVolumeRetentionWhenDeleted(pool) == poolv1.DeletePersistentVolumeClaimRetentionPolicyType
+
+	objs, err := c.pvcInformer.GetIndexer().ByIndex(cache.NamespaceIndex, pool.Namespace)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error while listing claims of the pool: %v", err), FailedDataVolumeOwnerReason}
+	}
+
+	for _, obj := range objs {
+		pvc := obj.(*k8score.PersistentVolumeClaim)
+		if !isPoolDataVolumeName(pool, pvc.Name) {
+			continue
+		}
+
+		dvObj, dvExists, err := c.dataVolumeInformer.GetStore().GetByKey(controller.NamespacedKey(pvc.Namespace, pvc.Name))
+		if err != nil {
+			return &syncErrorImpl{fmt.Errorf("Error while getting DataVolume %s of the pool: %v", pvc.Name, err), FailedDataVolumeOwnerReason}
+		}
+
+		if dvExists {
+			dv := dvObj.(*cdiv1.DataVolume)
+			payload, err := poolOwnerRefsPatch(pool, dv, owned)
+			if err == nil && payload != nil {
+				_, err = c.clientset.CdiClient().CdiV1beta1().DataVolumes(dv.Namespace).Patch(context.Background(), dv.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+			}
+			if err != nil {
+				return &syncErrorImpl{fmt.Errorf("Error while updating the owner of DataVolume %s: %v", dv.Name, err), FailedDataVolumeOwnerReason}
+			}
+			continue
+		}
+
+		payload, err := poolOwnerRefsPatch(pool, pvc, owned)
+		if err == nil && payload != nil {
+			_, err = c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+		}
+		if err != nil {
+			return &syncErrorImpl{fmt.Errorf("Error while updating the owner of claim %s: %v", pvc.Name, err), FailedDataVolumeOwnerReason}
+		}
+	}
+
+	return nil
+}
+
+// deletePoolDataVolumes deletes the DataVolumes and claims created from the DataVolumeTemplates of the pool for a VM
+func (c *PoolController) deletePoolDataVolumes(pool *poolv1.VirtualMachinePool, vmName string) error {
+	idx, err := indexFromName(vmName)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range pool.Spec.DataVolumeTemplates {
+		name := poolDataVolumeName(template.Name, pool.Name, idx)
+
+		err := c.clientset.CdiClient().CdiV1beta1().DataVolumes(pool.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		err = c.clientset.CoreV1().PersistentVolumeClaims(pool.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		log.Log.Object(pool).Infof("Deleted DataVolume %s/%s of pool", pool.Namespace, name)
+	}
+
+	return nil
+}
+
 func injectPoolRevisionLabelsIntoVM(vm *virtv1.VirtualMachine, revisionName string) *virtv1.VirtualMachine {
 
 	if vm.Labels == nil {
@@ -766,7 +1175,15 @@ func (c *PoolController) scaleOut(pool *poolv1.VirtualMachinePool, count int) er
 
 			vm.Labels = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vm.Annotations = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vm.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
+			err = c.ensurePoolDataVolumes(pool, index)
+			if err != nil {
+				c.expectations.CreationObserved(poolKey)
+				log.Log.Object(pool).Reason(err).Errorf("Failed to create DataVolumes for vm %s/%s of pool", pool.Namespace, name)
+				errChan <- err
+				return
+			}
+
+			vm.Spec = *indexVMSpec(pool, index)
 			vm = injectPoolRevisionLabelsIntoVM(vm, revisionName)
 
 			vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
@@ -844,11 +1261,18 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 				return
 			}
 
+			err = c.ensurePoolDataVolumes(pool, index)
+			if err != nil {
+				log.Log.Object(pool).Reason(err).Errorf("Failed to create DataVolumes for vm %s/%s of pool", vm.Namespace, vm.Name)
+				errChan <- err
+				return
+			}
+
 			vmCopy := vm.DeepCopy()
 
 			vmCopy.Labels = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)
 			vmCopy.Annotations = mapCopy(pool.Spec.VirtualMachineTemplate.ObjectMeta.Annotations)
-			vmCopy.Spec = *indexVMSpec(pool.Spec.VirtualMachineTemplate.Spec.DeepCopy(), index)
+			vmCopy.Spec = *indexVMSpec(pool, index)
 			vmCopy = injectPoolRevisionLabelsIntoVM(vmCopy, revisionName)
 
 			_, err = c.clientset.VirtualMachine(vmCopy.Namespace).Update(context.Background(), vmCopy)
@@ -874,37 +1298,114 @@ func (c *PoolController) opportunisticUpdate(pool *poolv1.VirtualMachinePool, vm
 	return nil
 }
 
-func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vmUpdatedList []*virtv1.VirtualMachine) error {
+type vmiUpdate struct {
+	vm         *virtv1.VirtualMachine
+	vmi        *virtv1.VirtualMachineInstance
+	updateType proactiveUpdateType
+}
+
+// isVMAvailable checks the VM and its VMI are ready. The VMI is looked up in the cache since the
+// ready condition of the VM lags behind the restart of its VMI.
+func (c *PoolController) isVMAvailable(vm *virtv1.VirtualMachine) bool {
+	if !isVMReady(vm) {
+		return false
+	}
+
+	obj, exists, _ := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if !exists {
+		return false
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	return vmi.DeletionTimestamp == nil &&
+		controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceReady, k8score.ConditionTrue)
+}
+
+// limitRestarts returns the restarts which fit into the unavailability budget of the rolling update.
+// Outdated VMs which are not ready are always restarted since they are unavailable anyway, the
+// remaining VMs are restarted starting with the highest index.
+func (c *PoolController) limitRestarts(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, restarts []vmiUpdate) []vmiUpdate {
+	if poolRollingUpdate(pool) == nil {
+		return restarts
+	}
+
+	budget := poolMaxUnavailable(pool) - (poolWantedReplicas(pool) - len(filterVMs(vms, c.isVMAvailable)))
+
+	sort.SliceStable(restarts, func(i, j int) bool {
+		a, _ := indexFromName(restarts[i].vm.Name)
+		b, _ := indexFromName(restarts[j].vm.Name)
+		return a > b
+	})
+
+	allowed := []vmiUpdate{}
+	for _, restart := range restarts {
+		if !c.isVMAvailable(restart.vm) {
+			allowed = append(allowed, restart)
+		} else if budget > 0 {
+			allowed = append(allowed, restart)
+			budget--
+		}
+	}
+	return allowed
+}
+
+func (c *PoolController) proactiveUpdate(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, vmUpdatedList []*virtv1.VirtualMachine) error {
+	updates := []vmiUpdate{}
+	restarts := []vmiUpdate{}
+	for _, vm := range vmUpdatedList {
+		vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
+		obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
+		if !exists {
+			// no VMI to update
+			continue
+		}
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp != nil {
+			// ignore VMIs which are already deleting
+			continue
+		}
+
+		updateType, err := c.isOutdatedVMI(pool, vm, vmi)
+		if err != nil {
+			return err
+		}
+		switch updateType {
+		case proactiveUpdateTypeRestart:
+			restarts = append(restarts, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		case proactiveUpdateTypePatchRevisionLabel:
+			updates = append(updates, vmiUpdate{vm: vm, vmi: vmi, updateType: updateType})
+		}
+	}
+	restarts = c.limitRestarts(pool, vms, restarts)
+	updates = append(updates, restarts...)
+
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return err
+	}
+	// Restarted VMIs count as unavailable until their deletion is observed,
+	// no further restarts are issued until then.
+	restartKeys := []string{}
+	for _, restart := range restarts {
+		restartKeys = append(restartKeys, controller.VirtualMachineInstanceKey(restart.vmi))
+	}
+	if len(restartKeys) > 0 {
+		c.expectations.ExpectDeletions(poolKey, restartKeys)
+	}
+
 	var wg sync.WaitGroup
-	wg.Add(len(vmUpdatedList))
-	errChan := make(chan error, len(vmUpdatedList))
-	for i := 0; i < len(vmUpdatedList); i++ {
+	wg.Add(len(updates))
+	errChan := make(chan error, len(updates))
+	for i := 0; i < len(updates); i++ {
 		go func(idx int) {
 			defer wg.Done()
-			vm := vmUpdatedList[idx]
-
-			vmiKey := controller.NamespacedKey(vm.Namespace, vm.Name)
-			obj, exists, _ := c.vmiInformer.GetStore().GetByKey(vmiKey)
-			if !exists {
-				// no VMI to update
-				return
-			}
-			vmi := obj.(*virtv1.VirtualMachineInstance)
-			if vmi.DeletionTimestamp != nil {
-				// ignore VMIs which are already deleting
-				return
-			}
+			vm := updates[idx].vm
+			vmi := updates[idx].vmi
 
-			updateType, err := c.isOutdatedVMI(vm, vmi)
-			if err != nil {
-				errChan <- err
-				return
-
-			}
-			switch updateType {
+			switch updates[idx].updateType {
 			case proactiveUpdateTypeRestart:
 				err := c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, &v1.DeleteOptions{})
 				if err != nil {
+					c.expectations.DeletionObserved(poolKey, controller.VirtualMachineInstanceKey(vmi))
 					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error proactively updating VM %s/%s by deleting outdated VMI: %v", vm.Namespace, vm.Name, err)
 					errChan <- err
 					return
@@ -976,7 +1477,7 @@ const (
 	proactiveUpdateTypeNone proactiveUpdateType = "no-update"
 )
 
-func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
+func (c *PoolController) isOutdatedVMI(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (proactiveUpdateType, error) {
 	// This function compares the pool revision (pool spec at a specific point in time) synced
 	// to the VM vs the one used to create the VMI. By comparing the pool spec revisions between
 	// the VM and VMI we can determine if the VM has mutated in a way that should result
@@ -996,6 +1497,9 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	//    proactive restart is required.
 	// 4. If the expected VMI template specs from the revisions are not identical in name, but
 	//    are identical in DeepEquals, patch the VMI with the new revision name used on the vm.
+	// 5. If live updates are preferred and the VMI template specs only differ in live updatable
+	//    features, the VM controller applies the changes to the running VMI, so only the
+	//    revision name gets patched.
 
 	vmRevisionName, exists := vm.Labels[virtv1.VirtualMachinePoolRevisionName]
 	if !exists {
@@ -1045,6 +1549,12 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	// the VM and the revision used to create the VMI, then the VMI
 	// must be updated.
 	if !equality.Semantic.DeepEqual(currentVMITemplate, expectedVMITemplate) {
+		rollingUpdate := poolRollingUpdate(pool)
+		if rollingUpdate != nil && rollingUpdate.PreferLiveUpdate &&
+			isLiveUpdatable(poolSpecRevisionForVM.VirtualMachineTemplate.Spec.LiveUpdateFeatures, currentVMITemplate, expectedVMITemplate) {
+			log.Log.Infof("Marking vmi %s/%s for live update", vm.Namespace, vm.Name)
+			return proactiveUpdateTypePatchRevisionLabel, nil
+		}
 		log.Log.Infof("Marking vmi %s/%s for update due out of sync spec", vm.Namespace, vm.Name)
 		return proactiveUpdateTypeRestart, nil
 	}
@@ -1054,6 +1564,26 @@ func (c *PoolController) isOutdatedVMI(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 	return proactiveUpdateTypePatchRevisionLabel, nil
 }
 
+// isLiveUpdatable returns true if the VMI templates only differ in features the VM controller can update on a running VMI.
+func isLiveUpdatable(features *virtv1.LiveUpdateFeatures, current, expected *virtv1.VirtualMachineInstanceTemplateSpec) bool {
+	if features == nil || current == nil || expected == nil {
+		return false
+	}
+
+	patched := current.DeepCopy()
+	if features.CPU != nil && patched.Spec.Domain.CPU != nil && expected.Spec.Domain.CPU != nil {
+		patched.Spec.Domain.CPU.Sockets = expected.Spec.Domain.CPU.Sockets
+	}
+	if features.Affinity != nil {
+		patched.Spec.Affinity = expected.Spec.Affinity.DeepCopy()
+	}
+	if features.Memory != nil && patched.Spec.Domain.Memory != nil && expected.Spec.Domain.Memory != nil {
+		patched.Spec.Domain.Memory.Guest = expected.Spec.Domain.Memory.Guest
+	}
+
+	return equality.Semantic.DeepEqual(patched, expected)
+}
+
 func (c *PoolController) isOutdatedVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) (bool, error) {
 
 	if vm.Labels == nil {
@@ -1143,6 +1673,11 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 	vmUpdatedList := []*virtv1.VirtualMachine{}
 
 	for _, vm := range vms {
+		if isPartitioned(pool, vm) {
+			// VMs below the partition keep the revision they were created from
+			continue
+		}
+
 		outdated, err := c.isOutdatedVM(pool, vm)
 		if err != nil {
 			return &syncErrorImpl{fmt.Errorf("Error while detected outdated VMs: %v", err), FailedUpdateReason}, false
@@ -1160,7 +1695,7 @@ func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.V
 		return &syncErrorImpl{fmt.Errorf("Error during VM update: %v", err), FailedUpdateReason}, false
 	}
 
-	err = c.proactiveUpdate(pool, vmUpdatedList)
+	err = c.proactiveUpdate(pool, vms, vmUpdatedList)
 	if err != nil {
 		return &syncErrorImpl{fmt.Errorf("Error during VMI update: %v", err), FailedUpdateReason}, false
 	}
@@ -1249,6 +1784,15 @@ func (c *PoolController) updateStatus(origPool *poolv1.VirtualMachinePool, vms [
 
 	pool.Status.Replicas = int32(len(vms))
 	pool.Status.ReadyReplicas = int32(len(c.filterReadyVMs(vms)))
+	if pool.Spec.UpdateStrategy != nil {
+		pool.Status.UpdateRevision = getRevisionName(pool)
+		pool.Status.UpdatedReplicas = int32(len(filterVMs(vms, func(vm *virtv1.VirtualMachine) bool {
+			return c.isUpdatedVM(pool, vm)
+		})))
+	} else {
+		pool.Status.UpdateRevision = ""
+		pool.Status.UpdatedReplicas = 0
+	}
 
 	if !equality.Semantic.DeepEqual(pool.Status, origPool.Status) || pool.Status.Replicas != pool.Status.ReadyReplicas {
 		err := c.statusUpdater.UpdateStatus(pool)
@@ -1340,6 +1884,10 @@ func (c *PoolController) execute(key string) error {
 		syncErr = c.pruneUnusedRevisions(pool, vms)
 	}
 
+	if syncErr == nil && pool.DeletionTimestamp == nil {
+		syncErr = c.reconcilePoolDataVolumeOwners(pool)
+	}
+
 	err = c.updateStatus(pool, vms, syncErr)
 	if err != nil {
 		return err
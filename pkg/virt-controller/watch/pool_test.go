@@ -29,8 +29,11 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -41,10 +44,14 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/api"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -80,6 +87,42 @@ var _ = Describe("Pool", func() {
 			2),
 	)
 
+	DescribeTable("Order VMs on scale in", func(policy poolv1.VirtualMachinePoolScaleInPolicy, expected []string) {
+		newVM := func(name string, age time.Duration, ready bool, created bool, cost string) *virtv1.VirtualMachine {
+			vm, _ := DefaultVirtualMachine(true)
+			vm.Name = name
+			vm.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+			vm.Status.Created = created
+			if ready {
+				markVmAsReady(vm)
+			}
+			if cost != "" {
+				vm.Annotations = map[string]string{poolv1.VirtualMachinePoolDeletionCostAnnotation: cost}
+			}
+			return vm
+		}
+
+		vms := []*virtv1.VirtualMachine{
+			newVM("vm-a", 2*time.Hour, true, true, "10"),
+			newVM("vm-b", 3*time.Hour, false, true, "5"),
+			newVM("vm-c", 1*time.Hour, true, false, "20"),
+			newVM("vm-d", 4*time.Hour, true, true, "invalid"),
+		}
+
+		sortVMsForScaleIn(policy, vms)
+
+		names := []string{}
+		for _, vm := range vms {
+			names = append(names, vm.Name)
+		}
+		Expect(names).To(Equal(expected))
+	},
+		Entry("oldest first", poolv1.VirtualMachinePoolScaleInOldestFirst, []string{"vm-d", "vm-b", "vm-a", "vm-c"}),
+		Entry("newest first", poolv1.VirtualMachinePoolScaleInNewestFirst, []string{"vm-c", "vm-a", "vm-b", "vm-d"}),
+		Entry("not ready first", poolv1.VirtualMachinePoolScaleInNotReadyFirst, []string{"vm-b", "vm-a", "vm-c", "vm-d"}),
+		Entry("least loaded", poolv1.VirtualMachinePoolScaleInLeastLoaded, []string{"vm-c", "vm-d", "vm-b", "vm-a"}),
+	)
+
 	Context("One valid Pool controller given", func() {
 
 		const (
@@ -100,12 +143,15 @@ var _ = Describe("Pool", func() {
 		var vmiInformer cache.SharedIndexInformer
 		var vmInformer cache.SharedIndexInformer
 		var poolInformer cache.SharedIndexInformer
+		var dataVolumeInformer cache.SharedIndexInformer
+		var pvcInformer cache.SharedIndexInformer
 		var stop chan struct{}
 		var controller *PoolController
 		var recorder *record.FakeRecorder
 		var mockQueue *testutils.MockWorkQueue
 		var client *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
+		var cdiClient *cdifake.Clientset
 
 		syncCaches := func(stop chan struct{}) {
 			go vmiInformer.Run(stop)
@@ -149,6 +195,8 @@ var _ = Describe("Pool", func() {
 			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 			vmInformer, vmSource = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 			poolInformer, poolSource = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			dataVolumeInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
 
@@ -169,6 +217,8 @@ var _ = Describe("Pool", func() {
 				vmInformer,
 				poolInformer,
 				crInformer,
+				dataVolumeInformer,
+				pvcInformer,
 				recorder,
 				uint(10))
 			// Wrap our workqueue to have a way to detect when we are done processing updates
@@ -194,6 +244,14 @@ var _ = Describe("Pool", func() {
 				return true, nil, nil
 			})
 			virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			cdiClient = cdifake.NewSimpleClientset()
+			cdiClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				Expect(action).To(BeNil())
+				return true, nil, nil
+			})
+			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
 			syncCaches(stop)
 		})
@@ -262,6 +320,217 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
 		})
 
+		Context("with pool DataVolumeTemplates", func() {
+			addDataVolumeTemplate := func(pool *poolv1.VirtualMachinePool) {
+				pool.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "data"},
+						Spec: cdiv1.DataVolumeSpec{
+							Source: &cdiv1.DataVolumeSource{Blank: &cdiv1.DataVolumeBlankImage{}},
+						},
+					},
+				}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes = append(pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "data",
+					VolumeSource: v1.VolumeSource{
+						DataVolume: &v1.DataVolumeSource{Name: "data"},
+					},
+				})
+			}
+
+			newPoolDataVolume := func(pool *poolv1.VirtualMachinePool, idx int, owned bool) *cdiv1.DataVolume {
+				dv := &cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("data-%s-%d", pool.Name, idx),
+						Namespace: pool.Namespace,
+					},
+				}
+				if owned {
+					dv.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+				}
+				return dv
+			}
+
+			newPoolClaim := func(pool *poolv1.VirtualMachinePool, idx int, owned bool) *k8sv1.PersistentVolumeClaim {
+				pvc := &k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("data-%s-%d", pool.Name, idx),
+						Namespace: pool.Namespace,
+					},
+				}
+				if owned {
+					pvc.OwnerReferences = []metav1.OwnerReference{poolOwnerRef(pool)}
+				}
+				return pvc
+			}
+
+			expectOwnerPatch := func(fakeClient *testing.Fake, resource, name string, owned bool) *bool {
+				patched := false
+				fakeClient.PrependReactor("patch", resource, func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patchAction := action.(testing.PatchAction)
+					Expect(patchAction.GetName()).To(Equal(name))
+					ops, err := patch.UnmarshalPatch(patchAction.GetPatch())
+					Expect(err).ToNot(HaveOccurred())
+					last := ops[len(ops)-1]
+					Expect(last.Path).To(Equal("/metadata/ownerReferences"))
+					refs, err := json.Marshal(last.Value)
+					Expect(err).ToNot(HaveOccurred())
+					if owned {
+						Expect(string(refs)).To(ContainSubstring(`"kind":"VirtualMachinePool"`))
+					} else {
+						Expect(string(refs)).ToNot(ContainSubstring(`"kind":"VirtualMachinePool"`))
+					}
+					patched = true
+					return true, nil, nil
+				})
+				return &patched
+			}
+
+			DescribeTable("should create the DataVolume of the index before the VM", func(policy *poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy, expectOwned bool) {
+				pool, vm := DefaultPool(1)
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = policy
+				addDataVolumeTemplate(pool)
+
+				addPool(pool)
+
+				poolRevision := createPoolRevision(pool)
+				expectControllerRevisionCreation(poolRevision)
+
+				dvName := fmt.Sprintf("data-%s-0", pool.Name)
+				cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					dv := action.(testing.CreateAction).GetObject().(*cdiv1.DataVolume)
+					Expect(dv.Name).To(Equal(dvName))
+					Expect(dv.Namespace).To(Equal(pool.Namespace))
+					if expectOwned {
+						Expect(dv.OwnerReferences).To(ConsistOf(poolOwnerRef(pool)))
+					} else {
+						Expect(dv.OwnerReferences).To(BeEmpty())
+					}
+					return true, dv, nil
+				})
+
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					volumes := arg.(*v1.VirtualMachine).Spec.Template.Spec.Volumes
+					Expect(volumes).To(ContainElement(v1.Volume{
+						Name: "data",
+						VolumeSource: v1.VolumeSource{
+							DataVolume: &v1.DataVolumeSource{Name: dvName},
+						},
+					}))
+				}).Return(vm, nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			},
+				Entry("retained by default", nil, false),
+				Entry("owned by the pool when deleted with it", &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: poolv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				}, true),
+			)
+
+			DescribeTable("should not recreate the DataVolume of the index", func(dvExists, pvcExists bool) {
+				pool, vm := DefaultPool(1)
+				addDataVolumeTemplate(pool)
+
+				addPool(pool)
+				if dvExists {
+					Expect(dataVolumeInformer.GetStore().Add(newPoolDataVolume(pool, 0, false))).To(Succeed())
+				}
+				if pvcExists {
+					Expect(pvcInformer.GetStore().Add(newPoolClaim(pool, 0, false))).To(Succeed())
+				}
+
+				poolRevision := createPoolRevision(pool)
+				expectControllerRevisionCreation(poolRevision)
+
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).Return(vm, nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			},
+				Entry("when the DataVolume exists", true, false),
+				Entry("when the claim exists", false, true),
+				Entry("when both exist", true, true),
+			)
+
+			DescribeTable("should reconcile the owner of the DataVolumes with the WhenDeleted policy", func(whenDeleted poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType, owned bool) {
+				pool, _ := DefaultPool(0)
+				pool.Spec.Paused = true
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenDeleted: whenDeleted,
+				}
+				addDataVolumeTemplate(pool)
+
+				addPool(pool)
+				// index 0 still has its DataVolume, the DataVolume of index 1 has been garbage collected
+				Expect(dataVolumeInformer.GetStore().Add(newPoolDataVolume(pool, 0, !owned))).To(Succeed())
+				Expect(pvcInformer.GetStore().Add(newPoolClaim(pool, 0, false))).To(Succeed())
+				Expect(pvcInformer.GetStore().Add(newPoolClaim(pool, 1, !owned))).To(Succeed())
+				// claims of other pools and already reconciled objects are left alone
+				Expect(pvcInformer.GetStore().Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "data-other-0", Namespace: pool.Namespace},
+				})).To(Succeed())
+				Expect(dataVolumeInformer.GetStore().Add(newPoolDataVolume(pool, 2, owned))).To(Succeed())
+				Expect(pvcInformer.GetStore().Add(newPoolClaim(pool, 2, false))).To(Succeed())
+
+				dvPatched := expectOwnerPatch(&cdiClient.Fake, "datavolumes", fmt.Sprintf("data-%s-0", pool.Name), owned)
+				pvcPatched := expectOwnerPatch(&k8sClient.Fake, "persistentvolumeclaims", fmt.Sprintf("data-%s-1", pool.Name), owned)
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(testing.UpdateAction).GetObject(), nil
+				})
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulPausedPoolReason)
+				Expect(*dvPatched).To(BeTrue())
+				Expect(*pvcPatched).To(BeTrue())
+			},
+				Entry("adding the pool when it is Delete", poolv1.DeletePersistentVolumeClaimRetentionPolicyType, true),
+				Entry("removing the pool when it is Retain", poolv1.RetainPersistentVolumeClaimRetentionPolicyType, false),
+			)
+
+			DescribeTable("on scale in", func(policy *poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy, expectDeletion bool) {
+				pool, vm := DefaultPool(0)
+				pool.Spec.PersistentVolumeClaimRetentionPolicy = policy
+				addDataVolumeTemplate(pool)
+
+				addPool(pool)
+
+				vm.Name = fmt.Sprintf("%s-3", pool.Name)
+				addVM(vm)
+
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, action.(testing.UpdateAction).GetObject(), nil
+				})
+
+				dvName := fmt.Sprintf("data-%s-3", pool.Name)
+				deleted := []string{}
+				if expectDeletion {
+					cdiClient.Fake.PrependReactor("delete", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						deleted = append(deleted, "dv/"+action.(testing.DeleteAction).GetName())
+						return true, nil, nil
+					})
+					k8sClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						deleted = append(deleted, "pvc/"+action.(testing.DeleteAction).GetName())
+						return true, nil, errors.NewNotFound(k8sv1.Resource("persistentvolumeclaims"), dvName)
+					})
+				}
+
+				vmInterface.EXPECT().Delete(context.Background(), vm.Name, gomock.Any()).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+
+				if expectDeletion {
+					Expect(deleted).To(Equal([]string{"dv/" + dvName, "pvc/" + dvName}))
+				}
+			},
+				Entry("should retain the DataVolume by default", nil, false),
+				Entry("should delete the DataVolume of the index with the Delete policy", &poolv1.VirtualMachinePoolPersistentVolumeClaimRetentionPolicy{
+					WhenScaled: poolv1.DeletePersistentVolumeClaimRetentionPolicyType,
+				}, true),
+			)
+		})
+
 		It("should update VM when VM template changes, but not VMI unless VMI template changes", func() {
 			pool, vm := DefaultPool(1)
 			pool.Status.Replicas = 1
@@ -371,6 +640,201 @@ var _ = Describe("Pool", func() {
 			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
 		})
 
+		Context("with a rolling update strategy", func() {
+			var pool *poolv1.VirtualMachinePool
+			var vm *v1.VirtualMachine
+			var oldPoolRevision, newPoolRevision *appsv1.ControllerRevision
+
+			BeforeEach(func() {
+				pool, vm = DefaultPool(3)
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.VirtualMachinePoolRollingUpdate{},
+				}
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1}
+				pool.Spec.VirtualMachineTemplate.Spec.LiveUpdateFeatures = &v1.LiveUpdateFeatures{CPU: &v1.LiveUpdateCPU{}}
+				oldPoolRevision = createPoolRevision(pool)
+
+				pool.Generation = 123
+			})
+
+			addPoolMember := func(idx int, vmRevisionName string, vmiRevisionName string, ready bool) *v1.VirtualMachineInstance {
+				member := injectPoolRevisionLabelsIntoVM(vm.DeepCopy(), vmRevisionName)
+				member.Name = fmt.Sprintf("%s-%d", pool.Name, idx)
+
+				vmi := api.NewMinimalVMI(member.Name)
+				vmi.Spec = member.Spec.Template.Spec
+				vmi.Namespace = member.Namespace
+				vmi.Labels = mapCopy(member.Spec.Template.ObjectMeta.Labels)
+				vmi.Labels[virtv1.VirtualMachinePoolRevisionName] = vmiRevisionName
+				vmi.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion:         virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					Kind:               virtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:               member.ObjectMeta.Name,
+					UID:                member.ObjectMeta.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				}}
+				if ready {
+					markVmAsReady(member)
+					markAsReady(vmi)
+				}
+
+				addVM(member)
+				addVMI(vmi, vmRevisionName != vmiRevisionName)
+				return vmi
+			}
+
+			addPoolWithRevisions := func() {
+				newPoolRevision = createPoolRevision(pool)
+				addPool(pool)
+				addCR(oldPoolRevision)
+				addCR(newPoolRevision)
+			}
+
+			expectStatusUpdate := func(updatedReplicas int32) {
+				client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					update, ok := action.(testing.UpdateAction)
+					Expect(ok).To(BeTrue())
+					updateObj := update.GetObject().(*poolv1.VirtualMachinePool)
+					Expect(updateObj.Status.UpdateRevision).To(Equal(newPoolRevision.Name))
+					Expect(updateObj.Status.UpdatedReplicas).To(Equal(updatedReplicas))
+					return true, update.GetObject(), nil
+				})
+			}
+
+			It("should restart outdated VMIs within maxUnavailable, starting with the highest index", func() {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPoolWithRevisions()
+				for x := 0; x < 3; x++ {
+					addPoolMember(x, newPoolRevision.Name, oldPoolRevision.Name, true)
+				}
+
+				vmiInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-2", pool.Name), gomock.Any()).Return(nil)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should not restart more VMIs until the restart is observed", func() {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPoolWithRevisions()
+				addPoolMember(0, newPoolRevision.Name, oldPoolRevision.Name, true)
+				addPoolMember(1, newPoolRevision.Name, oldPoolRevision.Name, true)
+				restarted := addPoolMember(2, newPoolRevision.Name, oldPoolRevision.Name, true)
+
+				vmiInterface.EXPECT().Delete(context.Background(), restarted.Name, gomock.Any()).Return(nil).Times(1)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+
+				// the VM of the restarted VMI is still reported ready
+				key, err := virtcontroller.KeyFunc(pool)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(controller.expectations.SatisfiedExpectations(key)).To(BeFalse())
+				mockQueue.Add(key)
+				controller.Execute()
+				Expect(recorder.Events).To(BeEmpty())
+
+				mockQueue.ExpectAdds(1)
+				vmiSource.Delete(restarted)
+				mockQueue.Wait()
+				Expect(controller.expectations.SatisfiedExpectations(key)).To(BeTrue())
+			})
+
+			It("should count VMs whose VMI is gone as unavailable", func() {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPoolWithRevisions()
+				restarted := addPoolMember(0, newPoolRevision.Name, newPoolRevision.Name, true)
+				addPoolMember(1, newPoolRevision.Name, oldPoolRevision.Name, true)
+				addPoolMember(2, newPoolRevision.Name, oldPoolRevision.Name, true)
+				// the VMI has been restarted but the VM is still reported ready
+				Expect(vmiInformer.GetStore().Delete(restarted)).To(Succeed())
+
+				vmiInterface.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				expectStatusUpdate(1)
+
+				controller.Execute()
+			})
+
+			It("should only restart outdated VMIs which are not ready when maxUnavailable is reached", func() {
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPoolWithRevisions()
+				addPoolMember(0, newPoolRevision.Name, oldPoolRevision.Name, false)
+				addPoolMember(1, newPoolRevision.Name, oldPoolRevision.Name, true)
+				addPoolMember(2, newPoolRevision.Name, oldPoolRevision.Name, true)
+
+				vmiInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-0", pool.Name), gomock.Any()).Return(nil)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should not update VMs below the partition", func() {
+				pool.Spec.Replicas = pointer.P(int32(2))
+				pool.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.P(int32(1))
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPool(pool)
+				addCR(oldPoolRevision)
+				newPoolRevision = createPoolRevision(pool)
+				addPoolMember(0, oldPoolRevision.Name, oldPoolRevision.Name, true)
+				addPoolMember(1, oldPoolRevision.Name, oldPoolRevision.Name, true)
+
+				expectControllerRevisionCreation(newPoolRevision)
+				vmInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					newVM := arg.(*v1.VirtualMachine)
+					Expect(newVM.Name).To(Equal(fmt.Sprintf("%s-1", pool.Name)))
+					Expect(newVM.Labels).To(HaveKeyWithValue(virtv1.VirtualMachinePoolRevisionName, newPoolRevision.Name))
+				}).Return(vm, nil)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulUpdateVirtualMachineReason)
+			})
+
+			It("should create surge VMs before restarting outdated VMIs", func() {
+				pool.Spec.Replicas = pointer.P(int32(2))
+				pool.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = pointer.P(intstr.FromInt(0))
+				pool.Spec.UpdateStrategy.RollingUpdate.MaxSurge = pointer.P(intstr.FromString("50%"))
+				pool.Spec.VirtualMachineTemplate.Spec.Template.ObjectMeta.Labels = map[string]string{"newkey": "newval"}
+				addPoolWithRevisions()
+				addPoolMember(0, newPoolRevision.Name, oldPoolRevision.Name, true)
+				addPoolMember(1, newPoolRevision.Name, oldPoolRevision.Name, true)
+
+				vmInterface.EXPECT().Create(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					Expect(arg.(*v1.VirtualMachine).Name).To(Equal(fmt.Sprintf("%s-2", pool.Name)))
+				}).Return(vm, nil)
+				vmiInterface.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should live update VMIs instead of restarting them when preferred", func() {
+				pool.Spec.Replicas = pointer.P(int32(1))
+				pool.Spec.UpdateStrategy.RollingUpdate.PreferLiveUpdate = true
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU.Sockets = 2
+				addPoolWithRevisions()
+				addPoolMember(0, newPoolRevision.Name, oldPoolRevision.Name, true)
+
+				vmiInterface.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				vmiInterface.EXPECT().Patch(context.Background(), fmt.Sprintf("%s-0", pool.Name), types.JSONPatchType, gomock.Any(), gomock.Any()).Do(
+					func(ctx context.Context, name string, pt types.PatchType, data []byte, opts *metav1.PatchOptions, _ ...string) {
+						Expect(string(data)).To(ContainSubstring(newPoolRevision.Name))
+					}).Return(nil, nil)
+				expectStatusUpdate(0)
+
+				controller.Execute()
+			})
+		})
+
 		It("should do nothing", func() {
 			pool, vm := DefaultPool(1)
 			vm.Name = fmt.Sprintf("%s-0", pool.Name)
@@ -590,6 +1054,35 @@ var _ = Describe("Pool", func() {
 			}
 		})
 
+		It("should delete VMs in the order of the scale-in policy", func() {
+			pool, vm := DefaultPool(1)
+			pool.Spec.ScaleInPolicy = poolv1.VirtualMachinePoolScaleInOldestFirst
+
+			addPool(pool)
+
+			for x := 0; x < 3; x++ {
+				newVM := vm.DeepCopy()
+				newVM.Name = fmt.Sprintf("%s-%d", pool.Name, x)
+				newVM.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Duration(x) * time.Minute))
+				addVM(newVM)
+			}
+
+			client.Fake.PrependReactor("update", "virtualmachinepools", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				update, ok := action.(testing.UpdateAction)
+				Expect(ok).To(BeTrue())
+				return true, update.GetObject(), nil
+			})
+
+			vmInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-0", pool.Name), gomock.Any()).Return(nil)
+			vmInterface.EXPECT().Delete(context.Background(), fmt.Sprintf("%s-1", pool.Name), gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			for x := 0; x < 2; x++ {
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			}
+		})
+
 		It("should not delete vms which are already marked deleted", func() {
 
 			pool, vm := DefaultPool(0)
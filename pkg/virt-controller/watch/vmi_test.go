@@ -61,6 +61,7 @@ import (
 
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/network/sriov"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
@@ -815,6 +816,58 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			controller.Execute()
 			Expect(pod.Annotations).To(HaveKeyWithValue(sriov.NetworkPCIMapAnnot, `{"`+sriovNetworkName+`":"`+selectedPCIAddress+`"}`))
 		})
+		It("should patch network-vhostuser-socket-map when vhost-user networks exist", func() {
+			const (
+				vhostUserNetworkName = "network1"
+				socketPath           = "/var/run/kubevirt-vhostuser/ovs.sock"
+			)
+			vmi := NewPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi = addDefaultNetwork(vmi, defaultNetworkName)
+			vmi = addDefaultNetworkStatus(vmi, defaultNetworkName)
+			vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, virtv1.Interface{
+				Name:                   vhostUserNetworkName,
+				InterfaceBindingMethod: virtv1.InterfaceBindingMethod{VhostUser: &virtv1.InterfaceVhostUser{}},
+			})
+			vmi.Spec.Networks = append(vmi.Spec.Networks, newMultusNetwork(vhostUserNetworkName, netAttachDefName))
+			vmi = addDefaultNetworkStatus(vmi, vhostUserNetworkName)
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			addVirtualMachine(vmi)
+			podFeeder.Add(pod)
+			addActivePods(vmi, pod.UID, "")
+			pod.Annotations[networkv1.NetworkStatusAnnot] = `
+			[
+			{
+			"name": "kindnet",
+			"interface": "eth0",
+			"ips": [
+			  "10.244.2.131"
+			],
+			"mac": "82:cf:7c:98:43:7e",
+			"default": true,
+			"dns": {}
+			},
+			{
+			"name": "` + netAttachDefName + `",
+			"interface": "poda7662f44d65",
+			"dns": {},
+			"device-info": {
+			  "type": "vhost-user",
+			  "version": "1.1.0",
+			  "vhost-user": {
+			    "mode": "client",
+			    "path": "` + socketPath + `"
+			  }
+			}
+			}
+			]`
+
+			vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vmi, nil)
+			prependInjectPodPatch(pod)
+			controller.Execute()
+			Expect(pod.Annotations).To(HaveKeyWithValue(vhostuser.NetworkSocketMapAnnot,
+				`{"`+vhostUserNetworkName+`":{"path":"`+socketPath+`","mode":"client"}}`))
+		})
 	})
 
 	Context("On valid VirtualMachineInstance given", func() {
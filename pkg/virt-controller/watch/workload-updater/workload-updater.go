@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -13,8 +14,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	k8sv1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,6 +29,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/pdbs"
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -63,6 +68,8 @@ const defaultThrottleInterval = 5 * time.Second
 const defaultBatchDeletionIntervalSeconds = 60
 const defaultBatchDeletionCount = 10
 
+const defaultCanaryPeriod = 10 * time.Minute
+
 func init() {
 	prometheus.MustRegister(outdatedVirtualMachineInstanceWorkloads)
 }
@@ -73,6 +80,10 @@ type WorkloadUpdateController struct {
 	vmiInformer           cache.SharedIndexInformer
 	podInformer           cache.SharedIndexInformer
 	migrationInformer     cache.SharedIndexInformer
+	vmInformer            cache.SharedIndexInformer
+	vmdbInformer          cache.SharedIndexInformer
+	nodeInformer          cache.SharedIndexInformer
+	namespaceInformer     cache.SharedIndexInformer
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	kubeVirtInformer      cache.SharedIndexInformer
@@ -88,7 +99,9 @@ type updateData struct {
 	migratableOutdatedVMIs []*virtv1.VirtualMachineInstance
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 
-	numActiveMigrations int
+	numUpdatedVMIs       int
+	numActiveMigrations  int
+	unfinishedMigrations []*virtv1.VirtualMachineInstanceMigration
 }
 
 func NewWorkloadUpdateController(
@@ -97,6 +110,10 @@ func NewWorkloadUpdateController(
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmdbInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -113,6 +130,10 @@ func NewWorkloadUpdateController(
 		podInformer:           podInformer,
 		migrationInformer:     migrationInformer,
 		kubeVirtInformer:      kubeVirtInformer,
+		vmInformer:            vmInformer,
+		vmdbInformer:          vmdbInformer,
+		nodeInformer:          nodeInformer,
+		namespaceInformer:     namespaceInformer,
 		recorder:              recorder,
 		clientset:             clientset,
 		statusUpdater:         status.NewKubeVirtStatusUpdater(clientset),
@@ -263,7 +284,7 @@ func (c *WorkloadUpdateController) Run(stopCh <-chan struct{}) {
 	threadiness := 1
 
 	// Wait for cache sync before we start the controller
-	cache.WaitForCacheSync(stopCh, c.migrationInformer.HasSynced, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.kubeVirtInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.migrationInformer.HasSynced, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.kubeVirtInformer.HasSynced, c.vmInformer.HasSynced, c.vmdbInformer.HasSynced, c.nodeInformer.HasSynced, c.namespaceInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -358,6 +379,7 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 	runningMigrations := migrationutils.FilterRunningMigrations(migrations)
 	data.numActiveMigrations = len(runningMigrations)
+	data.unfinishedMigrations = migrations
 
 	objs := c.vmiInformer.GetStore().List()
 	for _, obj := range objs {
@@ -366,6 +388,9 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 			// only consider running VMIs that aren't being shutdown
 			continue
 		} else if !c.isOutdated(vmi) && !c.doesRequireMigration(vmi) {
+			if vmi.Status.LauncherContainerImageVersion == c.launcherImage {
+				data.numUpdatedVMIs++
+			}
 			continue
 		}
 
@@ -463,6 +488,14 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		}
 	}
 
+	rollout := c.rolloutStatus(kv, data)
+	if !equality.Semantic.DeepEqual(kv.Status.WorkloadUpdate, rollout) {
+		err = c.patchWorkloadUpdateStatus(kv, rollout)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Rather than enqueing based on VMI activity, we keep periodically poping the loop
 	// until all VMIs are updated. Watching all VMI activity is chatty for this controller
 	// when we don't need to be that efficent in how quickly the updates are being processed.
@@ -470,12 +503,43 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		c.queue.AddAfter(key, periodicReEnqueueIntervalSeconds)
 	}
 
+	if rollout.Paused {
+		log.Log.Object(kv).V(4).Infof("workload updates are paused: %s", rollout.PausedReason)
+		return nil
+	}
+
+	if len(kv.Spec.WorkloadUpdateStrategy.RolloutOrder) > 0 {
+		c.filterCurrentRolloutStage(kv.Spec.WorkloadUpdateStrategy.RolloutOrder, data)
+	}
+
 	// Randomizes list so we don't always re-attempt the same vmis in
 	// the event that some are having difficulty being relocated
 	rand.Shuffle(len(data.migratableOutdatedVMIs), func(i, j int) {
 		data.migratableOutdatedVMIs[i], data.migratableOutdatedVMIs[j] = data.migratableOutdatedVMIs[j], data.migratableOutdatedVMIs[i]
 	})
 
+	// This is a best effort attempt at not creating a bunch of pending migrations
+	// in the event that we've hit the global max. This check isn't meant to prevent
+	// overloading the cluster. The migration controller handles that. We're merely
+	// optimizing here by not introducing new migration objects we know can't be processed
+	// right now.
+	maxParallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
+
+	maxNewMigrations := maxParallelMigrations - data.numActiveMigrations
+	if maxNewMigrations < 0 {
+		maxNewMigrations = 0
+	}
+
+	migrateCount := int(math.Min(float64(maxNewMigrations), float64(len(data.migratableOutdatedVMIs))))
+
+	// while the canary is updated, only as many workloads as the canary still needs are migrated
+	// and none are evicted, as only successfully migrated workloads prove the launcher image
+	maxEvictions := len(data.evictOutdatedVMIs)
+	if canaryMigrations, isCanary := c.canaryMigrations(&kv.Spec.WorkloadUpdateStrategy, rollout, data); isCanary {
+		migrateCount = int(math.Min(float64(migrateCount), float64(canaryMigrations)))
+		maxEvictions = 0
+	}
+
 	batchDeletionInterval := time.Duration(defaultBatchDeletionIntervalSeconds) * time.Second
 	batchDeletionCount := defaultBatchDeletionCount
 
@@ -490,42 +554,24 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	now := time.Now()
 
 	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
-	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
-		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
+	if now.After(nextBatch) && maxEvictions > 0 {
+		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(maxEvictions)))
 		c.lastDeletionBatch = now
 	} else {
 		batchDeletionCount = 0
 	}
 
-	// This is a best effort attempt at not creating a bunch of pending migrations
-	// in the event that we've hit the global max. This check isn't meant to prevent
-	// overloading the cluster. The migration controller handles that. We're merely
-	// optimizing here by not introducing new migration objects we know can't be processed
-	// right now.
-	maxParallelMigrations := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
-
-	maxNewMigrations := maxParallelMigrations - data.numActiveMigrations
-	if maxNewMigrations < 0 {
-		maxNewMigrations = 0
-	}
-
-	migrateCount := int(math.Min(float64(maxNewMigrations), float64(len(data.migratableOutdatedVMIs))))
-	var migrationCandidates []*virtv1.VirtualMachineInstance
-	if migrateCount > 0 {
-		migrationCandidates = data.migratableOutdatedVMIs[0:migrateCount]
-	}
-
-	var evictionCandidates []*virtv1.VirtualMachineInstance
-	if batchDeletionCount > 0 {
-		evictionCandidates = data.evictOutdatedVMIs[0:batchDeletionCount]
-	}
+	// VirtualMachineDisruptionBudgets limit how many VMIs of a group are updated at once
+	tracker := pdbs.NewDisruptionBudgetTracker(c.vmdbInformer, c.vmInformer, c.vmiInformer, data.unfinishedMigrations)
+	migrationCandidates := selectCandidates(tracker, data.migratableOutdatedVMIs, migrateCount)
+	evictionCandidates := selectCandidates(tracker, data.evictOutdatedVMIs, batchDeletionCount)
 
 	wgLen := len(migrationCandidates) + len(evictionCandidates)
 	wg := &sync.WaitGroup{}
 	wg.Add(wgLen)
 	errChan := make(chan error, wgLen)
 
-	c.migrationExpectations.ExpectCreations(key, migrateCount)
+	c.migrationExpectations.ExpectCreations(key, len(migrationCandidates))
 	for _, vmi := range migrationCandidates {
 		go func(vmi *virtv1.VirtualMachineInstance) {
 			defer wg.Done()
@@ -595,3 +641,223 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	return nil
 }
+
+// selectCandidates selects up to count candidates whose VirtualMachineDisruptionBudgets allow a disruption.
+func selectCandidates(tracker *pdbs.DisruptionBudgetTracker, candidates []*virtv1.VirtualMachineInstance, count int) []*virtv1.VirtualMachineInstance {
+	var selected []*virtv1.VirtualMachineInstance
+	for _, vmi := range candidates {
+		if len(selected) == count {
+			break
+		}
+		if tracker.Allow(vmi) {
+			selected = append(selected, vmi)
+		}
+	}
+	return selected
+}
+
+// rolloutStatus calculates the progress of the rollout of the current launcher image
+// and whether the rollout is paused.
+func (c *WorkloadUpdateController) rolloutStatus(kv *virtv1.KubeVirt, data *updateData) *virtv1.KubeVirtWorkloadUpdateStatus {
+	strategy := &kv.Spec.WorkloadUpdateStrategy
+	now := metav1.Now()
+
+	rollout := &virtv1.KubeVirtWorkloadUpdateStatus{
+		LauncherImage:     c.launcherImage,
+		StartTime:         &now,
+		UpdatedWorkloads:  data.numUpdatedVMIs,
+		OutdatedWorkloads: len(data.allOutdatedVMIs),
+	}
+
+	// a new launcher image starts a new rollout
+	countedMigrations := map[types.UID]bool{}
+	if prev := kv.Status.WorkloadUpdate; prev != nil && prev.LauncherImage == c.launcherImage {
+		rollout.StartTime = prev.StartTime
+		rollout.CanaryCompletionTime = prev.CanaryCompletionTime
+		rollout.MigratedWorkloads = prev.MigratedWorkloads
+		rollout.FailedMigrations = prev.FailedMigrations
+		for _, uid := range prev.FinishedMigrations {
+			countedMigrations[uid] = true
+		}
+	}
+	c.countFinishedMigrations(rollout, countedMigrations)
+
+	canarySize := canarySize(strategy, rollout)
+	if rollout.CanaryCompletionTime == nil && canarySize > 0 &&
+		(rollout.MigratedWorkloads >= canarySize || !hasCanaryCandidates(data)) {
+		rollout.CanaryCompletionTime = &now
+	}
+
+	switch {
+	case strategy.Paused:
+		rollout.PausedReason = virtv1.WorkloadUpdatePausedReasonPaused
+	case strategy.MaxFailedMigrations != nil && rollout.FailedMigrations > *strategy.MaxFailedMigrations:
+		rollout.PausedReason = virtv1.WorkloadUpdatePausedReasonFailedMigrations
+	case canarySize > 0 && rollout.OutdatedWorkloads > 0 && rollout.CanaryCompletionTime != nil &&
+		now.Time.Before(rollout.CanaryCompletionTime.Add(canaryPeriod(strategy))):
+		rollout.PausedReason = virtv1.WorkloadUpdatePausedReasonCanary
+	}
+	rollout.Paused = rollout.PausedReason != ""
+
+	return rollout
+}
+
+// countFinishedMigrations adds the workload update migrations of the rollout which finished since the last sync
+// to the migrated workloads and the failed migrations. The finished migrations are remembered until they are
+// removed, so that each of them is only counted once while the counts survive their garbage collection.
+func (c *WorkloadUpdateController) countFinishedMigrations(rollout *virtv1.KubeVirtWorkloadUpdateStatus, counted map[types.UID]bool) {
+	var finished []types.UID
+	for _, obj := range c.migrationInformer.GetStore().List() {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if _, ok := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; !ok {
+			continue
+		}
+		if !migration.IsFinal() {
+			continue
+		}
+		if rollout.StartTime != nil && migration.CreationTimestamp.Before(rollout.StartTime) {
+			continue
+		}
+
+		finished = append(finished, migration.UID)
+		if counted[migration.UID] {
+			continue
+		}
+		if migration.Status.Phase == virtv1.MigrationSucceeded {
+			rollout.MigratedWorkloads++
+		} else {
+			rollout.FailedMigrations++
+		}
+	}
+
+	sort.Slice(finished, func(i, j int) bool { return finished[i] < finished[j] })
+	rollout.FinishedMigrations = finished
+}
+
+// hasCanaryCandidates reports whether workloads are left which can be migrated as part of the canary.
+func hasCanaryCandidates(data *updateData) bool {
+	return len(data.migratableOutdatedVMIs) > 0 || countWorkloadUpdateMigrations(data.unfinishedMigrations) > 0
+}
+
+func countWorkloadUpdateMigrations(migrations []*virtv1.VirtualMachineInstanceMigration) int {
+	count := 0
+	for _, migration := range migrations {
+		if _, ok := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; ok {
+			count++
+		}
+	}
+	return count
+}
+
+// canaryMigrations returns how many more workloads can be migrated before the canary is updated.
+// The second return value is false if the canary is already updated or no canary is requested.
+func (c *WorkloadUpdateController) canaryMigrations(strategy *virtv1.KubeVirtWorkloadUpdateStrategy, rollout *virtv1.KubeVirtWorkloadUpdateStatus, data *updateData) (int, bool) {
+	canarySize := canarySize(strategy, rollout)
+	if canarySize == 0 || rollout.CanaryCompletionTime != nil {
+		return 0, false
+	}
+
+	disruptions := canarySize - rollout.MigratedWorkloads - countWorkloadUpdateMigrations(data.unfinishedMigrations)
+	if disruptions < 0 {
+		disruptions = 0
+	}
+	return disruptions, true
+}
+
+func canarySize(strategy *virtv1.KubeVirtWorkloadUpdateStrategy, rollout *virtv1.KubeVirtWorkloadUpdateStatus) int {
+	if strategy.CanaryPercentage == nil || *strategy.CanaryPercentage <= 0 {
+		return 0
+	}
+	total := rollout.UpdatedWorkloads + rollout.OutdatedWorkloads
+	return int(math.Ceil(float64(total) * float64(*strategy.CanaryPercentage) / 100))
+}
+
+func canaryPeriod(strategy *virtv1.KubeVirtWorkloadUpdateStrategy) time.Duration {
+	if strategy.CanaryPeriod != nil {
+		return strategy.CanaryPeriod.Duration
+	}
+	return defaultCanaryPeriod
+}
+
+// filterCurrentRolloutStage restricts the VMIs to update to the first rollout stage
+// which still has outdated VMIs. VMIs matching no stage are updated last.
+func (c *WorkloadUpdateController) filterCurrentRolloutStage(stages []virtv1.WorkloadUpdateRolloutStage, data *updateData) {
+	current := len(stages)
+	for _, vmi := range data.allOutdatedVMIs {
+		if stage := c.rolloutStageOf(stages, vmi); stage < current {
+			current = stage
+		}
+	}
+
+	filter := func(vmis []*virtv1.VirtualMachineInstance) []*virtv1.VirtualMachineInstance {
+		var filtered []*virtv1.VirtualMachineInstance
+		for _, vmi := range vmis {
+			if c.rolloutStageOf(stages, vmi) == current {
+				filtered = append(filtered, vmi)
+			}
+		}
+		return filtered
+	}
+	data.migratableOutdatedVMIs = filter(data.migratableOutdatedVMIs)
+	data.evictOutdatedVMIs = filter(data.evictOutdatedVMIs)
+}
+
+// rolloutStageOf returns the index of the first rollout stage matching the VMI,
+// or the number of stages if no stage matches.
+func (c *WorkloadUpdateController) rolloutStageOf(stages []virtv1.WorkloadUpdateRolloutStage, vmi *virtv1.VirtualMachineInstance) int {
+	for i, stage := range stages {
+		if c.matchesSelector(c.nodeInformer, vmi.Status.NodeName, stage.NodeSelector) &&
+			c.matchesSelector(c.namespaceInformer, vmi.Namespace, stage.NamespaceSelector) {
+			return i
+		}
+	}
+	return len(stages)
+}
+
+// matchesSelector reports whether the labels of the cluster scoped object with the given name match the selector.
+// A nil selector matches everything.
+func (c *WorkloadUpdateController) matchesSelector(informer cache.SharedIndexInformer, name string, labelSelector *metav1.LabelSelector) bool {
+	if labelSelector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Invalid selector in workload update rollout order")
+		return false
+	}
+	obj, exists, err := informer.GetStore().GetByKey(name)
+	if err != nil || !exists {
+		return false
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(accessor.GetLabels()))
+}
+
+func (c *WorkloadUpdateController) patchWorkloadUpdateStatus(kv *virtv1.KubeVirt, rollout *virtv1.KubeVirtWorkloadUpdateStatus) error {
+	newJson, err := json.Marshal(rollout)
+	if err != nil {
+		return err
+	}
+
+	patch := ""
+	if kv.Status.WorkloadUpdate == nil {
+		patch = fmt.Sprintf(`[{ "op": "add", "path": "/status/workloadUpdate", "value": %s}]`, string(newJson))
+	} else {
+		oldJson, err := json.Marshal(kv.Status.WorkloadUpdate)
+		if err != nil {
+			return err
+		}
+		test := fmt.Sprintf(`{ "op": "test", "path": "/status/workloadUpdate", "value": %s}`, string(oldJson))
+		update := fmt.Sprintf(`{ "op": "replace", "path": "/status/workloadUpdate", "value": %s}`, string(newJson))
+		patch = fmt.Sprintf("[%s, %s]", test, update)
+	}
+
+	err = c.statusUpdater.PatchStatus(kv, types.JSONPatchType, []byte(patch))
+	if err != nil {
+		return fmt.Errorf("unable to patch kubevirt obj status to update the workloadUpdate value: %v", err)
+	}
+	return nil
+}
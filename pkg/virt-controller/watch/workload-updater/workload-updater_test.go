@@ -1,7 +1,9 @@
 package workloadupdater
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -21,6 +23,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 
 	v1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
@@ -48,10 +51,15 @@ var _ = Describe("Workload Updater", func() {
 	var migrationSource *framework.FakeControllerSource
 	var kubeVirtSource *framework.FakeControllerSource
 	var kubeVirtInformer cache.SharedIndexInformer
+	var vmInformer cache.SharedIndexInformer
+	var vmdbInformer cache.SharedIndexInformer
+	var nodeInformer cache.SharedIndexInformer
+	var namespaceInformer cache.SharedIndexInformer
 	var recorder *record.FakeRecorder
 	var mockQueue *testutils.MockWorkQueue
 	var kubeClient *fake.Clientset
 	var migrationFeeder *testutils.MigrationFeeder
+	var workloadUpdateStatus *v1.KubeVirtWorkloadUpdateStatus
 
 	var controller *WorkloadUpdateController
 
@@ -113,8 +121,12 @@ var _ = Describe("Workload Updater", func() {
 
 		kubeVirtInformer, _ = testutils.NewFakeInformerFor(&v1.KubeVirt{})
 		kubeVirtInformer, kubeVirtSource = testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmdbInformer, _ = testutils.NewFakeInformerFor(&poolv1.VirtualMachineDisruptionBudget{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, vmInformer, vmdbInformer, nodeInformer, namespaceInformer, recorder, virtClient, config)
 		mockQueue = testutils.NewMockWorkQueue(controller.queue)
 		controller.queue = mockQueue
 		migrationFeeder = testutils.NewMigrationFeeder(mockQueue, migrationSource)
@@ -123,6 +135,16 @@ var _ = Describe("Workload Updater", func() {
 		virtClient.EXPECT().VirtualMachineInstanceMigration(v12.NamespaceDefault).Return(migrationInterface).AnyTimes()
 		virtClient.EXPECT().VirtualMachineInstance(v12.NamespaceDefault).Return(vmiInterface).AnyTimes()
 		virtClient.EXPECT().KubeVirt(v12.NamespaceDefault).Return(kubeVirtInterface).AnyTimes()
+
+		// Record the reported rollout progress
+		workloadUpdateStatus = nil
+		kubeVirtInterface.EXPECT().PatchStatus(gomock.Any(), types.JSONPatchType, workloadUpdatePatch{}, gomock.Any()).Do(func(name string, pt types.PatchType, data []byte, patchOptions *metav1.PatchOptions) {
+			var ops []struct {
+				Value *v1.KubeVirtWorkloadUpdateStatus `json:"value"`
+			}
+			Expect(json.Unmarshal(data, &ops)).To(Succeed())
+			workloadUpdateStatus = ops[len(ops)-1].Value
+		}).Return(nil, nil).AnyTimes()
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
@@ -289,6 +311,36 @@ var _ = Describe("Workload Updater", func() {
 			testutils.ExpectEvents(recorder, reasons...)
 		})
 
+		It("should not migrate more VMIs than the VirtualMachineDisruptionBudget allows", func() {
+			const totalVMs = 5
+			const disruptionsAllowed = 2
+			Expect(vmdbInformer.GetStore().Add(&poolv1.VirtualMachineDisruptionBudget{
+				ObjectMeta: v13.ObjectMeta{Name: "all", Namespace: v12.NamespaceDefault},
+				Spec: poolv1.VirtualMachineDisruptionBudgetSpec{
+					Selector: &v13.LabelSelector{},
+				},
+				Status: poolv1.VirtualMachineDisruptionBudgetStatus{DisruptionsAllowed: disruptionsAllowed},
+			})).To(Succeed())
+
+			reasons := []string{}
+			for i := 0; i < totalVMs; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			for i := 0; i < disruptionsAllowed; i++ {
+				reasons = append(reasons, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+
+			waitForNumberOfInstancesOnVMIInformerCache(controller, totalVMs)
+			kv := newKubeVirt(totalVMs)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			addKubeVirt(kv)
+
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).Times(disruptionsAllowed)
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, reasons...)
+		})
+
 		It("should migrate/shutdown outdated VMIs and leave up to date VMIs alone", func() {
 			reasons := []string{}
 			newVirtualMachine("testvm-outdated-migratable", true, "madeup", vmiSource, podSource)
@@ -443,6 +495,326 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("workload update rollout", func() {
+		var migratedVMIs []string
+
+		expectMigrations := func(count int) {
+			migratedVMIs = nil
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).DoAndReturn(func(migration *v1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) (*v1.VirtualMachineInstanceMigration, error) {
+				migratedVMIs = append(migratedVMIs, migration.Spec.VMIName)
+				return &v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil
+			}).Times(count)
+		}
+
+		expectMigrationEvents := func(count int) {
+			reasons := []string{}
+			for i := 0; i < count; i++ {
+				reasons = append(reasons, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			}
+			testutils.ExpectEvents(recorder, reasons...)
+		}
+
+		newRolloutKubeVirt := func(expectedNumOutdated int) *v1.KubeVirt {
+			kv := newKubeVirt(expectedNumOutdated)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			return kv
+		}
+
+		It("should report the progress of the rollout", func() {
+			for i := 0; i < 3; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-outdated-%d", i), false, "madeup", vmiSource, podSource)
+			}
+			for i := 0; i < 2; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-up-to-date-%d", i), false, expectedImage, vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+			addKubeVirt(newRolloutKubeVirt(3))
+
+			controller.Execute()
+			Expect(workloadUpdateStatus).ToNot(BeNil())
+			Expect(workloadUpdateStatus.LauncherImage).To(Equal(expectedImage))
+			Expect(workloadUpdateStatus.StartTime).ToNot(BeNil())
+			Expect(workloadUpdateStatus.UpdatedWorkloads).To(Equal(2))
+			Expect(workloadUpdateStatus.OutdatedWorkloads).To(Equal(3))
+			Expect(workloadUpdateStatus.Paused).To(BeFalse())
+		})
+
+		It("should not update workloads while paused", func() {
+			for i := 0; i < 5; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+			kv := newRolloutKubeVirt(5)
+			kv.Spec.WorkloadUpdateStrategy.Paused = true
+			addKubeVirt(kv)
+
+			controller.Execute()
+			Expect(workloadUpdateStatus.Paused).To(BeTrue())
+			Expect(workloadUpdateStatus.PausedReason).To(Equal(v1.WorkloadUpdatePausedReasonPaused))
+		})
+
+		It("should only update the canary before the canary period passed", func() {
+			canaryPercentage := 20
+			for i := 0; i < 10; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 10)
+			kv := newRolloutKubeVirt(10)
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = &canaryPercentage
+			addKubeVirt(kv)
+
+			expectMigrations(2)
+			controller.Execute()
+			expectMigrationEvents(2)
+			Expect(workloadUpdateStatus.CanaryCompletionTime).To(BeNil())
+		})
+
+		It("should count in-flight workload update migrations towards the canary", func() {
+			canaryPercentage := 20
+			for i := 0; i < 10; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			migration := newMigration("vmim-canary", "testvm-migratable-0", v1.MigrationRunning)
+			migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: ""}
+			kv := newRolloutKubeVirt(10)
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = &canaryPercentage
+			addKubeVirt(kv)
+			migrationFeeder.Add(migration)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 10)
+
+			expectMigrations(1)
+			controller.Execute()
+			expectMigrationEvents(1)
+		})
+
+		It("should pause the rollout during the canary period", func() {
+			canaryPercentage := 20
+			for i := 0; i < 2; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migrated-%d", i), true, expectedImage, vmiSource, podSource)
+			}
+			for i := 0; i < 8; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			kv := newRolloutKubeVirt(8)
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = &canaryPercentage
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage: expectedImage,
+				StartTime:     &startTime,
+			}
+			addKubeVirt(kv)
+			for i := 0; i < 2; i++ {
+				migrationFeeder.Add(newWorkloadUpdateMigration(fmt.Sprintf("vmim-succeeded-%d", i), fmt.Sprintf("testvm-migrated-%d", i), v1.MigrationSucceeded))
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 10)
+
+			controller.Execute()
+			Expect(workloadUpdateStatus.MigratedWorkloads).To(Equal(2))
+			Expect(workloadUpdateStatus.CanaryCompletionTime).ToNot(BeNil())
+			Expect(workloadUpdateStatus.Paused).To(BeTrue())
+			Expect(workloadUpdateStatus.PausedReason).To(Equal(v1.WorkloadUpdatePausedReasonCanary))
+		})
+
+		It("should only count successfully migrated workloads towards the canary", func() {
+			canaryPercentage := 20
+			By("starting workloads on the launcher image")
+			for i := 0; i < 2; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-up-to-date-%d", i), true, expectedImage, vmiSource, podSource)
+			}
+			for i := 0; i < 8; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			kv := newRolloutKubeVirt(8)
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = &canaryPercentage
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage: expectedImage,
+				StartTime:     &startTime,
+			}
+			addKubeVirt(kv)
+			By("failing a workload update migration")
+			migrationFeeder.Add(newWorkloadUpdateMigration("vmim-failed", "testvm-migratable-0", v1.MigrationFailed))
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 10)
+
+			expectMigrations(2)
+			controller.Execute()
+			expectMigrationEvents(2)
+			Expect(workloadUpdateStatus.UpdatedWorkloads).To(Equal(2))
+			Expect(workloadUpdateStatus.MigratedWorkloads).To(BeZero())
+			Expect(workloadUpdateStatus.CanaryCompletionTime).To(BeNil())
+		})
+
+		It("should keep counting finished migrations once they are removed", func() {
+			maxFailedMigrations := 1
+			for i := 0; i < 5; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			kv := newRolloutKubeVirt(5)
+			kv.Spec.WorkloadUpdateStrategy.MaxFailedMigrations = &maxFailedMigrations
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage:      expectedImage,
+				StartTime:          &startTime,
+				MigratedWorkloads:  3,
+				FailedMigrations:   2,
+				FinishedMigrations: []types.UID{"vmim-removed-0", "vmim-removed-1"},
+			}
+			addKubeVirt(kv)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+
+			controller.Execute()
+			Expect(workloadUpdateStatus.MigratedWorkloads).To(Equal(3))
+			Expect(workloadUpdateStatus.FailedMigrations).To(Equal(2))
+			Expect(workloadUpdateStatus.FinishedMigrations).To(BeEmpty())
+			Expect(workloadUpdateStatus.Paused).To(BeTrue())
+			Expect(workloadUpdateStatus.PausedReason).To(Equal(v1.WorkloadUpdatePausedReasonFailedMigrations))
+		})
+
+		It("should count each finished migration once", func() {
+			maxFailedMigrations := 1
+			for i := 0; i < 5; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			kv := newRolloutKubeVirt(5)
+			kv.Spec.WorkloadUpdateStrategy.MaxFailedMigrations = &maxFailedMigrations
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage:      expectedImage,
+				StartTime:          &startTime,
+				FailedMigrations:   1,
+				FinishedMigrations: []types.UID{"vmim-failed-0"},
+			}
+			addKubeVirt(kv)
+			migrationFeeder.Add(newWorkloadUpdateMigration("vmim-failed-0", "testvm-migratable-0", v1.MigrationFailed))
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+
+			expectMigrations(int(virtconfig.ParallelMigrationsPerClusterDefault))
+			controller.Execute()
+			expectMigrationEvents(int(virtconfig.ParallelMigrationsPerClusterDefault))
+			Expect(workloadUpdateStatus.FailedMigrations).To(Equal(1))
+			Expect(workloadUpdateStatus.FinishedMigrations).To(ConsistOf(types.UID("vmim-failed-0")))
+			Expect(workloadUpdateStatus.Paused).To(BeFalse())
+		})
+
+		It("should update the remaining workloads once the canary period passed", func() {
+			canaryPercentage := 20
+			for i := 0; i < 2; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-up-to-date-%d", i), true, expectedImage, vmiSource, podSource)
+			}
+			for i := 0; i < 8; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 10)
+			kv := newRolloutKubeVirt(8)
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = &canaryPercentage
+			kv.Spec.WorkloadUpdateStrategy.CanaryPeriod = &metav1.Duration{Duration: time.Minute}
+			canaryCompletionTime := metav1.NewTime(time.Now().Add(-2 * time.Minute))
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage:        expectedImage,
+				StartTime:            &canaryCompletionTime,
+				CanaryCompletionTime: &canaryCompletionTime,
+			}
+			addKubeVirt(kv)
+
+			expectMigrations(int(virtconfig.ParallelMigrationsPerClusterDefault))
+			controller.Execute()
+			expectMigrationEvents(int(virtconfig.ParallelMigrationsPerClusterDefault))
+			Expect(workloadUpdateStatus.Paused).To(BeFalse())
+		})
+
+		It("should pause the rollout when too many workload update migrations failed", func() {
+			maxFailedMigrations := 1
+			for i := 0; i < 5; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			startTime := metav1.NewTime(time.Now().Add(-time.Hour))
+			kv := newRolloutKubeVirt(5)
+			kv.Spec.WorkloadUpdateStrategy.MaxFailedMigrations = &maxFailedMigrations
+			kv.Status.WorkloadUpdate = &v1.KubeVirtWorkloadUpdateStatus{
+				LauncherImage: expectedImage,
+				StartTime:     &startTime,
+			}
+			addKubeVirt(kv)
+
+			for i := 0; i < 2; i++ {
+				migrationFeeder.Add(newWorkloadUpdateMigration(fmt.Sprintf("vmim-failed-%d", i), fmt.Sprintf("testvm-migratable-%d", i), v1.MigrationFailed))
+			}
+			By("ignoring failed migrations which were not created by the workload updater")
+			migrationFeeder.Add(newMigration("vmim-failed-manual", "testvm-migratable-2", v1.MigrationFailed))
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 5)
+
+			controller.Execute()
+			Expect(workloadUpdateStatus.FailedMigrations).To(Equal(2))
+			Expect(workloadUpdateStatus.Paused).To(BeTrue())
+			Expect(workloadUpdateStatus.PausedReason).To(Equal(v1.WorkloadUpdatePausedReasonFailedMigrations))
+		})
+
+		It("should ignore failed migrations of previous rollouts", func() {
+			maxFailedMigrations := 0
+			newVirtualMachine("testvm-migratable", true, "madeup", vmiSource, podSource)
+			kv := newRolloutKubeVirt(1)
+			kv.Spec.WorkloadUpdateStrategy.MaxFailedMigrations = &maxFailedMigrations
+			addKubeVirt(kv)
+
+			migration := newMigration("vmim-failed", "testvm-migratable", v1.MigrationFailed)
+			migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: ""}
+			migration.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+			migrationFeeder.Add(migration)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			expectMigrations(1)
+			controller.Execute()
+			expectMigrationEvents(1)
+			Expect(workloadUpdateStatus.FailedMigrations).To(BeZero())
+		})
+
+		It("should update workloads in the rollout order", func() {
+			Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+				ObjectMeta: v13.ObjectMeta{Name: "node-a", Labels: map[string]string{"zone": "a"}},
+			})).To(Succeed())
+			Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+				ObjectMeta: v13.ObjectMeta{Name: "node-b", Labels: map[string]string{"zone": "b"}},
+			})).To(Succeed())
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: v13.ObjectMeta{Name: v12.NamespaceDefault, Labels: map[string]string{"tier": "dev"}},
+			})).To(Succeed())
+
+			for _, node := range []string{"node-a", "node-b"} {
+				for i := 0; i < 2; i++ {
+					vmi := newVirtualMachine(fmt.Sprintf("testvm-%s-%d", node, i), true, "madeup", vmiSource, podSource)
+					vmi.Status.NodeName = node
+					vmiSource.Modify(vmi)
+				}
+			}
+			Eventually(func() int {
+				scheduled := 0
+				for _, obj := range vmiInformer.GetStore().List() {
+					if obj.(*v1.VirtualMachineInstance).Status.NodeName != "" {
+						scheduled++
+					}
+				}
+				return scheduled
+			}, 3*time.Second, 200*time.Millisecond).Should(Equal(4))
+
+			kv := newRolloutKubeVirt(4)
+			kv.Spec.WorkloadUpdateStrategy.RolloutOrder = []v1.WorkloadUpdateRolloutStage{
+				{
+					NodeSelector:      &v13.LabelSelector{MatchLabels: map[string]string{"zone": "b"}},
+					NamespaceSelector: &v13.LabelSelector{MatchLabels: map[string]string{"tier": "dev"}},
+				},
+				{
+					NodeSelector: &v13.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+				},
+			}
+			addKubeVirt(kv)
+
+			expectMigrations(2)
+			controller.Execute()
+			expectMigrationEvents(2)
+			Expect(migratedVMIs).To(ConsistOf("testvm-node-b-0", "testvm-node-b-1"))
+		})
+	})
+
 	Context("LiveUpdate features", func() {
 		It("VMI needs to be migrated when memory hotplug is requested", func() {
 			vmi := api.NewMinimalVMI("testvm")
@@ -530,5 +902,25 @@ func newMigration(name string, vmi string, phase v1.VirtualMachineInstanceMigrat
 	migration.Status.Phase = phase
 	migration.Spec.VMIName = vmi
 	migration.Namespace = v12.NamespaceDefault
+	migration.UID = types.UID(name)
 	return migration
 }
+
+func newWorkloadUpdateMigration(name string, vmi string, phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+	migration := newMigration(name, vmi, phase)
+	migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: ""}
+	migration.CreationTimestamp = metav1.Now()
+	return migration
+}
+
+// workloadUpdatePatch matches status patches of the workload update progress
+type workloadUpdatePatch struct{}
+
+func (workloadUpdatePatch) Matches(x interface{}) bool {
+	data, ok := x.([]byte)
+	return ok && strings.Contains(string(data), "/status/workloadUpdate")
+}
+
+func (workloadUpdatePatch) String() string {
+	return "is a patch of /status/workloadUpdate"
+}
@@ -103,8 +103,11 @@ type LauncherClient interface {
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
 	Exec(string, string, []string, int32) (int, string, error)
+	GuestExec(string, string, []string, int32, int64) (int, string, string, error)
 	Ping() error
 	GuestPing(string, int32) error
+	GuestFileRead(string, string, int64, int32) ([]byte, bool, error)
+	GuestFileWrite(string, string, []byte, int32) error
 	Close()
 	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
@@ -737,14 +740,23 @@ func (c *VirtLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSyst
 
 // Exec the command with args on the guest and return the resulting status code, stdOut and error
 func (c *VirtLauncherClient) Exec(domainName, command string, args []string, timeoutSeconds int32) (int, string, error) {
+	exitCode, stdOut, _, err := c.GuestExec(domainName, command, args, timeoutSeconds, 0)
+	return exitCode, stdOut, err
+}
+
+// GuestExec executes the command on the guest and returns its exit code, stdout and stderr.
+// The launcher truncates each output to maxOutputBytes, zero means the output is not limited.
+func (c *VirtLauncherClient) GuestExec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (int, string, string, error) {
 	request := &cmdv1.ExecRequest{
 		DomainName:     domainName,
 		Command:        command,
 		Args:           args,
 		TimeoutSeconds: int32(timeoutSeconds),
+		MaxOutputBytes: maxOutputBytes,
 	}
 	exitCode := -1
 	stdOut := ""
+	stdErr := ""
 
 	ctx, cancel := context.WithTimeout(
 		context.Background(),
@@ -756,13 +768,14 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 
 	resp, err := c.v1client.Exec(ctx, request)
 	if resp == nil {
-		return exitCode, stdOut, err
+		return exitCode, stdOut, stdErr, err
 	}
 
 	exitCode = int(resp.ExitCode)
 	stdOut = resp.StdOut
+	stdErr = resp.StdErr
 
-	return exitCode, stdOut, err
+	return exitCode, stdOut, stdErr, err
 }
 
 func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32) error {
@@ -782,6 +795,50 @@ func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32)
 	return err
 }
 
+// GuestFileRead reads up to maxBytes of the file at path on the guest and reports if the content was truncated
+func (c *VirtLauncherClient) GuestFileRead(domainName, path string, maxBytes int64, timeoutSeconds int32) ([]byte, bool, error) {
+	request := &cmdv1.GuestFileReadRequest{
+		DomainName:     domainName,
+		Path:           path,
+		MaxBytes:       maxBytes,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual transfer
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileRead(ctx, request)
+	if err = handleError(err, "GuestFileRead", resp.GetResponse()); err != nil {
+		return nil, false, err
+	}
+
+	return resp.GetContent(), resp.GetTruncated(), nil
+}
+
+// GuestFileWrite writes content to the file at path on the guest, replacing its previous content
+func (c *VirtLauncherClient) GuestFileWrite(domainName, path string, content []byte, timeoutSeconds int32) error {
+	request := &cmdv1.GuestFileWriteRequest{
+		DomainName:     domainName,
+		Path:           path,
+		Content:        content,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual transfer
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	resp, err := c.v1client.GuestFileWrite(ctx, request)
+	return handleError(err, "GuestFileWrite", resp)
+}
+
 func (c *VirtLauncherClient) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	request := &cmdv1.EmptyRequest{}
 	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
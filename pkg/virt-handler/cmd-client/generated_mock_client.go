@@ -252,6 +252,19 @@ func (_mr *_MockLauncherClientRecorder) Exec(arg0, arg1, arg2, arg3 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1, arg2, arg3)
 }
 
+func (_m *MockLauncherClient) GuestExec(_param0 string, _param1 string, _param2 []string, _param3 int32, _param4 int64) (int, string, string, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", _param0, _param1, _param2, _param3, _param4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
 func (_m *MockLauncherClient) Ping() error {
 	ret := _m.ctrl.Call(_m, "Ping")
 	ret0, _ := ret[0].(error)
@@ -272,6 +285,28 @@ func (_mr *_MockLauncherClientRecorder) GuestPing(arg0, arg1 interface{}) *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestPing", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) GuestFileRead(_param0 string, _param1 string, _param2 int64, _param3 int32) ([]byte, bool, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", _param0, _param1, _param2, _param3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileRead(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockLauncherClient) GuestFileWrite(_param0 string, _param1 string, _param2 []byte, _param3 int32) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", _param0, _param1, _param2, _param3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileWrite(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2, arg3)
+}
+
 func (_m *MockLauncherClient) Close() {
 	_m.ctrl.Call(_m, "Close")
 }
@@ -7,15 +7,20 @@ import (
 	"os"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilwait "k8s.io/apimachinery/pkg/util/wait"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+	ksmcli "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/monitoring/virt-handler/metrics"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
@@ -25,6 +30,8 @@ const failedSetCPUManagerLabelFmt = "failed to set a cpu manager label on host %
 
 type HeartBeat struct {
 	clientset                 k8scli.CoreV1Interface
+	ksmPolicyClient           ksmcli.KSMPolicyInterface
+	ksmPolicyStore            cache.Store
 	deviceManagerController   device_manager.DeviceControllerInterface
 	clusterConfig             *virtconfig.ClusterConfig
 	host                      string
@@ -33,9 +40,11 @@ type HeartBeat struct {
 	devicePluginWaitTimeout   time.Duration
 }
 
-func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
+func NewHeartBeat(clientset k8scli.CoreV1Interface, ksmPolicyClient ksmcli.KSMPolicyInterface, ksmPolicyStore cache.Store, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
 	return &HeartBeat{
 		clientset:               clientset,
+		ksmPolicyClient:         ksmPolicyClient,
+		ksmPolicyStore:          ksmPolicyStore,
 		deviceManagerController: deviceManager,
 		clusterConfig:           clusterConfig,
 		host:                    host,
@@ -141,7 +150,9 @@ func (h *HeartBeat) do() {
 		log.DefaultLogger().Reason(err).Errorf("Can't get node %s", h.host)
 		return
 	}
-	ksmEnabled, ksmEnabledByUs := handleKSM(node, h.clusterConfig)
+	ksmPolicy := selectKSMPolicy(node, h.listKSMPolicies())
+	ksmEnabled, ksmEnabledByUs := handleKSM(node, h.clusterConfig, ksmPolicy)
+	h.reportKSM(ksmPolicy, ksmEnabled)
 
 	data = []byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "%s", "%s": "%t", "%s": "%t"}, "annotations": {"%s": %s, "%s": "%t"}}}`,
 		v1.NodeSchedulable, kubevirtSchedulable,
@@ -167,6 +178,79 @@ func (h *HeartBeat) do() {
 	log.DefaultLogger().V(4).Infof("Heartbeat sent")
 }
 
+func (h *HeartBeat) listKSMPolicies() []*ksmv1alpha1.KSMPolicy {
+	var policies []*ksmv1alpha1.KSMPolicy
+	for _, obj := range h.ksmPolicyStore.List() {
+		policies = append(policies, obj.(*ksmv1alpha1.KSMPolicy))
+	}
+	return policies
+}
+
+// reportKSM exports the KSM statistics of the node as metrics and reports them in the status
+// of the KSMPolicy which applies to the node. The node is removed from the status of all other policies.
+// Since every node reports to the same policy, the status is only patched when the state of KSM changed.
+func (h *HeartBeat) reportKSM(policy *ksmv1alpha1.KSMPolicy, running bool) {
+	var nodeStatus *ksmv1alpha1.KSMNodeStatus
+	if stats, err := getKsmStats(); err != nil {
+		log.DefaultLogger().V(4).Reason(err).Infof("Can't read the KSM statistics of node %s", h.host)
+	} else {
+		savedMemory := stats.pagesSharing * int64(os.Getpagesize())
+		metrics.SetKSMMetrics(h.host, running, stats.pagesShared, stats.pagesSharing, savedMemory)
+		nodeStatus = &ksmv1alpha1.KSMNodeStatus{
+			Running:       running,
+			PagesShared:   stats.pagesShared,
+			PagesSharing:  stats.pagesSharing,
+			SavedMemory:   *resource.NewQuantity(savedMemory, resource.BinarySI),
+			LastProbeTime: metav1.Now(),
+		}
+		if running {
+			nodeStatus.PagesToScan = stats.pagesToScan
+			nodeStatus.SleepMilliseconds = stats.sleepMilliseconds
+		}
+	}
+
+	for _, p := range h.listKSMPolicies() {
+		current, exists := p.Status.Nodes[h.host]
+		if policy != nil && p.Name == policy.Name {
+			if nodeStatus != nil && (!exists || ksmNodeStatusChanged(&current, nodeStatus)) {
+				h.patchKSMPolicyStatus(p.Name, nodeStatus)
+			}
+			continue
+		}
+		if exists {
+			h.patchKSMPolicyStatus(p.Name, nil)
+		}
+	}
+}
+
+func ksmNodeStatusChanged(old, new *ksmv1alpha1.KSMNodeStatus) bool {
+	return old.Running != new.Running ||
+		old.PagesToScan != new.PagesToScan ||
+		old.SleepMilliseconds != new.SleepMilliseconds ||
+		old.PagesShared != new.PagesShared ||
+		old.PagesSharing != new.PagesSharing ||
+		old.SavedMemory.Cmp(new.SavedMemory) != 0
+}
+
+// patchKSMPolicyStatus sets the status of the node in the KSMPolicy, a nil status removes the node
+func (h *HeartBeat) patchKSMPolicyStatus(name string, nodeStatus *ksmv1alpha1.KSMNodeStatus) {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"nodes": map[string]*ksmv1alpha1.KSMNodeStatus{
+				h.host: nodeStatus,
+			},
+		},
+	})
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't create the status patch for KSMPolicy %s", name)
+		return
+	}
+	_, err = h.ksmPolicyClient.Patch(context.Background(), name, types.MergePatchType, data, metav1.PatchOptions{}, "status")
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't patch the status of KSMPolicy %s", name)
+	}
+}
+
 func (h *HeartBeat) isCPUManagerEnabled(cpuManagerPaths []string) bool {
 	var cpuManagerOptions map[string]interface{}
 	cpuManagerPath, err := detectCPUManagerFile(cpuManagerPaths)
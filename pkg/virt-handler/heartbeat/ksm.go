@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -32,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	"kubevirt.io/client-go/log"
 
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -58,6 +60,9 @@ var (
 	ksmSleepPath = ksmBasePath + "sleep_millisecs"
 	ksmPagesPath = ksmBasePath + "pages_to_scan"
 
+	ksmPagesSharedPath  = ksmBasePath + "pages_shared"
+	ksmPagesSharingPath = ksmBasePath + "pages_sharing"
+
 	memInfoPath = "/proc/meminfo"
 
 	pagesBoost              = pagesBoostDefault
@@ -75,6 +80,13 @@ type ksmState struct {
 	pages   int
 }
 
+type ksmStats struct {
+	pagesToScan       int64
+	sleepMilliseconds int64
+	pagesShared       int64
+	pagesSharing      int64
+}
+
 // Inspired from https://github.com/artyom/meminfo
 func getTotalAndAvailableMem() (uint64, uint64, error) {
 	var total, available uint64
@@ -122,6 +134,33 @@ func getKsmPages() (int, error) {
 	return pages, nil
 }
 
+func readKsmValue(path string) (int64, error) {
+	valueBytes, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(strings.TrimSpace(string(valueBytes)), 10, 64)
+}
+
+// getKsmStats reads the current scan parameters and the merging statistics of KSM
+func getKsmStats() (ksmStats, error) {
+	var stats ksmStats
+	for path, value := range map[string]*int64{
+		ksmPagesPath:        &stats.pagesToScan,
+		ksmSleepPath:        &stats.sleepMilliseconds,
+		ksmPagesSharedPath:  &stats.pagesShared,
+		ksmPagesSharingPath: &stats.pagesSharing,
+	} {
+		var err error
+		if *value, err = readKsmValue(path); err != nil {
+			return stats, err
+		}
+	}
+
+	return stats, nil
+}
+
 // Inspired from https://github.com/oVirt/mom/blob/master/doc/ksm.rules
 func calculateNewRunSleepAndPages(running bool) (ksmState, error) {
 	ksm := ksmState{running: running}
@@ -245,18 +284,89 @@ func getIntParam(node *v1.Node, param string, defaultValue, lowerBound, upperBou
 	return boundCheck(value, defaultValue, lowerBound, upperBound, fmt.Sprintf("%s override value out of bounds", param))
 }
 
-// handleKSM will update the ksm of the node (if available) based on the kv configuration and
-// will set the outcome value to the n.KSM struct
-// If the node labels match the selector terms, the ksm will be enabled.
-// Empty Selector will enable ksm for every node
-func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig) (bool, bool) {
+func getPolicyParam(value *int32, name string, defaultValue, lowerBound, upperBound int) int {
+	if value == nil {
+		return defaultValue
+	}
+
+	return boundCheck(int(*value), defaultValue, lowerBound, upperBound, fmt.Sprintf("%s of the KSMPolicy out of bounds", name))
+}
+
+func loadPolicyParams(policy *ksmv1alpha1.KSMPolicy) {
+	pagesToScan := policy.Spec.PagesToScan
+	if pagesToScan == nil {
+		pagesToScan = &ksmv1alpha1.KSMPagesToScan{}
+	}
+
+	pagesBoost = getPolicyParam(pagesToScan.Boost, "pagesToScan.boost", pagesBoostDefault, 0, math.MaxInt)
+	// The decay is a positive number in the policy, but is subtracted from the pages to scan
+	pagesDecay = -getPolicyParam(pagesToScan.Decay, "pagesToScan.decay", -pagesDecayDefault, 0, math.MaxInt)
+	nPagesMin = getPolicyParam(pagesToScan.Min, "pagesToScan.min", nPagesMinDefault, 0, math.MaxInt)
+	nPagesMax = getPolicyParam(pagesToScan.Max, "pagesToScan.max", nPagesMaxDefault, nPagesMin, math.MaxInt)
+	nPagesInit = getPolicyParam(pagesToScan.Init, "pagesToScan.init", nPagesInitDefault, nPagesMin, nPagesMax)
+	sleepMsBaseline = uint64(getPolicyParam(policy.Spec.SleepMillisecondsBaseline, "sleepMillisecondsBaseline", sleepMsBaselineDefault, 1, math.MaxInt))
+	freePercent = float32(getPolicyParam(policy.Spec.FreePercent, "freePercent", int(freePercentDefault*100), 0, 100)) / 100
+}
+
+func loadAnnotationParams(node *v1.Node) {
+	pagesBoost = getIntParam(node, kubevirtv1.KSMPagesBoostOverride, pagesBoostDefault, 0, math.MaxInt)
+	pagesDecay = getIntParam(node, kubevirtv1.KSMPagesDecayOverride, pagesDecayDefault, math.MinInt, 0)
+	nPagesMin = getIntParam(node, kubevirtv1.KSMPagesMinOverride, nPagesMinDefault, 0, math.MaxInt)
+	nPagesMax = getIntParam(node, kubevirtv1.KSMPagesMaxOverride, nPagesMaxDefault, nPagesMin, math.MaxInt)
+	nPagesInit = getIntParam(node, kubevirtv1.KSMPagesInitOverride, nPagesInitDefault, nPagesMin, nPagesMax)
+	sleepMsBaseline = uint64(getIntParam(node, kubevirtv1.KSMSleepMsBaselineOverride, sleepMsBaselineDefault, 1, math.MaxInt))
+	freePercent = getFloatParam(node, kubevirtv1.KSMFreePercentOverride, freePercentDefault, 0, 1)
+}
+
+// selectKSMPolicy returns the KSMPolicy which applies to the node, or nil if no policy selects the node.
+// The policy with the highest priority wins, ties are broken by the policy name.
+// An empty or missing node selector selects every node.
+func selectKSMPolicy(node *v1.Node, policies []*ksmv1alpha1.KSMPolicy) *ksmv1alpha1.KSMPolicy {
+	var matching []*ksmv1alpha1.KSMPolicy
+	for _, policy := range policies {
+		selector := labels.Everything()
+		if policy.Spec.NodeSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(policy.Spec.NodeSelector)
+			if err != nil {
+				log.DefaultLogger().Reason(err).Errorf("An error occurred while converting the node selector of KSMPolicy %s", policy.Name)
+				continue
+			}
+		}
+		if selector.Matches(labels.Set(node.ObjectMeta.Labels)) {
+			matching = append(matching, policy)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	priority := func(policy *ksmv1alpha1.KSMPolicy) int32 {
+		if policy.Spec.Priority == nil {
+			return 0
+		}
+		return *policy.Spec.Priority
+	}
+	sort.Slice(matching, func(i, j int) bool {
+		if pi, pj := priority(matching[i]), priority(matching[j]); pi != pj {
+			return pi > pj
+		}
+		return matching[i].Name < matching[j].Name
+	})
+
+	return matching[0]
+}
+
+// handleKSM will update the ksm of the node (if available) and will set the outcome value to the n.KSM struct.
+// KSM is tuned with the values of the KSMPolicy which applies to the node or, if no policy applies,
+// with the node annotations. See ksmEnabledForNode for when KSM is enabled at all.
+func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig, policy *ksmv1alpha1.KSMPolicy) (bool, bool) {
 	available, running := loadKSM()
 	if !available {
 		return running, false
 	}
 
-	ksmConfig := clusterConfig.GetKSMConfiguration()
-	if ksmConfig == nil {
+	if !ksmEnabledForNode(node, clusterConfig, policy) {
 		if disableKSM(node, running) {
 			return false, false
 		} else {
@@ -264,28 +374,37 @@ func handleKSM(node *v1.Node, clusterConfig *virtconfig.ClusterConfig) (bool, bo
 		}
 	}
 
+	if policy != nil {
+		loadPolicyParams(policy)
+	} else {
+		loadAnnotationParams(node)
+	}
+	return updateKSM(running)
+}
+
+// ksmEnabledForNode reports whether KSM is enabled on the node. If the KSMPolicy which applies to the node
+// explicitly enables or disables KSM, it takes precedence. Otherwise KSM is enabled if the node labels match
+// the NodeLabelSelector of the KSMConfiguration, an empty selector enables KSM for every node.
+func ksmEnabledForNode(node *v1.Node, clusterConfig *virtconfig.ClusterConfig, policy *ksmv1alpha1.KSMPolicy) bool {
+	if policy != nil && policy.Spec.Enabled != nil {
+		return *policy.Spec.Enabled
+	}
+
+	ksmConfig := clusterConfig.GetKSMConfiguration()
+	if ksmConfig == nil {
+		return false
+	}
+
 	selector, err := metav1.LabelSelectorAsSelector(ksmConfig.NodeLabelSelector)
 	if err != nil {
 		log.DefaultLogger().Errorf("An error occurred while converting the ksm selector: %s", err)
-		return running, false
-	}
-
-	if !selector.Matches(labels.Set(node.ObjectMeta.Labels)) {
-		if disableKSM(node, running) {
-			return false, false
-		} else {
-			return running, false
-		}
+		return false
 	}
 
-	pagesBoost = getIntParam(node, kubevirtv1.KSMPagesBoostOverride, pagesBoostDefault, 0, math.MaxInt)
-	pagesDecay = getIntParam(node, kubevirtv1.KSMPagesDecayOverride, pagesDecayDefault, math.MinInt, 0)
-	nPagesMin = getIntParam(node, kubevirtv1.KSMPagesMinOverride, nPagesMinDefault, 0, math.MaxInt)
-	nPagesMax = getIntParam(node, kubevirtv1.KSMPagesMaxOverride, nPagesMaxDefault, nPagesMin, math.MaxInt)
-	nPagesInit = getIntParam(node, kubevirtv1.KSMPagesInitOverride, nPagesInitDefault, nPagesMin, nPagesMax)
-	sleepMsBaseline = uint64(getIntParam(node, kubevirtv1.KSMSleepMsBaselineOverride, sleepMsBaselineDefault, 1, math.MaxInt))
-	freePercent = getFloatParam(node, kubevirtv1.KSMFreePercentOverride, freePercentDefault, 0, 1)
+	return selector.Matches(labels.Set(node.ObjectMeta.Labels))
+}
 
+func updateKSM(running bool) (bool, bool) {
 	ksm, err := calculateNewRunSleepAndPages(running)
 	if err != nil {
 		log.DefaultLogger().Reason(err).Errorf("An error occurred while calculating the new KSM values")
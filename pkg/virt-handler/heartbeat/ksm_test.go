@@ -37,7 +37,12 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	ksmcli "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
 
@@ -53,6 +58,9 @@ const (
 
 var _ = Describe("KSM", func() {
 	var fakeSysKSMDir string
+	var ksmClientset *kubevirtfake.Clientset
+	var ksmPolicyClient ksmcli.KSMPolicyInterface
+	var ksmPolicyStore cache.Store
 
 	createCustomKSMTree := func() {
 		var err error
@@ -64,6 +72,10 @@ var _ = Describe("KSM", func() {
 		Expect(err).NotTo(HaveOccurred())
 		err = os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_to_scan"), []byte("100\n"), 0644)
 		Expect(err).NotTo(HaveOccurred())
+		err = os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_shared"), []byte("1000\n"), 0644)
+		Expect(err).NotTo(HaveOccurred())
+		err = os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_sharing"), []byte("2500\n"), 0644)
+		Expect(err).NotTo(HaveOccurred())
 	}
 
 	createCustomMemInfo := func(pressure bool) {
@@ -110,6 +122,12 @@ var _ = Describe("KSM", func() {
 		ksmRunPath = ksmBasePath + "run"
 		ksmSleepPath = ksmBasePath + "sleep_millisecs"
 		ksmPagesPath = ksmBasePath + "pages_to_scan"
+		ksmPagesSharedPath = ksmBasePath + "pages_shared"
+		ksmPagesSharingPath = ksmBasePath + "pages_sharing"
+
+		ksmClientset = kubevirtfake.NewSimpleClientset()
+		ksmPolicyClient = ksmClientset.KsmV1alpha1().KSMPolicies()
+		ksmPolicyStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
 	})
 
 	AfterEach(func() {
@@ -124,7 +142,7 @@ var _ = Describe("KSM", func() {
 		}
 		fakeClient := fake.NewSimpleClientset(node)
 		createCustomMemInfo(false)
-		heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), config(virtconfig.CPUManager), "mynode")
+		heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), config(virtconfig.CPUManager), "mynode")
 
 		heartbeat.do()
 		node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), "mynode", metav1.GetOptions{})
@@ -190,7 +208,7 @@ var _ = Describe("KSM", func() {
 			err := os.WriteFile(filepath.Join(fakeSysKSMDir, "run"), []byte(initialKsmValue), 0644)
 			Expect(err).ToNot(HaveOccurred())
 			createCustomMemInfo(true)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), clusterConfig, "mynode")
 
 			heartbeat.do()
 
@@ -252,7 +270,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), clusterConfig, "mynode")
 
 			By("running a first heartbeat and expecting no change")
 			heartbeat.do()
@@ -320,7 +338,7 @@ var _ = Describe("KSM", func() {
 			}
 			fakeClient := fake.NewSimpleClientset(node)
 			createCustomMemInfo(false)
-			heartbeat := NewHeartBeat(fakeClient.CoreV1(), deviceController(true), clusterConfig, "mynode")
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), clusterConfig, "mynode")
 
 			By("running a first heartbeat and expecting the right values")
 			heartbeat.do()
@@ -350,4 +368,228 @@ var _ = Describe("KSM", func() {
 			expectKSMState(expected)
 		})
 	})
+
+	Describe(", when a KSMPolicy is provided,", func() {
+		newPolicy := func(name string, priority *int32, selector *metav1.LabelSelector) *ksmv1alpha1.KSMPolicy {
+			return &ksmv1alpha1.KSMPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: ksmv1alpha1.KSMPolicySpec{
+					NodeSelector: selector,
+					Priority:     priority,
+				},
+			}
+		}
+
+		addPolicy := func(policy *ksmv1alpha1.KSMPolicy) {
+			ExpectWithOffset(1, ksmPolicyStore.Add(policy)).To(Succeed())
+			_, err := ksmPolicyClient.Create(context.Background(), policy, metav1.CreateOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		}
+
+		matchingSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"test_label": "true"}}
+		otherSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"test_label": "false"}}
+
+		DescribeTable("should select", func(policies []*ksmv1alpha1.KSMPolicy, expectedPolicy string) {
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "mynode",
+					Labels: map[string]string{"test_label": "true"},
+				},
+			}
+			policy := selectKSMPolicy(node, policies)
+			if expectedPolicy == "" {
+				Expect(policy).To(BeNil())
+			} else {
+				Expect(policy).ToNot(BeNil())
+				Expect(policy.Name).To(Equal(expectedPolicy))
+			}
+		},
+			Entry("no policy if no policy exists", nil, ""),
+			Entry("no policy if no policy matches the node labels",
+				[]*ksmv1alpha1.KSMPolicy{newPolicy("other", nil, otherSelector)}, ""),
+			Entry("a policy without node selector",
+				[]*ksmv1alpha1.KSMPolicy{newPolicy("all", nil, nil)}, "all"),
+			Entry("a policy with an empty node selector",
+				[]*ksmv1alpha1.KSMPolicy{newPolicy("all", nil, &metav1.LabelSelector{})}, "all"),
+			Entry("the policy with the highest priority",
+				[]*ksmv1alpha1.KSMPolicy{
+					newPolicy("a", nil, nil),
+					newPolicy("b", pointer.Int32(10), matchingSelector),
+					newPolicy("c", pointer.Int32(20), otherSelector),
+				}, "b"),
+			Entry("the policy with the lowest name if the priorities are equal",
+				[]*ksmv1alpha1.KSMPolicy{
+					newPolicy("b", pointer.Int32(1), matchingSelector),
+					newPolicy("a", pointer.Int32(1), nil),
+				}, "a"),
+		)
+
+		It("should use the values of the policy and report the status", func() {
+			policy := newPolicy("policy", nil, matchingSelector)
+			policy.Spec.Enabled = pointer.Bool(true)
+			policy.Spec.FreePercent = pointer.Int32(90)
+			policy.Spec.SleepMillisecondsBaseline = pointer.Int32(1000)
+			policy.Spec.PagesToScan = &ksmv1alpha1.KSMPagesToScan{
+				Min:   pointer.Int32(50),
+				Max:   pointer.Int32(215),
+				Init:  pointer.Int32(200),
+				Boost: pointer.Int32(10),
+			}
+			addPolicy(policy)
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "mynode",
+					Labels: map[string]string{"test_label": "true"},
+				},
+			}
+			expected := ksmState{
+				running: true,
+				sleep:   1000 * (16 * 1024 * 1024) / (memTotal - memAvailableNoPressure),
+				pages:   200,
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			createCustomMemInfo(false)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), config(), "mynode")
+
+			By("running a first heartbeat and expecting KSM to start with the values of the policy")
+			heartbeat.do()
+			expectKSMState(expected)
+
+			node, err := fakeClient.CoreV1().Nodes().Get(context.TODO(), "mynode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue(kubevirtv1.KSMEnabledLabel, "true"))
+			Expect(node.Annotations).To(HaveKeyWithValue(kubevirtv1.KSMHandlerManagedAnnotation, "true"))
+
+			By("expecting the number of pages to scan to increase every heartbeat up to max value")
+			heartbeat.do()
+			expected.pages = 210
+			expectKSMState(expected)
+			heartbeat.do()
+			expected.pages = 215
+			expectKSMState(expected)
+
+			By("expecting the status of the node to be reported in the policy")
+			policy, err = ksmPolicyClient.Get(context.TODO(), "policy", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Status.Nodes).To(HaveKey("mynode"))
+			nodeStatus := policy.Status.Nodes["mynode"]
+			Expect(nodeStatus.Running).To(BeTrue())
+			Expect(nodeStatus.PagesToScan).To(BeEquivalentTo(215))
+			Expect(nodeStatus.SleepMilliseconds).To(BeEquivalentTo(expected.sleep))
+			Expect(nodeStatus.PagesShared).To(BeEquivalentTo(1000))
+			Expect(nodeStatus.PagesSharing).To(BeEquivalentTo(2500))
+			Expect(nodeStatus.SavedMemory.Value()).To(BeEquivalentTo(2500 * os.Getpagesize()))
+		})
+
+		DescribeTable("should", func(enabled *bool, ksmConfig *kubevirtv1.KSMConfiguration, expectedKsmValue string) {
+			policy := newPolicy("policy", nil, matchingSelector)
+			policy.Spec.Enabled = enabled
+			addPolicy(policy)
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "mynode",
+					Labels:      map[string]string{"test_label": "true"},
+					Annotations: map[string]string{kubevirtv1.KSMHandlerManagedAnnotation: "true"},
+				},
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			Expect(os.WriteFile(filepath.Join(fakeSysKSMDir, "run"), []byte("1\n"), 0644)).To(Succeed())
+			createCustomMemInfo(true)
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&kubevirtv1.KubeVirtConfiguration{
+				KSMConfiguration: ksmConfig,
+			})
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), clusterConfig, "mynode")
+
+			heartbeat.do()
+
+			running, err := os.ReadFile(filepath.Join(fakeSysKSMDir, "run"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(bytes.TrimSpace(running))).To(Equal(expectedKsmValue))
+		},
+			Entry("enable ksm if the policy enables it, even without ksmConfiguration",
+				pointer.Bool(true), nil, "1"),
+			Entry("disable ksm if the policy disables it, even if ksmConfiguration.nodeLabelSelector selects the node",
+				pointer.Bool(false), &kubevirtv1.KSMConfiguration{NodeLabelSelector: &metav1.LabelSelector{}}, "0"),
+			Entry("enable ksm if the policy does not set enabled and ksmConfiguration.nodeLabelSelector selects the node",
+				nil, &kubevirtv1.KSMConfiguration{NodeLabelSelector: &metav1.LabelSelector{}}, "1"),
+			Entry("disable ksm if the policy does not set enabled and ksmConfiguration is not provided",
+				nil, nil, "0"),
+		)
+
+		It("should only patch the status when the state of KSM changed", func() {
+			policy := newPolicy("policy", nil, matchingSelector)
+			policy.Spec.Enabled = pointer.Bool(false)
+			addPolicy(policy)
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "mynode",
+					Labels: map[string]string{"test_label": "true"},
+				},
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			createCustomMemInfo(false)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), config(), "mynode")
+
+			countStatusPatches := func() int {
+				count := 0
+				for _, action := range ksmClientset.Actions() {
+					if action.GetVerb() == "patch" && action.GetSubresource() == "status" {
+						count++
+					}
+				}
+				return count
+			}
+			syncPolicyStore := func() {
+				policy, err := ksmPolicyClient.Get(context.TODO(), "policy", metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ksmPolicyStore.Update(policy)).To(Succeed())
+			}
+
+			By("reporting the status with the first heartbeat")
+			heartbeat.do()
+			Expect(countStatusPatches()).To(Equal(1))
+			syncPolicyStore()
+
+			By("not patching the status again if nothing changed")
+			heartbeat.do()
+			Expect(countStatusPatches()).To(Equal(1))
+
+			By("patching the status once the KSM statistics changed")
+			Expect(os.WriteFile(filepath.Join(fakeSysKSMDir, "pages_sharing"), []byte("3000\n"), 0644)).To(Succeed())
+			heartbeat.do()
+			Expect(countStatusPatches()).To(Equal(2))
+			policy, err := ksmPolicyClient.Get(context.TODO(), "policy", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Status.Nodes["mynode"].PagesSharing).To(BeEquivalentTo(3000))
+		})
+
+		It("should remove the node from the status of policies which do not apply anymore", func() {
+			policy := newPolicy("policy", nil, otherSelector)
+			policy.Status.Nodes = map[string]ksmv1alpha1.KSMNodeStatus{
+				"mynode":    {Running: true},
+				"othernode": {Running: true},
+			}
+			addPolicy(policy)
+
+			node := &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "mynode",
+					Labels: map[string]string{"test_label": "true"},
+				},
+			}
+			fakeClient := fake.NewSimpleClientset(node)
+			createCustomMemInfo(false)
+			heartbeat := NewHeartBeat(fakeClient.CoreV1(), ksmPolicyClient, ksmPolicyStore, deviceController(true), config(), "mynode")
+
+			heartbeat.do()
+
+			policy, err := ksmPolicyClient.Get(context.TODO(), "policy", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(policy.Status.Nodes).ToNot(HaveKey("mynode"))
+			Expect(policy.Status.Nodes).To(HaveKey("othernode"))
+		})
+	})
 })
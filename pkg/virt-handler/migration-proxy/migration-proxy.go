@@ -30,6 +30,7 @@ import (
 	"strings"
 	"sync"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
@@ -46,11 +47,11 @@ const (
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 
 type ProxyManager interface {
-	StartTargetListener(key string, targetUnixFiles []string) error
+	StartTargetListener(key string, targetUnixFiles []string, migrationConfiguration *v1.MigrationConfiguration) error
 	GetTargetListenerPorts(key string) map[string]int
 	StopTargetListener(key string)
 
-	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error
+	StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfiguration *v1.MigrationConfiguration) error
 	GetSourceListenerFiles(key string) []string
 	StopSourceListener(key string)
 
@@ -124,11 +125,20 @@ func NewMigrationProxyManager(serverTLSConfig *tls.Config, clientTLSConfig *tls.
 	}
 }
 
+// isTLSDisabled returns whether TLS is disabled by the migration configuration of the VMI,
+// falling back to the cluster wide configuration when the VMI carries none.
+func (m *migrationProxyManager) isTLSDisabled(migrationConfiguration *v1.MigrationConfiguration) bool {
+	if migrationConfiguration == nil || migrationConfiguration.DisableTLS == nil {
+		migrationConfiguration = m.config.GetMigrationConfiguration()
+	}
+	return migrationConfiguration.DisableTLS != nil && *migrationConfiguration.DisableTLS
+}
+
 func SourceUnixFile(baseDir string, key string) string {
 	return filepath.Join(baseDir, "migrationproxy", key+"-source.sock")
 }
 
-func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string) error {
+func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles []string, migrationConfiguration *v1.MigrationConfiguration) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -171,7 +181,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 	proxiesList := []*migrationProxy{}
 	serverTLSConfig := m.serverTLSConfig
 	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
+	if m.isTLSDisabled(migrationConfiguration) {
 		serverTLSConfig = nil
 		clientTLSConfig = nil
 	}
@@ -257,7 +267,7 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 	}
 }
 
-func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string) error {
+func (m *migrationProxyManager) StartSourceListener(key string, targetAddress string, destSrcPortMap map[string]int, baseDir string, migrationConfiguration *v1.MigrationConfiguration) error {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
@@ -298,7 +308,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 	}
 	serverTLSConfig := m.serverTLSConfig
 	clientTLSConfig := m.clientTLSConfig
-	if m.config.GetMigrationConfiguration().DisableTLS != nil && *m.config.GetMigrationConfiguration().DisableTLS {
+	if m.isTLSDisabled(migrationConfiguration) {
 		serverTLSConfig = nil
 		clientTLSConfig = nil
 	}
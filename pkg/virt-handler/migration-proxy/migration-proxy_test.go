@@ -21,10 +21,13 @@ package migrationproxy
 
 import (
 	"crypto/tls"
+	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -164,9 +167,9 @@ var _ = Describe("MigrationProxy", func() {
 					MigrationConfiguration: migrationConfig,
 				})
 				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock})
+				manager.StartTargetListener("mykey", []string{virtqemudSock, directSock}, nil)
 				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
-				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir)
+				manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir, nil)
 
 				defer manager.StopTargetListener("myKey")
 				defer manager.StopSourceListener("myKey")
@@ -213,6 +216,70 @@ var _ = Describe("MigrationProxy", func() {
 				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
 			)
 
+			DescribeTable("by forwarding all multifd channels of a parallel migration at the same time", func(migrationConfig *v1.MigrationConfiguration) {
+				const parallelMigrationThreads = 8
+				// the main migration stream plus one connection per multifd channel
+				const connections = parallelMigrationThreads + 1
+
+				directMigrationPort := "49152"
+				directSock := filepath.Join(tmpDir, "mykey-"+directMigrationPort)
+				directListener, err := net.Listen("unix", directSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer directListener.Close()
+
+				// echo everything back on every accepted channel
+				go func() {
+					defer GinkgoRecover()
+					for {
+						fd, err := directListener.Accept()
+						if err != nil {
+							return
+						}
+						go func(fd net.Conn) {
+							defer fd.Close()
+							_, _ = io.Copy(fd, fd)
+						}(fd)
+					}
+				}()
+
+				config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					MigrationConfiguration: migrationConfig,
+				})
+				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
+				Expect(manager.StartTargetListener("mykey", []string{directSock}, nil)).To(Succeed())
+				defer manager.StopTargetListener("mykey")
+				destSrcPortMap := manager.GetTargetListenerPorts("mykey")
+				Expect(manager.StartSourceListener("mykey", "127.0.0.1", destSrcPortMap, tmpDir, nil)).To(Succeed())
+				defer manager.StopSourceListener("mykey")
+
+				sockFiles := manager.GetSourceListenerFiles("mykey")
+				Expect(sockFiles).To(HaveLen(1))
+
+				By("opening all channels before any of them is used")
+				conns := make([]net.Conn, connections)
+				for i := range conns {
+					conns[i], err = net.Dial("unix", sockFiles[0])
+					Expect(err).ShouldNot(HaveOccurred())
+					defer conns[i].Close()
+				}
+
+				By("sending data on every channel while all of them are open")
+				for i := len(conns) - 1; i >= 0; i-- {
+					message := []byte(fmt.Sprintf("multifd channel %d", i))
+					_, err := conns[i].Write(message)
+					Expect(err).ShouldNot(HaveOccurred())
+
+					reply := make([]byte, len(message))
+					Expect(conns[i].SetReadDeadline(time.Now().Add(10 * time.Second))).To(Succeed())
+					_, err = io.ReadFull(conns[i], reply)
+					Expect(err).ShouldNot(HaveOccurred())
+					Expect(reply).To(Equal(message))
+				}
+			},
+				Entry("with TLS enabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(false)}),
+				Entry("with TLS disabled", &v1.MigrationConfiguration{DisableTLS: pointer.BoolPtr(true)}),
+			)
+
 			DescribeTable("by ensuring no new listeners can be created after shutdown", func(migrationConfig *v1.MigrationConfiguration) {
 
 				key1 := "key1"
@@ -232,10 +299,10 @@ var _ = Describe("MigrationProxy", func() {
 					MigrationConfiguration: migrationConfig,
 				})
 				manager := NewMigrationProxyManager(tlsConfig, tlsConfig, config)
-				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock})
+				err = manager.StartTargetListener(key1, []string{virtqemudSock, directSock}, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 				destSrcPortMap := manager.GetTargetListenerPorts(key1)
-				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key1, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).ShouldNot(HaveOccurred())
 
 				defer manager.StopTargetListener(key1)
@@ -246,11 +313,11 @@ var _ = Describe("MigrationProxy", func() {
 				count := manager.OpenListenerCount()
 				Expect(count).To(Equal(2))
 
-				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock})
+				err = manager.StartTargetListener(key2, []string{virtqemudSock, directSock}, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
-				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir)
+				err = manager.StartSourceListener(key2, "127.0.0.1", destSrcPortMap, tmpDir, nil)
 				Expect(err).Should(HaveOccurred())
 				Expect(err.Error()).To(Equal("unable to process new migration connections during virt-handler shutdown"))
 
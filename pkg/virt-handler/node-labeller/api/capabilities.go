@@ -3,6 +3,7 @@ package api
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
 
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 )
@@ -14,6 +15,7 @@ type CPUSiblings []uint32
 type Capabilities struct {
 	XMLName xml.Name `xml:"capabilities"`
 	Host    Host     `xml:"host"`
+	Guests  []Guest  `xml:"guest"`
 }
 
 type Host struct {
@@ -27,6 +29,7 @@ type HostCPU struct {
 	Model   string    `xml:"model"`
 	Vendor  string    `xml:"vendor"`
 	Counter []Counter `xml:"counter"`
+	Pages   []Pages   `xml:"pages"`
 }
 
 type Counter struct {
@@ -85,6 +88,23 @@ type Topology struct {
 	Cells Cells `xml:"cells"`
 }
 
+type Guest struct {
+	OSType string    `xml:"os_type"`
+	Arch   GuestArch `xml:"arch"`
+}
+
+type GuestArch struct {
+	Name     string    `xml:"name,attr"`
+	Emulator string    `xml:"emulator"`
+	Machines []Machine `xml:"machine"`
+}
+
+type Machine struct {
+	Name       string    `xml:",chardata"`
+	Canonical  string    `xml:"canonical,attr"`
+	Deprecated yesnobool `xml:"deprecated,attr"`
+}
+
 func (c *Capabilities) GetTSCCounter() (*Counter, error) {
 	for _, c := range c.Host.CPU.Counter {
 		if c.Name == "tsc" {
@@ -94,6 +114,25 @@ func (c *Capabilities) GetTSCCounter() (*Counter, error) {
 	return nil, nil
 }
 
+// GetMachineTypes returns the sorted machine types the emulator supports for the host architecture.
+// Deprecated machine types are left out.
+func (c *Capabilities) GetMachineTypes() []string {
+	machineTypes := []string{}
+	for _, guest := range c.Guests {
+		if guest.Arch.Name != c.Host.CPU.Arch {
+			continue
+		}
+		for _, machine := range guest.Arch.Machines {
+			if machine.Deprecated {
+				continue
+			}
+			machineTypes = append(machineTypes, machine.Name)
+		}
+	}
+	sort.Strings(machineTypes)
+	return machineTypes
+}
+
 func (b *yesnobool) UnmarshalXMLAttr(attr xml.Attr) error {
 	if attr.Value == "yes" {
 		*b = true
@@ -3,6 +3,7 @@ package nodelabeller
 import (
 	"encoding/xml"
 	"os"
+	"sort"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -29,6 +30,20 @@ var _ = Describe("Capabilities", func() {
 		Expect(capabilities.Host.Topology.Cells.Cell[0].Cpus.CPU[7].Siblings).To(HaveLen(29))
 	})
 
+	It("should read the host page sizes and the machine types of the host architecture", func() {
+		f, err := os.Open("testdata/capabilities.xml")
+		Expect(err).ToNot(HaveOccurred())
+		defer f.Close()
+		capabilities := &api.Capabilities{}
+		Expect(xml.NewDecoder(f).Decode(capabilities)).To(Succeed())
+		Expect(capabilities.Host.CPU.Pages).To(HaveLen(3))
+		Expect(capabilities.Host.CPU.Pages[2].Size).To(BeNumerically("==", 1048576))
+		machineTypes := capabilities.GetMachineTypes()
+		Expect(machineTypes).To(ContainElements("q35", "pc-q35-5.2", "microvm"))
+		Expect(machineTypes).ToNot(ContainElement("pc-1.1"))
+		Expect(sort.StringsAreSorted(machineTypes)).To(BeTrue())
+	})
+
 	It("should properly read cpu siblings", func() {
 		f, err := os.Open("testdata/capabilities.xml")
 		Expect(err).ToNot(HaveOccurred())
@@ -128,6 +128,7 @@ func (n *NodeLabeller) loadDomCapabilities() error {
 
 	n.hostCapabilities.items = usableModels
 	n.SEV = hostDomCapabilities.SEV
+	n.TDX = hostDomCapabilities.TDX
 
 	return nil
 }
@@ -40,6 +40,7 @@ type cpuInfo struct {
 type HostDomCapabilities struct {
 	CPU CPU              `xml:"cpu"`
 	SEV SEVConfiguration `xml:"features>sev"`
+	TDX TDXConfiguration `xml:"features>tdx"`
 }
 
 // CPU represents slice of cpu modes
@@ -98,3 +99,7 @@ type SEVConfiguration struct {
 	MaxESGuests     uint   `xml:"maxESGuests"`
 	SupportedES     string `xml:"-"`
 }
+
+type TDXConfiguration struct {
+	Supported string `xml:"supported,attr"`
+}
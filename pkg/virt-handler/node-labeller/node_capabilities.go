@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
+)
+
+const (
+	virshVersionFile       = "virsh_version.txt"
+	runningHypervisorLabel = "Running hypervisor:"
+)
+
+var iommuGroupsPath = "/sys/kernel/iommu_groups"
+
+// loadEmulatorVersion loads the version of the emulator reported by virsh version,
+// e.g. "Running hypervisor: QEMU 8.0.0"
+func (n *NodeLabeller) loadEmulatorVersion() error {
+	rawFile, err := os.ReadFile(filepath.Join(n.volumePath, virshVersionFile))
+	if os.IsNotExist(err) {
+		n.logger.Warningf("node-labeller could not find %s, the emulator version will not be reported", virshVersionFile)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, line := range strings.Split(string(rawFile), "\n") {
+		if !strings.HasPrefix(line, runningHypervisorLabel) {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, runningHypervisorLabel))
+		if len(fields) > 0 {
+			n.emulatorVersion = fields[len(fields)-1]
+		}
+	}
+	return nil
+}
+
+// getIOMMUGroups lists the IOMMU groups of the node together with the PCI addresses of their devices
+func getIOMMUGroups() ([]nodecapabilitiesv1alpha1.IOMMUGroup, error) {
+	groupDirs, err := os.ReadDir(iommuGroupsPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	groups := []nodecapabilitiesv1alpha1.IOMMUGroup{}
+	for _, groupDir := range groupDirs {
+		id, err := strconv.ParseInt(groupDir.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		devices, err := os.ReadDir(filepath.Join(iommuGroupsPath, groupDir.Name(), "devices"))
+		if err != nil {
+			return nil, err
+		}
+		group := nodecapabilitiesv1alpha1.IOMMUGroup{ID: int32(id)}
+		for _, device := range devices {
+			group.Devices = append(group.Devices, device.Name())
+		}
+		groups = append(groups, group)
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].ID < groups[j].ID
+	})
+	return groups, nil
+}
+
+// getHugepageSizes returns the page sizes of the host besides the base page size
+func (n *NodeLabeller) getHugepageSizes() []resource.Quantity {
+	pages := n.capabilities.Host.CPU.Pages
+	if len(pages) < 2 {
+		return nil
+	}
+
+	sizes := make([]uint32, 0, len(pages))
+	for _, page := range pages {
+		sizes = append(sizes, page.Size)
+	}
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i] < sizes[j]
+	})
+
+	hugepageSizes := []resource.Quantity{}
+	for _, size := range sizes[1:] {
+		hugepageSizes = append(hugepageSizes, *resource.NewQuantity(int64(size)*1024, resource.BinarySI))
+	}
+	return hugepageSizes
+}
+
+// prepareNodeCapabilities collects everything the node labeller knows about the host
+func (n *NodeLabeller) prepareNodeCapabilities(cpuModels []string, cpuFeatures cpuFeatures, hostCpuModel hostCPUModel, obsoleteCPUsx86 map[string]bool, realtimeCapable bool) (nodecapabilitiesv1alpha1.NodeCapabilitiesStatus, error) {
+	_, hostModelObsolete := obsoleteCPUsx86[hostCpuModel.Name]
+	status := nodecapabilitiesv1alpha1.NodeCapabilitiesStatus{
+		CPU: nodecapabilitiesv1alpha1.CPUCapabilities{
+			Vendor:                    n.cpuModelVendor,
+			HostModel:                 hostCpuModel.Name,
+			HostModelObsolete:         hostModelObsolete,
+			HostModelRequiredFeatures: sortedKeys(hostCpuModel.requiredFeatures),
+			Features:                  sortedKeys(cpuFeatures),
+			HypervFeatures:            append([]string{}, n.hypervFeatures.items...),
+		},
+		MachineTypes: n.capabilities.GetMachineTypes(),
+		SEV: nodecapabilitiesv1alpha1.SEVCapabilities{
+			Supported:       n.SEV.Supported == isSupported,
+			SupportedES:     n.SEV.SupportedES == isSupported,
+			CBitPos:         int32(n.SEV.CBitPos),
+			ReducedPhysBits: int32(n.SEV.ReducedPhysBits),
+			MaxGuests:       int32(n.SEV.MaxGuests),
+			MaxESGuests:     int32(n.SEV.MaxESGuests),
+		},
+		TDX: nodecapabilitiesv1alpha1.TDXCapabilities{
+			Supported: n.TDX.Supported == isSupported,
+		},
+		Realtime:        realtimeCapable,
+		HugepageSizes:   n.getHugepageSizes(),
+		EmulatorVersion: n.emulatorVersion,
+	}
+	sort.Strings(status.CPU.HypervFeatures)
+
+	for _, model := range cpuModels {
+		if n.isCPUModelUsable(model, &hostCpuModel, cpuFeatures) {
+			status.CPU.Models = append(status.CPU.Models, model)
+		}
+	}
+	sort.Strings(status.CPU.Models)
+
+	if c, err := n.capabilities.GetTSCCounter(); err == nil && c != nil {
+		status.CPU.TSC = &nodecapabilitiesv1alpha1.TSCCapabilities{
+			Frequency: c.Frequency,
+			Scalable:  bool(c.Scaling),
+		}
+	}
+
+	iommuGroups, err := getIOMMUGroups()
+	if err != nil {
+		return status, err
+	}
+	status.IOMMUGroups = iommuGroups
+
+	return status, nil
+}
+
+// publishNodeCapabilities creates or updates the NodeCapabilities object of the node.
+// The object is owned by the node so that it is garbage collected together with it.
+func (n *NodeLabeller) publishNodeCapabilities(node *v1.Node, status nodecapabilitiesv1alpha1.NodeCapabilitiesStatus) error {
+	client := n.clientset.NodeCapabilities()
+
+	nodeCapabilities, err := client.Get(context.Background(), node.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		nodeCapabilities = &nodecapabilitiesv1alpha1.NodeCapabilities{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: node.Name,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       "Node",
+					Name:       node.Name,
+					UID:        node.UID,
+				}},
+			},
+			Status: status,
+		}
+		_, err = client.Create(context.Background(), nodeCapabilities, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(nodeCapabilities.Status, status) {
+		return nil
+	}
+
+	nodeCapabilities = nodeCapabilities.DeepCopy()
+	nodeCapabilities.Status = status
+	_, err = client.Update(context.Background(), nodeCapabilities, metav1.UpdateOptions{})
+	return err
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -64,6 +64,21 @@ var nodeLabellerLabels = []string{
 	kubevirtv1.NodeHostModelIsObsoleteLabel,
 }
 
+// schedulingHypervFeatures are the hyperv features virt-launcher pods select on, see
+// makeHVFeatureLabelTable in pkg/virt-controller/services. The other hyperv features are
+// only published in the NodeCapabilities object.
+var schedulingHypervFeatures = map[string]bool{
+	"vpindex":         true,
+	"runtime":         true,
+	"reset":           true,
+	"synic":           true,
+	"synictimer":      true,
+	"frequencies":     true,
+	"reenlightenment": true,
+	"tlbflush":        true,
+	"ipi":             true,
+}
+
 // NodeLabeller struct holds information needed to run node-labeller
 type NodeLabeller struct {
 	recorder                record.EventRecorder
@@ -83,6 +98,8 @@ type NodeLabeller struct {
 	capabilities            *api.Capabilities
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
+	TDX                     TDXConfiguration
+	emulatorVersion         string
 }
 
 func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, host, namespace string, recorder record.EventRecorder) (*NodeLabeller, error) {
@@ -181,6 +198,12 @@ func (n *NodeLabeller) loadAll() error {
 		return err
 	}
 
+	err = n.loadEmulatorVersion()
+	if err != nil {
+		n.logger.Errorf("node-labeller could not load emulator version: " + err.Error())
+		return err
+	}
+
 	n.loadHypervFeatures()
 
 	return nil
@@ -192,6 +215,11 @@ func (n *NodeLabeller) run() error {
 	cpuFeatures := n.getSupportedCpuFeatures()
 	hostCPUModel := n.GetHostCpuModel()
 
+	realtimeCapable, err := isNodeRealtimeCapable()
+	if err != nil {
+		n.logger.Reason(err).Error("failed to identify if a node is capable of running realtime workloads")
+	}
+
 	originalNode, err := n.clientset.CoreV1().Nodes().Get(context.Background(), n.host, metav1.GetOptions{})
 	if err != nil {
 		return err
@@ -201,7 +229,7 @@ func (n *NodeLabeller) run() error {
 
 	if !skipNodeLabelling(node) {
 		//prepare new labels
-		newLabels := n.prepareLabels(node, cpuModels, cpuFeatures, hostCPUModel, obsoleteCPUsx86)
+		newLabels := n.prepareLabels(node, cpuModels, cpuFeatures, hostCPUModel, obsoleteCPUsx86, realtimeCapable)
 		//remove old labeller labels
 		n.removeLabellerLabels(node)
 		//add new labels
@@ -209,8 +237,16 @@ func (n *NodeLabeller) run() error {
 	}
 
 	err = n.patchNode(originalNode, node)
+	if err != nil {
+		return err
+	}
+
+	nodeCapabilities, err := n.prepareNodeCapabilities(cpuModels, cpuFeatures, hostCPUModel, obsoleteCPUsx86, realtimeCapable)
+	if err != nil {
+		return err
+	}
 
-	return err
+	return n.publishNodeCapabilities(originalNode, nodeCapabilities)
 }
 
 func skipNodeLabelling(node *v1.Node) bool {
@@ -266,14 +302,16 @@ func (n *NodeLabeller) loadHypervFeatures() {
 
 // prepareLabels converts cpu models, features, hyperv features to map[string]string format
 // e.g. "cpu-feature.node.kubevirt.io/Penryn": "true"
-func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatures cpuFeatures, hostCpuModel hostCPUModel, obsoleteCPUsx86 map[string]bool) map[string]string {
+// Only labels which virt-launcher pods and migration targets select on are created, the
+// NodeCapabilities object publishes the full set of host capabilities.
+func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatures cpuFeatures, hostCpuModel hostCPUModel, obsoleteCPUsx86 map[string]bool, realtimeCapable bool) map[string]string {
 	newLabels := make(map[string]string)
 	for key := range cpuFeatures {
 		newLabels[kubevirtv1.CPUFeatureLabel+key] = "true"
 	}
 
 	for _, value := range cpuModels {
-		if !n.shouldAddCPUModelLabel(value, &hostCpuModel, newLabels) {
+		if !n.isCPUModelUsable(value, &hostCpuModel, cpuFeatures) {
 			continue
 		}
 
@@ -286,7 +324,9 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatu
 	}
 
 	for _, key := range n.hypervFeatures.items {
-		newLabels[kubevirtv1.HypervLabel+key] = "true"
+		if schedulingHypervFeatures[key] {
+			newLabels[kubevirtv1.HypervLabel+key] = "true"
+		}
 	}
 
 	if c, err := n.capabilities.GetTSCCounter(); err == nil && c != nil {
@@ -310,11 +350,7 @@ func (n *NodeLabeller) prepareLabels(node *v1.Node, cpuModels []string, cpuFeatu
 	newLabels[kubevirtv1.CPUModelVendorLabel+n.cpuModelVendor] = "true"
 	newLabels[kubevirtv1.HostModelCPULabel+hostCpuModel.Name] = "true"
 
-	capable, err := isNodeRealtimeCapable()
-	if err != nil {
-		n.logger.Reason(err).Error("failed to identify if a node is capable of running realtime workloads")
-	}
-	if capable {
+	if realtimeCapable {
 		newLabels[kubevirtv1.RealtimeLabel] = ""
 	}
 
@@ -387,10 +423,10 @@ func (n *NodeLabeller) alertIfHostModelIsObsolete(originalNode *v1.Node, hostMod
 	return nil
 }
 
-func (n *NodeLabeller) shouldAddCPUModelLabel(
+func (n *NodeLabeller) isCPUModelUsable(
 	cpuModelName string,
 	hostCpuModel *hostCPUModel,
-	featureLabels map[string]string,
+	cpuFeatures cpuFeatures,
 ) bool {
 	if cpuModelName == hostCpuModel.Name {
 		return true
@@ -412,7 +448,7 @@ func (n *NodeLabeller) shouldAddCPUModelLabel(
 	}
 	missingFeatures := make([]string, 0)
 	for f := range requiredFeatures {
-		if _, isFeatureSupported := featureLabels[kubevirtv1.CPUFeatureLabel+f]; !isFeatureSupported {
+		if _, isFeatureSupported := cpuFeatures[f]; !isFeatureSupported {
 			missingFeatures = append(missingFeatures, f)
 		}
 	}
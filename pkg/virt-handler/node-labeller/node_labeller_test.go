@@ -22,6 +22,10 @@
 package nodelabeller
 
 import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -29,6 +33,7 @@ import (
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
@@ -36,7 +41,9 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
 
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -50,6 +57,7 @@ var _ = Describe("Node-labeller ", func() {
 	var stop chan struct{}
 	var ctrl *gomock.Controller
 	var kubeClient *fake.Clientset
+	var kubevirtClient *kubevirtfake.Clientset
 	var mockQueue *testutils.MockWorkQueue
 	var config *virtconfig.ClusterConfig
 	var addedNode *v1.Node
@@ -82,6 +90,7 @@ var _ = Describe("Node-labeller ", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		kubeClient = fake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 
 		kubeClient.Fake.PrependReactor("get", "nodes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -89,6 +98,14 @@ var _ = Describe("Node-labeller ", func() {
 		})
 
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().NodeCapabilities().Return(kubevirtClient.NodecapabilitiesV1alpha1().NodeCapabilities()).AnyTimes()
+
+		iommuGroupsPath = GinkgoT().TempDir()
+		for group, devices := range map[string][]string{"0": {"0000:00:00.0"}, "13": {"0000:03:00.0", "0000:03:00.1"}} {
+			for _, device := range devices {
+				Expect(os.MkdirAll(filepath.Join(iommuGroupsPath, group, "devices", device), 0755)).To(Succeed())
+			}
+		}
 
 		kv := &kubevirtv1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
@@ -175,6 +192,112 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(res).To(BeTrue())
 	})
 
+	DescribeTable("should only keep hyperv labels which are selected on", func(nodeLabels map[string]string) {
+		addedNode.Labels = nodeLabels
+		nlController.hypervFeatures.items = []string{"base", "time", "vpindex", "synic", "synic2"}
+
+		var labels map[string]string
+		kubeClient.Fake.PrependReactor("patch", "nodes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			var patchOps []struct {
+				Op    string            `json:"op"`
+				Path  string            `json:"path"`
+				Value map[string]string `json:"value"`
+			}
+			Expect(json.Unmarshal(action.(testing.PatchAction).GetPatch(), &patchOps)).To(Succeed())
+			for _, patchOp := range patchOps {
+				if patchOp.Op == "replace" && patchOp.Path == "/metadata/labels" {
+					labels = patchOp.Value
+				}
+			}
+			return true, nil, nil
+		})
+		Expect(nlController.execute()).To(BeTrue())
+
+		Expect(labels).To(HaveKey(kubevirtv1.HypervLabel + "vpindex"))
+		Expect(labels).To(HaveKey(kubevirtv1.HypervLabel + "synic"))
+		Expect(labels).ToNot(HaveKey(kubevirtv1.HypervLabel + "base"))
+		Expect(labels).ToNot(HaveKey(kubevirtv1.HypervLabel + "time"))
+		Expect(labels).ToNot(HaveKey(kubevirtv1.HypervLabel + "synic2"))
+
+		nodeCapabilities, err := kubevirtClient.NodecapabilitiesV1alpha1().NodeCapabilities().Get(context.Background(), "testNode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeCapabilities.Status.CPU.HypervFeatures).To(ConsistOf("base", "time", "vpindex", "synic", "synic2"))
+	},
+		Entry("on a new node", map[string]string{}),
+		Entry("on a node with outdated labels", map[string]string{kubevirtv1.HypervLabel + "base": "true", kubevirtv1.HypervLabel + "synic2": "true"}),
+	)
+
+	Context("node capabilities", func() {
+		getNodeCapabilities := func() *nodecapabilitiesv1alpha1.NodeCapabilities {
+			nodeCapabilities, err := kubevirtClient.NodecapabilitiesV1alpha1().NodeCapabilities().Get(context.Background(), "testNode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return nodeCapabilities
+		}
+
+		It("should publish the host capabilities", func() {
+			testutils.ExpectNodePatch(kubeClient)
+			Expect(nlController.execute()).To(BeTrue())
+
+			nodeCapabilities := getNodeCapabilities()
+			Expect(nodeCapabilities.OwnerReferences).To(HaveLen(1))
+			Expect(nodeCapabilities.OwnerReferences[0].Kind).To(Equal("Node"))
+			Expect(nodeCapabilities.OwnerReferences[0].Name).To(Equal("testNode"))
+
+			status := nodeCapabilities.Status
+			Expect(status.CPU.Vendor).To(Equal("Intel"))
+			Expect(status.CPU.HostModel).To(Equal("Skylake-Client-IBRS"))
+			Expect(status.CPU.HostModelObsolete).To(BeFalse())
+			Expect(status.CPU.HostModelRequiredFeatures).To(Equal([]string{"acpi", "ds", "ss"}))
+			Expect(status.CPU.Models).To(ContainElements("Penryn", "Skylake-Client-IBRS"))
+			Expect(status.CPU.Models).ToNot(ContainElement("Opteron_G2"))
+			Expect(status.CPU.Features).ToNot(BeEmpty())
+			Expect(status.CPU.TSC).To(Equal(&nodecapabilitiesv1alpha1.TSCCapabilities{Frequency: 4008012000, Scalable: false}))
+			Expect(status.MachineTypes).To(ContainElements("q35", "pc-q35-5.2"))
+			Expect(status.MachineTypes).ToNot(ContainElement("pc-1.1"))
+			Expect(status.SEV.Supported).To(BeTrue())
+			Expect(status.SEV.SupportedES).To(BeTrue())
+			Expect(status.SEV.CBitPos).To(BeEquivalentTo(47))
+			Expect(status.TDX.Supported).To(BeFalse())
+			Expect(status.HugepageSizes).To(HaveLen(2))
+			Expect(status.HugepageSizes[0].Equal(resource.MustParse("2Mi"))).To(BeTrue())
+			Expect(status.HugepageSizes[1].Equal(resource.MustParse("1Gi"))).To(BeTrue())
+			Expect(status.IOMMUGroups).To(Equal([]nodecapabilitiesv1alpha1.IOMMUGroup{
+				{ID: 0, Devices: []string{"0000:00:00.0"}},
+				{ID: 13, Devices: []string{"0000:03:00.0", "0000:03:00.1"}},
+			}))
+			Expect(status.EmulatorVersion).To(Equal("7.2.0"))
+		})
+
+		It("should update outdated host capabilities", func() {
+			_, err := kubevirtClient.NodecapabilitiesV1alpha1().NodeCapabilities().Create(context.Background(), &nodecapabilitiesv1alpha1.NodeCapabilities{
+				ObjectMeta: metav1.ObjectMeta{Name: "testNode"},
+				Status: nodecapabilitiesv1alpha1.NodeCapabilitiesStatus{
+					EmulatorVersion: "6.2.0",
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			testutils.ExpectNodePatch(kubeClient)
+			Expect(nlController.execute()).To(BeTrue())
+
+			status := getNodeCapabilities().Status
+			Expect(status.EmulatorVersion).To(Equal("7.2.0"))
+			Expect(status.CPU.HostModel).To(Equal("Skylake-Client-IBRS"))
+		})
+
+		It("should not update up to date host capabilities", func() {
+			testutils.ExpectNodePatch(kubeClient)
+			Expect(nlController.execute()).To(BeTrue())
+
+			kubevirtClient.ClearActions()
+			addNode(addedNode)
+			Expect(nlController.execute()).To(BeTrue())
+			for _, action := range kubevirtClient.Actions() {
+				Expect(action.GetVerb()).To(Equal("get"))
+			}
+		})
+	})
+
 	AfterEach(func() {
 		close(stop)
 	})
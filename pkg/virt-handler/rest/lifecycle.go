@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/emicklei/go-restful/v3"
 
@@ -34,6 +35,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
@@ -218,6 +220,144 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	maxOutputBytes, err := strconv.ParseInt(request.QueryParameter("maxOutputBytes"), 10, 64)
+	if err != nil || maxOutputBytes <= 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a positive maxOutputBytes is required"))
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: guest exec parameters are required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guest exec parameters from request"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	execRequest := &v1.VirtualMachineInstanceGuestExecRequest{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(execRequest)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode guest exec parameters")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if execRequest.Command == "" || execRequest.TimeoutSeconds == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("command and timeout are required"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Executing %s on guest", execRequest.Command)
+
+	exitCode, stdOut, stdErr, err := client.GuestExec(api.VMINamespaceKeyFunc(vmi), execRequest.Command, execRequest.Args, *execRequest.TimeoutSeconds, maxOutputBytes)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to execute command on guest")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.VirtualMachineInstanceGuestExecResult{
+		ExitCode: int32(exitCode),
+		StdOut:   stdOut,
+		StdErr:   stdErr,
+	})
+}
+
+func (lh *LifecycleHandler) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	path := request.QueryParameter("path")
+	maxBytes, err := strconv.ParseInt(request.QueryParameter("maxBytes"), 10, 64)
+	if err != nil || path == "" || maxBytes <= 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("path and a positive maxBytes are required"))
+		return
+	}
+	timeoutSeconds, err := getTimeoutSeconds(request)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Reading guest file %s", path)
+
+	content, truncated, err := client.GuestFileRead(api.VMINamespaceKeyFunc(vmi), path, maxBytes, timeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to read guest file")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.VirtualMachineInstanceGuestFile{
+		Path:      path,
+		Content:   content,
+		Truncated: truncated,
+	})
+}
+
+func (lh *LifecycleHandler) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("Request with no body: guest file is required")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guest file from request"))
+		return
+	}
+	timeoutSeconds, err := getTimeoutSeconds(request)
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	defer request.Request.Body.Close()
+	file := &v1.VirtualMachineInstanceGuestFile{}
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(file)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		log.Log.Object(vmi).Reason(err).Error("Failed to decode guest file")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	if file.Path == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("path is required"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Writing guest file %s", file.Path)
+
+	if err := client.GuestFileWrite(api.VMINamespaceKeyFunc(vmi), file.Path, file.Content, timeoutSeconds); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to write guest file")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusOK)
+}
+
+func getTimeoutSeconds(request *restful.Request) (int32, error) {
+	timeoutSeconds, err := strconv.ParseInt(request.QueryParameter("timeoutSeconds"), 10, 32)
+	if err != nil || timeoutSeconds <= 0 {
+		return 0, fmt.Errorf("a positive timeoutSeconds is required")
+	}
+	return int32(timeoutSeconds), nil
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiInformer)
 	if err != nil {
@@ -64,6 +64,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	netcache "kubevirt.io/kubevirt/pkg/network/cache"
+	"kubevirt.io/kubevirt/pkg/network/netbinding"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -103,6 +104,7 @@ import (
 
 type netconf interface {
 	Setup(vmi *v1.VirtualMachineInstance, networks []v1.Network, launcherPid int, preSetup func() error) error
+	Announce(vmi *v1.VirtualMachineInstance, launcherPid int) error
 	Teardown(vmi *v1.VirtualMachineInstance) error
 }
 
@@ -193,6 +195,7 @@ func NewController(
 	vmiTargetInformer cache.SharedIndexInformer,
 	domainInformer cache.SharedInformer,
 	gracefulShutdownInformer cache.SharedIndexInformer,
+	ksmPolicyInformer cache.SharedIndexInformer,
 	watchdogTimeoutSeconds int,
 	maxDevices int,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -269,7 +272,7 @@ func NewController(
 
 	c.launcherClients = virtcache.LauncherClientInfoByVMI{}
 
-	c.netConf = netsetup.NewNetConf()
+	c.netConf = netsetup.NewNetConf(clusterConfig)
 	c.netStat = netsetup.NewNetStat()
 
 	c.downwardMetricsManager = downwardMetricsManager
@@ -295,7 +298,7 @@ func NewController(
 		device_manager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
 		clientset.CoreV1())
-	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), clientset.KSMPolicy(), ksmPolicyInformer.GetStore(), c.deviceManagerController, clusterConfig, host)
 
 	return c, nil
 }
@@ -582,6 +585,16 @@ func (d *VirtualMachineController) setupNetwork(vmi *v1.VirtualMachineInstance,
 	})
 }
 
+// announceNetwork advertises the guest addresses from the migration target pod,
+// once the guest runs on it.
+func (d *VirtualMachineController) announceNetwork(vmi *v1.VirtualMachineInstance) error {
+	isolationRes, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf(failedDetectIsolationFmt, err)
+	}
+	return d.netConf.Announce(vmi, isolationRes.Pid())
+}
+
 func domainMigrated(domain *api.Domain) bool {
 	if domain != nil && domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonMigrated {
 		return true
@@ -1257,6 +1270,48 @@ func (d *VirtualMachineController) updateIsoSizeStatus(vmi *v1.VirtualMachineIns
 	}
 }
 
+// updateMigratedVolumesSizeStatus reports the size of the disk images of filesystem volumes
+// which are moved to new claims, so that the target can create disk images of the same size.
+func (d *VirtualMachineController) updateMigratedVolumesSizeStatus(vmi *v1.VirtualMachineInstance) {
+	if vmi.Status.Phase != v1.Running || !migrations.IsStorageMigration(vmi) {
+		return
+	}
+
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to detect VMI")
+		return
+	}
+	rootPath, err := res.MountRoot()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to detect VMI")
+		return
+	}
+
+	migratedVolumes := make(map[string]bool)
+	for _, migratedVolume := range vmi.Status.MigrationState.MigratedVolumes {
+		migratedVolumes[migratedVolume.VolumeName] = true
+	}
+	for i, volumeStatus := range vmi.Status.VolumeStatus {
+		if !migratedVolumes[volumeStatus.Name] || volumeStatus.PersistentVolumeClaimInfo == nil ||
+			pvctypes.IsPVCBlock(volumeStatus.PersistentVolumeClaimInfo.VolumeMode) {
+			continue
+		}
+		diskPath := hostdisk.GetMountedHostDiskPath(volumeStatus.Name, "disk.img")
+		safeDiskPath, err := rootPath.AppendAndResolveWithRelativeRoot(diskPath)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to determine file size for volume %s", diskPath)
+			continue
+		}
+		fileInfo, err := safepath.StatAtNoFollow(safeDiskPath)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to determine file size for volume %s", diskPath)
+			continue
+		}
+		vmi.Status.VolumeStatus[i].Size = fileInfo.Size()
+	}
+}
+
 func (d *VirtualMachineController) updateSELinuxContext(vmi *v1.VirtualMachineInstance) error {
 	_, present, err := selinux.NewSELinux()
 	if err != nil {
@@ -1277,6 +1332,7 @@ func (d *VirtualMachineController) updateSELinuxContext(vmi *v1.VirtualMachineIn
 
 func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	d.updateIsoSizeStatus(vmi)
+	d.updateMigratedVolumesSizeStatus(vmi)
 	err := d.updateSELinuxContext(vmi)
 	if err != nil {
 		log.Log.Reason(err).Errorf("couldn't find the SELinux context for %s", vmi.Name)
@@ -2401,15 +2457,21 @@ func (d *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.Vi
 		return nil
 	}
 
-	_, allowPodBridgeNetworkLiveMigration := vmi.Annotations[v1.AllowPodBridgeNetworkLiveMigrationAnnotation]
-	if allowPodBridgeNetworkLiveMigration && netvmispec.IsPodNetworkWithBridgeBindingInterface(vmi.Spec.Networks, ifaces) {
+	if netvmispec.VhostUserInterfaceExist(ifaces) {
+		return fmt.Errorf("cannot migrate VMI which uses vhost-user interfaces")
+	}
+
+	// Bridge-bound interfaces on secondary networks keep the guest addresses on the migration target.
+	// On the pod network the target pod is assigned different ones, which the guest is made to lease
+	// once the migration completes.
+	if netvmispec.IsPodNetworkWithBridgeBindingInterface(vmi.Spec.Networks, ifaces) {
 		return nil
 	}
 	if netvmispec.IsPodNetworkWithMasqueradeBindingInterface(vmi.Spec.Networks, ifaces) {
 		return nil
 	}
 
-	return fmt.Errorf("cannot migrate VMI which does not use masquerade to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation)
+	return fmt.Errorf("cannot migrate VMI which does not use masquerade or bridge to connect to the pod network")
 }
 
 func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachineInstance) (blockMigrate bool, err error) {
@@ -2420,6 +2482,13 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 		volumeStatusMap[volumeStatus.Name] = volumeStatus
 	}
 
+	migratedVolumes := make(map[string]bool)
+	if migrations.IsStorageMigration(vmi) {
+		for _, migratedVolume := range vmi.Status.MigrationState.MigratedVolumes {
+			migratedVolumes[migratedVolume.VolumeName] = true
+		}
+	}
+
 	// Check if all VMI volumes can be shared between the source and the destination
 	// of a live migration. blockMigrate will be returned as false, only if all volumes
 	// are shared and the VMI has no local disks
@@ -2427,6 +2496,11 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
+		if migratedVolumes[volume.Name] {
+			// Volumes moved to a new claim are copied to the target
+			blockMigrate = true
+			continue
+		}
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
 
 			var claimName string
@@ -2499,15 +2573,18 @@ func (d *VirtualMachineController) handleTargetMigrationProxy(vmi *v1.VirtualMac
 	baseDir := fmt.Sprintf(filepath.Join(d.virtLauncherFSRunDirPattern, "kubevirt"), res.Pid())
 	migrationTargetSockets = append(migrationTargetSockets, socketFile)
 
-	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration
-	migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
+	migrationPortsRange := migrationproxy.GetMigrationPortsList(migrations.IsBlockMigration(vmi))
 	for _, port := range migrationPortsRange {
 		key := migrationproxy.ConstructProxyKey(string(vmi.UID), port)
 		// a proxy between the target direct qemu channel and the connector in the destination pod
 		destSocketFile := migrationproxy.SourceUnixFile(baseDir, key)
 		migrationTargetSockets = append(migrationTargetSockets, destSocketFile)
 	}
-	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets)
+	var migrationConfiguration *v1.MigrationConfiguration
+	if vmi.Status.MigrationState != nil {
+		migrationConfiguration = vmi.Status.MigrationState.MigrationConfiguration
+	}
+	err = d.migrationProxy.StartTargetListener(string(vmi.UID), migrationTargetSockets, migrationConfiguration)
 	if err != nil {
 		return err
 	}
@@ -2540,6 +2617,7 @@ func (d *VirtualMachineController) handleSourceMigrationProxy(vmi *v1.VirtualMac
 		vmi.Status.MigrationState.TargetNodeAddress,
 		vmi.Status.MigrationState.TargetDirectMigrationNodePorts,
 		baseDir,
+		vmi.Status.MigrationState.MigrationConfiguration,
 	)
 	if err != nil {
 		return err
@@ -2611,7 +2689,9 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 		}
 
-		if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
+		if migrationConfiguration.ParallelMigrationThreads != nil {
+			options.ParallelMigrationThreads = pointer.P(uint(*migrationConfiguration.ParallelMigrationThreads))
+		} else if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
 			threadCount, err := strconv.Atoi(threadCountStr)
 
 			if err != nil {
@@ -2671,6 +2751,13 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 		return err
 	}
 
+	// the disk images of migrated volumes are created with the size of the disk images on the source
+	if !hostdisk.ApplyMigratedVolumesSize(vmi) {
+		log.Log.Object(vmi).V(4).Info("Waiting for the source to report the size of the migrated volumes")
+		d.Queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), time.Second*1)
+		return nil
+	}
+
 	// give containerDisks some time to become ready before throwing errors on retries
 	info := d.getLauncherClientInfo(vmi)
 	if ready, err := d.containerDiskMounter.ContainerDisksReady(vmi, info.NotInitializedSince); !ready {
@@ -2735,6 +2822,7 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 	}
 
 	options := virtualMachineOptions(nil, 0, nil, d.capabilities, disksInfo, d.clusterConfig)
+	options.InterfaceDomainAttachment = netbinding.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetConfig())
 	if err := client.SyncMigrationTarget(vmi, options); err != nil {
 		return fmt.Errorf("syncing migration target failed: %v", err)
 	}
@@ -3034,6 +3122,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 	period := d.clusterConfig.GetMemBalloonStatsPeriod()
 
 	options := virtualMachineOptions(smbios, period, preallocatedVolumes, d.capabilities, disksInfo, d.clusterConfig)
+	options.InterfaceDomainAttachment = netbinding.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, d.clusterConfig.GetConfig())
 
 	err = client.SyncVirtualMachine(vmi, options)
 	if err != nil {
@@ -3321,6 +3410,11 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to update guest memory")
 	}
 
+	if err := d.announceNetwork(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error(errorMessage)
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to announce the guest network addresses")
+	}
+
 	if err := client.FinalizeVirtualMachineMigration(vmi); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
@@ -71,6 +71,8 @@ import (
 	notifyclient "kubevirt.io/kubevirt/pkg/virt-launcher/notify-client"
 
 	v1 "kubevirt.io/api/core/v1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/precond"
 
@@ -98,6 +100,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 	var domainSource *framework.FakeControllerSource
 	var domainInformer cache.SharedIndexInformer
 	var gracefulShutdownInformer cache.SharedIndexInformer
+	var ksmPolicyInformer cache.SharedIndexInformer
 	var mockQueue *testutils.MockWorkQueue
 	var mockWatchdog *MockWatchdog
 	var mockGracefulShutdown *MockGracefulShutdown
@@ -174,6 +177,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		vmiTargetInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		domainInformer, domainSource = testutils.NewFakeInformerFor(&api.Domain{})
 		gracefulShutdownInformer, _ = testutils.NewFakeInformerFor(&api.Domain{})
+		ksmPolicyInformer, _ = testutils.NewFakeInformerFor(&ksmv1alpha1.KSMPolicy{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
@@ -181,6 +185,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
 		virtClient.EXPECT().CoreV1().Return(clientTest.CoreV1()).AnyTimes()
+		virtClient.EXPECT().KSMPolicy().Return(kubevirtfake.NewSimpleClientset().KsmV1alpha1().KSMPolicies()).AnyTimes()
 		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
 
@@ -220,6 +225,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			vmiTargetInformer,
 			domainInformer,
 			gracefulShutdownInformer,
+			ksmPolicyInformer,
 			1,
 			10,
 			config,
@@ -2670,41 +2676,46 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonVirtIOFSNotMigratable))
 		})
 
-		It("should not be allowed to live-migrate if the VMI does not use masquerade to connect to the pod network", func() {
+		It("should not be allowed to live-migrate if the VMI does not use masquerade or bridge to connect to the pod network", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 
 			strategy := v1.EvictionStrategyLiveMigrate
 			vmi.Spec.EvictionStrategy = &strategy
 
-			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}},
+			}}
 			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
 
 			conditionManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
 			controller.updateLiveMigrationConditions(vmi, conditionManager)
 
-			testutils.ExpectEvent(recorder, fmt.Sprintf("cannot migrate VMI which does not use masquerade to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation))
+			testutils.ExpectEvent(recorder, "cannot migrate VMI which does not use masquerade or bridge to connect to the pod network")
 		})
-		Context("with AllowLiveMigrationBridgePodNetwork annotation", func() {
-			It("should allow to live-migrate if the VMI use bridge to connect to the pod network", func() {
-				vmi := api2.NewMinimalVMI("testvmi")
 
-				vmi.Annotations = map[string]string{v1.AllowPodBridgeNetworkLiveMigrationAnnotation: ""}
+		DescribeTable("should allow to live-migrate if the VMI use bridge to connect to the pod network", func(annotations map[string]string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Annotations = annotations
 
-				strategy := v1.EvictionStrategyLiveMigrate
-				vmi.Spec.EvictionStrategy = &strategy
+			strategy := v1.EvictionStrategyLiveMigrate
+			vmi.Spec.EvictionStrategy = &strategy
 
-				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
-				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
 
-				conditionManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
-				controller.updateLiveMigrationConditions(vmi, conditionManager)
-				Expect(vmi.Status.Conditions).To(ContainElement(v1.VirtualMachineInstanceCondition{
-					Type:   v1.VirtualMachineInstanceIsMigratable,
-					Status: k8sv1.ConditionTrue,
-				}))
-				Expect(vmi.Status.MigrationMethod).To(Equal(v1.LiveMigration))
-			})
-		})
+			conditionManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateLiveMigrationConditions(vmi, conditionManager)
+			Expect(vmi.Status.Conditions).To(ContainElement(v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceIsMigratable,
+				Status: k8sv1.ConditionTrue,
+			}))
+			Expect(vmi.Status.MigrationMethod).To(Equal(v1.LiveMigration))
+		},
+			Entry("without annotations", nil),
+			Entry("with the deprecated AllowLiveMigrationBridgePodNetwork annotation",
+				map[string]string{v1.AllowPodBridgeNetworkLiveMigrationAnnotation: ""}),
+		)
 
 		Context("check that migration is not supported when using Host Devices", func() {
 			envName := util.ResourceNameToEnvVar(v1.PCIResourcePrefix, "dev1")
@@ -2790,7 +2801,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 
 		Context("with network configuration", func() {
-			It("should block migration for bridge binding assigned to the pod network", func() {
+			It("should not block migration for bridge binding assigned to the pod network", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				interface_name := "interface_name"
 
@@ -2810,7 +2821,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				}
 
 				err := controller.checkNetworkInterfacesForMigration(vmi)
-				Expect(err).To(HaveOccurred())
+				Expect(err).ToNot(HaveOccurred())
 			})
 
 			It("should not block migration for masquerade binding assigned to the pod network", func() {
@@ -2858,6 +2869,53 @@ var _ = Describe("VirtualMachineInstance", func() {
 				err := controller.checkNetworkInterfacesForMigration(vmi)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("should not block migration for bridge binding assigned to a multus network along masquerade on the pod network", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+
+				vmi.Spec.Networks = []v1.Network{
+					*v1.DefaultPodNetwork(),
+					{
+						Name:          "secondary",
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad"}},
+					},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					*v1.DefaultMasqueradeNetworkInterface(),
+					{
+						Name: "secondary",
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{
+							Bridge: &v1.InterfaceBridge{},
+						},
+					},
+				}
+
+				err := controller.checkNetworkInterfacesForMigration(vmi)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should block migration for vhost-user binding", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				interface_name := "interface_name"
+
+				vmi.Spec.Networks = []v1.Network{
+					{
+						Name:          interface_name,
+						NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{}},
+					},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					{
+						Name: interface_name,
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{
+							VhostUser: &v1.InterfaceVhostUser{},
+						},
+					},
+				}
+
+				err := controller.checkNetworkInterfacesForMigration(vmi)
+				Expect(err).To(MatchError("cannot migrate VMI which uses vhost-user interfaces"))
+			})
 		})
 
 		Context("check right migration mode is used when using container disk volume with", func() {
@@ -3619,6 +3677,10 @@ func (nc *netConfStub) Setup(vmi *v1.VirtualMachineInstance, _ []v1.Network, lau
 	return nil
 }
 
+func (nc *netConfStub) Announce(vmi *v1.VirtualMachineInstance, launcherPid int) error {
+	return nil
+}
+
 func (nc *netConfStub) Teardown(vmi *v1.VirtualMachineInstance) error {
 	nc.vmiUID = ""
 	return nil
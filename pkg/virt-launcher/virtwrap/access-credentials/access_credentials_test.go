@@ -92,7 +92,7 @@ var _ = Describe("AccessCredentials", func() {
 		command := "some-command"
 		args := []string{"arg1", "arg2"}
 
-		expectedCmd := `{"execute": "guest-exec", "arguments": { "path": "some-command", "arg": [ "arg1", "arg2" ], "capture-output":true } }`
+		expectedCmd := `{"execute":"guest-exec","arguments":{"path":"some-command","arg":["arg1","arg2"],"capture-output":true}}`
 		expectedStatusCmd := `{"execute": "guest-exec-status", "arguments": { "pid": 789 } }`
 
 		mockConn.EXPECT().QemuAgentCommand(expectedCmd, domName).Return(`{"return":{"pid":789}}`, nil)
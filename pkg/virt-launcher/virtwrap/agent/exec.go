@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+	"unicode/utf8"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
@@ -16,6 +17,12 @@ type execReturnData struct {
 	Pid int `json:"pid"`
 }
 
+type execArguments struct {
+	Path          string   `json:"path"`
+	Args          []string `json:"arg,omitempty"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
 type execStatusReturn struct {
 	Return execStatusReturnData `json:"return"`
 }
@@ -23,6 +30,7 @@ type execStatusReturnData struct {
 	Exited   bool   `json:"exited"`
 	ExitCode int    `json:"exitcode"`
 	OutData  string `json:"out-data"`
+	ErrData  string `json:"err-data"`
 }
 
 // ExecExitCode returned at non-zero return codes
@@ -37,29 +45,35 @@ func (e ExecExitCode) Error() string {
 // GuestExec sends the provided command and args to the guest agent for execution and returns an error on an unsucessful exit code
 // The resulting stdout will be returned as a string
 func GuestExec(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32) (string, error) {
+	stdOut, _, err := GuestExecOutput(virConn, domName, command, args, timeoutSeconds, 0)
+	return stdOut, err
+}
+
+// GuestExecOutput works like GuestExec, but returns stderr as well. Each output is truncated to
+// maxOutputBytes at a rune boundary, a maxOutputBytes of zero keeps the whole output.
+func GuestExecOutput(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (string, string, error) {
 	stdOut := ""
-	argsStr := ""
-	for _, arg := range args {
-		if argsStr == "" {
-			argsStr = fmt.Sprintf("\"%s\"", arg)
-		} else {
-			argsStr = argsStr + fmt.Sprintf(", \"%s\"", arg)
-		}
+	stdErr := ""
+	// marshal the command, so quotes in the command or its arguments can't alter the agent command
+	cmdExec, err := json.Marshal(agentCommand{
+		Execute:   "guest-exec",
+		Arguments: execArguments{Path: command, Args: args, CaptureOutput: true},
+	})
+	if err != nil {
+		return "", "", err
 	}
-
-	cmdExec := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "%s", "arg": [ %s ], "capture-output":true } }`, command, argsStr)
-	output, err := virConn.QemuAgentCommand(cmdExec, domName)
+	output, err := virConn.QemuAgentCommand(string(cmdExec), domName)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	execRes := &execReturn{}
 	err = json.Unmarshal([]byte(output), execRes)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	if execRes.Return.Pid <= 0 {
-		return "", fmt.Errorf("Invalid pid [%d] returned from qemu agent during access credential injection: %s", execRes.Return.Pid, output)
+		return "", "", fmt.Errorf("Invalid pid [%d] returned from qemu agent during access credential injection: %s", execRes.Return.Pid, output)
 	}
 
 	exited := false
@@ -72,20 +86,25 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 		cmdExecStatus := fmt.Sprintf(`{"execute": "guest-exec-status", "arguments": { "pid": %d } }`, execRes.Return.Pid)
 		output, err := virConn.QemuAgentCommand(cmdExecStatus, domName)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 		execStatusRes := &execStatusReturn{}
 		err = json.Unmarshal([]byte(output), execStatusRes)
 		if err != nil {
-			return "", err
+			return "", "", err
 		}
 
 		if execStatusRes.Return.Exited {
 			stdOutBytes, err := base64.StdEncoding.DecodeString(execStatusRes.Return.OutData)
 			if err != nil {
-				return "", err
+				return "", "", err
 			}
-			stdOut = string(stdOutBytes)
+			stdErrBytes, err := base64.StdEncoding.DecodeString(execStatusRes.Return.ErrData)
+			if err != nil {
+				return "", "", err
+			}
+			stdOut = truncateOutput(stdOutBytes, maxOutputBytes)
+			stdErr = truncateOutput(stdErrBytes, maxOutputBytes)
 			exitCode = execStatusRes.Return.ExitCode
 			exited = true
 			break
@@ -97,10 +116,22 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 	}
 
 	if !exited {
-		return "", fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit", execRes.Return.Pid, command)
+		return "", "", fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit", execRes.Return.Pid, command)
 	} else if exitCode != 0 {
-		return stdOut, ExecExitCode{exitCode}
+		return stdOut, stdErr, ExecExitCode{exitCode}
 	}
 
-	return stdOut, nil
+	return stdOut, stdErr, nil
+}
+
+// truncateOutput cuts the output to maxBytes without splitting a multi-byte UTF-8 character
+func truncateOutput(output []byte, maxBytes int64) string {
+	if maxBytes <= 0 || int64(len(output)) <= maxBytes {
+		return string(output)
+	}
+	end := int(maxBytes)
+	for i := 0; i < utf8.UTFMax-1 && end > 0 && !utf8.RuneStart(output[end]); i++ {
+		end--
+	}
+	return string(output[:end])
 }
@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package agent
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest exec", func() {
+	const testPid = 42
+
+	var mockConn *cli.MockConnection
+
+	expectExec := func(exitCode int, stdOut, stdErr string) {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(command string, _ string) (string, error) {
+			if containsCommand(command, "guest-exec-status") {
+				return marshal(execStatusReturn{Return: execStatusReturnData{
+					Exited:   true,
+					ExitCode: exitCode,
+					OutData:  base64.StdEncoding.EncodeToString([]byte(stdOut)),
+					ErrData:  base64.StdEncoding.EncodeToString([]byte(stdErr)),
+				}}), nil
+			}
+			return fmt.Sprintf(`{"return":{"pid":%d}}`, testPid), nil
+		}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+	})
+
+	It("should return stdout and stderr", func() {
+		expectExec(0, "out\n", "err\n")
+
+		stdOut, stdErr, err := GuestExecOutput(mockConn, testDomainName, "/usr/bin/true", nil, 10, 1024)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdOut).To(Equal("out\n"))
+		Expect(stdErr).To(Equal("err\n"))
+	})
+
+	It("should return the output next to a non-zero exit code", func() {
+		expectExec(2, "out\n", "err\n")
+
+		stdOut, stdErr, err := GuestExecOutput(mockConn, testDomainName, "/usr/bin/false", nil, 10, 1024)
+		Expect(err).To(Equal(ExecExitCode{ExitCode: 2}))
+		Expect(stdOut).To(Equal("out\n"))
+		Expect(stdErr).To(Equal("err\n"))
+	})
+
+	It("should truncate stdout and stderr to the limit", func() {
+		expectExec(0, strings.Repeat("a", 20), strings.Repeat("b", 20))
+
+		stdOut, stdErr, err := GuestExecOutput(mockConn, testDomainName, "/usr/bin/yes", nil, 10, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdOut).To(Equal(strings.Repeat("a", 10)))
+		Expect(stdErr).To(Equal(strings.Repeat("b", 10)))
+	})
+
+	It("should not limit the output without a limit", func() {
+		expectExec(0, strings.Repeat("a", 20), "")
+
+		stdOut, err := GuestExec(mockConn, testDomainName, "/usr/bin/yes", nil, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdOut).To(Equal(strings.Repeat("a", 20)))
+	})
+
+	DescribeTable("should not split a multi-byte character when truncating", func(output string, maxBytes int64, expected string) {
+		Expect(truncateOutput([]byte(output), maxBytes)).To(Equal(expected))
+	},
+		Entry("with a limit inside a two byte character", "aé", int64(2), "a"),
+		Entry("with a limit inside a four byte character", "a😀b", int64(4), "a"),
+		Entry("with a limit after a four byte character", "a😀b", int64(5), "a😀"),
+		Entry("with output shorter than the limit", "é", int64(10), "é"),
+		Entry("with invalid UTF-8, cutting at most three bytes more", "\x80\x80\x80\x80\x80\x80", int64(5), "\x80\x80"),
+	)
+})
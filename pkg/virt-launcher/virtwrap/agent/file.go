@@ -0,0 +1,169 @@
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// guestFileChunkSize is the amount of bytes transferred with a single guest agent command,
+// it keeps the agent messages well below the size limits of qemu-guest-agent.
+const guestFileChunkSize = 64 * 1024
+
+type agentCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type fileOpenArguments struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type fileReadArguments struct {
+	Handle int `json:"handle"`
+	Count  int `json:"count"`
+}
+
+type fileWriteArguments struct {
+	Handle int    `json:"handle"`
+	Buffer string `json:"buf-b64"`
+}
+
+type fileCloseArguments struct {
+	Handle int `json:"handle"`
+}
+
+type fileOpenReturn struct {
+	Return int `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	Buffer string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+type fileWriteReturnData struct {
+	Count int `json:"count"`
+}
+
+func agentCall(virConn cli.Connection, domName string, command string, arguments interface{}, result interface{}) error {
+	cmd, err := json.Marshal(agentCommand{Execute: command, Arguments: arguments})
+	if err != nil {
+		return err
+	}
+	output, err := virConn.QemuAgentCommand(string(cmd), domName)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal([]byte(output), result)
+}
+
+func guestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int, error) {
+	openRes := &fileOpenReturn{}
+	if err := agentCall(virConn, domName, "guest-file-open", fileOpenArguments{Path: path, Mode: mode}, openRes); err != nil {
+		return 0, fmt.Errorf("failed to open guest file %s: %v", path, err)
+	}
+	return openRes.Return, nil
+}
+
+func guestFileClose(virConn cli.Connection, domName string, handle int) error {
+	return agentCall(virConn, domName, "guest-file-close", fileCloseArguments{Handle: handle}, nil)
+}
+
+// GuestFileRead reads up to maxBytes of the file at path inside the guest. The returned flag
+// is true when the file is larger than maxBytes and the content was truncated.
+func GuestFileRead(virConn cli.Connection, domName string, path string, maxBytes int64, timeoutSeconds int32) (content []byte, truncated bool, err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	checkUntil := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	for {
+		// read one byte more than allowed to detect truncation
+		count := guestFileChunkSize
+		if remaining := maxBytes + 1 - int64(len(content)); remaining < int64(count) {
+			count = int(remaining)
+		}
+
+		readRes := &fileReadReturn{}
+		if err := agentCall(virConn, domName, "guest-file-read", fileReadArguments{Handle: handle, Count: count}, readRes); err != nil {
+			return nil, false, fmt.Errorf("failed to read guest file %s: %v", path, err)
+		}
+		data, err := base64.StdEncoding.DecodeString(readRes.Return.Buffer)
+		if err != nil {
+			return nil, false, err
+		}
+		content = append(content, data...)
+
+		if int64(len(content)) > maxBytes {
+			return content[:maxBytes], true, nil
+		}
+		if readRes.Return.EOF || readRes.Return.Count == 0 {
+			return content, false, nil
+		}
+		if time.Now().After(checkUntil) {
+			return nil, false, fmt.Errorf("Timed out reading guest file %s", path)
+		}
+	}
+}
+
+// GuestFileWrite creates or truncates the file at path inside the guest and writes content to it.
+func GuestFileWrite(virConn cli.Connection, domName string, path string, content []byte, timeoutSeconds int32) (err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "w")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	checkUntil := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	for len(content) > 0 {
+		chunk := content
+		if len(chunk) > guestFileChunkSize {
+			chunk = chunk[:guestFileChunkSize]
+		}
+
+		writeRes := &fileWriteReturn{}
+		arguments := fileWriteArguments{Handle: handle, Buffer: base64.StdEncoding.EncodeToString(chunk)}
+		if err := agentCall(virConn, domName, "guest-file-write", arguments, writeRes); err != nil {
+			return fmt.Errorf("failed to write guest file %s: %v", path, err)
+		}
+		if writeRes.Return.Count <= 0 {
+			return fmt.Errorf("failed to write guest file %s: no bytes written", path)
+		}
+		written := writeRes.Return.Count
+		if written > len(chunk) {
+			written = len(chunk)
+		}
+		content = content[written:]
+
+		if len(content) > 0 && time.Now().After(checkUntil) {
+			return fmt.Errorf("Timed out writing guest file %s", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const (
+	testDomainName = "default_testvmi"
+	testFilePath   = "/etc/test.conf"
+	testFileHandle = 1000
+	maxFileBytes   = 1024 * 1024
+)
+
+// fakeGuestFile emulates the guest-file-* commands of the guest agent for a single file
+type fakeGuestFile struct {
+	content  []byte
+	offset   int
+	open     bool
+	commands []string
+}
+
+func (f *fakeGuestFile) command(command string, domainName string) (string, error) {
+	Expect(domainName).To(Equal(testDomainName))
+
+	cmd := struct {
+		Execute   string          `json:"execute"`
+		Arguments json.RawMessage `json:"arguments"`
+	}{}
+	Expect(json.Unmarshal([]byte(command), &cmd)).To(Succeed())
+	f.commands = append(f.commands, cmd.Execute)
+
+	switch cmd.Execute {
+	case "guest-file-open":
+		args := fileOpenArguments{}
+		Expect(json.Unmarshal(cmd.Arguments, &args)).To(Succeed())
+		Expect(args.Path).To(Equal(testFilePath))
+		if args.Mode == "w" {
+			f.content = nil
+		}
+		f.offset = 0
+		f.open = true
+		return fmt.Sprintf(`{"return":%d}`, testFileHandle), nil
+	case "guest-file-read":
+		args := fileReadArguments{}
+		Expect(json.Unmarshal(cmd.Arguments, &args)).To(Succeed())
+		Expect(f.open).To(BeTrue())
+		Expect(args.Handle).To(Equal(testFileHandle))
+		Expect(args.Count).To(BeNumerically(">", 0))
+		Expect(args.Count).To(BeNumerically("<=", guestFileChunkSize))
+
+		end := f.offset + args.Count
+		if end > len(f.content) {
+			end = len(f.content)
+		}
+		data := f.content[f.offset:end]
+		f.offset = end
+		return marshal(fileReadReturn{Return: fileReadReturnData{
+			Count:  len(data),
+			Buffer: base64.StdEncoding.EncodeToString(data),
+			EOF:    f.offset == len(f.content),
+		}}), nil
+	case "guest-file-write":
+		args := fileWriteArguments{}
+		Expect(json.Unmarshal(cmd.Arguments, &args)).To(Succeed())
+		Expect(f.open).To(BeTrue())
+		Expect(args.Handle).To(Equal(testFileHandle))
+
+		data, err := base64.StdEncoding.DecodeString(args.Buffer)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(len(data)).To(BeNumerically("<=", guestFileChunkSize))
+		f.content = append(f.content, data...)
+		return marshal(fileWriteReturn{Return: fileWriteReturnData{Count: len(data)}}), nil
+	case "guest-file-close":
+		args := fileCloseArguments{}
+		Expect(json.Unmarshal(cmd.Arguments, &args)).To(Succeed())
+		Expect(args.Handle).To(Equal(testFileHandle))
+		f.open = false
+		return `{"return":{}}`, nil
+	}
+
+	return "", fmt.Errorf("unexpected command %s", cmd.Execute)
+}
+
+func marshal(obj interface{}) string {
+	data, err := json.Marshal(obj)
+	Expect(err).ToNot(HaveOccurred())
+	return string(data)
+}
+
+// binaryContent returns content of the given size containing every byte value
+func binaryContent(size int) []byte {
+	content := make([]byte, size)
+	for i := range content {
+		content[i] = byte(i % 256)
+	}
+	return content
+}
+
+var _ = Describe("Guest file", func() {
+	var mockConn *cli.MockConnection
+	var guestFile *fakeGuestFile
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		guestFile = &fakeGuestFile{}
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), gomock.Any()).DoAndReturn(guestFile.command).AnyTimes()
+	})
+
+	Context("read", func() {
+		DescribeTable("should honor the size limit", func(size int, expectTruncated bool) {
+			guestFile.content = binaryContent(size)
+
+			content, truncated, err := GuestFileRead(mockConn, testDomainName, testFilePath, maxFileBytes, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(truncated).To(Equal(expectTruncated))
+			if expectTruncated {
+				Expect(content).To(Equal(guestFile.content[:maxFileBytes]))
+			} else {
+				Expect(content).To(Equal(guestFile.content))
+			}
+			Expect(guestFile.open).To(BeFalse())
+		},
+			Entry("with an empty file", 0, false),
+			Entry("with a file smaller than a chunk", 100, false),
+			Entry("with a file of exactly 1MiB", maxFileBytes, false),
+			Entry("with a file one byte larger than 1MiB", maxFileBytes+1, true),
+			Entry("with a file much larger than 1MiB", 3*maxFileBytes, true),
+		)
+
+		It("should not read more than one byte beyond the limit", func() {
+			guestFile.content = binaryContent(3 * maxFileBytes)
+
+			_, truncated, err := GuestFileRead(mockConn, testDomainName, testFilePath, maxFileBytes, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(truncated).To(BeTrue())
+			Expect(guestFile.offset).To(Equal(maxFileBytes + 1))
+		})
+
+		It("should fail on invalid base64 data", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockConn = cli.NewMockConnection(ctrl)
+			mockConn.EXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(command string, domainName string) (string, error) {
+				switch {
+				case containsCommand(command, "guest-file-open"):
+					return fmt.Sprintf(`{"return":%d}`, testFileHandle), nil
+				case containsCommand(command, "guest-file-read"):
+					return `{"return":{"count":3,"buf-b64":"not base64!","eof":true}}`, nil
+				default:
+					return `{"return":{}}`, nil
+				}
+			}).AnyTimes()
+
+			_, _, err := GuestFileRead(mockConn, testDomainName, testFilePath, maxFileBytes, 10)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("write", func() {
+		DescribeTable("should write the content in base64 encoded chunks", func(size int) {
+			content := binaryContent(size)
+
+			Expect(GuestFileWrite(mockConn, testDomainName, testFilePath, content, 10)).To(Succeed())
+			Expect(guestFile.content).To(Equal(content))
+			Expect(guestFile.open).To(BeFalse())
+		},
+			Entry("with a small file", 100),
+			Entry("with a file of several chunks", 3*guestFileChunkSize+1),
+			Entry("with a file of 1MiB", maxFileBytes),
+		)
+
+		It("should fail when the guest agent does not write anything", func() {
+			ctrl := gomock.NewController(GinkgoT())
+			mockConn = cli.NewMockConnection(ctrl)
+			mockConn.EXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(command string, domainName string) (string, error) {
+				switch {
+				case containsCommand(command, "guest-file-open"):
+					return fmt.Sprintf(`{"return":%d}`, testFileHandle), nil
+				case containsCommand(command, "guest-file-write"):
+					return `{"return":{"count":0}}`, nil
+				default:
+					return `{"return":{}}`, nil
+				}
+			}).AnyTimes()
+
+			Expect(GuestFileWrite(mockConn, testDomainName, testFilePath, []byte("data"), 10)).ToNot(Succeed())
+		})
+	})
+
+	It("should round trip binary content through base64", func() {
+		content := binaryContent(2*guestFileChunkSize + 17)
+
+		Expect(GuestFileWrite(mockConn, testDomainName, testFilePath, content, 10)).To(Succeed())
+		read, truncated, err := GuestFileRead(mockConn, testDomainName, testFilePath, maxFileBytes, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(truncated).To(BeFalse())
+		Expect(read).To(Equal(content))
+		Expect(guestFile.commands[0]).To(Equal("guest-file-open"))
+		Expect(guestFile.commands[len(guestFile.commands)-1]).To(Equal("guest-file-close"))
+	})
+})
+
+func containsCommand(command string, execute string) bool {
+	cmd := agentCommand{}
+	return json.Unmarshal([]byte(command), &cmd) == nil && cmd.Execute == execute
+}
@@ -548,8 +548,9 @@ func (l *Launcher) Exec(ctx context.Context, request *cmdv1.ExecRequest) (*cmdv1
 		},
 	}
 
-	stdOut, err := l.domainManager.Exec(request.DomainName, request.Command, request.Args, request.TimeoutSeconds)
+	stdOut, stdErr, err := l.domainManager.Exec(request.DomainName, request.Command, request.Args, request.TimeoutSeconds, request.MaxOutputBytes)
 	resp.StdOut = stdOut
+	resp.StdErr = stdErr
 
 	exitCode := agent.ExecExitCode{}
 	if err != nil && !errors.As(err, &exitCode) {
@@ -577,6 +578,40 @@ func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingReques
 	return resp, nil
 }
 
+func (l *Launcher) GuestFileRead(ctx context.Context, request *cmdv1.GuestFileReadRequest) (*cmdv1.GuestFileReadResponse, error) {
+	resp := &cmdv1.GuestFileReadResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	content, truncated, err := l.domainManager.GuestFileRead(request.DomainName, request.Path, request.MaxBytes, request.TimeoutSeconds)
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		return resp, err
+	}
+	resp.Content = content
+	resp.Truncated = truncated
+
+	return resp, nil
+}
+
+func (l *Launcher) GuestFileWrite(ctx context.Context, request *cmdv1.GuestFileWriteRequest) (*cmdv1.Response, error) {
+	resp := &cmdv1.Response{
+		Success: true,
+	}
+
+	err := l.domainManager.GuestFileWrite(request.DomainName, request.Path, request.Content, request.TimeoutSeconds)
+	if err != nil {
+		resp.Success = false
+		resp.Message = err.Error()
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
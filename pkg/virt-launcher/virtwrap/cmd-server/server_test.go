@@ -334,7 +334,9 @@ var _ = Describe("Virt remote commands", func() {
 				testExecErr              = errors.New("exec error")
 				testGuestPingErr         = errors.New("guest ping error")
 				testStdOut               = "stdOut"
+				testStdErr               = "stdErr"
 				testTimeoutSeconds int32 = 10
+				testMaxOutputBytes int64 = 1024
 
 				expectExec = func() *gomock.Call {
 					return domainManager.EXPECT().Exec(
@@ -342,6 +344,7 @@ var _ = Describe("Virt remote commands", func() {
 						testCommand,
 						testArgs,
 						testTimeoutSeconds,
+						testMaxOutputBytes,
 					)
 				}
 				execRequest = func() *cmdv1.ExecRequest {
@@ -350,6 +353,7 @@ var _ = Describe("Virt remote commands", func() {
 						Command:        testCommand,
 						Args:           testArgs,
 						TimeoutSeconds: testTimeoutSeconds,
+						MaxOutputBytes: testMaxOutputBytes,
 					}
 				}
 				expectGuestPing = func() *gomock.Call {
@@ -376,37 +380,44 @@ var _ = Describe("Virt remote commands", func() {
 				server.Exec(context.TODO(), execRequest())
 			})
 			It("returns exec errors in the response", func() {
-				expectExec().Times(1).Return("", testExecErr)
+				expectExec().Times(1).Return("", "", testExecErr)
 				resp, err := server.Exec(context.TODO(), execRequest())
 				Expect(err).To(HaveOccurred())
 				Expect(resp.Response.Success).To(BeFalse())
 				Expect(resp.Response.Message).To(Equal(testExecErr.Error()))
 			})
 			It("does not return exit code errors", func() {
-				expectExec().Times(1).Return("", agent.ExecExitCode{ExitCode: 1})
+				expectExec().Times(1).Return("", "", agent.ExecExitCode{ExitCode: 1})
 				_, err := server.Exec(context.TODO(), execRequest())
 				Expect(err).ToNot(HaveOccurred())
 			})
 			It("returns non-zero exit code and stdOut if possible", func() {
-				expectExec().Times(1).Return(testStdOut, agent.ExecExitCode{ExitCode: 1})
+				expectExec().Times(1).Return(testStdOut, "", agent.ExecExitCode{ExitCode: 1})
 				resp, err := server.Exec(context.TODO(), execRequest())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.ExitCode).To(BeEquivalentTo(1))
 				Expect(resp.StdOut).To(Equal(testStdOut))
 			})
 			It("returns zero exit code and stdOut if possible", func() {
-				expectExec().Times(1).Return(testStdOut, nil)
+				expectExec().Times(1).Return(testStdOut, "", nil)
 				resp, err := server.Exec(context.TODO(), execRequest())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.ExitCode).To(BeEquivalentTo(0))
 				Expect(resp.StdOut).To(Equal(testStdOut))
 			})
+			It("returns stdErr next to stdOut", func() {
+				expectExec().Times(1).Return(testStdOut, testStdErr, agent.ExecExitCode{ExitCode: 1})
+				resp, err := server.Exec(context.TODO(), execRequest())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.StdOut).To(Equal(testStdOut))
+				Expect(resp.StdErr).To(Equal(testStdErr))
+			})
 			It("returns true success on execution (including failed executions)", func() {
 				// the success field just indicates the request was successful.
 				// A non-zero exit code does not mean the execution failed, just the command.
 				// An example of a failed execution would be when the guest-agent is not available,
 				// then success should not be true.
-				expectExec().Times(1).Return(testStdOut, agent.ExecExitCode{ExitCode: 1})
+				expectExec().Times(1).Return(testStdOut, "", agent.ExecExitCode{ExitCode: 1})
 				resp, err := server.Exec(context.TODO(), execRequest())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.Response.Success).To(BeTrue())
@@ -431,6 +442,75 @@ var _ = Describe("Virt remote commands", func() {
 
 		})
 
+		Context("guest files", func() {
+			const (
+				testDomainName           = "test"
+				testPath                 = "/etc/hostname"
+				testMaxBytes       int64 = 1024
+				testTimeoutSeconds int32 = 10
+			)
+
+			var server cmdv1.CmdServer
+
+			BeforeEach(func() {
+				server = &Launcher{
+					domainManager: domainManager,
+				}
+			})
+
+			It("should return the content read from the guest", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, testPath, testMaxBytes, testTimeoutSeconds).Return([]byte("content"), true, nil)
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileReadRequest{
+					DomainName:     testDomainName,
+					Path:           testPath,
+					MaxBytes:       testMaxBytes,
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.Content).To(Equal([]byte("content")))
+				Expect(resp.Truncated).To(BeTrue())
+			})
+
+			It("returns read errors in the response", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, testPath, testMaxBytes, testTimeoutSeconds).Return(nil, false, errors.New("read error"))
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileReadRequest{
+					DomainName:     testDomainName,
+					Path:           testPath,
+					MaxBytes:       testMaxBytes,
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal("read error"))
+			})
+
+			It("should write the content to the guest", func() {
+				domainManager.EXPECT().GuestFileWrite(testDomainName, testPath, []byte("content"), testTimeoutSeconds).Return(nil)
+				resp, err := server.GuestFileWrite(context.TODO(), &cmdv1.GuestFileWriteRequest{
+					DomainName:     testDomainName,
+					Path:           testPath,
+					Content:        []byte("content"),
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Success).To(BeTrue())
+			})
+
+			It("returns write errors in the response", func() {
+				domainManager.EXPECT().GuestFileWrite(testDomainName, testPath, []byte("content"), testTimeoutSeconds).Return(errors.New("write error"))
+				resp, err := server.GuestFileWrite(context.TODO(), &cmdv1.GuestFileWriteRequest{
+					DomainName:     testDomainName,
+					Path:           testPath,
+					Content:        []byte("content"),
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Success).To(BeFalse())
+				Expect(resp.Message).To(Equal("write error"))
+			})
+		})
+
 	})
 
 	Describe("Version mismatch", func() {
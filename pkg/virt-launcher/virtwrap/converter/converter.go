@@ -65,6 +65,8 @@ import (
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -133,6 +135,10 @@ type ConverterContext struct {
 	FreePageReporting     bool
 	BochsForEFIGuests     bool
 	SerialConsoleLog      bool
+	// DomainAttachmentByInterfaceName holds the domain attachment type of interfaces bound by network binding plugins.
+	DomainAttachmentByInterfaceName map[string]string
+	// VhostUserSockets holds the vhost-user sockets exposed by the CNI, by interface name.
+	VhostUserSockets map[string]vhostuser.Socket
 }
 
 func contains(volumes []string, name string) bool {
@@ -1518,8 +1524,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and vhost-user require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || hasVhostUserDomainAttachment(c.DomainAttachmentByInterfaceName) ||
+		netvmispec.VhostUserInterfaceExist(vmi.Spec.Domain.Devices.Interfaces) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -47,6 +47,8 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	"kubevirt.io/kubevirt/pkg/network/namescheme"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
@@ -1614,6 +1616,105 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
 			Expect(domain.Spec.Devices.HostDevices).To(Equal([]api.HostDevice{{Type: identifyDevice}}))
 		})
+		Context("network binding plugin with domain attachment", func() {
+			const bindingNetworkName = "net1"
+
+			BeforeEach(func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Networks = []v1.Network{{
+					Name:          bindingNetworkName,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red"}},
+				}}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:    bindingNetworkName,
+					Binding: &v1.PluginBinding{Name: "plugin"},
+				}}
+			})
+
+			It("should not create an interface when no domain attachment is specified", func() {
+				domain := vmiToDomain(vmi, c)
+				Expect(domain).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
+			})
+
+			DescribeTable("should create an ethernet interface", func(domainAttachment v1.DomainAttachmentType) {
+				c.DomainAttachmentByInterfaceName = map[string]string{bindingNetworkName: string(domainAttachment)}
+				domain := vmiToDomain(vmi, c)
+				Expect(domain).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+				Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("ethernet"))
+			},
+				Entry("for tap", v1.Tap),
+				Entry("for managedTap", v1.ManagedTap),
+			)
+
+			It("should create a vhostuser interface with shared memory", func() {
+				c.DomainAttachmentByInterfaceName = map[string]string{bindingNetworkName: string(v1.VhostUser)}
+				domain := vmiToDomain(vmi, c)
+				Expect(domain).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+				iface := domain.Spec.Devices.Interfaces[0]
+				Expect(iface.Type).To(Equal("vhostuser"))
+				Expect(iface.Source.Type).To(Equal("unix"))
+				Expect(iface.Source.Mode).To(Equal("server"))
+				Expect(iface.Source.Path).To(Equal(vhostuser.SocketPath(namescheme.HashedPodInterfaceName(vmi.Spec.Networks[0]))))
+				Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+				Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			})
+		})
+
+		Context("vhost-user interface", func() {
+			const vhostUserNetworkName = "net1"
+
+			BeforeEach(func() {
+				v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+				vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+				vmi.Spec.Networks = []v1.Network{{
+					Name:          vhostUserNetworkName,
+					NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "ovs-dpdk"}},
+				}}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   vhostUserNetworkName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{VhostUser: &v1.InterfaceVhostUser{}},
+				}}
+			})
+
+			It("should create a vhostuser interface with the default socket and shared memory", func() {
+				domain := vmiToDomain(vmi, c)
+				Expect(domain).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+				iface := domain.Spec.Devices.Interfaces[0]
+				Expect(iface.Type).To(Equal("vhostuser"))
+				Expect(iface.Source).To(Equal(api.InterfaceSource{
+					Type: "unix",
+					Path: vhostuser.SocketPath(namescheme.HashedPodInterfaceName(vmi.Spec.Networks[0])),
+					Mode: vhostuser.ModeServer,
+				}))
+				Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+				Expect(domain.Spec.MemoryBacking.HugePages).ToNot(BeNil())
+				Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			})
+
+			It("should create a vhostuser interface with the socket exposed by the CNI", func() {
+				c.VhostUserSockets = map[string]vhostuser.Socket{
+					vhostUserNetworkName: {Path: "/var/run/kubevirt-vhostuser/ovs.sock", Mode: vhostuser.ModeClient},
+				}
+				domain := vmiToDomain(vmi, c)
+				Expect(domain).ToNot(BeNil())
+				Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+				Expect(domain.Spec.Devices.Interfaces[0].Source).To(Equal(api.InterfaceSource{
+					Type: "unix",
+					Path: "/var/run/kubevirt-vhostuser/ovs.sock",
+					Mode: vhostuser.ModeClient,
+				}))
+			})
+
+			It("should fail when the network is not a Multus network", func() {
+				vmi.Spec.Networks[0].NetworkSource = v1.NetworkSource{Pod: &v1.PodNetwork{}}
+				domain := &api.Domain{}
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).ToNot(Succeed())
+			})
+		})
 	})
 
 	Context("graphics and video device", func() {
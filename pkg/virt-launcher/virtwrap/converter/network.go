@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
 
 	"kubevirt.io/kubevirt/pkg/network/dns"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
@@ -52,7 +53,9 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			return nil, fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if iface.Binding != nil || iface.SRIOV != nil || iface.Slirp != nil {
+		domainAttachment := c.DomainAttachmentByInterfaceName[iface.Name]
+		// Binding plugins without a domain attachment type configure the domain through their sidecar.
+		if iface.Binding != nil && domainAttachment == "" || iface.SRIOV != nil || iface.Slirp != nil {
 			continue
 		}
 
@@ -81,7 +84,7 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
-		if iface.Bridge != nil || iface.Masquerade != nil {
+		if iface.Bridge != nil || iface.Masquerade != nil || isTapDomainAttachment(domainAttachment) {
 			// TODO:(ihar) consider abstracting interface type conversion /
 			// detection into drivers
 
@@ -93,6 +96,19 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			} else {
 				domainIface.Rom = &api.Rom{Enabled: "no"}
 			}
+		} else if domainAttachment == string(v1.VhostUser) {
+			// QEMU creates the socket, the data plane of the binding connects to it.
+			setVhostUserInterface(&domainIface, iface, vhostuser.DefaultSocket(*net))
+		} else if iface.VhostUser != nil {
+			if net.Multus == nil {
+				return nil, fmt.Errorf("vhost-user interface %s requires Multus meta-cni", iface.Name)
+			}
+
+			socket, exists := c.VhostUserSockets[iface.Name]
+			if !exists {
+				socket = vhostuser.DefaultSocket(*net)
+			}
+			setVhostUserInterface(&domainIface, iface, socket)
 		} else if iface.Macvtap != nil {
 			if net.Multus == nil {
 				return nil, fmt.Errorf("macvtap interface %s requires Multus meta-cni", iface.Name)
@@ -123,6 +139,42 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 	return domainInterfaces, nil
 }
 
+func isTapDomainAttachment(domainAttachment string) bool {
+	return domainAttachment == string(v1.Tap) || domainAttachment == string(v1.ManagedTap)
+}
+
+// setVhostUserInterface configures the domain interface to use the given vhost-user socket.
+// https://libvirt.org/formatdomain.html#vhost-user-interface
+func setVhostUserInterface(domainIface *api.Interface, iface v1.Interface, socket vhostuser.Socket) {
+	domainIface.Type = "vhostuser"
+	domainIface.Source = api.InterfaceSource{
+		Type: "unix",
+		Path: socket.Path,
+		Mode: socket.Mode,
+	}
+	if domainIface.Driver != nil {
+		// The vhost-net backend does not apply to vhost-user interfaces.
+		domainIface.Driver.Name = ""
+	}
+	if iface.MacAddress != "" {
+		domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+	}
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	} else {
+		domainIface.Rom = &api.Rom{Enabled: "no"}
+	}
+}
+
+func hasVhostUserDomainAttachment(domainAttachmentByInterfaceName map[string]string) bool {
+	for _, domainAttachment := range domainAttachmentByInterfaceName {
+		if domainAttachment == string(v1.VhostUser) {
+			return true
+		}
+	}
+	return false
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Model != "" {
 		return iface.Model
@@ -255,15 +255,16 @@ func (_mr *_MockDomainManagerRecorder) GetGuestOSInfo() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestOSInfo")
 }
 
-func (_m *MockDomainManager) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (string, error) {
-	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3)
+func (_m *MockDomainManager) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32, _param4 int64) (string, string, error) {
+	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3, _param4)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-func (_mr *_MockDomainManagerRecorder) Exec(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
-	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1, arg2, arg3)
+func (_mr *_MockDomainManagerRecorder) Exec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1, arg2, arg3, arg4)
 }
 
 func (_m *MockDomainManager) GuestPing(_param0 string) error {
@@ -276,6 +277,28 @@ func (_mr *_MockDomainManagerRecorder) GuestPing(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestPing", arg0)
 }
 
+func (_m *MockDomainManager) GuestFileRead(_param0 string, _param1 string, _param2 int64, _param3 int32) ([]byte, bool, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", _param0, _param1, _param2, _param3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileRead(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockDomainManager) GuestFileWrite(_param0 string, _param1 string, _param2 []byte, _param3 int32) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", _param0, _param1, _param2, _param3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileWrite(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2, arg3)
+}
+
 func (_m *MockDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
 	ret := _m.ctrl.Call(_m, "MemoryDump", vmi, dumpPath)
 	ret0, _ := ret[0].(error)
@@ -362,14 +362,24 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 	// live migration. It also collects all generated disks suck as cloudinit, secrets, ServiceAccount and ConfigMaps
 	// to make sure that these are being copied during migration.
 	// Persistent volume claims without ReadWriteMany access mode
-	// should be filtered out earlier in the process
+	// should be filtered out earlier in the process, unless they are
+	// moved to a new claim as part of a storage migration.
 
 	disks := &migrationDisks{
 		shared:    make(map[string]bool),
 		generated: make(map[string]bool),
 	}
+	migratedVolumes := make(map[string]bool)
+	if migrations.IsStorageMigration(vmi) {
+		for _, migratedVolume := range vmi.Status.MigrationState.MigratedVolumes {
+			migratedVolumes[migratedVolume.VolumeName] = true
+		}
+	}
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
+		if migratedVolumes[volume.Name] {
+			continue
+		}
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil ||
 			(volSrc.HostDisk != nil && *volSrc.HostDisk.Shared) {
 			disks.shared[volume.Name] = true
@@ -817,7 +827,7 @@ func (l *LibvirtDomainManager) asyncMigrationAbort(vmi *v1.VirtualMachineInstanc
 }
 
 func isBlockMigration(vmi *v1.VirtualMachineInstance) bool {
-	return vmi.Status.MigrationMethod == v1.BlockMigration
+	return migrations.IsBlockMigration(vmi)
 }
 
 func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, virtShareDir string, domSpec *api.DomainSpec) (*libvirt.DomainMigrateParameters, error) {
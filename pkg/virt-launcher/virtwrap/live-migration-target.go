@@ -20,10 +20,15 @@
 package virtwrap
 
 import (
+	"encoding/xml"
 	"fmt"
 	"net"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
+
+	"libvirt.org/go/libvirt"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -31,6 +36,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -38,11 +44,74 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 )
 
+const (
+	linkStateDown = "down"
+	linkStateUp   = "up"
+)
+
+// podNetworkLinkDownDuration is how long the link is kept down, for the guest to notice it
+var podNetworkLinkDownDuration = time.Second
+
 func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineInstance) error {
 	if err := l.setGuestTime(vmi); err != nil {
 		return err
 	}
 
+	if err := l.renewPodNetworkLease(vmi); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// renewPodNetworkLease makes the guest request a new DHCP lease for its interface bound with bridge
+// to the pod network, by cycling the interface link.
+// The migration target pod is assigned a different address than the source pod, which the DHCP server
+// of the target pod offers to the guest.
+func (l *LibvirtDomainManager) renewPodNetworkLease(vmi *v1.VirtualMachineInstance) error {
+	podNetwork := netvmispec.LookupPodNetwork(vmi.Spec.Networks)
+	if podNetwork == nil {
+		return nil
+	}
+	podIface := netvmispec.LookupInterfaceByName(vmi.Spec.Domain.Devices.Interfaces, podNetwork.Name)
+	if podIface == nil || podIface.Bridge == nil {
+		return nil
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	const errMsgPrefix = "failed to renew the pod network lease"
+
+	dom, err := l.virConn.LookupDomainByName(api.VMINamespaceKeyFunc(vmi))
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	defer dom.Free()
+
+	spec, err := getDomainSpec(dom)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	domainIface := lookupDomainInterfaceByName(spec.Devices.Interfaces, podNetwork.Name)
+	if domainIface == nil {
+		return fmt.Errorf("%s: domain interface %s not found", errMsgPrefix, podNetwork.Name)
+	}
+
+	log.Log.Object(vmi).Infof("Cycling the link of interface %s to renew its DHCP lease", podNetwork.Name)
+	for _, state := range []string{linkStateDown, linkStateUp} {
+		if state == linkStateUp {
+			time.Sleep(podNetworkLinkDownDuration)
+		}
+		domainIface.LinkState = &api.LinkState{State: state}
+		ifaceXML, err := xml.Marshal(domainIface)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+		if err := dom.UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE); err != nil {
+			return fmt.Errorf("%s: failed to set the link %s: %v", errMsgPrefix, state, err)
+		}
+	}
 	return nil
 }
 
@@ -76,7 +145,7 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 		return fmt.Errorf("conversion failed: %v", err)
 	}
 
-	dom, err := l.preStartHook(vmi, domain, true)
+	dom, err := l.preStartHook(vmi, domain, true, c.DomainAttachmentByInterfaceName)
 	if err != nil {
 		return fmt.Errorf("pre-start pod-setup failed: %v", err)
 	}
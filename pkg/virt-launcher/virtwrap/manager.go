@@ -75,6 +75,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/ignition"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netsriov "kubevirt.io/kubevirt/pkg/network/sriov"
+	"kubevirt.io/kubevirt/pkg/network/vhostuser"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
@@ -130,8 +131,10 @@ type DomainManager interface {
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
-	Exec(string, string, []string, int32) (string, error)
+	Exec(string, string, []string, int32, int64) (string, string, error)
 	GuestPing(string) error
+	GuestFileRead(string, string, int64, int32) ([]byte, bool, error)
+	GuestFileWrite(string, string, []byte, int32) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
@@ -541,8 +544,16 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 	return nil
 }
 
-func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32) (string, error) {
-	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
+func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, timeoutSeconds int32, maxOutputBytes int64) (string, string, error) {
+	return agent.GuestExecOutput(l.virConn, domainName, command, args, timeoutSeconds, maxOutputBytes)
+}
+
+func (l *LibvirtDomainManager) GuestFileRead(domainName, path string, maxBytes int64, timeoutSeconds int32) ([]byte, bool, error) {
+	return agent.GuestFileRead(l.virConn, domainName, path, maxBytes, timeoutSeconds)
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(domainName, path string, content []byte, timeoutSeconds int32) error {
+	return agent.GuestFileWrite(l.virConn, domainName, path, content, timeoutSeconds)
 }
 
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
@@ -665,7 +676,7 @@ func (l *LibvirtDomainManager) generateCloudInitEmptyISO(vmi *v1.VirtualMachineI
 //
 // The Domain.Spec can be alterned in this function and any changes
 // made to the domain will get set in libvirt after this function exits.
-func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, domain *api.Domain, generateEmptyIsos bool) (*api.Domain, error) {
+func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, domain *api.Domain, generateEmptyIsos bool, domainAttachmentByInterfaceName map[string]string) (*api.Domain, error) {
 	logger := log.Log.Object(vmi)
 
 	logger.Info("Executing PreStartHook on VMI pod environment")
@@ -721,7 +732,9 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return iface.State != v1.InterfaceStateAbsent
 	})
 	nonAbsentNets := netvmispec.FilterNetworksByInterfaces(vmi.Spec.Networks, nonAbsentIfaces)
-	err = netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}).SetupPodNetworkPhase2(domain, nonAbsentNets)
+	err = netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{},
+		netsetup.WithDomainAttachmentByInterfaceName(domainAttachmentByInterfaceName),
+	).SetupPodNetworkPhase2(domain, nonAbsentNets)
 	if err != nil {
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
@@ -965,6 +978,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			c.BochsForEFIGuests = options.GetClusterConfig().GetBochsDisplayForEFIGuests()
 			c.SerialConsoleLog = isSerialConsoleLogEnabled(options.GetClusterConfig().GetSerialConsoleLogDisabled(), vmi)
 		}
+		c.DomainAttachmentByInterfaceName = options.GetInterfaceDomainAttachment()
 	}
 	c.DisksInfo = l.disksInfo
 
@@ -988,6 +1002,16 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 			return nil, err
 		}
 		c.GPUHostDevices = gpuHostDevices
+
+		if netvmispec.VhostUserInterfaceExist(vmi.Spec.Domain.Devices.Interfaces) {
+			vhostUserSockets, err := vhostuser.ReadNetworkSocketMapFile(
+				filepath.Join(vhostuser.SocketMapMountPath, vhostuser.SocketMapVolumePath),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the vhost-user socket map: %v", err)
+			}
+			c.VhostUserSockets = vhostUserSockets
+		}
 	}
 
 	return c, nil
@@ -1034,7 +1058,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 	if err != nil {
 		// We need the domain but it does not exist, so create it
 		if domainerrors.IsNotFound(err) {
-			domain, err = l.preStartHook(vmi, domain, false)
+			domain, err = l.preStartHook(vmi, domain, false, c.DomainAttachmentByInterfaceName)
 			if err != nil {
 				logger.Reason(err).Error("pre start setup for VirtualMachineInstance failed.")
 				return nil, err
@@ -1167,7 +1191,9 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 
 	if vmi.IsRunning() {
 		networkInterfaceManager := newVirtIOInterfaceManager(
-			dom, netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}))
+			dom, netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{},
+				netsetup.WithDomainAttachmentByInterfaceName(c.DomainAttachmentByInterfaceName),
+			))
 		if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}, domain); err != nil {
 			return nil, err
 		}
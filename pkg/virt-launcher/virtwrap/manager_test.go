@@ -1923,6 +1923,114 @@ var _ = Describe("Manager", func() {
 			copyDisks := getDiskTargetsForMigration(mockDomain, vmi)
 			Expect(copyDisks).Should(ConsistOf("vdb", "vdd"))
 		})
+		It("should copy volumes that are moved to a new claim", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testblock",
+						}},
+					},
+				},
+			}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigratedVolumes: []v1.StorageMigratedVolume{
+					{VolumeName: "myvolume", DestinationClaim: "newblock"},
+				},
+			}
+
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(embedMigrationDomain, nil)
+
+			copyDisks := getDiskTargetsForMigration(mockDomain, vmi)
+			Expect(copyDisks).Should(ContainElement("vda"))
+		})
+		Context("renewing the pod network lease on the target", func() {
+			var manager *LibvirtDomainManager
+			var domainSpec *api.DomainSpec
+
+			BeforeEach(func() {
+				previousLinkDownDuration := podNetworkLinkDownDuration
+				podNetworkLinkDownDuration = 0
+				DeferCleanup(func() {
+					podNetworkLinkDownDuration = previousLinkDownDuration
+				})
+
+				manager = &LibvirtDomainManager{
+					virConn:       mockConn,
+					virtShareDir:  testVirtShareDir,
+					metadataCache: metadataCache,
+				}
+				domainSpec = &api.DomainSpec{
+					Devices: api.Devices{
+						Interfaces: []api.Interface{{
+							Type:   "ethernet",
+							Alias:  api.NewUserDefinedAlias("default"),
+							MAC:    &api.MAC{MAC: "de:ad:00:00:be:af"},
+							Target: &api.InterfaceTarget{Device: "tap0", Managed: "no"},
+						}},
+					},
+				}
+			})
+
+			It("should cycle the link of the interface bound with bridge to the pod network", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+				mockConn.EXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockDomain, nil)
+				domainSpecXML, err := xml.Marshal(domainSpec)
+				Expect(err).ToNot(HaveOccurred())
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainSpecXML), nil)
+
+				var linkStates []string
+				for _, state := range []string{"down", "up"} {
+					state := state
+					iface := domainSpec.Devices.Interfaces[0]
+					iface.LinkState = &api.LinkState{State: state}
+					ifaceXML, err := xml.Marshal(iface)
+					Expect(err).ToNot(HaveOccurred())
+					mockDomain.EXPECT().UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE).DoAndReturn(
+						func(_ string, _ libvirt.DomainDeviceModifyFlags) error {
+							linkStates = append(linkStates, state)
+							return nil
+						})
+				}
+				mockDomain.EXPECT().Free()
+
+				Expect(manager.renewPodNetworkLease(vmi)).To(Succeed())
+				Expect(linkStates).To(Equal([]string{"down", "up"}))
+			})
+
+			DescribeTable("should not touch the domain", func(iface v1.Interface, network v1.Network) {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+				vmi.Spec.Networks = []v1.Network{network}
+
+				Expect(manager.renewPodNetworkLease(vmi)).To(Succeed())
+			},
+				Entry("when the pod network is bound with masquerade",
+					*v1.DefaultMasqueradeNetworkInterface(), *v1.DefaultPodNetwork()),
+				Entry("when a secondary network is bound with bridge",
+					*v1.DefaultBridgeNetworkInterface(),
+					v1.Network{Name: "default", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad"}}}),
+			)
+
+			It("should fail when the domain has no interface for the pod network", func() {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+				mockConn.EXPECT().LookupDomainByName(api.VMINamespaceKeyFunc(vmi)).Return(mockDomain, nil)
+				domainSpecXML, err := xml.Marshal(&api.DomainSpec{})
+				Expect(err).ToNot(HaveOccurred())
+				mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainSpecXML), nil)
+				mockDomain.EXPECT().Free()
+
+				Expect(manager.renewPodNetworkLease(vmi)).To(MatchError(ContainSubstring("domain interface default not found")))
+			})
+		})
 		AfterEach(func() {
 			ip.GetLoopbackAddress = funcPreviousValue
 		})
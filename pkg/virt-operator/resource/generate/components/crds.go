@@ -28,10 +28,14 @@ import (
 
 	"kubevirt.io/api/instancetype"
 
+	"kubevirt.io/api/ksm"
+
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 
+	"kubevirt.io/api/nodecapabilities"
+
 	schedulingv1 "k8s.io/api/scheduling/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +47,8 @@ import (
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
 	poolv1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
 )
@@ -62,10 +68,16 @@ var (
 	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
 	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEDISRUPTIONBUDGET   = "virtualmachinedisruptionbudgets." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTSCHEDULE   = "virtualmachinesnapshotschedules." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEGROUPSNAPSHOT      = "virtualmachinegroupsnapshots." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEGROUPRESTORE       = "virtualmachinegrouprestores." + snapshotv1.SchemeGroupVersion.Group
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
+	KSMPOLICY                        = "ksmpolicies." + ksmv1alpha1.KSMPolicyKind.Group
+	NODECAPABILITIES                 = "nodecapabilities." + nodecapabilitiesv1alpha1.NodeCapabilitiesKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clonev1alpha1.VirtualMachineCloneKind.Group
 	PreserveUnknownFieldsFalse       = false
 )
@@ -445,6 +457,53 @@ func NewVirtualMachinePoolCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineDisruptionBudgetCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEDISRUPTIONBUDGET
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: poolv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    poolv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinedisruptionbudgets",
+			Singular:   "virtualmachinedisruptionbudget",
+			Kind:       poolv1.VirtualMachineDisruptionBudgetKind,
+			ShortNames: []string{"vmdb", "vmdbs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "Expected", Type: "integer", JSONPath: ".status.expectedVirtualMachines",
+				Description: "Number of covered VirtualMachines which are expected to run"},
+			{Name: "Healthy", Type: "integer", JSONPath: ".status.currentHealthy",
+				Description: "Number of covered VirtualMachineInstances which are running and ready"},
+			{Name: "Allowed Disruptions", Type: "integer", JSONPath: ".status.disruptionsAllowed",
+				Description: "Number of covered VirtualMachineInstances which can currently be disrupted"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineSnapshotCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -526,6 +585,128 @@ func NewVirtualMachineSnapshotContentCrd() (*extv1.CustomResourceDefinition, err
 	return crd, nil
 }
 
+func NewVirtualMachineGroupSnapshotCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEGROUPSNAPSHOT
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinegroupsnapshots",
+			Singular:   "virtualmachinegroupsnapshot",
+			Kind:       "VirtualMachineGroupSnapshot",
+			ShortNames: []string{"vmgroupsnapshot", "vmgroupsnapshots"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Phase", Type: "string", JSONPath: phaseJSONPath},
+		{Name: "ReadyToUse", Type: "boolean", JSONPath: ".status.readyToUse"},
+		{Name: "CreationTime", Type: "date", JSONPath: ".status.creationTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineGroupRestoreCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEGROUPRESTORE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinegrouprestores",
+			Singular:   "virtualmachinegrouprestore",
+			Kind:       "VirtualMachineGroupRestore",
+			ShortNames: []string{"vmgrouprestore", "vmgrouprestores"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "GroupSnapshot", Type: "string", JSONPath: ".spec.virtualMachineGroupSnapshotName"},
+		{Name: "Complete", Type: "boolean", JSONPath: ".status.complete"},
+		{Name: "RestoreTime", Type: "date", JSONPath: ".status.restoreTime"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINESNAPSHOTSCHEDULE
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: snapshotv1.SchemeGroupVersion.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	}, []extv1.CustomResourceColumnDefinition{
+		{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+		{Name: "Suspend", Type: "boolean", JSONPath: ".spec.suspend"},
+		{Name: "LastSchedule", Type: "date", JSONPath: ".status.lastScheduleTime"},
+		{Name: "LastSuccess", Type: "date", JSONPath: ".status.lastSuccessTime"},
+		{Name: "Error", Type: "string", JSONPath: errorMessageJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineRestoreCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -809,6 +990,90 @@ func NewMigrationPolicyCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewKSMPolicyCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = KSMPOLICY
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: ksmv1alpha1.KSMPolicyKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    ksmv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:   ksm.ResourceKSMPolicies,
+			Singular: "ksmpolicy",
+			Kind:     ksmv1alpha1.KSMPolicyKind.Kind,
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "Priority", Type: "integer", JSONPath: ".spec.priority",
+				Description: "Priority of the policy when several policies select the same node"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		}, &extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewNodeCapabilitiesCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = NODECAPABILITIES
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: nodecapabilitiesv1alpha1.NodeCapabilitiesKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    nodecapabilitiesv1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     nodecapabilities.ResourceNodeCapabilities,
+			Singular:   "nodecapabilities",
+			ShortNames: []string{"nodecaps"},
+			Kind:       nodecapabilitiesv1alpha1.NodeCapabilitiesKind.Kind,
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "Vendor", Type: "string", JSONPath: ".status.cpu.vendor",
+				Description: "Vendor of the host CPU"},
+			{Name: "Host-Model", Type: "string", JSONPath: ".status.cpu.hostModel",
+				Description: "CPU model used for guests with the host-model CPU mode"},
+			{Name: "Emulator", Type: "string", JSONPath: ".status.emulatorVersion",
+				Description: "Version of the emulator"},
+			{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -24,7 +24,13 @@ var _ = Describe("CRDs", func() {
 		Entry("for KV", NewKubeVirtCrd),
 		Entry("for VMSNAPSHOT", NewVirtualMachineSnapshotCrd),
 		Entry("for VMSNAPSHOTCONTENT", NewVirtualMachineSnapshotContentCrd),
+		Entry("for VMSNAPSHOTSCHEDULE", NewVirtualMachineSnapshotScheduleCrd),
+		Entry("for VMGROUPSNAPSHOT", NewVirtualMachineGroupSnapshotCrd),
+		Entry("for VMGROUPRESTORE", NewVirtualMachineGroupRestoreCrd),
 		Entry("for VMPOOL", NewVirtualMachinePoolCrd),
+		Entry("for VMDISRUPTIONBUDGET", NewVirtualMachineDisruptionBudgetCrd),
+		Entry("for KSMPOLICY", NewKSMPolicyCrd),
+		Entry("for NODECAPABILITIES", NewNodeCapabilitiesCrd),
 	)
 
 	It("DataVolumeTemplates should have nullable a XPreserveUnknownFields on metadata", func() {
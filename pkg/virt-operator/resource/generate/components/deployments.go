@@ -388,7 +388,7 @@ func NewApiServerDeployment(namespace, repository, imagePrefix, version, product
 	return deployment, nil
 }
 
-func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersion, launcherVersion, exportServerVersion, productName, productVersion, productComponent, image, launcherImage, exporterImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, verbosity string, extraEnv map[string]string) (*appsv1.Deployment, error) {
+func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersion, launcherVersion, exportServerVersion, gsVersion, productName, productVersion, productComponent, image, launcherImage, exporterImage, gsImage string, pullPolicy corev1.PullPolicy, imagePullSecrets []corev1.LocalObjectReference, verbosity string, extraEnv map[string]string) (*appsv1.Deployment, error) {
 	podAntiAffinity := newPodAntiAffinity(kubevirtLabelKey, kubernetesHostnameTopologyKey, metav1.LabelSelectorOpIn, []string{VirtControllerName})
 	deploymentName := VirtControllerName
 	imageName := fmt.Sprintf("%s%s", imagePrefix, deploymentName)
@@ -404,6 +404,9 @@ func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersi
 	if exporterImage == "" {
 		exporterImage = fmt.Sprintf("%s/%s%s%s", repository, imagePrefix, "virt-exportserver", AddVersionSeparatorPrefix(exportServerVersion))
 	}
+	if gsImage == "" {
+		gsImage = fmt.Sprintf("%s/%s%s%s", repository, imagePrefix, "libguestfs-tools", AddVersionSeparatorPrefix(gsVersion))
+	}
 
 	pod := &deployment.Spec.Template.Spec
 	pod.ServiceAccountName = ControllerServiceAccountName
@@ -421,6 +424,8 @@ func NewControllerDeployment(namespace, repository, imagePrefix, controllerVersi
 		launcherImage,
 		"--exporter-image",
 		exporterImage,
+		"--libguestfs-image",
+		gsImage,
 		portName,
 		"8443",
 		"-v",
@@ -1009,7 +1009,8 @@ var CRDsValidation map[string]string = map[string]string{
                 nodeLabelSelector:
                   description: NodeLabelSelector is a selector that filters in which
                     nodes the KSM will be enabled. Empty NodeLabelSelector will enable
-                    ksm for every node.
+                    ksm for every node. A KSMPolicy which selects a node and sets
+                    enabled takes precedence over this selector.
                   properties:
                     matchExpressions:
                       description: matchExpressions is a list of label selector requirements.
@@ -1173,6 +1174,12 @@ var CRDsValidation map[string]string = map[string]string{
                     layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: EvacuationOrder defines whether the VMIs with the most
+                    memory are migrated first or last when a node is drained. The
+                    priority class of the VMIs takes precedence. By default, no memory
+                    based order is applied.
+                  type: string
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -1191,6 +1198,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationThreads:
+                  description: ParallelMigrationThreads is the number of multifd connections
+                    used to transfer the memory of a VMI during a live migration.
+                    By default, a single connection is used.
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -1224,6 +1237,65 @@ var CRDsValidation map[string]string = map[string]string{
                 binding:
                   additionalProperties:
                     properties:
+                      computeResourceOverhead:
+                        description: 'ComputeResourceOverhead specifies the resource
+                          overhead that should be added to the compute container when
+                          using the binding. The overhead is added once per binding,
+                          regardless of the number of interfaces using it. version:
+                          1alphav1'
+                        properties:
+                          claims:
+                            description: "Claims lists the names of resources, defined
+                              in spec.resourceClaims, that are used by this container.
+                              \n This is an alpha field and requires enabling the
+                              DynamicResourceAllocation feature gate. \n This field
+                              is immutable. It can only be set for containers."
+                            items:
+                              description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                              properties:
+                                name:
+                                  description: Name must match the name of one entry
+                                    in pod.spec.resourceClaims of the Pod where this
+                                    field is used. It makes that resource available
+                                    inside a container.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute
+                              resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of compute
+                              resources required. If Requests is omitted for a container,
+                              it defaults to Limits if that is explicitly specified, otherwise
+                              to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                        type: object
+                      domainAttachmentType:
+                        description: 'DomainAttachmentType is a standard domain network
+                          attachment method kubevirt supports. Supported values: "tap",
+                          "managedTap", "vhostuser". The standard domain attachment
+                          can be used instead or in addition to the sidecarImage. version:
+                          1alphav1'
+                        type: string
                       networkAttachmentDefinition:
                         description: 'NetworkAttachmentDefinition references to a
                           NetworkAttachmentDefinition CR object. Format: <name>, <namespace>/<name>.
@@ -2524,6 +2596,130 @@ var CRDsValidation map[string]string = map[string]string{
                 be forced updated per the BatchShutdownInteral interval \n Defaults
                 to 10"
               type: integer
+            canaryPercentage:
+              description: "CanaryPercentage is the percentage of the workloads which
+                are live migrated first. Once the canary is migrated, the remaining
+                workloads are only updated after CanaryPeriod passed without the rollout
+                being paused by failed migrations. Workloads are not evicted while
+                the canary is migrated. Requires the LiveMigrate workload update method.
+                \n Defaults to no canary"
+              type: integer
+            canaryPeriod:
+              description: "CanaryPeriod is the time to wait after the canary is updated
+                before updating the remaining workloads \n Defaults to 10 minutes"
+              type: string
+            maxFailedMigrations:
+              description: "MaxFailedMigrations is the number of failed workload update
+                migrations after which the rollout is paused. The rollout continues
+                once it is raised above the failed migrations or the rollout of a
+                new version starts. \n Defaults to no limit"
+              type: integer
+            paused:
+              description: Paused stops the automated workload updates until it is
+                unset
+              type: boolean
+            rolloutOrder:
+              description: RolloutOrder defines the order in which workloads are updated.
+                Workloads matching a stage are only updated once all workloads matching
+                the previous stages are updated. Workloads matching no stage are updated
+                last.
+              items:
+                description: WorkloadUpdateRolloutStage selects the workloads which
+                  are updated together
+                properties:
+                  namespaceSelector:
+                    description: NamespaceSelector selects the workloads by the labels
+                      of their namespace
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: A label selector requirement is a selector
+                            that contains values, a key, and an operator that relates
+                            the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: operator represents a key's relationship
+                                to a set of values. Valid operators are In, NotIn,
+                                Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: values is an array of string values. If
+                                the operator is In or NotIn, the values array must
+                                be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced
+                                during a strategic merge patch.
+                              items:
+                                type: string
+                              type: array
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: matchLabels is a map of {key,value} pairs. A
+                          single {key,value} in the matchLabels map is equivalent
+                          to an element of matchExpressions, whose key field is "key",
+                          the operator is "In", and the values array contains only
+                          "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                  nodeSelector:
+                    description: NodeSelector selects the workloads by the labels
+                      of the node they run on
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: A label selector requirement is a selector
+                            that contains values, a key, and an operator that relates
+                            the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: operator represents a key's relationship
+                                to a set of values. Valid operators are In, NotIn,
+                                Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: values is an array of string values. If
+                                the operator is In or NotIn, the values array must
+                                be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced
+                                during a strategic merge patch.
+                              items:
+                                type: string
+                              type: array
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: matchLabels is a map of {key,value} pairs. A
+                          single {key,value} in the matchLabels map is equivalent
+                          to an element of matchExpressions, whose key field is "key",
+                          the operator is "In", and the values array contains only
+                          "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             workloadUpdateMethods:
               description: "WorkloadUpdateMethods defines the methods that can be
                 used to disrupt workloads during automated workload updates. When
@@ -3531,6 +3727,239 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         targetKubeVirtVersion:
           type: string
+        workloadUpdate:
+          description: WorkloadUpdate reports the progress of the automated workload
+            updates
+          properties:
+            canaryCompletionTime:
+              description: CanaryCompletionTime is the time the canary of the rollout
+                was migrated
+              format: date-time
+              nullable: true
+              type: string
+            failedMigrations:
+              description: FailedMigrations is the number of workload update migrations
+                which failed during the rollout
+              type: integer
+            finishedMigrations:
+              description: FinishedMigrations are the UIDs of the existing finished
+                workload update migrations which are counted in MigratedWorkloads
+                and FailedMigrations
+              items:
+                description: UID is a type that holds unique ID values, including
+                  UUIDs.  Because we don't ONLY use UUIDs, this is an alias to string.  Being
+                  a type captures intent and helps make sure that UIDs and names do
+                  not get conflated.
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            launcherImage:
+              description: LauncherImage is the virt-launcher image the workloads
+                are updated to
+              type: string
+            migratedWorkloads:
+              description: MigratedWorkloads is the number of workloads which were
+                successfully migrated by workload update migrations during the rollout
+              type: integer
+            outdatedWorkloads:
+              description: OutdatedWorkloads is the number of workloads which still
+                need an update
+              type: integer
+            paused:
+              description: Paused indicates that no workloads are updated at the moment
+              type: boolean
+            pausedReason:
+              description: PausedReason is a brief CamelCase string that describes
+                why the rollout is paused
+              type: string
+            startTime:
+              description: StartTime is the time the rollout of the launcher image
+                started
+              format: date-time
+              nullable: true
+              type: string
+            updatedWorkloads:
+              description: UpdatedWorkloads is the number of workloads which run the
+                launcher image
+              type: integer
+          required:
+          - failedMigrations
+          - migratedWorkloads
+          - outdatedWorkloads
+          - updatedWorkloads
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"ksmpolicy": `openAPIV3Schema:
+  description: KSMPolicy configures kernel samepage merging (KSM) on the nodes it
+    selects
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        enabled:
+          description: Enabled explicitly enables or disables KSM on the selected
+            nodes and takes precedence over the NodeLabelSelector of the KSMConfiguration
+            in the KubeVirt CR. If unset, KSM is only enabled on the nodes selected
+            by the KSMConfiguration and this policy only tunes it.
+          type: boolean
+        freePercent:
+          description: FreePercent is the percentage of the node memory which has
+            to be available. KSM starts merging pages when less memory is available
+            and slows down once enough memory is available again. Defaults to 20
+          format: int32
+          type: integer
+        nodeSelector:
+          description: NodeSelector selects the nodes on which KSM is managed by this
+            policy. An empty selector selects all nodes.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        pagesToScan:
+          description: PagesToScan bounds the number of pages KSM scans before it
+            goes to sleep
+          properties:
+            boost:
+              description: Boost is the number of pages added on every heartbeat while
+                the node is under memory pressure. Defaults to 300
+              format: int32
+              type: integer
+            decay:
+              description: Decay is the number of pages removed on every heartbeat
+                while the node is not under memory pressure. Defaults to 50
+              format: int32
+              type: integer
+            init:
+              description: Init is the number of pages KSM scans when it starts. Defaults
+                to 100
+              format: int32
+              type: integer
+            max:
+              description: Max is the highest number of pages KSM scans under memory
+                pressure. Defaults to 1250
+              format: int32
+              type: integer
+            min:
+              description: Min is the number of pages below which KSM stops when the
+                node is not under memory pressure. Defaults to 64
+              format: int32
+              type: integer
+          type: object
+        priority:
+          description: Priority decides which policy applies when several policies
+            select the same node. The policy with the highest priority wins, ties
+            are broken by the policy name. Defaults to 0
+          format: int32
+          type: integer
+        sleepMillisecondsBaseline:
+          description: SleepMillisecondsBaseline is the time KSM sleeps between scans
+            on a node with 16GiB of used memory. The sleep time scales down with the
+            amount of used memory, down to a tenth of the baseline. Defaults to 100
+          format: int32
+          type: integer
+      type: object
+    status:
+      nullable: true
+      properties:
+        nodes:
+          additionalProperties:
+            description: KSMNodeStatus reports the state of KSM on a node
+            properties:
+              lastProbeTime:
+                description: LastProbeTime is the time the state was last reported
+                  by virt-handler
+                format: date-time
+                nullable: true
+                type: string
+              pagesShared:
+                description: PagesShared is the number of shared pages which are in
+                  use
+                format: int64
+                type: integer
+              pagesSharing:
+                description: PagesSharing is the number of additional sites which
+                  share these pages
+                format: int64
+                type: integer
+              pagesToScan:
+                description: PagesToScan is the number of pages KSM currently scans
+                  before it goes to sleep
+                format: int64
+                type: integer
+              running:
+                description: Running indicates whether KSM is currently merging pages
+                  on the node
+                type: boolean
+              savedMemory:
+                anyOf:
+                - type: integer
+                - type: string
+                description: SavedMemory is the amount of memory saved by merging
+                  pages
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+              sleepMilliseconds:
+                description: SleepMilliseconds is the time KSM currently sleeps between
+                  scans
+                format: int64
+                type: integer
+            required:
+            - pagesShared
+            - pagesSharing
+            - running
+            - savedMemory
+            type: object
+          description: Nodes reports the state of KSM on the nodes managed by this
+            policy, keyed by the node name
+          type: object
       type: object
   required:
   - spec
@@ -3567,6 +3996,32 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        disableTLS:
+          description: DisableTLS disables the additional layer of live migration
+            encryption provided by KubeVirt.
+          type: boolean
+        maxParallelMigrations:
+          description: MaxParallelMigrations is the maximum number of concurrent live
+            migrations of VMIs matched by this policy. The cluster-wide and per-node
+            limits still apply.
+          format: int32
+          type: integer
+        parallelMigrationThreads:
+          description: ParallelMigrationThreads is the number of multifd connections
+            used to transfer the memory of a VMI.
+          format: int32
+          type: integer
+        priority:
+          description: Priority decides which policy applies when several policies
+            match the same VMI. The policy with the highest priority wins, before
+            the number of matching labels is considered. Defaults to 0
+          format: int32
+          type: integer
+        progressTimeout:
+          description: ProgressTimeout is the maximum number of seconds a live migration
+            is allowed to make no progress.
+          format: int64
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -3583,16 +4038,50 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     status:
       nullable: true
+      properties:
+        conflictingPolicies:
+          description: ConflictingPolicies are the policies which match at least one
+            VMI with the same priority and the same number of matching labels as this
+            policy
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+        matchedVirtualMachineInstances:
+          description: MatchedVirtualMachineInstances are the first 100 VMIs this
+            policy currently applies to, ordered by namespace and name
+          items:
+            description: MatchedVirtualMachineInstance references a VMI matched by
+              a migration policy
+            properties:
+              name:
+                type: string
+              namespace:
+                type: string
+            required:
+            - name
+            - namespace
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        matchedVirtualMachineInstancesCount:
+          description: MatchedVirtualMachineInstancesCount is the number of VMIs this
+            policy currently applies to
+          format: int32
+          type: integer
+        migratingVirtualMachineInstancesCount:
+          description: MigratingVirtualMachineInstancesCount is the number of VMIs
+            this policy applies to which are currently migrating
+          format: int32
+          type: integer
       type: object
   required:
   - spec
   type: object
 `,
-	"virtualmachine": `openAPIV3Schema:
-  description: VirtualMachine handles the VirtualMachines that are not running or
-    are in a stopped state The VirtualMachine contains the template to create the
-    VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance
-    in its status.
+	"nodecapabilities": `openAPIV3Schema:
+  description: NodeCapabilities reports the virtualization capabilities of a node.
+    It is published by virt-handler and carries the name of the node it describes.
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation
@@ -3606,23 +4095,193 @@ var CRDsValidation map[string]string = map[string]string{
       type: string
     metadata:
       type: object
-    spec:
-      description: Spec contains the specification of VirtualMachineInstance created
+    status:
+      nullable: true
       properties:
-        dataVolumeTemplates:
-          description: dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance
-            template can reference. DataVolumes in this list are dynamically created
-            for the VirtualMachine and are tied to the VirtualMachine's life-cycle.
-          items:
-            nullable: true
-            properties:
-              apiVersion:
-                description: 'APIVersion defines the versioned schema of this representation
-                  of an object. Servers should convert recognized schemas to the latest
-                  internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
-                type: string
-              kind:
-                description: 'Kind is a string value representing the REST resource
+        cpu:
+          description: CPU describes the CPU models and features the node can provide
+            to guests
+          properties:
+            features:
+              description: Features lists the CPU features which are supported by
+                the node
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            hostModel:
+              description: HostModel is the CPU model used for guests with the host-model
+                CPU mode
+              type: string
+            hostModelObsolete:
+              description: HostModelObsolete indicates whether the host model is part
+                of the obsolete CPU models
+              type: boolean
+            hostModelRequiredFeatures:
+              description: HostModelRequiredFeatures lists the features the host model
+                requires on top of its definition
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            hypervFeatures:
+              description: HypervFeatures lists the Hyper-V enlightenments which are
+                supported by the node
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            models:
+              description: Models lists the CPU models which are usable on the node
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            tsc:
+              description: TSC describes the time stamp counter of the node
+              properties:
+                frequency:
+                  description: Frequency is the frequency of the counter in Hz
+                  format: int64
+                  type: integer
+                scalable:
+                  description: Scalable indicates whether the counter frequency can
+                    be scaled for guests
+                  type: boolean
+              required:
+              - frequency
+              - scalable
+              type: object
+            vendor:
+              description: Vendor is the vendor of the host CPU
+              type: string
+          type: object
+        emulatorVersion:
+          description: EmulatorVersion is the version of the emulator which runs the
+            guests on the node
+          type: string
+        hugepageSizes:
+          description: HugepageSizes lists the hugepage sizes supported by the node
+          items:
+            anyOf:
+            - type: integer
+            - type: string
+            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+            x-kubernetes-int-or-string: true
+          type: array
+          x-kubernetes-list-type: atomic
+        iommuGroups:
+          description: IOMMUGroups lists the IOMMU groups of the node and the PCI
+            devices which belong to them
+          items:
+            description: IOMMUGroup is an IOMMU group of a node
+            properties:
+              devices:
+                description: Devices lists the PCI addresses of the devices which
+                  belong to the group
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              id:
+                description: ID is the number of the group
+                format: int32
+                type: integer
+            required:
+            - id
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        machineTypes:
+          description: MachineTypes lists the machine types the emulator supports
+            for the node architecture
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        realtime:
+          description: Realtime indicates whether the node is able to run realtime
+            workloads
+          type: boolean
+        sev:
+          description: SEV reports whether the node supports AMD Secure Encrypted
+            Virtualization
+          properties:
+            cbitpos:
+              description: CBitPos is the position of the C-bit in the page table
+                entries
+              format: int32
+              type: integer
+            maxESGuests:
+              description: MaxESGuests is the number of SEV-ES guests which can run
+                concurrently
+              format: int32
+              type: integer
+            maxGuests:
+              description: MaxGuests is the number of SEV guests which can run concurrently
+              format: int32
+              type: integer
+            reducedPhysBits:
+              description: ReducedPhysBits is the number of physical address bits
+                lost when memory encryption is enabled
+              format: int32
+              type: integer
+            supported:
+              description: Supported indicates whether SEV is supported
+              type: boolean
+            supportedES:
+              description: SupportedES indicates whether SEV-ES is supported
+              type: boolean
+          required:
+          - supported
+          type: object
+        tdx:
+          description: TDX reports whether the node supports Intel Trust Domain Extensions
+          properties:
+            supported:
+              description: Supported indicates whether TDX is supported
+              type: boolean
+          required:
+          - supported
+          type: object
+      type: object
+  type: object
+`,
+	"virtualmachine": `openAPIV3Schema:
+  description: VirtualMachine handles the VirtualMachines that are not running or
+    are in a stopped state The VirtualMachine contains the template to create the
+    VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance
+    in its status.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Spec contains the specification of VirtualMachineInstance created
+      properties:
+        dataVolumeTemplates:
+          description: dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance
+            template can reference. DataVolumes in this list are dynamically created
+            for the VirtualMachine and are tied to the VirtualMachine's life-cycle.
+          items:
+            nullable: true
+            properties:
+              apiVersion:
+                description: 'APIVersion defines the versioned schema of this representation
+                  of an object. Servers should convert recognized schemas to the latest
+                  internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+                type: string
+              kind:
+                description: 'Kind is a string value representing the REST resource
                   this object represents. Servers may infer this from the endpoint
                   the client submits requests to. Cannot be updated. In CamelCase.
                   More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
@@ -6026,6 +6685,11 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              vhostuser:
+                                description: InterfaceVhostUser connects to a given
+                                  network via a vhost-user socket served by a userspace
+                                  data plane, such as OVS-DPDK.
+                                type: object
                             required:
                             - name
                             type: object
@@ -7873,6 +8537,35 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           type: array
           x-kubernetes-list-type: atomic
+        guestIdentity:
+          description: GuestIdentity configures the regeneration of the identity the
+            guest of a VirtualMachine target is presented with, so the target does
+            not boot with the identity of the source.
+          properties:
+            newHostname:
+              description: NewHostname sets the hostname of the target. Setting it
+                implies Regenerate.
+              type: string
+            regenerate:
+              description: Regenerate assigns a new firmware UUID to the target, which
+                results in a new cloud-init instance-id, and resets the target's hostname
+                so it defaults to the target's name.
+              type: boolean
+            sysprep:
+              description: Sysprep runs virt-sysprep against the volumes of the target
+                before its first boot, resetting host specific configuration like
+                the machine-id and the SSH host keys. The target is kept halted until
+                virt-sysprep completes.
+              properties:
+                operations:
+                  description: Operations is the list of virt-sysprep operations to
+                    run, the virt-sysprep default operations are run if it is empty.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+          type: object
         labelFilters:
           description: 'Example use: "!some/key*". For a detailed description, please
             refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.'
@@ -7895,7 +8588,8 @@ var CRDsValidation map[string]string = map[string]string{
         source:
           description: 'Source is the object that would be cloned. Currently supported
             source types are: VirtualMachine of kubevirt.io API group, VirtualMachineSnapshot
-            of snapshot.kubevirt.io API group'
+            of snapshot.kubevirt.io API group, VirtualMachineExport of export.kubevirt.io
+            API group, DataSource of cdi.kubevirt.io API group'
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced.
@@ -7914,10 +8608,15 @@ var CRDsValidation map[string]string = map[string]string{
           type: object
         target:
           description: 'Target is the outcome of the cloning process. Currently supported
-            source types are: - VirtualMachine of kubevirt.io API group - Empty (nil).
-            If the target is not provided, the target type would default to VirtualMachine
-            and a random name would be generated for the target. The target''s name
-            can be viewed by inspecting status "TargetName" field below.'
+            source types are: - VirtualMachine of kubevirt.io API group - DataVolume
+            of cdi.kubevirt.io API group - PersistentVolumeClaim of the core API group
+            - Empty (nil). A VirtualMachine target can only be cloned from a VirtualMachine
+            or a VirtualMachineSnapshot, while DataVolume and PersistentVolumeClaim
+            targets can only be cloned from a VirtualMachineExport exposing a single
+            volume or from a DataSource. If the target is not provided, the target type
+            would default to VirtualMachine and a random name would be generated for
+            the target. The target''s name can be viewed by inspecting status "TargetName"
+            field below.'
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced.
@@ -7934,6 +8633,14 @@ var CRDsValidation map[string]string = map[string]string{
           - kind
           - name
           type: object
+        targetNamespace:
+          description: TargetNamespace is the namespace the target is created in,
+            it defaults to the namespace of the clone. The user creating the clone
+            has to be allowed to create the target in that namespace. Volume targets
+            are cloned from the PVC behind the source, which the user has to be allowed
+            to clone. Cloning a VirtualMachine or a VirtualMachineSnapshot into another
+            namespace requires the CrossNamespaceVolumeDataSource feature of the cluster.
+          type: string
       required:
       - source
       type: object
@@ -8776,6 +9483,127 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinedisruptionbudget": `openAPIV3Schema:
+  description: VirtualMachineDisruptionBudget limits the number of VirtualMachines
+    of a group, selected by labels or by their VirtualMachinePool, which are voluntarily
+    disrupted at the same time, e.g. by node drains or by workload updates. In contrast
+    to a PodDisruptionBudget it is aware of the VirtualMachines which are expected
+    to run, even while they have no instance.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        maxUnavailable:
+          anyOf:
+          - type: integer
+          - type: string
+          description: MaxUnavailable is the number of covered VirtualMachines which
+            can be unavailable. Value can be an absolute number or a percentage of
+            the expected VirtualMachines, rounded up. Mutually exclusive with MinAvailable.
+            Defaults to 1 when MinAvailable is not set.
+          x-kubernetes-int-or-string: true
+        minAvailable:
+          anyOf:
+          - type: integer
+          - type: string
+          description: MinAvailable is the number of covered VirtualMachines which
+            must stay available. Value can be an absolute number or a percentage of
+            the expected VirtualMachines, rounded up. Mutually exclusive with MaxUnavailable.
+          x-kubernetes-int-or-string: true
+        selector:
+          description: Selector selects the covered VirtualMachineInstances by their
+            labels. The labels of the instance template of VirtualMachines are used
+            to count stopped VirtualMachines. Mutually exclusive with VirtualMachinePoolName.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        virtualMachinePoolName:
+          description: VirtualMachinePoolName covers the VirtualMachines of the named
+            pool in the namespace of the budget. Mutually exclusive with Selector.
+          type: string
+      type: object
+    status:
+      properties:
+        currentHealthy:
+          description: CurrentHealthy is the number of covered VirtualMachineInstances
+            which are running and ready.
+          format: int32
+          type: integer
+        desiredHealthy:
+          description: DesiredHealthy is the number of covered VirtualMachineInstances
+            which must stay running and ready.
+          format: int32
+          type: integer
+        disruptionsAllowed:
+          description: DisruptionsAllowed is the number of covered VirtualMachineInstances
+            which can currently be disrupted.
+          format: int32
+          type: integer
+        expectedVirtualMachines:
+          description: ExpectedVirtualMachines is the number of covered VirtualMachines
+            which are expected to run.
+          format: int32
+          type: integer
+        observedGeneration:
+          description: ObservedGeneration is the generation of the budget the status
+            was computed for.
+          format: int64
+          type: integer
+        podDisruptionBudgetName:
+          description: PodDisruptionBudgetName is the name of the PodDisruptionBudget
+            which protects the virt-launcher pods of the covered VirtualMachineInstances
+            without a PodDisruptionBudget of their own from evictions.
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachineexport": `openAPIV3Schema:
   description: VirtualMachineExport defines the operation of exporting a VM source
@@ -8796,6 +9624,12 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineExportSpec is the spec for a VirtualMachineExport
         resource
       properties:
+        baseSnapshot:
+          description: BaseSnapshot is the name of an older VirtualMachineSnapshot
+            of the same VirtualMachine. It is only valid when the source is a VirtualMachineSnapshot.
+            The volumes of the source are then additionally exported as the list of
+            extents which changed since the base snapshot
+          type: string
         source:
           description: TypedLocalObjectReference contains enough information to let
             you locate the typed referenced object inside the same namespace.
@@ -9030,9 +9864,9 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineinstance": `openAPIV3Schema:
-  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition.
-    It represents a virtual machine in the runtime environment of kubernetes.
+	"virtualmachinegrouprestore": `openAPIV3Schema:
+  description: VirtualMachineGroupRestore defines the operation of restoring the VMs
+    of a VirtualMachineGroupSnapshot to the point in time of the group snapshot
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation
@@ -9047,51 +9881,283 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: VirtualMachineInstance Spec contains the VirtualMachineInstance
-        specification.
+      description: VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore
+        resource
       properties:
-        accessCredentials:
-          description: Specifies a set of public keys to inject into the vm guest
+        virtualMachineGroupSnapshotName:
+          description: VirtualMachineGroupSnapshotName is the group snapshot the VMs
+            are restored from, every VM of the group is restored into the VM it was
+            snapshotted from
+          type: string
+      required:
+      - virtualMachineGroupSnapshotName
+      type: object
+    status:
+      description: VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore
+        resource
+      properties:
+        complete:
+          type: boolean
+        conditions:
           items:
-            description: AccessCredential represents a credential source that can
-              be used to authorize remote access to the vm guest Only one of its members
-              may be specified.
+            description: Condition defines conditions
             properties:
-              sshPublicKey:
-                description: SSHPublicKey represents the source and method of applying
-                  a ssh public key into a guest virtual machine.
-                properties:
-                  propagationMethod:
-                    description: PropagationMethod represents how the public key is
-                      injected into the vm guest.
-                    properties:
-                      configDrive:
-                        description: ConfigDrivePropagation means that the ssh public
-                          keys are injected into the VM using metadata using the configDrive
-                          cloud-init provider
-                        type: object
-                      noCloud:
-                        description: NoCloudPropagation means that the ssh public
-                          keys are injected into the VM using metadata using the noCloud
-                          cloud-init provider
-                        type: object
-                      qemuGuestAgent:
-                        description: QemuGuestAgentAccessCredentailPropagation means
-                          ssh public keys are dynamically injected into the vm at
-                          runtime via the qemu guest agent. This feature requires
-                          the qemu guest agent to be running within the guest.
-                        properties:
-                          users:
-                            description: Users represents a list of guest users that
-                              should have the ssh public keys added to their authorized_keys
-                              file.
-                            items:
-                              type: string
-                            type: array
-                            x-kubernetes-list-type: set
-                        required:
-                        - users
-                        type: object
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        restoreTime:
+          description: RestoreTime is set once all the VMs of the group are restored
+          format: date-time
+          nullable: true
+          type: string
+        virtualMachineRestores:
+          description: VirtualMachineRestores are the restores of the VMs of the group
+          items:
+            description: GroupRestoreMember references the restore of a VM of a VirtualMachineGroupRestore
+            properties:
+              virtualMachineName:
+                type: string
+              virtualMachineRestoreName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineRestoreName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinegroupsnapshot": `openAPIV3Schema:
+  description: VirtualMachineGroupSnapshot defines the operation of snapshotting a
+    set of VMs at a single crash consistent point in time. The VMs are restored together
+    with a VirtualMachineGroupRestore
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is set on the snapshots of the VMs of the group
+          type: string
+        failureDeadline:
+          description: This time represents the number of seconds we permit the group
+            snapshot to take. In case we pass this deadline we mark this group snapshot
+            as failed. Defaults to DefaultFailureDeadline - 5min
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines to snapshot, in the namespace
+            of the group snapshot
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - selector
+      type: object
+    status:
+      description: VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot
+        resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        error:
+          description: Error is the last error encountered during the snapshot/restore
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        frozen:
+          description: Frozen is set once the guest file systems of all the running
+            VMs of the group are frozen, the volumes of the VMs are only snapshotted
+            afterwards
+          type: boolean
+        phase:
+          description: VirtualMachineSnapshotPhase is the current phase of the VirtualMachineSnapshot
+          type: string
+        readyToUse:
+          type: boolean
+        virtualMachineSnapshots:
+          description: VirtualMachineSnapshots are the snapshots of the VMs of the
+            group, each of them can be restored with a VirtualMachineRestore
+          items:
+            description: GroupSnapshotMember references the snapshot of a VM of a
+              VirtualMachineGroupSnapshot
+            properties:
+              virtualMachineName:
+                type: string
+              virtualMachineSnapshotName:
+                type: string
+            required:
+            - virtualMachineName
+            - virtualMachineSnapshotName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstance": `openAPIV3Schema:
+  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition.
+    It represents a virtual machine in the runtime environment of kubernetes.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineInstance Spec contains the VirtualMachineInstance
+        specification.
+      properties:
+        accessCredentials:
+          description: Specifies a set of public keys to inject into the vm guest
+          items:
+            description: AccessCredential represents a credential source that can
+              be used to authorize remote access to the vm guest Only one of its members
+              may be specified.
+            properties:
+              sshPublicKey:
+                description: SSHPublicKey represents the source and method of applying
+                  a ssh public key into a guest virtual machine.
+                properties:
+                  propagationMethod:
+                    description: PropagationMethod represents how the public key is
+                      injected into the vm guest.
+                    properties:
+                      configDrive:
+                        description: ConfigDrivePropagation means that the ssh public
+                          keys are injected into the VM using metadata using the configDrive
+                          cloud-init provider
+                        type: object
+                      noCloud:
+                        description: NoCloudPropagation means that the ssh public
+                          keys are injected into the VM using metadata using the noCloud
+                          cloud-init provider
+                        type: object
+                      qemuGuestAgent:
+                        description: QemuGuestAgentAccessCredentailPropagation means
+                          ssh public keys are dynamically injected into the vm at
+                          runtime via the qemu guest agent. This feature requires
+                          the qemu guest agent to be running within the guest.
+                        properties:
+                          users:
+                            description: Users represents a list of guest users that
+                              should have the ssh public keys added to their authorized_keys
+                              file.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: set
+                        required:
+                        - users
+                        type: object
                     type: object
                   source:
                     description: Source represents where the public keys are pulled
@@ -10564,6 +11630,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      vhostuser:
+                        description: InterfaceVhostUser connects to a given network
+                          via a vhost-user socket served by a userspace data plane,
+                          such as OVS-DPDK.
+                        type: object
                     required:
                     - name
                     type: object
@@ -12117,6 +13188,28 @@ var CRDsValidation map[string]string = map[string]string{
             failed:
               description: Indicates that the migration failed
               type: boolean
+            migratedVolumes:
+              description: MigratedVolumes lists the volumes whose data is copied
+                to a new claim on the target during this migration
+              items:
+                description: StorageMigratedVolume maps a volume of a VirtualMachineInstance
+                  to the claim its data is copied to during a live migration.
+                properties:
+                  destinationClaim:
+                    description: DestinationClaim is the name of the PersistentVolumeClaim
+                      the volume is copied to. The claim must exist in the namespace
+                      of the VMI.
+                    type: string
+                  volumeName:
+                    description: VolumeName is the name of the VMI volume to move
+                      to the destination claim
+                    type: string
+                required:
+                - destinationClaim
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
             migrationConfiguration:
               description: Migration configurations to apply
               properties:
@@ -12155,6 +13248,12 @@ var CRDsValidation map[string]string = map[string]string{
                     layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: EvacuationOrder defines whether the VMIs with the most
+                    memory are migrated first or last when a node is drained. The
+                    priority class of the VMIs takes precedence. By default, no memory
+                    based order is applied.
+                  type: string
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -12173,6 +13272,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationThreads:
+                  description: ParallelMigrationThreads is the number of multifd connections
+                    used to transfer the memory of a VMI during a live migration.
+                    By default, a single connection is used.
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -12452,6 +13557,40 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        addedNodeSelector:
+          additionalProperties:
+            type: string
+          description: AddedNodeSelector is an additional selector that can be used
+            to complement a NodeSelector or NodeAffinity as set on the VM to restrict
+            the set of allowed target nodes for a migration. In case of key collisions,
+            values set on the VM objects are going to be preserved to ensure that
+            addedNodeSelector can only restrict but not bypass constraints already
+            set on the VM object.
+          type: object
+        migratedVolumes:
+          description: MigratedVolumes moves the listed volumes to new PersistentVolumeClaims
+            while the VMI keeps running. The data of each volume is copied to its
+            destination claim during the migration and the VMI and its VM are switched
+            over to the destination claims once the migration succeeded.
+          items:
+            description: StorageMigratedVolume maps a volume of a VirtualMachineInstance
+              to the claim its data is copied to during a live migration.
+            properties:
+              destinationClaim:
+                description: DestinationClaim is the name of the PersistentVolumeClaim
+                  the volume is copied to. The claim must exist in the namespace of
+                  the VMI.
+                type: string
+              volumeName:
+                description: VolumeName is the name of the VMI volume to move to the
+                  destination claim
+                type: string
+            required:
+            - destinationClaim
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -12505,13 +13644,35 @@ var CRDsValidation map[string]string = map[string]string{
             failed:
               description: Indicates that the migration failed
               type: boolean
-            migrationConfiguration:
-              description: Migration configurations to apply
-              properties:
-                allowAutoConverge:
-                  description: AllowAutoConverge allows the platform to compromise
-                    performance/availability of VMIs to guarantee successful VMI live
-                    migrations. Defaults to false
+            migratedVolumes:
+              description: MigratedVolumes lists the volumes whose data is copied
+                to a new claim on the target during this migration
+              items:
+                description: StorageMigratedVolume maps a volume of a VirtualMachineInstance
+                  to the claim its data is copied to during a live migration.
+                properties:
+                  destinationClaim:
+                    description: DestinationClaim is the name of the PersistentVolumeClaim
+                      the volume is copied to. The claim must exist in the namespace
+                      of the VMI.
+                    type: string
+                  volumeName:
+                    description: VolumeName is the name of the VMI volume to move
+                      to the destination claim
+                    type: string
+                required:
+                - destinationClaim
+                - volumeName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            migrationConfiguration:
+              description: Migration configurations to apply
+              properties:
+                allowAutoConverge:
+                  description: AllowAutoConverge allows the platform to compromise
+                    performance/availability of VMIs to guarantee successful VMI live
+                    migrations. Defaults to false
                   type: boolean
                 allowPostCopy:
                   description: AllowPostCopy enables post-copy live migrations. Such
@@ -12543,6 +13704,12 @@ var CRDsValidation map[string]string = map[string]string{
                     layer of live migration encryption provided by KubeVirt. This
                     is usually a bad idea. Defaults to false
                   type: boolean
+                evacuationOrder:
+                  description: EvacuationOrder defines whether the VMIs with the most
+                    memory are migrated first or last when a node is drained. The
+                    priority class of the VMIs takes precedence. By default, no memory
+                    based order is applied.
+                  type: string
                 matchSELinuxLevelOnMigration:
                   description: By default, the SELinux level of target virt-launcher
                     pods is forced to the level of the source virt-launcher. When
@@ -12561,6 +13728,12 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationThreads:
+                  description: ParallelMigrationThreads is the number of multifd connections
+                    used to transfer the memory of a VMI during a live migration.
+                    By default, a single connection is used.
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -13293,6 +14466,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
                         type: string
+                      vhostuser:
+                        description: InterfaceVhostUser connects to a given network
+                          via a vhost-user socket served by a userspace data plane,
+                          such as OVS-DPDK.
+                        type: object
                     required:
                     - name
                     type: object
@@ -15481,6 +16659,11 @@ var CRDsValidation map[string]string = map[string]string{
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
+                              vhostuser:
+                                description: InterfaceVhostUser connects to a given
+                                  network via a vhost-user socket served by a userspace
+                                  data plane, such as OVS-DPDK.
+                                type: object
                             required:
                             - name
                             type: object
@@ -17084,140 +18267,846 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                 type: object
             required:
-            - deviceName
-            - name
-            type: object
-          type: array
-          x-kubernetes-list-type: atomic
-        hostDevices:
-          description: Optionally defines any HostDevices associated with the instancetype.
-          items:
-            properties:
-              deviceName:
-                description: DeviceName is the resource name of the host device exposed
-                  by a device plugin
-                type: string
-              name:
-                type: string
-              tag:
-                description: If specified, the virtual network interface address and
-                  its tag will be provided to the guest via config drive
-                type: string
-            required:
-            - deviceName
-            - name
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hostDevices:
+          description: Optionally defines any HostDevices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                description: DeviceName is the resource name of the host device exposed
+                  by a device plugin
+                type: string
+              name:
+                type: string
+              tag:
+                description: If specified, the virtual network interface address and
+                  its tag will be provided to the guest via config drive
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        launchSecurity:
+          description: Optionally defines the LaunchSecurity to be used by the instancetype.
+          properties:
+            sev:
+              description: AMD Secure Encrypted Virtualization (SEV).
+              properties:
+                attestation:
+                  description: If specified, run the attestation process for a vmi.
+                  type: object
+                dhCert:
+                  description: Base64 encoded guest owner's Diffie-Hellman key.
+                  type: string
+                policy:
+                  description: 'Guest policy flags as defined in AMD SEV API specification.
+                    Note: due to security reasons it is not allowed to enable guest
+                    debugging. Therefore NoDebug flag is not exposed to users and
+                    is always true.'
+                  properties:
+                    encryptedState:
+                      description: SEV-ES is required. Defaults to false.
+                      type: boolean
+                  type: object
+                session:
+                  description: Base64 encoded session blob.
+                  type: string
+              type: object
+          type: object
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest
+                OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance
+                instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture
+                    valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+            overcommitPercent:
+              description: OvercommitPercent is the percentage of the guest memory
+                which will be overcommitted. This means that the VMIs parent pod (virt-launcher)
+                will request less physical memory by a factor specified by the OvercommitPercent.
+                Overcommits can lead to memory exhaustion, which in turn can lead
+                to crashes. Use carefully. Defaults to 0
+              maximum: 100
+              minimum: 0
+              type: integer
+          required:
+          - guest
+          type: object
+        nodeSelector:
+          additionalProperties:
+            type: string
+          description: "NodeSelector is a selector which must be true for the vmi
+            to fit on a node. Selector which must match a node's labels for the vmi
+            to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+            \n NodeSelector is the name of the custom node selector for the instancetype."
+          type: object
+        schedulerName:
+          description: "If specified, the VMI will be dispatched by specified scheduler.
+            If not specified, the VMI will be dispatched by default scheduler. \n
+            SchedulerName is the name of the custom K8s scheduler for the instancetype."
+          type: string
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinepool": `openAPIV3Schema:
+  description: VirtualMachinePool resource contains a VirtualMachine configuration
+    that can be used to replicate multiple VirtualMachine resources.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        dataVolumeTemplates:
+          description: DataVolumeTemplates is a list of DataVolumes created once per
+            pool index, named <template name>-<pool name>-<index>. In contrast to
+            the dataVolumeTemplates of the VirtualMachine template, they are not owned
+            by the VirtualMachine and survive its recreation. Volumes of the VirtualMachine
+            template referencing a template by name are pointed to the DataVolume
+            of their index.
+          items:
+            nullable: true
+            properties:
+              apiVersion:
+                description: 'APIVersion defines the versioned schema of this representation
+                  of an object. Servers should convert recognized schemas to the latest
+                  internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+                type: string
+              kind:
+                description: 'Kind is a string value representing the REST resource
+                  this object represents. Servers may infer this from the endpoint
+                  the client submits requests to. Cannot be updated. In CamelCase.
+                  More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+                type: string
+              metadata:
+                nullable: true
+                type: object
+                x-kubernetes-preserve-unknown-fields: true
+              spec:
+                description: DataVolumeSpec contains the DataVolume specification.
+                properties:
+                  checkpoints:
+                    description: Checkpoints is a list of DataVolumeCheckpoints, representing
+                      stages in a multistage import.
+                    items:
+                      description: DataVolumeCheckpoint defines a stage in a warm
+                        migration.
+                      properties:
+                        current:
+                          description: Current is the identifier of the snapshot created
+                            for this checkpoint.
+                          type: string
+                        previous:
+                          description: Previous is the identifier of the snapshot
+                            from the previous checkpoint.
+                          type: string
+                      required:
+                      - current
+                      - previous
+                      type: object
+                    type: array
+                  contentType:
+                    description: 'DataVolumeContentType options: "kubevirt", "archive"'
+                    enum:
+                    - kubevirt
+                    - archive
+                    type: string
+                  finalCheckpoint:
+                    description: FinalCheckpoint indicates whether the current DataVolumeCheckpoint
+                      is the final checkpoint.
+                    type: boolean
+                  preallocation:
+                    description: Preallocation controls whether storage for DataVolumes
+                      should be allocated in advance.
+                    type: boolean
+                  priorityClassName:
+                    description: PriorityClassName for Importer, Cloner and Uploader
+                      pod
+                    type: string
+                  pvc:
+                    description: PVC is the PVC specification
+                    properties:
+                      accessModes:
+                        description: 'accessModes contains the desired access modes
+                          the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                        items:
+                          type: string
+                        type: array
+                      dataSource:
+                        description: 'dataSource field can be used to specify either:
+                          * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                          * An existing PVC (PersistentVolumeClaim) If the provisioner
+                          or an external controller can support the specified data
+                          source, it will create a new volume based on the contents
+                          of the specified data source. When the AnyVolumeDataSource
+                          feature gate is enabled, dataSource contents will be copied
+                          to dataSourceRef, and dataSourceRef contents will be copied
+                          to dataSource when dataSourceRef.namespace is not specified.
+                          If the namespace is specified, then dataSourceRef will not
+                          be copied to dataSource.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      dataSourceRef:
+                        description: 'dataSourceRef specifies the object from which
+                          to populate the volume with data, if a non-empty volume
+                          is desired. This may be any object from a non-empty API
+                          group (non core object) or a PersistentVolumeClaim object.
+                          When this field is specified, volume binding will only succeed
+                          if the type of the specified object matches some installed
+                          volume populator or dynamic provisioner. This field will
+                          replace the functionality of the dataSource field and as
+                          such if both fields are non-empty, they must have the same
+                          value. For backwards compatibility, when namespace isn''t
+                          specified in dataSourceRef, both fields (dataSource and
+                          dataSourceRef) will be set to the same value automatically
+                          if one of them is empty and the other is non-empty. When
+                          namespace is specified in dataSourceRef, dataSource isn''t
+                          set to the same value and must be empty. There are three
+                          important differences between dataSource and dataSourceRef:
+                          * While dataSource only allows two specific types of objects,
+                          dataSourceRef   allows any non-core object, as well as PersistentVolumeClaim
+                          objects. * While dataSource ignores disallowed values (dropping
+                          them), dataSourceRef   preserves all values, and generates
+                          an error if a disallowed value is   specified. * While dataSource
+                          only allows local objects, dataSourceRef allows objects   in
+                          any namespaces. (Beta) Using this field requires the AnyVolumeDataSource
+                          feature gate to be enabled. (Alpha) Using the namespace
+                          field of dataSourceRef requires the CrossNamespaceVolumeDataSource
+                          feature gate to be enabled.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                          namespace:
+                            description: Namespace is the namespace of resource being
+                              referenced Note that when a namespace is specified,
+                              a gateway.networking.k8s.io/ReferenceGrant object is
+                              required in the referent namespace to allow that namespace's
+                              owner to accept the reference. See the ReferenceGrant
+                              documentation for details. (Alpha) This field requires
+                              the CrossNamespaceVolumeDataSource feature gate to be
+                              enabled.
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      resources:
+                        description: 'resources represents the minimum resources the
+                          volume should have. If RecoverVolumeExpansionFailure feature
+                          is enabled users are allowed to specify resource requirements
+                          that are lower than previous value but must still be higher
+                          than capacity recorded in the status field of the claim.
+                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                        properties:
+                          claims:
+                            description: "Claims lists the names of resources, defined
+                              in spec.resourceClaims, that are used by this container.
+                              \n This is an alpha field and requires enabling the
+                              DynamicResourceAllocation feature gate. \n This field
+                              is immutable. It can only be set for containers."
+                            items:
+                              description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                              properties:
+                                name:
+                                  description: Name must match the name of one entry
+                                    in pod.spec.resourceClaims of the Pod where this
+                                    field is used. It makes that resource available
+                                    inside a container.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute
+                              resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of
+                              compute resources required. If Requests is omitted for
+                              a container, it defaults to Limits if that is explicitly
+                              specified, otherwise to an implementation-defined value.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                        type: object
+                      selector:
+                        description: selector is a label query over volumes to consider
+                          for binding.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: A label selector requirement is a selector
+                                that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: operator represents a key's relationship
+                                    to a set of values. Valid operators are In, NotIn,
+                                    Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: values is an array of string values.
+                                    If the operator is In or NotIn, the values array
+                                    must be non-empty. If the operator is Exists or
+                                    DoesNotExist, the values array must be empty.
+                                    This array is replaced during a strategic merge
+                                    patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: matchLabels is a map of {key,value} pairs.
+                              A single {key,value} in the matchLabels map is equivalent
+                              to an element of matchExpressions, whose key field is
+                              "key", the operator is "In", and the values array contains
+                              only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                      storageClassName:
+                        description: 'storageClassName is the name of the StorageClass
+                          required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                        type: string
+                      volumeMode:
+                        description: volumeMode defines what type of volume is required
+                          by the claim. Value of Filesystem is implied when not included
+                          in claim spec.
+                        type: string
+                      volumeName:
+                        description: volumeName is the binding reference to the PersistentVolume
+                          backing this claim.
+                        type: string
+                    type: object
+                  source:
+                    description: Source is the src of the data for the requested DataVolume
+                    properties:
+                      blank:
+                        description: DataVolumeBlankImage provides the parameters
+                          to create a new raw blank image for the PVC
+                        type: object
+                      gcs:
+                        description: DataVolumeSourceGCS provides the parameters to
+                          create a Data Volume from an GCS source
+                        properties:
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the GCS source
+                            type: string
+                          url:
+                            description: URL is the url of the GCS source
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      http:
+                        description: DataVolumeSourceHTTP can be either an http or
+                          https endpoint, with an optional basic auth user name and
+                          password, and an optional configmap containing additional
+                          CAs
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap is a configmap reference, containing
+                              a Certificate Authority(CA) public key, and a base64
+                              encoded pem certificate
+                            type: string
+                          extraHeaders:
+                            description: ExtraHeaders is a list of strings containing
+                              extra headers to include with HTTP transfer requests
+                            items:
+                              type: string
+                            type: array
+                          secretExtraHeaders:
+                            description: SecretExtraHeaders is a list of Secret references,
+                              each containing an extra HTTP header that may include
+                              sensitive information
+                            items:
+                              type: string
+                            type: array
+                          secretRef:
+                            description: SecretRef A Secret reference, the secret
+                              should contain accessKeyId (user name) base64 encoded,
+                              and secretKey (password) also base64 encoded
+                            type: string
+                          url:
+                            description: URL is the URL of the http(s) endpoint
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      imageio:
+                        description: DataVolumeSourceImageIO provides the parameters
+                          to create a Data Volume from an imageio source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap provides a reference to the
+                              CA cert
+                            type: string
+                          diskId:
+                            description: DiskID provides id of a disk to be imported
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the ovirt-engine
+                            type: string
+                          url:
+                            description: URL is the URL of the ovirt-engine
+                            type: string
+                        required:
+                        - diskId
+                        - url
+                        type: object
+                      pvc:
+                        description: DataVolumeSourcePVC provides the parameters to
+                          create a Data Volume from an existing PVC
+                        properties:
+                          name:
+                            description: The name of the source PVC
+                            type: string
+                          namespace:
+                            description: The namespace of the source PVC
+                            type: string
+                        required:
+                        - name
+                        - namespace
+                        type: object
+                      registry:
+                        description: DataVolumeSourceRegistry provides the parameters
+                          to create a Data Volume from an registry source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap provides a reference to the
+                              Registry certs
+                            type: string
+                          imageStream:
+                            description: ImageStream is the name of image stream for
+                              import
+                            type: string
+                          pullMethod:
+                            description: PullMethod can be either "pod" (default import),
+                              or "node" (node docker cache based import)
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the Registry source
+                            type: string
+                          url:
+                            description: 'URL is the url of the registry source (starting
+                              with the scheme: docker, oci-archive)'
+                            type: string
+                        type: object
+                      s3:
+                        description: DataVolumeSourceS3 provides the parameters to
+                          create a Data Volume from an S3 source
+                        properties:
+                          certConfigMap:
+                            description: CertConfigMap is a configmap reference, containing
+                              a Certificate Authority(CA) public key, and a base64
+                              encoded pem certificate
+                            type: string
+                          secretRef:
+                            description: SecretRef provides the secret reference needed
+                              to access the S3 source
+                            type: string
+                          url:
+                            description: URL is the url of the S3 source
+                            type: string
+                        required:
+                        - url
+                        type: object
+                      snapshot:
+                        description: DataVolumeSourceSnapshot provides the parameters
+                          to create a Data Volume from an existing VolumeSnapshot
+                        properties:
+                          name:
+                            description: The name of the source VolumeSnapshot
+                            type: string
+                          namespace:
+                            description: The namespace of the source VolumeSnapshot
+                            type: string
+                        required:
+                        - name
+                        - namespace
+                        type: object
+                      upload:
+                        description: DataVolumeSourceUpload provides the parameters
+                          to create a Data Volume by uploading the source
+                        type: object
+                      vddk:
+                        description: DataVolumeSourceVDDK provides the parameters
+                          to create a Data Volume from a Vmware source
+                        properties:
+                          backingFile:
+                            description: BackingFile is the path to the virtual hard
+                              disk to migrate from vCenter/ESXi
+                            type: string
+                          initImageURL:
+                            description: InitImageURL is an optional URL to an image
+                              containing an extracted VDDK library, overrides v2v-vmware
+                              config map
+                            type: string
+                          secretRef:
+                            description: SecretRef provides a reference to a secret
+                              containing the username and password needed to access
+                              the vCenter or ESXi host
+                            type: string
+                          thumbprint:
+                            description: Thumbprint is the certificate thumbprint
+                              of the vCenter or ESXi host
+                            type: string
+                          url:
+                            description: URL is the URL of the vCenter or ESXi host
+                              with the VM to migrate
+                            type: string
+                          uuid:
+                            description: UUID is the UUID of the virtual machine that
+                              the backing file is attached to in vCenter/ESXi
+                            type: string
+                        type: object
+                    type: object
+                  sourceRef:
+                    description: SourceRef is an indirect reference to the source
+                      of data for the requested DataVolume
+                    properties:
+                      kind:
+                        description: The kind of the source reference, currently only
+                          "DataSource" is supported
+                        type: string
+                      name:
+                        description: The name of the source reference
+                        type: string
+                      namespace:
+                        description: The namespace of the source reference, defaults
+                          to the DataVolume namespace
+                        type: string
+                    required:
+                    - kind
+                    - name
+                    type: object
+                  storage:
+                    description: Storage is the requested storage specification
+                    properties:
+                      accessModes:
+                        description: 'AccessModes contains the desired access modes
+                          the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                        items:
+                          type: string
+                        type: array
+                      dataSource:
+                        description: 'This field can be used to specify either: *
+                          An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot)
+                          * An existing PVC (PersistentVolumeClaim) * An existing
+                          custom resource that implements data population (Alpha)
+                          In order to use custom resource types that implement data
+                          population, the AnyVolumeDataSource feature gate must be
+                          enabled. If the provisioner or an external controller can
+                          support the specified data source, it will create a new
+                          volume based on the contents of the specified data source.
+                          If the AnyVolumeDataSource feature gate is enabled, this
+                          field will always have the same contents as the DataSourceRef
+                          field.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      dataSourceRef:
+                        description: 'Specifies the object from which to populate
+                          the volume with data, if a non-empty volume is desired.
+                          This may be any local object from a non-empty API group
+                          (non core object) or a PersistentVolumeClaim object. When
+                          this field is specified, volume binding will only succeed
+                          if the type of the specified object matches some installed
+                          volume populator or dynamic provisioner. This field will
+                          replace the functionality of the DataSource field and as
+                          such if both fields are non-empty, they must have the same
+                          value. For backwards compatibility, both fields (DataSource
+                          and DataSourceRef) will be set to the same value automatically
+                          if one of them is empty and the other is non-empty. There
+                          are two important differences between DataSource and DataSourceRef:
+                          * While DataSource only allows two specific types of objects,
+                          DataSourceRef allows any non-core object, as well as PersistentVolumeClaim
+                          objects. * While DataSource ignores disallowed values (dropping
+                          them), DataSourceRef preserves all values, and generates
+                          an error if a disallowed value is specified. (Beta) Using
+                          this field requires the AnyVolumeDataSource feature gate
+                          to be enabled.'
+                        properties:
+                          apiGroup:
+                            description: APIGroup is the group for the resource being
+                              referenced. If APIGroup is not specified, the specified
+                              Kind must be in the core API group. For any other third-party
+                              types, APIGroup is required.
+                            type: string
+                          kind:
+                            description: Kind is the type of resource being referenced
+                            type: string
+                          name:
+                            description: Name is the name of resource being referenced
+                            type: string
+                          namespace:
+                            description: Namespace is the namespace of resource being
+                              referenced Note that when a namespace is specified,
+                              a gateway.networking.k8s.io/ReferenceGrant object is
+                              required in the referent namespace to allow that namespace's
+                              owner to accept the reference. See the ReferenceGrant
+                              documentation for details. (Alpha) This field requires
+                              the CrossNamespaceVolumeDataSource feature gate to be
+                              enabled.
+                            type: string
+                        required:
+                        - kind
+                        - name
+                        type: object
+                      resources:
+                        description: 'Resources represents the minimum resources the
+                          volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                        properties:
+                          claims:
+                            description: "Claims lists the names of resources, defined
+                              in spec.resourceClaims, that are used by this container.
+                              \n This is an alpha field and requires enabling the
+                              DynamicResourceAllocation feature gate. \n This field
+                              is immutable. It can only be set for containers."
+                            items:
+                              description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                              properties:
+                                name:
+                                  description: Name must match the name of one entry
+                                    in pod.spec.resourceClaims of the Pod where this
+                                    field is used. It makes that resource available
+                                    inside a container.
+                                  type: string
+                              required:
+                              - name
+                              type: object
+                            type: array
+                            x-kubernetes-list-map-keys:
+                            - name
+                            x-kubernetes-list-type: map
+                          limits:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Limits describes the maximum amount of compute
+                              resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                          requests:
+                            additionalProperties:
+                              anyOf:
+                              - type: integer
+                              - type: string
+                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                              x-kubernetes-int-or-string: true
+                            description: 'Requests describes the minimum amount of
+                              compute resources required. If Requests is omitted for
+                              a container, it defaults to Limits if that is explicitly
+                              specified, otherwise to an implementation-defined value.
+                              More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/'
+                            type: object
+                        type: object
+                      selector:
+                        description: A label query over volumes to consider for binding.
+                        properties:
+                          matchExpressions:
+                            description: matchExpressions is a list of label selector
+                              requirements. The requirements are ANDed.
+                            items:
+                              description: A label selector requirement is a selector
+                                that contains values, a key, and an operator that
+                                relates the key and values.
+                              properties:
+                                key:
+                                  description: key is the label key that the selector
+                                    applies to.
+                                  type: string
+                                operator:
+                                  description: operator represents a key's relationship
+                                    to a set of values. Valid operators are In, NotIn,
+                                    Exists and DoesNotExist.
+                                  type: string
+                                values:
+                                  description: values is an array of string values.
+                                    If the operator is In or NotIn, the values array
+                                    must be non-empty. If the operator is Exists or
+                                    DoesNotExist, the values array must be empty.
+                                    This array is replaced during a strategic merge
+                                    patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                          matchLabels:
+                            additionalProperties:
+                              type: string
+                            description: matchLabels is a map of {key,value} pairs.
+                              A single {key,value} in the matchLabels map is equivalent
+                              to an element of matchExpressions, whose key field is
+                              "key", the operator is "In", and the values array contains
+                              only "value". The requirements are ANDed.
+                            type: object
+                        type: object
+                      storageClassName:
+                        description: 'Name of the StorageClass required by the claim.
+                          More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                        type: string
+                      volumeMode:
+                        description: volumeMode defines what type of volume is required
+                          by the claim. Value of Filesystem is implied when not included
+                          in claim spec.
+                        type: string
+                      volumeName:
+                        description: VolumeName is the binding reference to the PersistentVolume
+                          backing this claim.
+                        type: string
+                    type: object
+                type: object
+              status:
+                description: DataVolumeTemplateDummyStatus is here simply for backwards
+                  compatibility with a previous API.
+                nullable: true
+                type: object
+            required:
+            - spec
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        ioThreadsPolicy:
-          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
-          type: string
-        launchSecurity:
-          description: Optionally defines the LaunchSecurity to be used by the instancetype.
-          properties:
-            sev:
-              description: AMD Secure Encrypted Virtualization (SEV).
-              properties:
-                attestation:
-                  description: If specified, run the attestation process for a vmi.
-                  type: object
-                dhCert:
-                  description: Base64 encoded guest owner's Diffie-Hellman key.
-                  type: string
-                policy:
-                  description: 'Guest policy flags as defined in AMD SEV API specification.
-                    Note: due to security reasons it is not allowed to enable guest
-                    debugging. Therefore NoDebug flag is not exposed to users and
-                    is always true.'
-                  properties:
-                    encryptedState:
-                      description: SEV-ES is required. Defaults to false.
-                      type: boolean
-                  type: object
-                session:
-                  description: Base64 encoded session blob.
-                  type: string
-              type: object
-          type: object
-        memory:
-          description: Required Memory related attributes of the instancetype.
-          properties:
-            guest:
-              anyOf:
-              - type: integer
-              - type: string
-              description: Required amount of memory which is visible inside the guest
-                OS.
-              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-              x-kubernetes-int-or-string: true
-            hugepages:
-              description: Optionally enables the use of hugepages for the VirtualMachineInstance
-                instead of regular memory.
-              properties:
-                pageSize:
-                  description: PageSize specifies the hugepage size, for x86_64 architecture
-                    valid values are 1Gi and 2Mi.
-                  type: string
-              type: object
-            overcommitPercent:
-              description: OvercommitPercent is the percentage of the guest memory
-                which will be overcommitted. This means that the VMIs parent pod (virt-launcher)
-                will request less physical memory by a factor specified by the OvercommitPercent.
-                Overcommits can lead to memory exhaustion, which in turn can lead
-                to crashes. Use carefully. Defaults to 0
-              maximum: 100
-              minimum: 0
-              type: integer
-          required:
-          - guest
-          type: object
-        nodeSelector:
-          additionalProperties:
-            type: string
-          description: "NodeSelector is a selector which must be true for the vmi
-            to fit on a node. Selector which must match a node's labels for the vmi
-            to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
-            \n NodeSelector is the name of the custom node selector for the instancetype."
-          type: object
-        schedulerName:
-          description: "If specified, the VMI will be dispatched by specified scheduler.
-            If not specified, the VMI will be dispatched by default scheduler. \n
-            SchedulerName is the name of the custom K8s scheduler for the instancetype."
-          type: string
-      required:
-      - cpu
-      - memory
-      type: object
-  required:
-  - spec
-  type: object
-`,
-	"virtualmachinepool": `openAPIV3Schema:
-  description: VirtualMachinePool resource contains a VirtualMachine configuration
-    that can be used to replicate multiple VirtualMachine resources.
-  properties:
-    apiVersion:
-      description: 'APIVersion defines the versioned schema of this representation
-        of an object. Servers should convert recognized schemas to the latest internal
-        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
-      type: string
-    kind:
-      description: 'Kind is a string value representing the REST resource this object
-        represents. Servers may infer this from the endpoint the client submits requests
-        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
-      type: string
-    metadata:
-      type: object
-    spec:
-      properties:
         paused:
           description: Indicates that the pool is paused.
           type: boolean
+        persistentVolumeClaimRetentionPolicy:
+          description: PersistentVolumeClaimRetentionPolicy describes the lifecycle
+            of the DataVolumes created from DataVolumeTemplates. By default they are
+            retained when the pool is scaled in or deleted.
+          properties:
+            whenDeleted:
+              description: WhenDeleted specifies what happens to the DataVolumes when
+                the pool is deleted. The pool owns the DataVolumes, or their claims
+                once the DataVolumes are garbage collected, while the policy is Delete.
+                Defaults to Retain.
+              type: string
+            whenScaled:
+              description: WhenScaled specifies what happens to the DataVolumes of
+                an index when the pool is scaled in and the VirtualMachine of the
+                index is removed. Defaults to Retain.
+              type: string
+          type: object
         replicas:
           description: Number of desired pods. This is a pointer to distinguish between
             explicit zero and not specified. Defaults to 1.
           format: int32
           type: integer
+        scaleInPolicy:
+          description: ScaleInPolicy determines which VirtualMachines are removed
+            first when the pool is scaled in. One of Random, OldestFirst, NewestFirst,
+            NotReadyFirst or LeastLoaded. Defaults to Random.
+          type: string
         selector:
           description: Label selector for pods. Existing Poolss whose pods are selected
             by this will be the ones affected by this deployment.
@@ -17258,6 +19147,48 @@ var CRDsValidation map[string]string = map[string]string{
                 contains only "value". The requirements are ANDed.
               type: object
           type: object
+        updateStrategy:
+          description: UpdateStrategy controls how running VirtualMachines are updated
+            when the template changes. Without an update strategy all outdated VirtualMachines
+            are restarted at once.
+          properties:
+            rollingUpdate:
+              description: RollingUpdate paces the restarts of outdated VirtualMachines.
+              properties:
+                maxSurge:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxSurge is the maximum number of VirtualMachines which
+                    are created on top of the desired replicas while the update is
+                    in progress. Value can be an absolute number or a percentage of
+                    the desired replicas, rounded up. Defaults to 0.
+                  x-kubernetes-int-or-string: true
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: MaxUnavailable is the maximum number of VirtualMachines
+                    which can be unavailable during the update. Value can be an absolute
+                    number or a percentage of the desired replicas, rounded down.
+                    Defaults to 1.
+                  x-kubernetes-int-or-string: true
+                partition:
+                  description: Partition restricts the update to the VirtualMachines
+                    with an index greater than or equal to the partition. VirtualMachines
+                    with a lower index keep the template they were created from. Defaults
+                    to 0.
+                  format: int32
+                  type: integer
+                preferLiveUpdate:
+                  description: PreferLiveUpdate applies template changes limited to
+                    live updatable features, like CPU sockets, guest memory or node
+                    affinity, to running VirtualMachines without restarting them.
+                    It takes effect for the features enabled in the liveUpdateFeatures
+                    of the template.
+                  type: boolean
+              type: object
+          type: object
         virtualMachineTemplate:
           description: Template describes the VM that will be created.
           properties:
@@ -19873,6 +21804,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           interface address and its tag will be provided
                                           to the guest via config drive
                                         type: string
+                                      vhostuser:
+                                        description: InterfaceVhostUser connects to
+                                          a given network via a vhost-user socket
+                                          served by a userspace data plane, such as
+                                          OVS-DPDK.
+                                        type: object
                                     required:
                                     - name
                                     type: object
@@ -21452,6 +23389,18 @@ var CRDsValidation map[string]string = map[string]string{
         replicas:
           format: int32
           type: integer
+        updateRevision:
+          description: UpdateRevision is the name of the revision of the pool spec
+            VirtualMachines are updated to. Only reported when an update strategy
+            is set.
+          type: string
+        updatedReplicas:
+          description: UpdatedReplicas is the number of VirtualMachines which run
+            the update revision, either because their instance was created from it
+            or because they are stopped and will start from it. Only reported when
+            an update strategy is set.
+          format: int32
+          type: integer
       type: object
   required:
   - spec
@@ -22051,6 +24000,11 @@ var CRDsValidation map[string]string = map[string]string{
     spec:
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
+        mode:
+          description: Mode defines whether the whole VirtualMachine is restored,
+            or only its volumes as standalone PersistentVolumeClaims named after the
+            target. Defaults to VirtualMachine
+          type: string
         patches:
           description: "If the target for the restore does not exist, it will be created.
             Patches holds JSON patches that would be applied to the target manifest
@@ -22079,8 +24033,25 @@ var CRDsValidation map[string]string = map[string]string{
           - kind
           - name
           type: object
+        targetNamespace:
+          description: TargetNamespace is the namespace the target is restored into,
+            it defaults to the namespace of the VirtualMachineRestore. Restoring into
+            another namespace requires the CrossNamespaceVolumeDataSource feature
+            of the cluster, and a ReferenceGrant allowing the PersistentVolumeClaims
+            of the target namespace to be populated from the VolumeSnapshots of the
+            namespace of the VirtualMachineRestore
+          type: string
         virtualMachineSnapshotName:
           type: string
+        volumes:
+          description: Volumes lists the names of the volumes to restore, the other
+            volumes of the target are left untouched. All the volumes are restored
+            when empty. Volumes can only be restored selectively into an existing
+            VirtualMachine or with the VolumesOnly mode
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
       required:
       - target
       - virtualMachineSnapshotName
@@ -22277,6 +24248,137 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule defines the periodic snapshotting of
+    a set of VMs
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy is set on the snapshots taken by the schedule
+          type: string
+        failureDeadline:
+          description: FailureDeadline is set on the snapshots taken by the schedule
+          type: string
+        freeze:
+          description: Freeze defines whether the guest file systems have to be frozen
+            for a snapshot to be taken. Defaults to BestEffort
+          type: string
+        retention:
+          description: Retention defines which of the snapshots taken by the schedule
+            are kept. All of them are kept when unset
+          properties:
+            age:
+              description: Age is the maximum age of the snapshots kept
+              type: string
+            count:
+              description: Count is the maximum number of snapshots kept for each
+                VM
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: Schedule is the cron expression, in the standard five fields
+            format and evaluated in UTC, at which the selected VMs are snapshotted
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines to snapshot, in the namespace
+            of the schedule
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements.
+                The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains
+                  values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set
+                      of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator
+                      is In or NotIn, the values array must be non-empty. If the operator
+                      is Exists or DoesNotExist, the values array must be empty. This
+                      array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value}
+                in the matchLabels map is equivalent to an element of matchExpressions,
+                whose key field is "key", the operator is "In", and the values array
+                contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        suspend:
+          description: Suspend stops the schedule from taking new snapshots, the retention
+            still applies
+          type: boolean
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule
+        resource
+      properties:
+        error:
+          description: Error is the last failure of the schedule, either to take or
+            to complete a snapshot
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastScheduleTime:
+          description: LastScheduleTime is the last time the VMs were snapshotted
+            by the schedule
+          format: date-time
+          nullable: true
+          type: string
+        lastSuccessTime:
+          description: LastSuccessTime is the creation time of the last snapshot which
+            succeeded
+          format: date-time
+          nullable: true
+          type: string
+        nextScheduleTime:
+          description: NextScheduleTime is the next time the VMs are going to be snapshotted
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinesnapshotcontent": `openAPIV3Schema:
   description: VirtualMachineSnapshotContent contains the snapshot data
@@ -25050,6 +27152,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               will be provided to the guest via config
                                               drive
                                             type: string
+                                          vhostuser:
+                                            description: InterfaceVhostUser connects
+                                              to a given network via a vhost-user
+                                              socket served by a userspace data plane,
+                                              such as OVS-DPDK.
+                                            type: object
                                         required:
                                         - name
                                         type: object
@@ -201,6 +201,9 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinesnapshots",
 					"virtualmachinerestores",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinegroupsnapshots",
+					"virtualmachinegrouprestores",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -27,7 +27,9 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/api/ksm"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/nodecapabilities"
 )
 
 const (
@@ -41,6 +43,8 @@ const (
 	VMInstancesGuestOSInfo = "virtualmachineinstances/guestosinfo"
 	VMInstancesFileSysList = "virtualmachineinstances/filesystemlist"
 	VMInstancesUserList    = "virtualmachineinstances/userlist"
+	VMInstancesGuestExec   = "virtualmachineinstances/guestexec"
+	VMInstancesGuestFile   = "virtualmachineinstances/guestfile"
 
 	VMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	VMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
@@ -153,6 +157,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesGuestFile,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -172,6 +177,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
+					VMInstancesGuestExec,
+					VMInstancesGuestFile,
 					VMInstancesSEVSetupSession,
 					VMInstancesSEVInjectLaunchSecret,
 				},
@@ -241,6 +248,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinegroupsnapshots",
+					"virtualmachinegrouprestores",
 					"virtualmachinerestores",
 				},
 				Verbs: []string{
@@ -289,11 +299,23 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinedisruptionbudgets",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupNamePool,
+				},
+				Resources: []string{
+					"virtualmachinepools/scale",
+				},
+				Verbs: []string{
+					"get", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					migrations.GroupName,
@@ -305,6 +327,28 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					nodecapabilities.GroupName,
+				},
+				Resources: []string{
+					nodecapabilities.ResourceNodeCapabilities,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -335,6 +379,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesGuestFile,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -354,6 +399,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
+					VMInstancesGuestExec,
+					VMInstancesGuestFile,
 					VMInstancesSEVSetupSession,
 					VMInstancesSEVInjectLaunchSecret,
 				},
@@ -423,6 +470,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinegroupsnapshots",
+					"virtualmachinegrouprestores",
 					"virtualmachinerestores",
 				},
 				Verbs: []string{
@@ -471,11 +521,23 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinedisruptionbudgets",
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupNamePool,
+				},
+				Resources: []string{
+					"virtualmachinepools/scale",
+				},
+				Verbs: []string{
+					"get", "update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -498,6 +560,28 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					nodecapabilities.GroupName,
+				},
+				Resources: []string{
+					nodecapabilities.ResourceNodeCapabilities,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -565,6 +649,9 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"virtualmachinesnapshots",
 					"virtualmachinesnapshotcontents",
+					"virtualmachinesnapshotschedules",
+					"virtualmachinegroupsnapshots",
+					"virtualmachinegrouprestores",
 					"virtualmachinerestores",
 				},
 				Verbs: []string{
@@ -613,6 +700,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"virtualmachinepools",
+					"virtualmachinedisruptionbudgets",
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -629,6 +717,28 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					nodecapabilities.GroupName,
+				},
+				Resources: []string{
+					nodecapabilities.ResourceNodeCapabilities,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -31,6 +31,7 @@ import (
 	"kubevirt.io/api/instancetype"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/ksm"
 	"kubevirt.io/api/migrations"
 )
 
@@ -340,6 +341,9 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"virtualmachinepools/finalizers",
 					"virtualmachinepools/status",
 					"virtualmachinepools/scale",
+					"virtualmachinedisruptionbudgets",
+					"virtualmachinedisruptionbudgets/finalizers",
+					"virtualmachinedisruptionbudgets/status",
 				},
 
 				Verbs: []string{
@@ -352,6 +356,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies + "/status",
+				},
+				Verbs: []string{
+					"patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"kubevirt.io",
@@ -491,6 +517,17 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					migrations.GroupName,
+				},
+				Resources: []string{
+					migrations.ResourceMigrationPolicies + "/status",
+				},
+				Verbs: []string{
+					"update", "patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					clone.GroupName,
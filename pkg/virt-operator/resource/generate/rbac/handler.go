@@ -26,7 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/ksm"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/nodecapabilities"
 
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
@@ -156,6 +158,39 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					ksm.GroupName,
+				},
+				Resources: []string{
+					ksm.ResourceKSMPolicies + "/status",
+				},
+				Verbs: []string{
+					"patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					nodecapabilities.GroupName,
+				},
+				Resources: []string{
+					nodecapabilities.ResourceNodeCapabilities,
+				},
+				Verbs: []string{
+					"get", "create", "update",
+				},
+			},
 		},
 	}
 }
@@ -519,6 +519,18 @@ func (c *KubeVirtDeploymentConfig) GetExportServerVersion() string {
 	return c.KubeVirtVersion
 }
 
+func (c *KubeVirtDeploymentConfig) GetGsVersion() string {
+	if c.UseShasums() {
+		return c.GsSha
+	}
+
+	if digest := DigestFromImageName(c.GsImage); digest != "" {
+		return digest
+	}
+
+	return c.KubeVirtVersion
+}
+
 func (c *KubeVirtDeploymentConfig) GetPrHelperVersion() string {
 	if c.UseShasums() {
 		return c.PrHelperSha
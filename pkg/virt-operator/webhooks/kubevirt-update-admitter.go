@@ -78,6 +78,9 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *
 	results = append(results, validateCustomizeComponents(newKV.Spec.CustomizeComponents)...)
 	results = append(results, validateCertificates(newKV.Spec.CertificateRotationStrategy.SelfSigned)...)
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
+	results = append(results, validateMigrationConfiguration(field.NewPath("spec").Child("configuration", "migrations"), newKV.Spec.Configuration.MigrationConfiguration)...)
+	results = append(results, validateNetworkBindings(field.NewPath("spec").Child("configuration", "network", "binding"), newKV.Spec.Configuration.NetworkConfiguration)...)
+	results = append(results, validateWorkloadUpdateStrategy(field.NewPath("spec").Child("workloadUpdateStrategy"), &newKV.Spec.WorkloadUpdateStrategy)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
 		if newKV.Spec.Configuration.TLSConfiguration != nil {
@@ -485,3 +488,100 @@ func validateGuestToRequestHeadroom(ratioStrPtr *string) (causes []metav1.Status
 
 	return
 }
+
+func validateMigrationConfiguration(field *field.Path, migrationConfiguration *v1.MigrationConfiguration) (causes []metav1.StatusCause) {
+	if migrationConfiguration == nil {
+		return
+	}
+
+	if threads := migrationConfiguration.ParallelMigrationThreads; threads != nil && *threads <= 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("parallel migration thread count (%d) must be larger than 1", *threads),
+			Field:   field.Child("parallelMigrationThreads").String(),
+		})
+	}
+
+	return
+}
+
+func validateNetworkBindings(field *field.Path, networkConfiguration *v1.NetworkConfiguration) (causes []metav1.StatusCause) {
+	if networkConfiguration == nil {
+		return
+	}
+
+	for name, binding := range networkConfiguration.Binding {
+		switch binding.DomainAttachmentType {
+		case "", v1.Tap, v1.ManagedTap, v1.VhostUser:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("domain attachment type %q of network binding %q is not supported", binding.DomainAttachmentType, name),
+				Field:   field.Key(name).Child("domainAttachmentType").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func validateWorkloadUpdateStrategy(field *field.Path, strategy *v1.KubeVirtWorkloadUpdateStrategy) (causes []metav1.StatusCause) {
+	if percentage := strategy.CanaryPercentage; percentage != nil && (*percentage < 1 || *percentage > 100) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("canary percentage (%d) must be between 1 and 100", *percentage),
+			Field:   field.Child("canaryPercentage").String(),
+		})
+	} else if percentage != nil && !hasWorkloadUpdateMethod(strategy, v1.WorkloadUpdateMethodLiveMigrate) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("canary requires the %s workload update method", v1.WorkloadUpdateMethodLiveMigrate),
+			Field:   field.Child("canaryPercentage").String(),
+		})
+	}
+
+	if period := strategy.CanaryPeriod; period != nil && period.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("canary period (%s) must not be negative", period.Duration),
+			Field:   field.Child("canaryPeriod").String(),
+		})
+	}
+
+	if failed := strategy.MaxFailedMigrations; failed != nil && *failed < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("max failed migrations (%d) must not be negative", *failed),
+			Field:   field.Child("maxFailedMigrations").String(),
+		})
+	}
+
+	for i, stage := range strategy.RolloutOrder {
+		stageField := field.Child("rolloutOrder").Index(i)
+		if _, err := metav1.LabelSelectorAsSelector(stage.NodeSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid node selector: %v", err),
+				Field:   stageField.Child("nodeSelector").String(),
+			})
+		}
+		if _, err := metav1.LabelSelectorAsSelector(stage.NamespaceSelector); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid namespace selector: %v", err),
+				Field:   stageField.Child("namespaceSelector").String(),
+			})
+		}
+	}
+
+	return
+}
+
+func hasWorkloadUpdateMethod(strategy *v1.KubeVirtWorkloadUpdateStrategy, method v1.WorkloadUpdateMethod) bool {
+	for _, m := range strategy.WorkloadUpdateMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
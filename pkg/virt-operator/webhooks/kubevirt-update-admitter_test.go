@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -162,6 +163,97 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with MigrationConfiguration", func() {
+		migrationsField := field.NewPath("spec", "configuration", "migrations")
+
+		DescribeTable("should reject parallel migration threads", func(threads uint32) {
+			causes := validateMigrationConfiguration(migrationsField, &v1.MigrationConfiguration{ParallelMigrationThreads: &threads})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.configuration.migrations.parallelMigrationThreads"))
+		},
+			Entry("zero", uint32(0)),
+			Entry("one", uint32(1)),
+		)
+
+		DescribeTable("should accept", func(migrationConfiguration *v1.MigrationConfiguration) {
+			Expect(validateMigrationConfiguration(migrationsField, migrationConfiguration)).To(BeEmpty())
+		},
+			Entry("no migration configuration", nil),
+			Entry("unset parallel migration threads", &v1.MigrationConfiguration{}),
+			Entry("multiple parallel migration threads", &v1.MigrationConfiguration{ParallelMigrationThreads: pointer.Uint32(8)}),
+		)
+	})
+
+	Context("with network binding plugins", func() {
+		bindingField := field.NewPath("spec", "configuration", "network", "binding")
+
+		It("should reject unknown domain attachment type", func() {
+			causes := validateNetworkBindings(bindingField, &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"foo": {DomainAttachmentType: "bar"}},
+			})
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("spec.configuration.network.binding[foo].domainAttachmentType"))
+		})
+
+		DescribeTable("should accept", func(domainAttachmentType v1.DomainAttachmentType) {
+			Expect(validateNetworkBindings(bindingField, &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"foo": {DomainAttachmentType: domainAttachmentType}},
+			})).To(BeEmpty())
+		},
+			Entry("unset domain attachment type", v1.DomainAttachmentType("")),
+			Entry("tap", v1.Tap),
+			Entry("managedTap", v1.ManagedTap),
+			Entry("vhostuser", v1.VhostUser),
+		)
+	})
+
+	Context("with workload update strategy", func() {
+		strategyField := field.NewPath("spec", "workloadUpdateStrategy")
+
+		DescribeTable("should reject", func(strategy *v1.KubeVirtWorkloadUpdateStrategy, expectedField string) {
+			causes := validateWorkloadUpdateStrategy(strategyField, strategy)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("zero canary percentage", &v1.KubeVirtWorkloadUpdateStrategy{CanaryPercentage: pointer.Int(0)}, "spec.workloadUpdateStrategy.canaryPercentage"),
+			Entry("canary percentage above 100", &v1.KubeVirtWorkloadUpdateStrategy{CanaryPercentage: pointer.Int(101)}, "spec.workloadUpdateStrategy.canaryPercentage"),
+			Entry("canary without live migration", &v1.KubeVirtWorkloadUpdateStrategy{
+				WorkloadUpdateMethods: []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodEvict},
+				CanaryPercentage:      pointer.Int(10),
+			}, "spec.workloadUpdateStrategy.canaryPercentage"),
+			Entry("negative canary period", &v1.KubeVirtWorkloadUpdateStrategy{CanaryPeriod: &metav1.Duration{Duration: -time.Minute}}, "spec.workloadUpdateStrategy.canaryPeriod"),
+			Entry("negative max failed migrations", &v1.KubeVirtWorkloadUpdateStrategy{MaxFailedMigrations: pointer.Int(-1)}, "spec.workloadUpdateStrategy.maxFailedMigrations"),
+			Entry("invalid node selector", &v1.KubeVirtWorkloadUpdateStrategy{
+				RolloutOrder: []v1.WorkloadUpdateRolloutStage{{}, {
+					NodeSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "zone", Operator: "Unknown"}}},
+				}},
+			}, "spec.workloadUpdateStrategy.rolloutOrder[1].nodeSelector"),
+			Entry("invalid namespace selector", &v1.KubeVirtWorkloadUpdateStrategy{
+				RolloutOrder: []v1.WorkloadUpdateRolloutStage{{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "not valid"}},
+				}},
+			}, "spec.workloadUpdateStrategy.rolloutOrder[0].namespaceSelector"),
+		)
+
+		DescribeTable("should accept", func(strategy *v1.KubeVirtWorkloadUpdateStrategy) {
+			Expect(validateWorkloadUpdateStrategy(strategyField, strategy)).To(BeEmpty())
+		},
+			Entry("empty strategy", &v1.KubeVirtWorkloadUpdateStrategy{}),
+			Entry("canary", &v1.KubeVirtWorkloadUpdateStrategy{
+				WorkloadUpdateMethods: []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict},
+				CanaryPercentage:      pointer.Int(10),
+				CanaryPeriod:          &metav1.Duration{Duration: time.Hour},
+			}),
+			Entry("no tolerated failed migrations", &v1.KubeVirtWorkloadUpdateStrategy{MaxFailedMigrations: pointer.Int(0)}),
+			Entry("rollout order", &v1.KubeVirtWorkloadUpdateStrategy{
+				RolloutOrder: []v1.WorkloadUpdateRolloutStage{{
+					NodeSelector:      &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}},
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "dev"}},
+				}},
+			}),
+		)
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
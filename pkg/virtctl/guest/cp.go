@@ -0,0 +1,131 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package guest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+type copyCommand struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+func NewCopyCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := copyCommand{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "cp (SOURCE) (DESTINATION)",
+		Short: "Copy a file from or to a virtual machine instance via the guest agent.",
+		Long: `Copy a file from or to a virtual machine instance via the guest agent.
+The location inside the guest is given as (VMI):(PATH) and has to be an absolute path.
+Only regular files up to 1MiB can be copied.`,
+		Args:    templates.ExactArgs(COMMAND_CP, 2),
+		Example: copyUsage(),
+		RunE:    c.run,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func copyUsage() string {
+	return `  # Copy a local file into the virtual machine instance 'myvmi':
+  {{ProgramName}} guest cp myconfig.yaml myvmi:/etc/myapp/config.yaml
+
+  # Copy a file of the virtual machine instance 'myvmi' in namespace 'mynamespace' into the local directory:
+  {{ProgramName}} guest cp vmi/myvmi.mynamespace:/var/log/myapp.log .`
+}
+
+func (c *copyCommand) run(cmd *cobra.Command, args []string) error {
+	local, remote, toRemote, err := templates.ParseSCPArguments(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if remote.Username != "" {
+		return fmt.Errorf("a username is not supported, files are accessed as the user running the guest agent")
+	}
+	if remote.Path == "" {
+		return fmt.Errorf("expected a path inside the guest after ':'")
+	}
+	if remote.Namespace == "" {
+		if remote.Namespace, _, err = c.clientConfig.Namespace(); err != nil {
+			return err
+		}
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+	vmiClient := virtClient.VirtualMachineInstance(remote.Namespace)
+
+	if toRemote {
+		return copyToGuest(vmiClient, remote, local)
+	}
+	return copyFromGuest(vmiClient, remote, local)
+}
+
+func copyToGuest(vmiClient kubecli.VirtualMachineInstanceInterface, remote templates.RemoteSCPArgument, local templates.LocalSCPArgument) error {
+	content, err := os.ReadFile(local.Path)
+	if err != nil {
+		return err
+	}
+
+	guestPath := remote.Path
+	if strings.HasSuffix(guestPath, "/") {
+		guestPath = path.Join(guestPath, filepath.Base(local.Path))
+	}
+
+	file := &v1.VirtualMachineInstanceGuestFile{
+		Path:    guestPath,
+		Content: content,
+	}
+	if err := vmiClient.GuestFileWrite(context.Background(), remote.Name, file); err != nil {
+		return fmt.Errorf("Error copying %s to VirtualMachineInstance %s: %v", local.Path, remote.Name, err)
+	}
+	return nil
+}
+
+func copyFromGuest(vmiClient kubecli.VirtualMachineInstanceInterface, remote templates.RemoteSCPArgument, local templates.LocalSCPArgument) error {
+	file, err := vmiClient.GuestFileRead(context.Background(), remote.Name, remote.Path)
+	if err != nil {
+		return fmt.Errorf("Error copying %s from VirtualMachineInstance %s: %v", remote.Path, remote.Name, err)
+	}
+	if file.Truncated {
+		return fmt.Errorf("Error copying %s from VirtualMachineInstance %s: the file exceeds the size limit", remote.Path, remote.Name)
+	}
+
+	localPath := local.Path
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(remote.Path))
+	}
+	return os.WriteFile(localPath, file.Content, 0644)
+}
@@ -0,0 +1,85 @@
+package guest_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guest"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Guest cp", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+	var tmpDir string
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	DescribeTable("should fail with invalid locations", func(src, dst string) {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_CP, src, dst)
+		Expect(cmd()).ToNot(Succeed())
+	},
+		Entry("with two local locations", "a", "b"),
+		Entry("with two guest locations", vmiName+":/a", vmiName+":/b"),
+		Entry("with a username", "a", "user@"+vmiName+":/b"),
+		Entry("without a guest path", "a", vmiName+":"),
+	)
+
+	It("should copy a local file into the guest", func() {
+		localPath := filepath.Join(tmpDir, "config.yaml")
+		Expect(os.WriteFile(localPath, []byte("test"), 0644)).To(Succeed())
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileWrite(context.Background(), vmiName, &v1.VirtualMachineInstanceGuestFile{
+			Path:    "/etc/myapp/config.yaml",
+			Content: []byte("test"),
+		}).Return(nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_CP, localPath, vmiName+":/etc/myapp/")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should copy a guest file into a local directory", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, "/var/log/myapp.log").Return(v1.VirtualMachineInstanceGuestFile{
+			Path:    "/var/log/myapp.log",
+			Content: []byte("test"),
+		}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_CP, "vmi/"+vmiName+".mynamespace:/var/log/myapp.log", tmpDir)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(tmpDir, "myapp.log"))).To(Equal([]byte("test")))
+	})
+
+	It("should not write a truncated guest file", func() {
+		localPath := filepath.Join(tmpDir, "myapp.log")
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileRead(context.Background(), vmiName, "/var/log/myapp.log").Return(v1.VirtualMachineInstanceGuestFile{
+			Path:      "/var/log/myapp.log",
+			Content:   []byte("test"),
+			Truncated: true,
+		}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_CP, vmiName+":/var/log/myapp.log", localPath)
+		Expect(cmd()).To(MatchError(ContainSubstring("exceeds the size limit")))
+		Expect(localPath).ToNot(BeAnExistingFile())
+	})
+})
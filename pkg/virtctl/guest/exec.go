@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package guest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const timeoutFlag = "timeout"
+
+type execCommand struct {
+	clientConfig   clientcmd.ClientConfig
+	timeoutSeconds int32
+}
+
+func NewExecCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := execCommand{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "exec (VMI) -- (COMMAND) [ARGS...]",
+		Short: "Execute a command inside a virtual machine instance via the guest agent.",
+		Long: `Execute a command inside a virtual machine instance via the guest agent.
+The command is executed directly without a shell, its output is printed once it exited.`,
+		Args:    cobra.MinimumNArgs(2),
+		Example: execUsage(),
+		RunE:    c.run,
+	}
+	cmd.Flags().Int32Var(&c.timeoutSeconds, timeoutFlag, 0, "Seconds the command is allowed to run, the server default is used when unset.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func execUsage() string {
+	return `  # List the root directory of the virtual machine instance 'myvmi':
+  {{ProgramName}} guest exec myvmi -- /usr/bin/ls -l /
+
+  # Run a command which is allowed to take up to two minutes:
+  {{ProgramName}} guest exec myvmi --timeout 120 -- /usr/bin/sleep 100`
+}
+
+func (c *execCommand) run(cmd *cobra.Command, args []string) error {
+	_, namespace, name, err := templates.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		if namespace, _, err = c.clientConfig.Namespace(); err != nil {
+			return err
+		}
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	execRequest := &v1.VirtualMachineInstanceGuestExecRequest{
+		Command: args[1],
+		Args:    args[2:],
+	}
+	if cmd.Flags().Changed(timeoutFlag) {
+		execRequest.TimeoutSeconds = &c.timeoutSeconds
+	}
+
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(context.Background(), name, execRequest)
+	if err != nil {
+		return fmt.Errorf("Error executing command in VirtualMachineInstance %s: %v", name, err)
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), result.StdOut)
+	fmt.Fprint(cmd.ErrOrStderr(), result.StdErr)
+	if result.ExitCode != 0 {
+		return fmt.Errorf("command exited with code %d", result.ExitCode)
+	}
+	return nil
+}
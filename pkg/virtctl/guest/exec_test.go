@@ -0,0 +1,78 @@
+package guest_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guest"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Guest exec", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	It("should fail without a command", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_EXEC, vmiName)
+		Expect(cmd()).ToNot(Succeed())
+	})
+
+	It("should execute the command and print its output without the error output", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, &v1.VirtualMachineInstanceGuestExecRequest{
+			Command: "/usr/bin/echo",
+			Args:    []string{"-n", "hello"},
+		}).Return(v1.VirtualMachineInstanceGuestExecResult{StdOut: "hello", StdErr: "warning"}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(guest.COMMAND_GUEST, guest.COMMAND_EXEC, vmiName, "--", "/usr/bin/echo", "-n", "hello")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("hello"))
+	})
+
+	It("should pass the timeout and the namespace", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, &v1.VirtualMachineInstanceGuestExecRequest{
+			Command:        "/usr/bin/true",
+			Args:           []string{},
+			TimeoutSeconds: pointer.Int32(120),
+		}).Return(v1.VirtualMachineInstanceGuestExecResult{}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_EXEC, vmiName+".mynamespace", "--timeout", "120", "--", "/usr/bin/true")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail when the command exits with an error", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, gomock.Any()).Return(v1.VirtualMachineInstanceGuestExecResult{ExitCode: 2}, nil).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_EXEC, vmiName, "--", "/usr/bin/false")
+		Expect(cmd()).To(MatchError("command exited with code 2"))
+	})
+
+	It("should fail when the request fails", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, gomock.Any()).Return(v1.VirtualMachineInstanceGuestExecResult{}, fmt.Errorf("agent not connected")).Times(1)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guest.COMMAND_GUEST, guest.COMMAND_EXEC, vmiName, "--", "/usr/bin/true")
+		Expect(cmd()).To(MatchError(ContainSubstring("agent not connected")))
+	})
+})
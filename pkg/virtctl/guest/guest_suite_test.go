@@ -0,0 +1,11 @@
+package guest_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuest(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const noneValue = "<none>"
+
+// capability is a single row of the capabilities of a node, list capabilities
+// are compared item by item while scalar capabilities are compared as a whole
+type capability struct {
+	name   string
+	values []string
+	list   bool
+}
+
+func NewCapabilitiesCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities (NODE)",
+		Short: "Show the host capabilities virt-handler published for a node.",
+		Args:  templates.ExactArgs(COMMAND_CAPABILITIES, 1),
+		Example: `  # Show the CPU models, machine types and devices of 'node01':
+  {{ProgramName}} node capabilities node01`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return showCapabilities(cmd, clientConfig, args[0])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewCompareCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compare (NODE) (NODE)",
+		Short: "Show the differences between the host capabilities of two nodes.",
+		Long: `Show the differences between the host capabilities of two nodes.
+For lists like CPU models or features only the entries missing on one of the nodes are shown.`,
+		Args: templates.ExactArgs(COMMAND_COMPARE, 2),
+		Example: `  # Check why a virtual machine instance can not be migrated from 'node01' to 'node02':
+  {{ProgramName}} node compare node01 node02`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return compareCapabilities(cmd, clientConfig, args[0], args[1])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func getCapabilities(clientConfig clientcmd.ClientConfig, nodeNames ...string) ([]*nodecapabilitiesv1alpha1.NodeCapabilities, error) {
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	nodeCapabilities := make([]*nodecapabilitiesv1alpha1.NodeCapabilities, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		capabilities, err := virtClient.NodeCapabilities().Get(context.Background(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Error getting the capabilities of node %s: %v", nodeName, err)
+		}
+		nodeCapabilities = append(nodeCapabilities, capabilities)
+	}
+	return nodeCapabilities, nil
+}
+
+func showCapabilities(cmd *cobra.Command, clientConfig clientcmd.ClientConfig, nodeName string) error {
+	nodeCapabilities, err := getCapabilities(clientConfig, nodeName)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	for _, c := range capabilitiesOf(&nodeCapabilities[0].Status) {
+		value := noneValue
+		if len(c.values) > 0 {
+			value = strings.Join(c.values, ", ")
+		}
+		fmt.Fprintf(w, "%s:\t%s\n", c.name, value)
+	}
+	return w.Flush()
+}
+
+func compareCapabilities(cmd *cobra.Command, clientConfig clientcmd.ClientConfig, firstNode, secondNode string) error {
+	nodeCapabilities, err := getCapabilities(clientConfig, firstNode, secondNode)
+	if err != nil {
+		return err
+	}
+
+	first := capabilitiesOf(&nodeCapabilities[0].Status)
+	second := capabilitiesOf(&nodeCapabilities[1].Status)
+
+	var rows [][3]string
+	for i := range first {
+		if first[i].list {
+			onlyFirst, onlySecond := difference(first[i].values, second[i].values), difference(second[i].values, first[i].values)
+			if len(onlyFirst) > 0 || len(onlySecond) > 0 {
+				rows = append(rows, [3]string{first[i].name, joinOrNone(onlyFirst), joinOrNone(onlySecond)})
+			}
+		} else if joinOrNone(first[i].values) != joinOrNone(second[i].values) {
+			rows = append(rows, [3]string{first[i].name, joinOrNone(first[i].values), joinOrNone(second[i].values)})
+		}
+	}
+
+	if len(rows) == 0 {
+		cmd.Printf("Nodes %s and %s have the same capabilities\n", firstNode, secondNode)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "CAPABILITY\t%s\t%s\n", firstNode, secondNode)
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", row[0], row[1], row[2])
+	}
+	return w.Flush()
+}
+
+func capabilitiesOf(status *nodecapabilitiesv1alpha1.NodeCapabilitiesStatus) []capability {
+	scalar := func(name, value string) capability {
+		c := capability{name: name}
+		if value != "" {
+			c.values = []string{value}
+		}
+		return c
+	}
+	list := func(name string, values []string) capability {
+		return capability{name: name, values: values, list: true}
+	}
+
+	tscFrequency, tscScalable := "", ""
+	if status.CPU.TSC != nil {
+		tscFrequency = strconv.FormatInt(status.CPU.TSC.Frequency, 10)
+		tscScalable = strconv.FormatBool(status.CPU.TSC.Scalable)
+	}
+
+	hugepageSizes := make([]string, 0, len(status.HugepageSizes))
+	for _, size := range status.HugepageSizes {
+		hugepageSizes = append(hugepageSizes, size.String())
+	}
+
+	iommuGroups := make([]string, 0, len(status.IOMMUGroups))
+	for _, group := range status.IOMMUGroups {
+		iommuGroups = append(iommuGroups, fmt.Sprintf("%d=%s", group.ID, strings.Join(group.Devices, "+")))
+	}
+
+	return []capability{
+		scalar("CPU Vendor", status.CPU.Vendor),
+		scalar("Host CPU Model", status.CPU.HostModel),
+		scalar("Host CPU Model Obsolete", strconv.FormatBool(status.CPU.HostModelObsolete)),
+		list("Host CPU Model Required Features", status.CPU.HostModelRequiredFeatures),
+		list("CPU Models", status.CPU.Models),
+		list("CPU Features", status.CPU.Features),
+		list("Hyper-V Features", status.CPU.HypervFeatures),
+		scalar("TSC Frequency", tscFrequency),
+		scalar("TSC Scalable", tscScalable),
+		list("Machine Types", status.MachineTypes),
+		scalar("SEV", strconv.FormatBool(status.SEV.Supported)),
+		scalar("SEV-ES", strconv.FormatBool(status.SEV.SupportedES)),
+		scalar("TDX", strconv.FormatBool(status.TDX.Supported)),
+		scalar("Realtime", strconv.FormatBool(status.Realtime)),
+		list("Hugepage Sizes", hugepageSizes),
+		list("IOMMU Groups", iommuGroups),
+		scalar("Emulator Version", status.EmulatorVersion),
+	}
+}
+
+// difference returns the values of a which are not in b
+func difference(a, b []string) []string {
+	inB := make(map[string]struct{}, len(b))
+	for _, value := range b {
+		inB[value] = struct{}{}
+	}
+	var diff []string
+	for _, value := range a {
+		if _, exists := inB[value]; !exists {
+			diff = append(diff, value)
+		}
+	}
+	return diff
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return noneValue
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,96 @@
+package node_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/node"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Node capabilities", func() {
+
+	newNodeCapabilities := func(name, hostModel string, models ...string) *nodecapabilitiesv1alpha1.NodeCapabilities {
+		return &nodecapabilitiesv1alpha1.NodeCapabilities{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: nodecapabilitiesv1alpha1.NodeCapabilitiesStatus{
+				CPU: nodecapabilitiesv1alpha1.CPUCapabilities{
+					Vendor:    "Intel",
+					HostModel: hostModel,
+					Models:    models,
+				},
+				MachineTypes:    []string{"pc-q35-7.2", "q35"},
+				HugepageSizes:   []resource.Quantity{resource.MustParse("2Mi")},
+				IOMMUGroups:     []nodecapabilitiesv1alpha1.IOMMUGroup{{ID: 13, Devices: []string{"0000:03:00.0", "0000:03:00.1"}}},
+				EmulatorVersion: "7.2.0",
+			},
+		}
+	}
+
+	expectClients := func(nodeCapabilities ...*nodecapabilitiesv1alpha1.NodeCapabilities) {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		nodeCapabilitiesClient := kubevirtfake.NewSimpleClientset().NodecapabilitiesV1alpha1().NodeCapabilities()
+		for _, c := range nodeCapabilities {
+			_, err := nodeCapabilitiesClient.Create(context.Background(), c, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		kubecli.MockKubevirtClientInstance.EXPECT().NodeCapabilities().Return(nodeCapabilitiesClient).AnyTimes()
+	}
+
+	It("should show the capabilities of a node", func() {
+		expectClients(newNodeCapabilities("node01", "Skylake-Client-IBRS", "Haswell", "Penryn"))
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(node.COMMAND_NODE, node.COMMAND_CAPABILITIES, "node01")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(MatchRegexp(`Host CPU Model:\s+Skylake-Client-IBRS\n`))
+		Expect(string(out)).To(MatchRegexp(`CPU Models:\s+Haswell, Penryn\n`))
+		Expect(string(out)).To(MatchRegexp(`CPU Features:\s+<none>\n`))
+		Expect(string(out)).To(MatchRegexp(`Hugepage Sizes:\s+2Mi\n`))
+		Expect(string(out)).To(MatchRegexp(`IOMMU Groups:\s+13=0000:03:00.0\+0000:03:00.1\n`))
+		Expect(string(out)).To(MatchRegexp(`Emulator Version:\s+7.2.0\n`))
+	})
+
+	It("should fail when the node has no capabilities", func() {
+		expectClients()
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(node.COMMAND_NODE, node.COMMAND_CAPABILITIES, "node01")
+		Expect(cmd()).To(MatchError(ContainSubstring("Error getting the capabilities of node node01")))
+	})
+
+	It("should show the differences between two nodes", func() {
+		first := newNodeCapabilities("node01", "Skylake-Client-IBRS", "Haswell", "Penryn", "Skylake-Client-IBRS")
+		second := newNodeCapabilities("node02", "Haswell", "Haswell", "Penryn")
+		second.Status.EmulatorVersion = "8.0.0"
+		expectClients(first, second)
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(node.COMMAND_NODE, node.COMMAND_COMPARE, "node01", "node02")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(MatchRegexp(`(?s)^CAPABILITY\s+node01\s+node02\n` +
+			`Host CPU Model\s+Skylake-Client-IBRS\s+Haswell\n` +
+			`CPU Models\s+Skylake-Client-IBRS\s+<none>\n` +
+			`Emulator Version\s+7.2.0\s+8.0.0\n$`))
+	})
+
+	It("should report when two nodes have the same capabilities", func() {
+		expectClients(newNodeCapabilities("node01", "Haswell", "Penryn"), newNodeCapabilities("node02", "Haswell", "Penryn"))
+
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(node.COMMAND_NODE, node.COMMAND_COMPARE, "node01", "node02")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("Nodes node01 and node02 have the same capabilities"))
+	})
+})
@@ -0,0 +1,235 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	"kubevirt.io/kubevirt/pkg/util/pdbs"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const dryRunFlag = "dry-run"
+
+type drainCommand struct {
+	clientConfig clientcmd.ClientConfig
+	dryRun       bool
+}
+
+func NewDrainCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := drainCommand{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "drain (NODE) --dry-run",
+		Short: "Preview what happens to the virtual machine instances on a node when it is drained.",
+		Long: `Preview what happens to the virtual machine instances on a node when it is drained.
+VMIs are listed in the order they are migrated, followed by the VMIs which are shut down,
+block the drain or are evicted by an external controller.
+The priority classes of the VMIs, PodDisruptionBudgets, VirtualMachineDisruptionBudgets
+and the limits of parallel migrations are taken into account.
+The node itself has to be drained with 'kubectl drain'.`,
+		Args:    templates.ExactArgs(COMMAND_DRAIN, 1),
+		Example: drainUsage(),
+		RunE:    c.run,
+	}
+	cmd.Flags().BoolVar(&c.dryRun, dryRunFlag, false, "Only report what would happen to the virtual machine instances on the node.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func drainUsage() string {
+	return `  # Show which virtual machine instances would be migrated, shut down or block the drain of 'node01':
+  {{ProgramName}} node drain node01 --dry-run`
+}
+
+func (c *drainCommand) run(cmd *cobra.Command, args []string) error {
+	if !c.dryRun {
+		return fmt.Errorf("only --%s is supported, use 'kubectl drain' to drain the node", dryRunFlag)
+	}
+	nodeName := args[0]
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	kvList, err := virtClient.KubeVirt(metav1.NamespaceAll).List(&metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Error listing KubeVirt resources: %v", err)
+	}
+	if len(kvList.Items) == 0 {
+		return fmt.Errorf("No KubeVirt resource found")
+	}
+	config := kvList.Items[0].Spec.Configuration
+	migrationConfig := config.MigrationConfiguration
+	if migrationConfig == nil {
+		migrationConfig = &v1.MigrationConfiguration{}
+	}
+
+	node, err := virtClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting node %s: %v", nodeName, err)
+	}
+
+	vmiList, err := virtClient.VirtualMachineInstance(metav1.NamespaceAll).List(context.Background(), &metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Error listing VirtualMachineInstances: %v", err)
+	}
+	vmiStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	var vmis []*v1.VirtualMachineInstance
+	for i := range vmiList.Items {
+		vmi := &vmiList.Items[i]
+		if err := vmiStore.Add(vmi); err != nil {
+			return err
+		}
+		if vmi.Status.NodeName == nodeName {
+			vmis = append(vmis, vmi)
+		}
+	}
+
+	migrationList, err := virtClient.VirtualMachineInstanceMigration(metav1.NamespaceAll).List(&metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Error listing VirtualMachineInstanceMigrations: %v", err)
+	}
+	var unfinishedMigrations []*v1.VirtualMachineInstanceMigration
+	for i := range migrationList.Items {
+		if !migrationList.Items[i].IsFinal() {
+			unfinishedMigrations = append(unfinishedMigrations, &migrationList.Items[i])
+		}
+	}
+
+	podList, err := virtClient.CoreV1().Pods(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.AppLabel, "virt-launcher"),
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", nodeName),
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing virt-launcher pods on node %s: %v", nodeName, err)
+	}
+	pods := make([]*k8sv1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pods = append(pods, &podList.Items[i])
+	}
+
+	pdbList, err := virtClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Error listing PodDisruptionBudgets: %v", err)
+	}
+	podDisruptionBudgets := make([]*policyv1.PodDisruptionBudget, 0, len(pdbList.Items))
+	for i := range pdbList.Items {
+		podDisruptionBudgets = append(podDisruptionBudgets, &pdbList.Items[i])
+	}
+
+	budgetTracker, err := newDisruptionBudgetTracker(virtClient, vmiStore, unfinishedMigrations)
+	if err != nil {
+		return err
+	}
+
+	plan := migrationutils.PlanEvacuation(vmis, &migrationutils.EvacuationState{
+		EvictionStrategy:     config.EvictionStrategy,
+		Order:                migrationConfig.EvacuationOrder,
+		LauncherPods:         pods,
+		PodDisruptionBudgets: podDisruptionBudgets,
+		DisruptionBudgets:    budgetTracker,
+		FreeMigrationSlots:   freeMigrationSlots(node, migrationConfig, vmiStore, unfinishedMigrations),
+	})
+	if len(plan) == 0 {
+		cmd.Printf("No VirtualMachineInstances are running on node %s\n", nodeName)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tACTION\tREASON")
+	for _, entry := range plan {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entry.VMI.Namespace, entry.VMI.Name, entry.Action, entry.Reason)
+	}
+	return w.Flush()
+}
+
+// newDisruptionBudgetTracker tracks the VirtualMachineDisruptionBudgets of the cluster. The VirtualMachines
+// are only listed if a budget covers a pool.
+func newDisruptionBudgetTracker(virtClient kubecli.KubevirtClient, vmiStore cache.Store, migrations []*v1.VirtualMachineInstanceMigration) (*pdbs.DisruptionBudgetTracker, error) {
+	budgetList, err := virtClient.VirtualMachineDisruptionBudget(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing VirtualMachineDisruptionBudgets: %v", err)
+	}
+	budgetIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	coversPools := false
+	for i := range budgetList.Items {
+		if err := budgetIndexer.Add(&budgetList.Items[i]); err != nil {
+			return nil, err
+		}
+		coversPools = coversPools || budgetList.Items[i].Spec.VirtualMachinePoolName != ""
+	}
+
+	vmStore := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	if coversPools {
+		vmList, err := virtClient.VirtualMachine(metav1.NamespaceAll).List(context.Background(), &metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Error listing VirtualMachines: %v", err)
+		}
+		for i := range vmList.Items {
+			if err := vmStore.Add(&vmList.Items[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return pdbs.NewDisruptionBudgetTrackerFromStores(budgetIndexer, vmStore, vmiStore, migrations), nil
+}
+
+// freeMigrationSlots returns how many migrations the evacuation of the node may start right away,
+// like the evacuation controller does
+func freeMigrationSlots(node *k8sv1.Node, migrationConfig *v1.MigrationConfiguration, vmiStore cache.Store, migrations []*v1.VirtualMachineInstanceMigration) int {
+	parallelPerNode := virtconfig.ParallelOutboundMigrationsPerNodeDefault
+	if migrationConfig.ParallelOutboundMigrationsPerNode != nil {
+		parallelPerNode = *migrationConfig.ParallelOutboundMigrationsPerNode
+	}
+	parallelPerCluster := virtconfig.ParallelMigrationsPerClusterDefault
+	if migrationConfig.ParallelMigrationsPerCluster != nil {
+		parallelPerCluster = *migrationConfig.ParallelMigrationsPerCluster
+	}
+
+	runningMigrations := migrationutils.FilterRunningMigrations(migrations)
+	runningFromNode := 0
+	for _, migration := range runningMigrations {
+		obj, exists, err := vmiStore.GetByKey(migration.Namespace + "/" + migration.Spec.VMIName)
+		if err == nil && exists && obj.(*v1.VirtualMachineInstance).Status.NodeName == node.Name {
+			runningFromNode++
+		}
+	}
+
+	freeSlots := int(parallelPerCluster) - len(runningMigrations)
+	if freeOnNode := migrationutils.ParallelOutboundMigrationsForNode(node, parallelPerNode) - runningFromNode; freeOnNode < freeSlots {
+		freeSlots = freeOnNode
+	}
+	return freeSlots
+}
@@ -0,0 +1,188 @@
+package node_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	poolv1 "kubevirt.io/api/pool/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/node"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Node drain", func() {
+
+	const nodeName = "node01"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+	var kvInterface *kubecli.MockKubeVirtInterface
+	var kubeClient *fake.Clientset
+	var virtClientset *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		kvInterface = kubecli.NewMockKubeVirtInterface(ctrl)
+		kubeClient = fake.NewSimpleClientset(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}})
+		virtClientset = kubevirtfake.NewSimpleClientset()
+	})
+
+	newVMI := func(name, memory string, migratable bool) v1.VirtualMachineInstance {
+		vmi := v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, UID: types.UID(name)},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: nodeName,
+			},
+		}
+		vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)}
+		condition := v1.VirtualMachineInstanceCondition{Type: v1.VirtualMachineInstanceIsMigratable, Status: k8sv1.ConditionTrue}
+		if !migratable {
+			condition.Status = k8sv1.ConditionFalse
+			condition.Reason = v1.VirtualMachineInstanceReasonHostDeviceNotMigratable
+			condition.Message = "VMI uses a PCI host devices"
+		}
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{condition}
+		return vmi
+	}
+
+	addLauncherPod := func(vmi *v1.VirtualMachineInstance, priority int32) {
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "virt-launcher-" + vmi.Name,
+				Namespace:       vmi.Namespace,
+				Labels:          map[string]string{v1.AppLabel: "virt-launcher"},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vmi, v1.VirtualMachineInstanceGroupVersionKind)},
+			},
+			Spec:   k8sv1.PodSpec{NodeName: nodeName, Priority: &priority},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+		}
+		for key, value := range vmi.Labels {
+			pod.Labels[key] = value
+		}
+		_, err := kubeClient.CoreV1().Pods(vmi.Namespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectClients := func(kv v1.KubeVirt, vmis ...v1.VirtualMachineInstance) {
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(metav1.NamespaceAll).Return(kvInterface).Times(1)
+		kvInterface.EXPECT().List(gomock.Any()).Return(&v1.KubeVirtList{Items: []v1.KubeVirt{kv}}, nil).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceAll).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().List(context.Background(), &metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceList{Items: vmis}, nil).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceAll).Return(migrationInterface).Times(1)
+		migrationInterface.EXPECT().List(&metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{}, nil).Times(1)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineDisruptionBudget(metav1.NamespaceAll).
+			Return(virtClientset.PoolV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceAll)).Times(1)
+	}
+
+	runDryRun := func() string {
+		cmd := clientcmd.NewRepeatableVirtctlCommandWithOut(node.COMMAND_NODE, node.COMMAND_DRAIN, nodeName, "--dry-run")
+		out, err := cmd()
+		Expect(err).ToNot(HaveOccurred())
+		return string(out)
+	}
+
+	It("should fail without --dry-run", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(node.COMMAND_NODE, node.COMMAND_DRAIN, nodeName)
+		Expect(cmd()).To(MatchError(ContainSubstring("kubectl drain")))
+	})
+
+	It("should report the VMIs in evacuation order", func() {
+		kv := v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+					MigrationConfiguration: &v1.MigrationConfiguration{
+						EvacuationOrder: pointer.P(v1.EvacuationOrderLargestMemoryFirst),
+					},
+				},
+			},
+		}
+		small := newVMI("small", "1Gi", true)
+		large := newVMI("large", "8Gi", true)
+		prioritized := newVMI("prioritized", "2Gi", true)
+		addLauncherPod(&prioritized, 1000)
+		pinned := newVMI("pinned", "2Gi", false)
+		external := newVMI("external", "2Gi", true)
+		external.Spec.EvictionStrategy = pointer.P(v1.EvictionStrategyExternal)
+		elsewhere := newVMI("elsewhere", "2Gi", true)
+		elsewhere.Status.NodeName = "node02"
+		expectClients(kv, small, pinned, large, prioritized, external, elsewhere)
+
+		Expect(runDryRun()).To(MatchRegexp(`(?s)NAMESPACE\s+NAME\s+ACTION\s+REASON\n` +
+			`default\s+prioritized\s+Migrate\s+priority 1000\n` +
+			`default\s+large\s+Migrate\s*\n` +
+			`default\s+small\s+Migrate\s+waits for a free migration slot\n` +
+			`default\s+pinned\s+BlockDrain\s+HostDeviceNotLiveMigratable: VMI uses a PCI host devices\n` +
+			`default\s+external\s+External\s+eviction is handled by an external controller\n$`))
+	})
+
+	It("should report VMIs whose PodDisruptionBudget blocks the drain", func() {
+		kv := v1.KubeVirt{Spec: v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+			EvictionStrategy: pointer.P(v1.EvictionStrategyNone),
+		}}}
+		protected := newVMI("protected", "1Gi", true)
+		protected.Labels = map[string]string{"app": "database"}
+		addLauncherPod(&protected, 0)
+		unprotected := newVMI("unprotected", "1Gi", true)
+		addLauncherPod(&unprotected, 0)
+		expectClients(kv, protected, unprotected)
+
+		_, err := kubeClient.PolicyV1().PodDisruptionBudgets(metav1.NamespaceDefault).Create(context.Background(), &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: metav1.NamespaceDefault},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "database"}}},
+			Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		out := runDryRun()
+		Expect(out).To(MatchRegexp(`default\s+protected\s+BlockDrain\s+eviction strategy is None, PodDisruptionBudget database does not allow the eviction\n`))
+		Expect(out).To(MatchRegexp(`default\s+unprotected\s+Shutdown\s+eviction strategy is None\n`))
+	})
+
+	It("should report VMIs which are held back by a VirtualMachineDisruptionBudget", func() {
+		kv := v1.KubeVirt{Spec: v1.KubeVirtSpec{Configuration: v1.KubeVirtConfiguration{
+			EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+		}}}
+		first := newVMI("first", "1Gi", true)
+		first.Labels = map[string]string{"group": "a"}
+		second := newVMI("second", "1Gi", true)
+		second.Labels = map[string]string{"group": "a"}
+		expectClients(kv, first, second)
+
+		_, err := virtClientset.PoolV1alpha1().VirtualMachineDisruptionBudgets(metav1.NamespaceDefault).Create(context.Background(), &poolv1.VirtualMachineDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "group-a", Namespace: metav1.NamespaceDefault},
+			Spec:       poolv1.VirtualMachineDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"group": "a"}}},
+			Status:     poolv1.VirtualMachineDisruptionBudgetStatus{DisruptionsAllowed: 1},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(runDryRun()).To(MatchRegexp(`(?s)NAMESPACE\s+NAME\s+ACTION\s+REASON\n` +
+			`default\s+first\s+Migrate\s*\n` +
+			`default\s+second\s+Migrate\s+held back by a VirtualMachineDisruptionBudget\n$`))
+	})
+
+	It("should report that no VMIs are running on the node", func() {
+		expectClients(v1.KubeVirt{})
+
+		Expect(runDryRun()).To(ContainSubstring("No VirtualMachineInstances are running on node " + nodeName))
+	})
+})
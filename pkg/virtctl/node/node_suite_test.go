@@ -0,0 +1,11 @@
+package node_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestNode(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
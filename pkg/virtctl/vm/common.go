@@ -38,6 +38,7 @@ const (
 	forceArg       = "force"
 	gracePeriodArg = "grace-period"
 	persistArg     = "persist"
+	targetNodeArg  = "target-node"
 
 	YAML = "yaml"
 	JSON = "json"
@@ -49,6 +50,7 @@ var (
 	volumeName   string
 	persist      bool
 	dryRun       bool
+	targetNode   string
 )
 
 type Command struct {
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
 
@@ -44,6 +45,7 @@ func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().StringVar(&targetNode, targetNodeArg, "", "--target-node=node01: Name of the node the virtual machine should be migrated to. If unset, the scheduler picks the target node.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -56,9 +58,12 @@ func (o *Command) migrateRun(args []string) error {
 		return err
 	}
 
-	dryRunOption := setDryRunOption(dryRun)
+	migrateOptions := &v1.MigrateOptions{DryRun: setDryRunOption(dryRun)}
+	if targetNode != "" {
+		migrateOptions.AddedNodeSelector = map[string]string{k8sv1.LabelHostname: targetNode}
+	}
 
-	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, &v1.MigrateOptions{DryRun: dryRunOption})
+	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, migrateOptions)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
 	}
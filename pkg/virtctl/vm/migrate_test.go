@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -52,22 +53,17 @@ var _ = Describe("Migrate command", func() {
 		Expect(err).Should(MatchError("argument validation failed"))
 	})
 
-	DescribeTable("should migrate a vm according to options", func(migrateOptions *v1.MigrateOptions) {
+	DescribeTable("should migrate a vm according to options", func(migrateOptions *v1.MigrateOptions, args ...string) {
 		vm := kubecli.NewMinimalVM(vmName)
 
 		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
 		vmInterface.EXPECT().Migrate(context.Background(), vm.Name, migrateOptions).Return(nil).Times(1)
 
-		var cmd func() error
-		if len(migrateOptions.DryRun) == 0 {
-			cmd = clientcmd.NewRepeatableVirtctlCommand("migrate", vmName)
-		} else {
-			cmd = clientcmd.NewRepeatableVirtctlCommand("migrate", "--dry-run", vmName)
-		}
-
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{"migrate", vmName}, args...)...)
 		Expect(cmd()).To(Succeed())
 	},
 		Entry("with default", &v1.MigrateOptions{}),
-		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}),
+		Entry("with dry-run option", &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}, "--dry-run"),
+		Entry("with target node option", &v1.MigrateOptions{AddedNodeSelector: map[string]string{k8sv1.LabelHostname: "node01"}}, "--target-node", "node01"),
 	)
 })
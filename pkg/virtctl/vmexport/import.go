@@ -0,0 +1,321 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vmexport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+)
+
+const (
+	// secretCACertKey is the optional entry of the token secret used to store the CA of the export server
+	secretCACertKey = "ca.crt"
+)
+
+// importResources holds the resources described by the manifests of a VirtualMachineExport
+type importResources struct {
+	configMaps  []*k8sv1.ConfigMap
+	dataVolumes []*cdiv1.DataVolume
+	vm          *virtv1.VirtualMachine
+}
+
+// ImportVirtualMachineExport creates the VirtualMachine described by the manifests of a VirtualMachineExport,
+// along with the DataVolumes importing its volumes from the export server
+func ImportVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error {
+	secret, err := client.CoreV1().Secrets(vmeInfo.Namespace).Get(context.TODO(), vmeInfo.TokenSecret, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	token := string(secret.Data[secretTokenKey])
+	if token == "" {
+		return fmt.Errorf("secret '%s/%s' does not contain a '%s' entry", vmeInfo.Namespace, vmeInfo.TokenSecret, secretTokenKey)
+	}
+
+	manifests, err := getImportManifests(vmeInfo, token, secret.Data[secretCACertKey])
+	if err != nil {
+		return err
+	}
+	resources, err := decodeImportManifests(manifests)
+	if err != nil {
+		return err
+	}
+	prepareImportResources(resources, vmeInfo)
+
+	created := &createdImportResources{}
+	if err := createImportResources(client, resources, vmeInfo, token, created); err != nil {
+		created.cleanup(client, vmeInfo.Namespace)
+		return err
+	}
+
+	fmt.Printf("VirtualMachine '%s/%s' imported successfully\n", vmeInfo.Namespace, vmeInfo.Name)
+	return nil
+}
+
+// createdImportResources holds the names of the resources created by an import, resources which
+// already existed are not part of it, so they are left alone when the import fails
+type createdImportResources struct {
+	configMaps  []string
+	secrets     []string
+	dataVolumes []string
+}
+
+// createImportResources creates the resources of the import, the VirtualMachine is created last so
+// everything it refers to exists once it starts. Existing resources are only reused when explicitly allowed,
+// as nothing guarantees they were left behind by a previous attempt.
+func createImportResources(client kubecli.KubevirtClient, resources *importResources, vmeInfo *VMExportInfo, token string, created *createdImportResources) error {
+	namespace := vmeInfo.Namespace
+	for _, cm := range resources.configMaps {
+		_, err := client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+		if err == nil {
+			created.configMaps = append(created.configMaps, cm.Name)
+		} else if err := handleExistingResource(err, "ConfigMap", namespace, cm.Name, vmeInfo.ReuseExisting); err != nil {
+			return err
+		}
+	}
+	for _, headerSecret := range getHeaderSecrets(resources, namespace, token) {
+		_, err := client.CoreV1().Secrets(namespace).Create(context.TODO(), headerSecret, metav1.CreateOptions{})
+		if err == nil {
+			created.secrets = append(created.secrets, headerSecret.Name)
+		} else if err := handleExistingResource(err, "Secret", namespace, headerSecret.Name, vmeInfo.ReuseExisting); err != nil {
+			return err
+		}
+	}
+	for _, dv := range resources.dataVolumes {
+		_, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(context.TODO(), dv, metav1.CreateOptions{})
+		if err == nil {
+			created.dataVolumes = append(created.dataVolumes, dv.Name)
+		} else if err := handleExistingResource(err, "DataVolume", namespace, dv.Name, vmeInfo.ReuseExisting); err != nil {
+			return err
+		}
+	}
+	_, err := client.VirtualMachine(namespace).Create(context.TODO(), resources.vm)
+	return err
+}
+
+// handleExistingResource filters out the error of a resource which already exists when it can be reused
+func handleExistingResource(err error, kind, namespace, name string, reuse bool) error {
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	if reuse {
+		fmt.Printf("Reusing the existing %s '%s/%s'\n", kind, namespace, name)
+		return nil
+	}
+	return fmt.Errorf("%s '%s/%s' already exists, use %s to reuse the resources left behind by a previous import", kind, namespace, name, REUSE_FLAG)
+}
+
+// cleanup deletes the resources created by a failed import
+func (c *createdImportResources) cleanup(client kubecli.KubevirtClient, namespace string) {
+	for _, name := range c.dataVolumes {
+		if err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			fmt.Printf("Failed to delete DataVolume '%s/%s': %v\n", namespace, name, err)
+		}
+	}
+	for _, name := range c.secrets {
+		if err := client.CoreV1().Secrets(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			fmt.Printf("Failed to delete Secret '%s/%s': %v\n", namespace, name, err)
+		}
+	}
+	for _, name := range c.configMaps {
+		if err := client.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			fmt.Printf("Failed to delete ConfigMap '%s/%s': %v\n", namespace, name, err)
+		}
+	}
+}
+
+// getImportManifests retrieves the manifests of the VirtualMachineExport in json format
+func getImportManifests(vmeInfo *VMExportInfo, token string, caCert []byte) ([]byte, error) {
+	transport := &http.Transport{}
+	if len(caCert) > 0 {
+		roots := x509.NewCertPool()
+		roots.AppendCertsFromPEM(caCert)
+		transport.TLSClientConfig = &tls.Config{RootCAs: roots}
+	}
+	httpClient := httpClientCreatorFunc(transport, vmeInfo.Insecure)
+
+	req, err := http.NewRequest(http.MethodGet, vmeInfo.ManifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(ACCEPT, APPLICATION_JSON)
+	req.Header.Set(exportTokenHeader, token)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("bad status: %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// decodeImportManifests extracts the resources to import from the list returned by the export server
+func decodeImportManifests(manifests []byte) (*importResources, error) {
+	list := &k8sv1.List{}
+	if err := json.Unmarshal(manifests, list); err != nil {
+		return nil, err
+	}
+
+	resources := &importResources{}
+	for _, item := range list.Items {
+		typeMeta := &metav1.TypeMeta{}
+		if err := json.Unmarshal(item.Raw, typeMeta); err != nil {
+			return nil, err
+		}
+		switch typeMeta.Kind {
+		case "ConfigMap":
+			cm := &k8sv1.ConfigMap{}
+			if err := json.Unmarshal(item.Raw, cm); err != nil {
+				return nil, err
+			}
+			resources.configMaps = append(resources.configMaps, cm)
+		case "DataVolume":
+			dv := &cdiv1.DataVolume{}
+			if err := json.Unmarshal(item.Raw, dv); err != nil {
+				return nil, err
+			}
+			resources.dataVolumes = append(resources.dataVolumes, dv)
+		case virtv1.VirtualMachineGroupVersionKind.Kind:
+			if resources.vm != nil {
+				return nil, fmt.Errorf("the manifests contain more than one VirtualMachine")
+			}
+			resources.vm = &virtv1.VirtualMachine{}
+			if err := json.Unmarshal(item.Raw, resources.vm); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if resources.vm == nil {
+		return nil, fmt.Errorf("the manifests don't contain a VirtualMachine")
+	}
+
+	return resources, nil
+}
+
+// prepareImportResources moves the resources to the target namespace, renames the VirtualMachine and
+// applies the requested storage class and network mappings
+func prepareImportResources(resources *importResources, vmeInfo *VMExportInfo) {
+	for _, cm := range resources.configMaps {
+		cm.ObjectMeta = importObjectMeta(cm.ObjectMeta, vmeInfo.Namespace)
+	}
+	for _, dv := range resources.dataVolumes {
+		dv.ObjectMeta = importObjectMeta(dv.ObjectMeta, vmeInfo.Namespace)
+		dv.Status = cdiv1.DataVolumeStatus{}
+		mapStorageClass(&dv.Spec, vmeInfo.StorageClassMap)
+	}
+
+	vm := resources.vm
+	vm.ObjectMeta = importObjectMeta(vm.ObjectMeta, vmeInfo.Namespace)
+	vm.Name = vmeInfo.Name
+	vm.Status = virtv1.VirtualMachineStatus{}
+	for i := range vm.Spec.DataVolumeTemplates {
+		mapStorageClass(&vm.Spec.DataVolumeTemplates[i].Spec, vmeInfo.StorageClassMap)
+	}
+	if vm.Spec.Template != nil {
+		for _, network := range vm.Spec.Template.Spec.Networks {
+			if network.Multus == nil {
+				continue
+			}
+			if target, ok := vmeInfo.NetworkMap[network.Multus.NetworkName]; ok {
+				network.Multus.NetworkName = target
+			}
+		}
+	}
+}
+
+// importObjectMeta keeps only the metadata which is meaningful for a newly created resource
+func importObjectMeta(meta metav1.ObjectMeta, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
+
+// mapStorageClass replaces the storage class of a DataVolume according to the storage class mappings
+func mapStorageClass(spec *cdiv1.DataVolumeSpec, storageClassMap map[string]string) {
+	if spec.Storage != nil {
+		spec.Storage.StorageClassName = mapName(spec.Storage.StorageClassName, storageClassMap)
+	}
+	if spec.PVC != nil {
+		spec.PVC.StorageClassName = mapName(spec.PVC.StorageClassName, storageClassMap)
+	}
+}
+
+func mapName(name *string, mappings map[string]string) *string {
+	if name == nil {
+		return nil
+	}
+	if target, ok := mappings[*name]; ok {
+		return &target
+	}
+	return name
+}
+
+// getHeaderSecrets generates the secrets referenced by the DataVolumes to pass the export token to CDI
+func getHeaderSecrets(resources *importResources, namespace, token string) []*k8sv1.Secret {
+	var specs []*cdiv1.DataVolumeSpec
+	for _, dv := range resources.dataVolumes {
+		specs = append(specs, &dv.Spec)
+	}
+	for i := range resources.vm.Spec.DataVolumeTemplates {
+		specs = append(specs, &resources.vm.Spec.DataVolumeTemplates[i].Spec)
+	}
+
+	var secrets []*k8sv1.Secret
+	names := make(map[string]bool)
+	for _, spec := range specs {
+		if spec.Source == nil || spec.Source.HTTP == nil {
+			continue
+		}
+		for _, name := range spec.Source.HTTP.SecretExtraHeaders {
+			if names[name] {
+				continue
+			}
+			names[name] = true
+			secrets = append(secrets, &k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				StringData: map[string]string{
+					secretTokenKey: fmt.Sprintf("%s:%s", exportTokenHeader, token),
+				},
+			})
+		}
+	}
+	return secrets
+}
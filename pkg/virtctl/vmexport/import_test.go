@@ -0,0 +1,299 @@
+package vmexport_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakek8sclient "k8s.io/client-go/kubernetes/fake"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtctlvmexport "kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("vmexport import", func() {
+	const (
+		importedVMName = "imported-vm"
+		tokenSecret    = "export-token"
+		token          = "test-token"
+		headerSecret   = "header-secret-test-vme"
+		caConfigMap    = "export-ca-cm-test-vme"
+		dvName         = "test-dv"
+	)
+
+	var (
+		ctrl         *gomock.Controller
+		kubeClient   *fakek8sclient.Clientset
+		cdiClient    *cdifake.Clientset
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		server       *httptest.Server
+		serverStatus int
+	)
+
+	setflag := func(flag, parameter string) string {
+		return fmt.Sprintf("%s=%s", flag, parameter)
+	}
+
+	newManifests := func() []byte {
+		storageClass := "source-sc"
+		vm := &virtv1.VirtualMachine{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       virtv1.VirtualMachineGroupVersionKind.Kind,
+				APIVersion: virtv1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "source-vm",
+				Namespace:       "source-ns",
+				ResourceVersion: "1",
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				DataVolumeTemplates: []virtv1.DataVolumeTemplateSpec{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "template-dv"},
+						Spec: cdiv1.DataVolumeSpec{
+							Source: &cdiv1.DataVolumeSource{
+								HTTP: &cdiv1.DataVolumeSourceHTTP{
+									URL:                "https://export/template-dv/disk.img.gz",
+									CertConfigMap:      caConfigMap,
+									SecretExtraHeaders: []string{headerSecret},
+								},
+							},
+							Storage: &cdiv1.StorageSpec{StorageClassName: &storageClass},
+						},
+					},
+				},
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+					Spec: virtv1.VirtualMachineInstanceSpec{
+						Networks: []virtv1.Network{
+							*virtv1.DefaultPodNetwork(),
+							{
+								Name: "secondary",
+								NetworkSource: virtv1.NetworkSource{
+									Multus: &virtv1.MultusNetwork{NetworkName: "source-ns/source-net"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		dv := &cdiv1.DataVolume{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "DataVolume",
+				APIVersion: "cdi.kubevirt.io/v1beta1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      dvName,
+				Namespace: "source-ns",
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: &cdiv1.DataVolumeSource{
+					HTTP: &cdiv1.DataVolumeSourceHTTP{
+						URL:                "https://export/test-dv/disk.img.gz",
+						CertConfigMap:      caConfigMap,
+						SecretExtraHeaders: []string{headerSecret},
+					},
+				},
+				PVC: &k8sv1.PersistentVolumeClaimSpec{StorageClassName: &storageClass},
+			},
+		}
+		cm := &k8sv1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ConfigMap",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: caConfigMap},
+			Data:       map[string]string{"ca.pem": "cert"},
+		}
+		list := k8sv1.List{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "List",
+				APIVersion: "v1",
+			},
+		}
+		for _, resource := range []runtime.Object{cm, vm, dv} {
+			list.Items = append(list.Items, runtime.RawExtension{Object: resource})
+		}
+		manifests, err := json.Marshal(list)
+		Expect(err).ToNot(HaveOccurred())
+		return manifests
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+
+		kubeClient = fakek8sclient.NewSimpleClientset(&k8sv1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tokenSecret,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Data: map[string][]byte{"token": []byte(token)},
+		})
+		cdiClient = cdifake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+
+		serverStatus = http.StatusOK
+		manifests := newManifests()
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("x-kubevirt-export-token")).To(Equal(token))
+			Expect(r.Header.Get(virtctlvmexport.ACCEPT)).To(Equal(virtctlvmexport.APPLICATION_JSON))
+			w.WriteHeader(serverStatus)
+			if serverStatus == http.StatusOK {
+				_, err := w.Write(manifests)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}))
+		virtctlvmexport.SetHTTPClientCreator(func(*http.Transport, bool) *http.Client {
+			return server.Client()
+		})
+	})
+
+	AfterEach(func() {
+		virtctlvmexport.SetDefaultHTTPClientCreator()
+		server.Close()
+	})
+
+	importCommand := func(args ...string) func() error {
+		args = append([]string{"vmexport", virtctlvmexport.IMPORT, importedVMName, setflag(virtctlvmexport.MANIFEST_URL_FLAG, server.URL), setflag(virtctlvmexport.TOKEN_SECRET_FLAG, tokenSecret)}, args...)
+		return clientcmd.NewRepeatableVirtctlCommand(args...)
+	}
+
+	It("should create the VirtualMachine and its volumes in the target namespace", func() {
+		vmInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+			Expect(vm.Name).To(Equal(importedVMName))
+			Expect(vm.Namespace).To(Equal(metav1.NamespaceDefault))
+			Expect(vm.ResourceVersion).To(BeEmpty())
+			Expect(*vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(Equal("target-sc"))
+			Expect(vm.Spec.Template.Spec.Networks[1].Multus.NetworkName).To(Equal("target-net"))
+			return vm, nil
+		})
+
+		cmd := importCommand(setflag(virtctlvmexport.STORAGE_CLASS_FLAG, "source-sc:target-sc"), setflag(virtctlvmexport.NETWORK_FLAG, "source-ns/source-net:target-net"))
+		Expect(cmd()).To(Succeed())
+
+		dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), dvName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*dv.Spec.PVC.StorageClassName).To(Equal("target-sc"))
+		Expect(dv.Spec.Source.HTTP.SecretExtraHeaders).To(ConsistOf(headerSecret))
+
+		_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.Background(), caConfigMap, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), headerSecret, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.StringData).To(HaveKeyWithValue("token", "x-kubevirt-export-token:"+token))
+	})
+
+	It("should keep the storage classes and networks when no mappings are provided", func() {
+		vmInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+			Expect(*vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(Equal("source-sc"))
+			Expect(vm.Spec.Template.Spec.Networks[1].Multus.NetworkName).To(Equal("source-ns/source-net"))
+			return vm, nil
+		})
+
+		Expect(importCommand()()).To(Succeed())
+	})
+
+	It("should reuse the resources left behind by a previous attempt when requested", func() {
+		_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: metav1.NamespaceDefault},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmInterface.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, vm *virtv1.VirtualMachine) (*virtv1.VirtualMachine, error) {
+			return vm, nil
+		})
+
+		Expect(importCommand(virtctlvmexport.REUSE_FLAG)()).To(Succeed())
+	})
+
+	DescribeTable("should fail without creating the VirtualMachine when a resource already exists", func(kind, name string, create func() error, createdBefore func() error) {
+		Expect(create()).To(Succeed())
+
+		err := importCommand()()
+		Expect(err).To(MatchError(fmt.Sprintf("%s '%s/%s' already exists, use %s to reuse the resources left behind by a previous import",
+			kind, metav1.NamespaceDefault, name, virtctlvmexport.REUSE_FLAG)))
+
+		// The resources created before the conflict are deleted again
+		if createdBefore != nil {
+			Expect(createdBefore()).To(MatchError(ContainSubstring("not found")))
+		}
+	},
+		Entry("ConfigMap", "ConfigMap", caConfigMap, func() error {
+			_, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: caConfigMap, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			return err
+		}, nil),
+		Entry("Secret", "Secret", headerSecret, func() error {
+			_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: headerSecret, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			return err
+		}, func() error {
+			_, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.Background(), caConfigMap, metav1.GetOptions{})
+			return err
+		}),
+		Entry("DataVolume", "DataVolume", dvName, func() error {
+			_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			return err
+		}, func() error {
+			_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), headerSecret, metav1.GetOptions{})
+			return err
+		}),
+	)
+
+	It("should delete the resources it created when the import fails", func() {
+		_, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: caConfigMap, Namespace: metav1.NamespaceDefault},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmInterface.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("vm creation failed"))
+
+		Expect(importCommand(virtctlvmexport.REUSE_FLAG)()).To(MatchError("vm creation failed"))
+
+		_, err = cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), dvName, metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), headerSecret, metav1.GetOptions{})
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		// The ConfigMap existed before the import
+		_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.Background(), caConfigMap, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail when the export server returns a bad status", func() {
+		serverStatus = http.StatusUnauthorized
+		err := importCommand()()
+		Expect(err).To(MatchError(ContainSubstring("bad status")))
+	})
+
+	It("should fail when the token secret has no token", func() {
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), tokenSecret, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		secret.Data = nil
+		_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Update(context.Background(), secret, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = importCommand()()
+		Expect(err).To(MatchError(fmt.Sprintf("secret '%s/%s' does not contain a 'token' entry", metav1.NamespaceDefault, tokenSecret)))
+	})
+})
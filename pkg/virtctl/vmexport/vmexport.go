@@ -22,8 +22,11 @@ package vmexport
 import (
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -63,6 +66,7 @@ const (
 	CREATE   = "create"
 	DELETE   = "delete"
 	DOWNLOAD = "download"
+	IMPORT   = "import"
 
 	// Available vmexport flags
 	OUTPUT_FLAG         = "--output"
@@ -80,14 +84,23 @@ const (
 	INCLUDE_SECRET_FLAG = "--include-secret"
 	PORT_FORWARD_FLAG   = "--port-forward"
 	LOCAL_PORT_FLAG     = "--local-port"
+	RESUME_FLAG         = "--resume"
+	VERIFY_FLAG         = "--verify"
+	MANIFEST_URL_FLAG   = "--manifest-url"
+	TOKEN_SECRET_FLAG   = "--token-secret"
+	STORAGE_CLASS_FLAG  = "--storage-class-map"
+	NETWORK_FLAG        = "--network-map"
+	REUSE_FLAG          = "--reuse-existing"
 
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
 	OUTPUT_FORMAT_YAML = "yaml"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT             = "gzip"
+	RAW_FORMAT              = "raw"
+	QCOW2_FORMAT            = "qcow2"
+	COMPRESSED_QCOW2_FORMAT = "compressed-qcow2"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -97,6 +110,10 @@ const (
 	processingWaitInterval = 2 * time.Second
 	// processingWaitTotal is the maximum time used to wait for a virtualMachineExport to be ready
 	processingWaitTotal = 2 * time.Minute
+	// serverBusyWaitInterval is the time interval used to retry a request the export server is not ready to serve
+	serverBusyWaitInterval = 10 * time.Second
+	// serverBusyWaitTotal is the maximum time used to wait for the export server to read a volume
+	serverBusyWaitTotal = 30 * time.Minute
 
 	// exportTokenHeader is the http header used to download the exported volume using the secret token
 	exportTokenHeader = "x-kubevirt-export-token"
@@ -134,12 +151,19 @@ var (
 	includeSecret        bool
 	exportManifest       bool
 	portForward          bool
+	resume               bool
+	verify               bool
 	format               string
 	localPort            string
 	serviceUrl           string
 	volumeName           string
 	ttl                  string
 	manifestOutputFormat string
+	importManifestUrl    string
+	importTokenSecret    string
+	storageClassMappings []string
+	networkMappings      []string
+	reuseExisting        bool
 )
 
 type exportFunc func(client kubecli.KubevirtClient, vmeInfo *VMExportInfo) error
@@ -162,6 +186,8 @@ type VMExportInfo struct {
 	ExportManifest bool
 	Decompress     bool
 	PortForward    bool
+	Resume         bool
+	Verify         bool
 	LocalPort      string
 	OutputFile     string
 	OutputWriter   io.Writer
@@ -172,6 +198,26 @@ type VMExportInfo struct {
 	ServiceURL     string
 	ExportSource   k8sv1.TypedLocalObjectReference
 	TTL            metav1.Duration
+	// VolumeFormat is the exact format to download, when empty the gzipped volume is preferred
+	VolumeFormat exportv1.ExportVolumeFormat
+	// downloadFormat is the format of the volume which is being downloaded
+	downloadFormat exportv1.ExportVolumeFormat
+	// ManifestURL is the URL of the manifests of the VirtualMachineExport to import
+	ManifestURL string
+	// TokenSecret is the secret holding the token of the VirtualMachineExport to import
+	TokenSecret string
+	// StorageClassMap maps the storage classes of the exported volumes to the ones used when importing them
+	StorageClassMap map[string]string
+	// NetworkMap maps the networks of the exported VirtualMachine to the ones used when importing it
+	NetworkMap map[string]string
+	// ReuseExisting allows an import to reuse the resources left behind by a previous attempt
+	ReuseExisting bool
+}
+
+// volumeChecksums is the checksum manifest of the raw content of an exported volume
+type volumeChecksums struct {
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
 }
 
 type command struct {
@@ -233,11 +279,26 @@ func usage() string {
 	# Create a VirtualMachineExport and download the requested volume from it
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --volume=volume1 --output=disk.img.gz
 
+	# Download a volume as a sparse, compressed QCOW2 image
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --format=compressed-qcow2 --output=disk.qcow2
+
+	# Resume an interrupted download and verify the checksum of the volume once it finishes
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --resume --verify
+
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
 	# Get the VirtualMachine manifest in Yaml format from an existing VirtualMachineExport including CDI header secret
-	{{ProgramName}} vmexport download existing-export --include-secret --manifest`
+	{{ProgramName}} vmexport download existing-export --include-secret --manifest
+
+	# Import a VirtualMachine exported by another cluster as vm1, using the export token stored in the 'token' entry of a local secret
+	{{ProgramName}} vmexport import vm1 --manifest-url=https://vmexport-proxy.example.com/api/export.kubevirt.io/v1alpha1/namespaces/ns1/virtualmachineexports/vm1-export/external/manifests/all --token-secret=vm1-export-token
+
+	# Import a VirtualMachine as before, replacing the storage classes and networks which don't exist in this cluster
+	{{ProgramName}} vmexport import vm1 --manifest-url=<url> --token-secret=vm1-export-token --storage-class-map=fast:standard --network-map=ns1/net1:ns2/net2
+
+	# Retry a failed import, reusing the ConfigMaps, Secrets and DataVolumes created by the previous attempt
+	{{ProgramName}} vmexport import vm1 --manifest-url=<url> --token-secret=vm1-export-token --reuse-existing`
 	return usage
 }
 
@@ -260,7 +321,7 @@ func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. Valid options are gzip (default), raw, qcow2 and compressed-qcow2.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should not be deleted after the download finishes.")
 	cmd.Flags().StringVar(&ttl, "ttl", "", "The time after the export was created that it is eligible to be automatically deleted, defaults to 2 hours by the server side if not specified")
@@ -270,6 +331,13 @@ func NewVirtualMachineExportCommand(clientConfig clientcmd.ClientConfig) *cobra.
 	cmd.Flags().StringVar(&localPort, "local-port", "0", "Defines the specific port to be used in port-forward.")
 	cmd.Flags().BoolVar(&includeSecret, "include-secret", false, "When used with manifest and set to true include a secret that contains proper headers for CDI to import using the manifest")
 	cmd.Flags().BoolVar(&exportManifest, "manifest", false, "Instead of downloading a volume, retrieve the VM manifest")
+	cmd.Flags().BoolVar(&resume, "resume", false, "When used with the 'download' option, continues a partial download in the output file instead of starting over. Not supported with the qcow2 formats.")
+	cmd.Flags().BoolVar(&verify, "verify", false, "When used with the 'download' option, verifies the checksum of the downloaded volume. Not supported with the qcow2 formats.")
+	cmd.Flags().StringVar(&importManifestUrl, "manifest-url", "", "When used with the 'import' option, specifies the URL of the manifests of the VirtualMachineExport to import.")
+	cmd.Flags().StringVar(&importTokenSecret, "token-secret", "", "When used with the 'import' option, specifies the secret holding the token of the VirtualMachineExport in its 'token' entry, and optionally the CA of the export server in its 'ca.crt' entry.")
+	cmd.Flags().StringSliceVar(&storageClassMappings, "storage-class-map", nil, "When used with the 'import' option, replaces the storage class of the imported volumes. Format is source:target, can be repeated.")
+	cmd.Flags().StringSliceVar(&networkMappings, "network-map", nil, "When used with the 'import' option, replaces the network name of the imported VM interfaces. Format is source:target, can be repeated.")
+	cmd.Flags().BoolVar(&reuseExisting, "reuse-existing", false, "When used with the 'import' option, reuses the ConfigMaps, Secrets and DataVolumes which already exist instead of failing. Their content is not compared with the manifests.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -297,7 +365,7 @@ func (c *command) run(args []string) error {
 		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
 	}
 
-	// Finally, run the vmexport function (create|delete|download)
+	// Finally, run the vmexport function (create|delete|download|import)
 	if err := exportFunction(virtClient, &vmeInfo); err != nil {
 		return err
 	}
@@ -306,8 +374,8 @@ func (c *command) run(args []string) error {
 }
 
 // parseExportArguments parses and validates vmexport arguments and flags. These arguments should always be:
-//  1. The vmexport function (create|delete|download)
-//  2. The VirtualMachineExport name
+//  1. The vmexport function (create|delete|download|import)
+//  2. The VirtualMachineExport name, or the VirtualMachine name when importing
 func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) error {
 	funcName := strings.ToLower(args[0])
 
@@ -328,6 +396,11 @@ func (c *command) parseExportArguments(args []string, vmeInfo *VMExportInfo) err
 		if err := handleDownloadFlags(); err != nil {
 			return err
 		}
+	case IMPORT:
+		exportFunction = ImportVirtualMachineExport
+		if err := handleImportFlags(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid function '%s'", funcName)
 	}
@@ -348,7 +421,12 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.OutputFile = outputFile
 	// User wants the output in a file, create
 	if outputFile != "" {
-		output, err := os.Create(vmeInfo.OutputFile)
+		flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+		// When resuming, the partial download is kept and the rest of the volume is appended
+		if resume {
+			flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+		}
+		output, err := os.OpenFile(vmeInfo.OutputFile, flags, 0666)
 		if err != nil {
 			return err
 		}
@@ -359,6 +437,15 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	// If raw format is specified, we'll attempt to download and decompress a gzipped volume
 	if format == RAW_FORMAT {
 		vmeInfo.Decompress = true
+		// A download can only be resumed at an offset of the served file, so the raw volume is requested
+		if resume {
+			vmeInfo.Decompress = false
+			vmeInfo.VolumeFormat = exportv1.KubeVirtRaw
+		}
+	}
+	// QCOW2 images are converted by the export server, they can only be downloaded as they are
+	if format == QCOW2_FORMAT || format == COMPRESSED_QCOW2_FORMAT {
+		vmeInfo.VolumeFormat = exportv1.ExportVolumeFormat(format)
 	}
 	vmeInfo.ShouldCreate = shouldCreate
 	vmeInfo.Insecure = insecure
@@ -368,6 +455,13 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 	vmeInfo.OutputFormat = manifestOutputFormat
 	vmeInfo.IncludeSecret = includeSecret
 	vmeInfo.ExportManifest = exportManifest
+	vmeInfo.Resume = resume
+	vmeInfo.Verify = verify
+	vmeInfo.ManifestURL = importManifestUrl
+	vmeInfo.TokenSecret = importTokenSecret
+	vmeInfo.StorageClassMap = parseMappings(storageClassMappings)
+	vmeInfo.NetworkMap = parseMappings(networkMappings)
+	vmeInfo.ReuseExisting = reuseExisting
 	if portForward {
 		vmeInfo.PortForward = portForward
 		vmeInfo.Insecure = true
@@ -437,7 +531,7 @@ func CreateVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExport
 		return err
 	}
 
-	fmt.Printf("VirtualMachineExport '%s/%s' created succesfully\n", vmeInfo.Namespace, vmeInfo.Name)
+	fmt.Printf("VirtualMachineExport '%s/%s' created successfully\n", vmeInfo.Namespace, vmeInfo.Name)
 	return nil
 }
 
@@ -451,7 +545,7 @@ func DeleteVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExport
 		return nil
 	}
 
-	fmt.Printf("VirtualMachineExport '%s/%s' deleted succesfully\n", vmeInfo.Namespace, vmeInfo.Name)
+	fmt.Printf("VirtualMachineExport '%s/%s' deleted successfully\n", vmeInfo.Namespace, vmeInfo.Name)
 	return nil
 }
 
@@ -552,14 +646,41 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 		return err
 	}
 
-	resp, err := HandleHTTPRequest(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	var headers map[string]string
+	var offset int64
+	if vmeInfo.Resume {
+		fi, err := os.Stat(vmeInfo.OutputFile)
+		if err != nil {
+			return err
+		}
+		if offset = fi.Size(); offset > 0 {
+			headers = map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}
+		}
+	}
+
+	resp, err := handleHTTPRequestWhenReady(client, vmexport, downloadUrl, vmeInfo, headers)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
 	// Check server response
-	if resp.StatusCode != http.StatusOK {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		// The server sent the whole volume, so the partial download is discarded
+		if offset > 0 {
+			if err := truncateOutput(vmeInfo); err != nil {
+				return err
+			}
+		}
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		fmt.Printf("Resuming download at byte %d\n", offset)
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0 &&
+		resp.Header.Get("Content-Range") == fmt.Sprintf("bytes */%d", offset):
+		// The output already contains the whole volume
+		fmt.Println("Download already completed")
+		return verifyIfRequested(client, vmexport, vmeInfo)
+	default:
 		return fmt.Errorf("bad status: %s", resp.Status)
 	}
 
@@ -570,7 +691,108 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 
 	// Prevent this output ending up in the stdout
 	if vmeInfo.OutputFile != "" {
-		fmt.Println("Download finished succesfully")
+		fmt.Println("Download finished successfully")
+	}
+	return verifyIfRequested(client, vmexport, vmeInfo)
+}
+
+// handleHTTPRequestWhenReady sends the request again while the export server is still reading the
+// volume, which it needs to do once before serving ranges of compressed volumes and checksums
+func handleHTTPRequestWhenReady(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, vmeInfo *VMExportInfo, headers map[string]string) (*http.Response, error) {
+	deadline := time.Now().Add(serverBusyWaitTotal)
+	for {
+		resp, err := HandleHTTPRequest(client, vmexport, url, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+		if err != nil || resp.StatusCode != http.StatusServiceUnavailable {
+			return resp, err
+		}
+		resp.Body.Close()
+		interval := serverBusyWaitInterval
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			interval = time.Duration(seconds) * time.Second
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for the export server to read the volume", serverBusyWaitTotal)
+		}
+		fmt.Printf("Waiting for the export server to read the volume, retrying in %s\n", interval)
+		time.Sleep(interval)
+	}
+}
+
+// truncateOutput discards the content of the output file
+func truncateOutput(vmeInfo *VMExportInfo) error {
+	output, ok := vmeInfo.OutputWriter.(*os.File)
+	if !ok {
+		return fmt.Errorf("unable to truncate the output")
+	}
+	return output.Truncate(0)
+}
+
+func verifyIfRequested(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) error {
+	if !vmeInfo.Verify {
+		return nil
+	}
+	if err := verifyDownloadedVolume(client, vmexport, vmeInfo); err != nil {
+		return err
+	}
+	fmt.Println("Checksum verified successfully")
+	return nil
+}
+
+// verifyDownloadedVolume compares the downloaded volume with the checksums provided by the export server
+func verifyDownloadedVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) error {
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil {
+		return err
+	}
+	checksumsUrl := ""
+	if exportVolume != nil {
+		for _, format := range exportVolume.Formats {
+			if format.Format == exportv1.Checksums {
+				if checksumsUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if checksumsUrl == "" {
+		return fmt.Errorf("unable to get the checksums of the volume from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+
+	resp, err := handleHTTPRequestWhenReady(client, vmexport, checksumsUrl, vmeInfo, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad status: %s", resp.Status)
+	}
+	expected := &volumeChecksums{}
+	if err := json.NewDecoder(resp.Body).Decode(expected); err != nil {
+		return err
+	}
+
+	output, err := os.Open(vmeInfo.OutputFile)
+	if err != nil {
+		return err
+	}
+	defer util.CloseIOAndCheckErr(output, nil)
+	var rd io.Reader = output
+	// The checksums are computed on the raw volume
+	if vmeInfo.downloadFormat == exportv1.KubeVirtGz && !vmeInfo.Decompress {
+		gzipReader, err := gzip.NewReader(output)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		rd = gzipReader
+	}
+	hash := sha256.New()
+	size, err := io.Copy(hash, rd)
+	if err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); size != expected.Size || sum != expected.SHA256 {
+		return fmt.Errorf("checksum verification failed: expected %d bytes with SHA256 %s, got %d bytes with SHA256 %s", expected.Size, expected.SHA256, size, sum)
 	}
 	return nil
 }
@@ -587,14 +809,9 @@ func replaceUrlWithServiceUrl(manifestUrl string, vmeInfo *VMExportInfo) (string
 	return manUrl.String(), nil
 }
 
-// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
-func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
-	var (
-		downloadUrl string
-		err         error
-		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
-	)
+// getExportVolume inspects the VirtualMachineExport status to find the requested volume
+func getExportVolume(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportv1.VirtualMachineExportVolume, error) {
+	var links *exportv1.VirtualMachineExportLink
 
 	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
 		links = vmexport.Status.Links.External
@@ -602,28 +819,48 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		links = vmexport.Status.Links.Internal
 	}
 	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
 	volumeNumber := len(links.Volumes)
 	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
 	}
-	for _, exportVolume := range links.Volumes {
+	for i, exportVolume := range links.Volumes {
 		// Access the requested volume
 		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
-					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
-					if err != nil {
-						return "", err
-					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
-					break
+			return &links.Volumes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extected URL
+func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	var (
+		downloadUrl string
+		format      exportv1.VirtualMachineExportVolumeFormat
+	)
+
+	exportVolume, err := getExportVolume(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
+	}
+	if exportVolume != nil {
+		if vmeInfo.VolumeFormat != "" {
+			return getUrlWithFormat(vmexport, *exportVolume, vmeInfo)
+		}
+		for _, format = range exportVolume.Formats {
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+				downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+				if err != nil {
+					return "", err
 				}
 			}
+			// By default, we always attempt to find and get the compressed file URL,
+			// so we only break the loop when one is found.
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				break
+			}
 		}
 	}
 
@@ -635,10 +872,22 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	if downloadUrl == "" {
 		return "", fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
+	vmeInfo.downloadFormat = format.Format
 
 	return downloadUrl, nil
 }
 
+// getUrlWithFormat returns the URL of the volume in exactly the requested format
+func getUrlWithFormat(vmexport *exportv1.VirtualMachineExport, exportVolume exportv1.VirtualMachineExportVolume, vmeInfo *VMExportInfo) (string, error) {
+	for _, format := range exportVolume.Formats {
+		if format.Format == vmeInfo.VolumeFormat {
+			vmeInfo.downloadFormat = format.Format
+			return replaceUrlWithServiceUrl(format.Url, vmeInfo)
+		}
+	}
+	return "", fmt.Errorf("unable to get a URL for format '%s' from '%s/%s' VirtualMachineExport", vmeInfo.VolumeFormat, vmexport.Namespace, vmexport.Name)
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -893,8 +1142,14 @@ func handleCreateFlags() error {
 	if serviceUrl != "" {
 		return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, CREATE)
 	}
+	if resume {
+		return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, CREATE)
+	}
+	if verify {
+		return fmt.Errorf(ErrIncompatibleFlag, VERIFY_FLAG, CREATE)
+	}
 
-	return nil
+	return handleImportOnlyFlags(CREATE)
 }
 
 // handleDeleteFlags ensures that only compatible flag combinations are used with 'delete'
@@ -927,8 +1182,14 @@ func handleDeleteFlags() error {
 	if serviceUrl != "" {
 		return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, DELETE)
 	}
+	if resume {
+		return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, DELETE)
+	}
+	if verify {
+		return fmt.Errorf(ErrIncompatibleFlag, VERIFY_FLAG, DELETE)
+	}
 
-	return nil
+	return handleImportOnlyFlags(DELETE)
 }
 
 // handleDownloadFlags ensures that only compatible flag combinations are used with 'download'
@@ -945,8 +1206,24 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT && format != QCOW2_FORMAT && format != COMPRESSED_QCOW2_FORMAT {
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/qcow2/compressed-qcow2")
+	}
+
+	for _, flag := range []string{RESUME_FLAG, VERIFY_FLAG} {
+		if (flag == RESUME_FLAG && !resume) || (flag == VERIFY_FLAG && !verify) {
+			continue
+		}
+		if outputFile == "" {
+			return fmt.Errorf(ErrRequiredFlag, OUTPUT_FLAG, flag)
+		}
+		// QCOW2 images are converted on every request, they can neither be resumed nor verified
+		if format == QCOW2_FORMAT || format == COMPRESSED_QCOW2_FORMAT {
+			return fmt.Errorf(ErrIncompatibleFlag, flag, fmt.Sprintf("%s=%s", FORMAT_FLAG, format))
+		}
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, flag, MANIFEST_FLAG)
+		}
 	}
 
 	if exportManifest {
@@ -964,9 +1241,102 @@ func handleDownloadFlags() error {
 		}
 	}
 
+	return handleImportOnlyFlags(DOWNLOAD)
+}
+
+// handleImportFlags ensures that only compatible flag combinations are used with 'import'
+func handleImportFlags() error {
+	if vm != "" || snapshot != "" || pvc != "" {
+		return fmt.Errorf(ErrIncompatibleExportType)
+	}
+
+	if importManifestUrl == "" {
+		return fmt.Errorf(ErrRequiredFlag, MANIFEST_URL_FLAG, IMPORT)
+	}
+	if importTokenSecret == "" {
+		return fmt.Errorf(ErrRequiredFlag, TOKEN_SECRET_FLAG, IMPORT)
+	}
+	if outputFile != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, OUTPUT_FLAG, IMPORT)
+	}
+	if volumeName != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, IMPORT)
+	}
+	if keepVme {
+		return fmt.Errorf(ErrIncompatibleFlag, KEEP_FLAG, IMPORT)
+	}
+	if exportManifest {
+		return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, IMPORT)
+	}
+	if portForward {
+		return fmt.Errorf(ErrIncompatibleFlag, PORT_FORWARD_FLAG, IMPORT)
+	}
+	if format != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, FORMAT_FLAG, IMPORT)
+	}
+	if serviceUrl != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, SERVICE_URL_FLAG, IMPORT)
+	}
+	if ttl != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, TTL_FLAG, IMPORT)
+	}
+	if resume {
+		return fmt.Errorf(ErrIncompatibleFlag, RESUME_FLAG, IMPORT)
+	}
+	if verify {
+		return fmt.Errorf(ErrIncompatibleFlag, VERIFY_FLAG, IMPORT)
+	}
+	if err := validateMappings(STORAGE_CLASS_FLAG, storageClassMappings); err != nil {
+		return err
+	}
+	if err := validateMappings(NETWORK_FLAG, networkMappings); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// handleImportOnlyFlags ensures that the flags of 'import' are not used with other functions
+func handleImportOnlyFlags(funcName string) error {
+	if importManifestUrl != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_URL_FLAG, funcName)
+	}
+	if importTokenSecret != "" {
+		return fmt.Errorf(ErrIncompatibleFlag, TOKEN_SECRET_FLAG, funcName)
+	}
+	if len(storageClassMappings) > 0 {
+		return fmt.Errorf(ErrIncompatibleFlag, STORAGE_CLASS_FLAG, funcName)
+	}
+	if len(networkMappings) > 0 {
+		return fmt.Errorf(ErrIncompatibleFlag, NETWORK_FLAG, funcName)
+	}
+	if reuseExisting {
+		return fmt.Errorf(ErrIncompatibleFlag, REUSE_FLAG, funcName)
+	}
+
+	return nil
+}
+
+// validateMappings ensures that every mapping passed to a flag is a source:target pair
+func validateMappings(flag string, mappings []string) error {
+	for _, mapping := range mappings {
+		if source, target, found := strings.Cut(mapping, ":"); !found || source == "" || target == "" {
+			return fmt.Errorf(ErrInvalidValue, flag, "source:target pairs")
+		}
+	}
 	return nil
 }
 
+// parseMappings converts a list of already validated source:target pairs into a map
+func parseMappings(mappings []string) map[string]string {
+	res := make(map[string]string, len(mappings))
+	for _, mapping := range mappings {
+		source, target, _ := strings.Cut(mapping, ":")
+		res[source] = target
+	}
+	return res
+}
+
 // getExportSecretName builds the name of the token secret based on the virtualMachineExport object
 func getExportSecretName(vmexportName string) string {
 	return fmt.Sprintf("secret-%s", vmexportName)
@@ -2,10 +2,15 @@ package vmexport_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -291,7 +296,22 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.VOLUME_FLAG, virtctlvmexport.MANIFEST_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.MANIFEST_FLAG, setflag(virtctlvmexport.VM_FLAG, "test"), setflag(virtctlvmexport.VOLUME_FLAG, "volume")),
 			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.MANIFEST_FLAG, setflag(virtctlvmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.LOCAL_PORT_FLAG, "valid port numbers"), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.PORT_FORWARD_FLAG, setflag(virtctlvmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.FORMAT_FLAG, "gzip/raw"), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.FORMAT_FLAG, "gzip/raw/qcow2/compressed-qcow2"), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'create' with resume flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.RESUME_FLAG, virtctlvmexport.CREATE), virtctlvmexport.CREATE, vmexportName, setflag(virtctlvmexport.PVC_FLAG, "test"), virtctlvmexport.RESUME_FLAG),
+			Entry("Using 'delete' with verify flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.VERIFY_FLAG, virtctlvmexport.DELETE), virtctlvmexport.DELETE, vmexportName, virtctlvmexport.VERIFY_FLAG),
+			Entry("Using 'resume' without output", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.OUTPUT_FLAG, virtctlvmexport.RESUME_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.RESUME_FLAG),
+			Entry("Using 'verify' without output", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.OUTPUT_FLAG, virtctlvmexport.VERIFY_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, virtctlvmexport.VERIFY_FLAG),
+			Entry("Using 'resume' with qcow2 format", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.RESUME_FLAG, setflag(virtctlvmexport.FORMAT_FLAG, virtctlvmexport.QCOW2_FORMAT)), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "disk.qcow2"), setflag(virtctlvmexport.FORMAT_FLAG, virtctlvmexport.QCOW2_FORMAT), virtctlvmexport.RESUME_FLAG),
+			Entry("Using 'import' without manifest url", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.MANIFEST_URL_FLAG, virtctlvmexport.IMPORT), virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.TOKEN_SECRET_FLAG, "token")),
+			Entry("Using 'import' without token secret", fmt.Sprintf(virtctlvmexport.ErrRequiredFlag, virtctlvmexport.TOKEN_SECRET_FLAG, virtctlvmexport.IMPORT), virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.MANIFEST_URL_FLAG, manifestUrl)),
+			Entry("Using 'import' with export type", virtctlvmexport.ErrIncompatibleExportType, virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.VM_FLAG, "test")),
+			Entry("Using 'import' with invalid flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.OUTPUT_FLAG, virtctlvmexport.IMPORT), virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.MANIFEST_URL_FLAG, manifestUrl), setflag(virtctlvmexport.TOKEN_SECRET_FLAG, "token"), setflag(virtctlvmexport.OUTPUT_FLAG, "disk.img")),
+			Entry("Using 'import' with invalid storage class mapping", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.STORAGE_CLASS_FLAG, "source:target pairs"), virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.MANIFEST_URL_FLAG, manifestUrl), setflag(virtctlvmexport.TOKEN_SECRET_FLAG, "token"), setflag(virtctlvmexport.STORAGE_CLASS_FLAG, "invalid")),
+			Entry("Using 'import' with invalid network mapping", fmt.Sprintf(virtctlvmexport.ErrInvalidValue, virtctlvmexport.NETWORK_FLAG, "source:target pairs"), virtctlvmexport.IMPORT, "vm", setflag(virtctlvmexport.MANIFEST_URL_FLAG, manifestUrl), setflag(virtctlvmexport.TOKEN_SECRET_FLAG, "token"), setflag(virtctlvmexport.NETWORK_FLAG, "net:")),
+			Entry("Using 'create' with import flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.MANIFEST_URL_FLAG, virtctlvmexport.CREATE), virtctlvmexport.CREATE, vmexportName, setflag(virtctlvmexport.PVC_FLAG, "test"), setflag(virtctlvmexport.MANIFEST_URL_FLAG, manifestUrl)),
+			Entry("Using 'download' with import flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.STORAGE_CLASS_FLAG, virtctlvmexport.DOWNLOAD), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "disk.img"), setflag(virtctlvmexport.STORAGE_CLASS_FLAG, "a:b")),
+			Entry("Using 'delete' with reuse-existing flag", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.REUSE_FLAG, virtctlvmexport.DELETE), virtctlvmexport.DELETE, vmexportName, virtctlvmexport.REUSE_FLAG),
+			Entry("Using 'verify' with manifest", fmt.Sprintf(virtctlvmexport.ErrIncompatibleFlag, virtctlvmexport.VERIFY_FLAG, virtctlvmexport.MANIFEST_FLAG), virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.OUTPUT_FLAG, "manifest.yaml"), virtctlvmexport.MANIFEST_FLAG, virtctlvmexport.VERIFY_FLAG),
 		)
 
 		AfterEach(func() {
@@ -305,7 +325,7 @@ var _ = Describe("vmexport", func() {
 		})
 
 		// Create tests
-		It("VirtualMachineExport is created succesfully", func() {
+		It("VirtualMachineExport is created successfully", func() {
 			utils.HandleVMExportCreate(vmExportClient, nil)
 			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.CREATE, vmexportName, setflag(virtctlvmexport.PVC_FLAG, "test-pvc"))
 			err := cmd()
@@ -313,7 +333,7 @@ var _ = Describe("vmexport", func() {
 		})
 
 		// Delete tests
-		It("VirtualMachineExport is deleted succesfully", func() {
+		It("VirtualMachineExport is deleted successfully", func() {
 			utils.HandleVMExportDelete(vmExportClient, vmexportName)
 			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DELETE, vmexportName)
 			err := cmd()
@@ -491,6 +511,173 @@ var _ = Describe("vmexport", func() {
 		})
 	})
 
+	Context("Resumable downloads", func() {
+		const (
+			volumeContent = "hello world!"
+			downloadUrl   = "https://export/disk.img"
+			checksumsUrl  = "https://export/checksums"
+		)
+
+		var (
+			orgHttpFunc virtctlvmexport.HandleHTTPRequestFunc
+			outputFile  string
+			vmexport    *exportv1.VirtualMachineExport
+		)
+
+		checksumsBody := func(content string) string {
+			sum := sha256.Sum256([]byte(content))
+			return fmt.Sprintf(`{"size":%d,"sha256":"%s"}`, len(content), hex.EncodeToString(sum[:]))
+		}
+
+		response := func(statusCode int, body string) *http.Response {
+			return &http.Response{
+				StatusCode: statusCode,
+				Status:     http.StatusText(statusCode),
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}
+
+		BeforeEach(func() {
+			testInit(http.StatusOK)
+			orgHttpFunc = virtctlvmexport.HandleHTTPRequest
+			outputFile = filepath.Join(GinkgoT().TempDir(), "disk.img")
+
+			vmexport = utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmexport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.KubeVirtRaw, Url: downloadUrl},
+						{Format: exportv1.Checksums, Url: checksumsUrl},
+					},
+				},
+			}, secretName)
+			utils.HandleSecretGet(kubeClient, secretName)
+			utils.HandleVMExportGet(vmExportClient, vmexport, vmexportName)
+		})
+
+		AfterEach(func() {
+			virtctlvmexport.HandleHTTPRequest = orgHttpFunc
+			testDone()
+		})
+
+		It("should append the rest of the volume to a partial download", func() {
+			Expect(os.WriteFile(outputFile, []byte(volumeContent[:5]), 0644)).To(Succeed())
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				if url == checksumsUrl {
+					return response(http.StatusOK, checksumsBody(volumeContent)), nil
+				}
+				Expect(url).To(Equal(downloadUrl))
+				Expect(headers).To(HaveKeyWithValue("Range", "bytes=5-"))
+				return response(http.StatusPartialContent, volumeContent[5:]), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.RESUME_FLAG, virtctlvmexport.VERIFY_FLAG)
+			Expect(cmd()).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(BeEquivalentTo(volumeContent))
+		})
+
+		It("should retry while the export server is reading the volume", func() {
+			Expect(os.WriteFile(outputFile, []byte(volumeContent[:5]), 0644)).To(Succeed())
+			busy := 2
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				if busy > 0 {
+					busy--
+					resp := response(http.StatusServiceUnavailable, "")
+					resp.Header.Set("Retry-After", "0")
+					return resp, nil
+				}
+				return response(http.StatusPartialContent, volumeContent[5:]), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.RESUME_FLAG)
+			Expect(cmd()).To(Succeed())
+			Expect(busy).To(BeZero())
+			Expect(os.ReadFile(outputFile)).To(BeEquivalentTo(volumeContent))
+		})
+
+		It("should fail when the export server does not become ready in time", func() {
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				resp := response(http.StatusServiceUnavailable, "")
+				resp.Header.Set("Retry-After", "86400")
+				return resp, nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.RESUME_FLAG)
+			err := cmd()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("timed out after 30m0s waiting for the export server to read the volume"))
+		})
+
+		It("should start over when the server sends the whole volume", func() {
+			Expect(os.WriteFile(outputFile, []byte("garbage"), 0644)).To(Succeed())
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				return response(http.StatusOK, volumeContent), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.RESUME_FLAG)
+			Expect(cmd()).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(BeEquivalentTo(volumeContent))
+		})
+
+		It("should succeed when the download was already completed", func() {
+			Expect(os.WriteFile(outputFile, []byte(volumeContent), 0644)).To(Succeed())
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				resp := response(http.StatusRequestedRangeNotSatisfiable, "")
+				resp.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", len(volumeContent)))
+				return resp, nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.RESUME_FLAG)
+			Expect(cmd()).To(Succeed())
+			Expect(os.ReadFile(outputFile)).To(BeEquivalentTo(volumeContent))
+		})
+
+		It("should fail when the checksum of the download doesn't match", func() {
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				if url == checksumsUrl {
+					return response(http.StatusOK, checksumsBody(volumeContent)), nil
+				}
+				return response(http.StatusOK, "corrupted!"), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.VERIFY_FLAG)
+			err := cmd()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("checksum verification failed"))
+		})
+
+		It("should verify the decompressed content of a gzipped download", func() {
+			var compressed bytes.Buffer
+			gzipWriter := gzip.NewWriter(&compressed)
+			_, err := gzipWriter.Write([]byte(volumeContent))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gzipWriter.Close()).To(Succeed())
+			vmexport.Status.Links.External.Volumes[0].Formats[0].Format = exportv1.KubeVirtGz
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				if url == checksumsUrl {
+					return response(http.StatusOK, checksumsBody(volumeContent)), nil
+				}
+				return response(http.StatusOK, compressed.String()), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.VERIFY_FLAG)
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should fail to verify when there are no checksums available", func() {
+			vmexport.Status.Links.External.Volumes[0].Formats = vmexport.Status.Links.External.Volumes[0].Formats[:1]
+			virtctlvmexport.HandleHTTPRequest = func(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, url string, insecure bool, exportURL string, headers map[string]string) (*http.Response, error) {
+				return response(http.StatusOK, volumeContent), nil
+			}
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandName, virtctlvmexport.DOWNLOAD, vmexportName, setflag(virtctlvmexport.VOLUME_FLAG, volumeName), setflag(virtctlvmexport.OUTPUT_FLAG, outputFile), virtctlvmexport.VERIFY_FLAG)
+			err := cmd()
+			Expect(err).To(MatchError(fmt.Sprintf("unable to get the checksums of the volume from '%s/%s' VirtualMachineExport", metav1.NamespaceDefault, vmexportName)))
+		})
+	})
+
 	Context("getUrlFromVirtualMachineExport", func() {
 		// Mocking the minimum viable VMExportInfo struct
 		var vmeinfo *virtctlvmexport.VMExportInfo
@@ -544,6 +731,50 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("raw"))
 		})
 
+		DescribeTable("Should get the URL of the requested qcow2 format", func(volumeFormat exportv1.ExportVolumeFormat, expectedUrl string) {
+			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name: volumeName,
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{
+							Format: exportv1.KubeVirtGz,
+							Url:    "https://export/disk.img.gz",
+						},
+						{
+							Format: exportv1.KubeVirtQcow2,
+							Url:    "https://export/disk.qcow2",
+						},
+						{
+							Format: exportv1.KubeVirtQcow2Compressed,
+							Url:    "https://export/disk-compressed.qcow2",
+						},
+					},
+				},
+			}, secretName)
+			vmeinfo.VolumeFormat = volumeFormat
+			url, err := virtctlvmexport.GetUrlFromVirtualMachineExport(vmExport, vmeinfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).To(Equal(expectedUrl))
+		},
+			Entry("qcow2", exportv1.KubeVirtQcow2, "https://export/disk.qcow2"),
+			Entry("compressed qcow2", exportv1.KubeVirtQcow2Compressed, "https://export/disk-compressed.qcow2"),
+		)
+
+		It("Should not fall back to another format when the requested qcow2 format is missing", func() {
+			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
+			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
+				{
+					Name:    volumeName,
+					Formats: utils.GetExportVolumeFormat("compressed", exportv1.KubeVirtGz),
+				},
+			}, secretName)
+			vmeinfo.VolumeFormat = exportv1.KubeVirtQcow2
+			url, err := virtctlvmexport.GetUrlFromVirtualMachineExport(vmExport, vmeinfo)
+			Expect(err).To(HaveOccurred())
+			Expect(url).To(Equal(""))
+		})
+
 		It("Should not get any URL when there's no valid options", func() {
 			vmExport := utils.VMExportSpecPVC(vmexportName, metav1.NamespaceDefault, "test-pvc", secretName)
 			vmExport.Status = utils.GetVMEStatus([]exportv1.VirtualMachineExportVolume{
@@ -44,6 +44,53 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestIdentity) DeepCopyInto(out *GuestIdentity) {
+	*out = *in
+	if in.NewHostname != nil {
+		in, out := &in.NewHostname, &out.NewHostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(Sysprep)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestIdentity.
+func (in *GuestIdentity) DeepCopy() *GuestIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sysprep) DeepCopyInto(out *Sysprep) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sysprep.
+func (in *Sysprep) DeepCopy() *Sysprep {
+	if in == nil {
+		return nil
+	}
+	out := new(Sysprep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineClone) DeepCopyInto(out *VirtualMachineClone) {
 	*out = *in
@@ -118,6 +165,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(v1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TargetNamespace != nil {
+		in, out := &in.TargetNamespace, &out.TargetNamespace
+		*out = new(string)
+		**out = **in
+	}
 	if in.AnnotationFilters != nil {
 		in, out := &in.AnnotationFilters, &out.AnnotationFilters
 		*out = make([]string, len(*in))
@@ -140,6 +192,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GuestIdentity != nil {
+		in, out := &in.GuestIdentity, &out.GuestIdentity
+		*out = new(GuestIdentity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
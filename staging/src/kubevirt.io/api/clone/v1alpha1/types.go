@@ -40,19 +40,34 @@ type VirtualMachineClone struct {
 type VirtualMachineCloneSpec struct {
 	// Source is the object that would be cloned. Currently supported source types are:
 	// VirtualMachine of kubevirt.io API group,
-	// VirtualMachineSnapshot of snapshot.kubevirt.io API group
+	// VirtualMachineSnapshot of snapshot.kubevirt.io API group,
+	// VirtualMachineExport of export.kubevirt.io API group,
+	// DataSource of cdi.kubevirt.io API group
 	Source *corev1.TypedLocalObjectReference `json:"source"`
 
 	// Target is the outcome of the cloning process.
 	// Currently supported source types are:
 	// - VirtualMachine of kubevirt.io API group
+	// - DataVolume of cdi.kubevirt.io API group
+	// - PersistentVolumeClaim of the core API group
 	// - Empty (nil).
+	// A VirtualMachine target can only be cloned from a VirtualMachine or a VirtualMachineSnapshot,
+	// while DataVolume and PersistentVolumeClaim targets can only be cloned from a VirtualMachineExport
+	// exposing a single volume or from a DataSource.
 	// If the target is not provided, the target type would default to VirtualMachine and a random
 	// name would be generated for the target. The target's name can be viewed by
 	// inspecting status "TargetName" field below.
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace the target is created in, it defaults to the namespace
+	// of the clone. The user creating the clone has to be allowed to create the target in that namespace.
+	// Volume targets are cloned from the PVC behind the source, which the user has to be allowed to clone.
+	// Cloning a VirtualMachine or a VirtualMachineSnapshot into another namespace requires
+	// the CrossNamespaceVolumeDataSource feature of the cluster.
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
@@ -73,18 +88,49 @@ type VirtualMachineCloneSpec struct {
 	// be generated automatically.
 	// +optional
 	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+	// GuestIdentity configures the regeneration of the identity the guest of a VirtualMachine target
+	// is presented with, so the target does not boot with the identity of the source.
+	// +optional
+	GuestIdentity *GuestIdentity `json:"guestIdentity,omitempty"`
+}
+
+// GuestIdentity configures the regeneration of the guest identity of a VirtualMachine target
+type GuestIdentity struct {
+	// Regenerate assigns a new firmware UUID to the target, which results in a new cloud-init
+	// instance-id, and resets the target's hostname so it defaults to the target's name.
+	// +optional
+	Regenerate bool `json:"regenerate,omitempty"`
+	// NewHostname sets the hostname of the target. Setting it implies Regenerate.
+	// +optional
+	NewHostname *string `json:"newHostname,omitempty"`
+	// Sysprep runs virt-sysprep against the volumes of the target before its first boot, resetting
+	// host specific configuration like the machine-id and the SSH host keys. The target is kept
+	// halted until virt-sysprep completes.
+	// +optional
+	Sysprep *Sysprep `json:"sysprep,omitempty"`
+}
+
+// Sysprep configures the virt-sysprep run against the volumes of the target
+type Sysprep struct {
+	// Operations is the list of virt-sysprep operations to run, the virt-sysprep
+	// default operations are run if it is empty.
+	// +optional
+	// +listType=atomic
+	Operations []string `json:"operations,omitempty"`
 }
 
 type VirtualMachineClonePhase string
 
 const (
-	PhaseUnset         VirtualMachineClonePhase = ""
-	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
-	CreatingTargetVM   VirtualMachineClonePhase = "CreatingTargetVM"
-	RestoreInProgress  VirtualMachineClonePhase = "RestoreInProgress"
-	Succeeded          VirtualMachineClonePhase = "Succeeded"
-	Failed             VirtualMachineClonePhase = "Failed"
-	Unknown            VirtualMachineClonePhase = "Unknown"
+	PhaseUnset           VirtualMachineClonePhase = ""
+	SnapshotInProgress   VirtualMachineClonePhase = "SnapshotInProgress"
+	CreatingTargetVM     VirtualMachineClonePhase = "CreatingTargetVM"
+	CreatingTargetVolume VirtualMachineClonePhase = "CreatingTargetVolume"
+	RestoreInProgress    VirtualMachineClonePhase = "RestoreInProgress"
+	SysprepInProgress    VirtualMachineClonePhase = "SysprepInProgress"
+	Succeeded            VirtualMachineClonePhase = "Succeeded"
+	Failed               VirtualMachineClonePhase = "Failed"
+	Unknown              VirtualMachineClonePhase = "Unknown"
 )
 
 type VirtualMachineCloneStatus struct {
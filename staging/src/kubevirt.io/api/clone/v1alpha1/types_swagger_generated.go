@@ -10,12 +10,30 @@ func (VirtualMachineClone) SwaggerDoc() map[string]string {
 
 func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group",
-		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- Empty (nil).\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
+		"source":            "Source is the object that would be cloned. Currently supported source types are:\nVirtualMachine of kubevirt.io API group,\nVirtualMachineSnapshot of snapshot.kubevirt.io API group,\nVirtualMachineExport of export.kubevirt.io API group,\nDataSource of cdi.kubevirt.io API group",
+		"target":            "Target is the outcome of the cloning process.\nCurrently supported source types are:\n- VirtualMachine of kubevirt.io API group\n- DataVolume of cdi.kubevirt.io API group\n- PersistentVolumeClaim of the core API group\n- Empty (nil).\nA VirtualMachine target can only be cloned from a VirtualMachine or a VirtualMachineSnapshot,\nwhile DataVolume and PersistentVolumeClaim targets can only be cloned from a VirtualMachineExport\nexposing a single volume or from a DataSource.\nIf the target is not provided, the target type would default to VirtualMachine and a random\nname would be generated for the target. The target's name can be viewed by\ninspecting status \"TargetName\" field below.\n+optional",
+		"targetNamespace":   "TargetNamespace is the namespace the target is created in, it defaults to the namespace\nof the clone. The user creating the clone has to be allowed to create the target in that namespace.\nVolume targets are cloned from the PVC behind the source, which the user has to be allowed to clone.\nCloning a VirtualMachine or a VirtualMachineSnapshot into another namespace requires\nthe CrossNamespaceVolumeDataSource feature of the cluster.\n+optional",
 		"annotationFilters": "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"labelFilters":      "Example use: \"!some/key*\".\nFor a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional\n+listType=atomic",
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
+		"guestIdentity":     "GuestIdentity configures the regeneration of the identity the guest of a VirtualMachine target\nis presented with, so the target does not boot with the identity of the source.\n+optional",
+	}
+}
+
+func (GuestIdentity) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "GuestIdentity configures the regeneration of the guest identity of a VirtualMachine target",
+		"regenerate":  "Regenerate assigns a new firmware UUID to the target, which results in a new cloud-init\ninstance-id, and resets the target's hostname so it defaults to the target's name.\n+optional",
+		"newHostname": "NewHostname sets the hostname of the target. Setting it implies Regenerate.\n+optional",
+		"sysprep":     "Sysprep runs virt-sysprep against the volumes of the target before its first boot, resetting\nhost specific configuration like the machine-id and the SSH host keys. The target is kept\nhalted until virt-sysprep completes.\n+optional",
+	}
+}
+
+func (Sysprep) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "Sysprep configures the virt-sysprep run against the volumes of the target",
+		"operations": "Operations is the list of virt-sysprep operations to run, the virt-sysprep\ndefault operations are run if it is empty.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -2047,6 +2047,11 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 		*out = new(InterfacePasst)
 		**out = **in
 	}
+	if in.VhostUser != nil {
+		in, out := &in.VhostUser, &out.VhostUser
+		*out = new(InterfaceVhostUser)
+		**out = **in
+	}
 	return
 }
 
@@ -2063,6 +2068,11 @@ func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
 	*out = *in
+	if in.ComputeResourceOverhead != nil {
+		in, out := &in.ComputeResourceOverhead, &out.ComputeResourceOverhead
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2172,6 +2182,22 @@ func (in *InterfaceSlirp) DeepCopy() *InterfaceSlirp {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceVhostUser) DeepCopyInto(out *InterfaceVhostUser) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceVhostUser.
+func (in *InterfaceVhostUser) DeepCopy() *InterfaceVhostUser {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceVhostUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KSMConfiguration) DeepCopyInto(out *KSMConfiguration) {
 	*out = *in
@@ -2602,6 +2628,11 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkloadUpdate != nil {
+		in, out := &in.WorkloadUpdate, &out.WorkloadUpdate
+		*out = new(KubeVirtWorkloadUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2615,6 +2646,35 @@ func (in *KubeVirtStatus) DeepCopy() *KubeVirtStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopyInto(out *KubeVirtWorkloadUpdateStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedMigrations != nil {
+		in, out := &in.FinishedMigrations, &out.FinishedMigrations
+		*out = make([]types.UID, len(*in))
+		copy(*out, *in)
+	}
+	if in.CanaryCompletionTime != nil {
+		in, out := &in.CanaryCompletionTime, &out.CanaryCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtWorkloadUpdateStatus.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopy() *KubeVirtWorkloadUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtWorkloadUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpdateStrategy) {
 	*out = *in
@@ -2633,6 +2693,28 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.CanaryPercentage != nil {
+		in, out := &in.CanaryPercentage, &out.CanaryPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.CanaryPeriod != nil {
+		in, out := &in.CanaryPeriod, &out.CanaryPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxFailedMigrations != nil {
+		in, out := &in.MaxFailedMigrations, &out.MaxFailedMigrations
+		*out = new(int)
+		**out = **in
+	}
+	if in.RolloutOrder != nil {
+		in, out := &in.RolloutOrder, &out.RolloutOrder
+		*out = make([]WorkloadUpdateRolloutStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2974,6 +3056,13 @@ func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AddedNodeSelector != nil {
+		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -3040,6 +3129,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationThreads != nil {
+		in, out := &in.ParallelMigrationThreads, &out.ParallelMigrationThreads
+		*out = new(uint32)
+		**out = **in
+	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
 		*out = new(string)
@@ -3050,6 +3144,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.EvacuationOrder != nil {
+		in, out := &in.EvacuationOrder, &out.EvacuationOrder
+		*out = new(EvacuationOrder)
+		**out = **in
+	}
 	return
 }
 
@@ -3150,7 +3249,7 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 		in, out := &in.Binding, &out.Binding
 		*out = make(map[string]InterfaceBindingPlugin, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			(*out)[key] = *val.DeepCopy()
 		}
 	}
 	return
@@ -4148,6 +4247,22 @@ func (in *StopOptions) DeepCopy() *StopOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigratedVolume) DeepCopyInto(out *StorageMigratedVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigratedVolume.
+func (in *StorageMigratedVolume) DeepCopy() *StorageMigratedVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigratedVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4737,6 +4852,69 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecRequest) DeepCopyInto(out *VirtualMachineInstanceGuestExecRequest) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecRequest.
+func (in *VirtualMachineInstanceGuestExecRequest) DeepCopy() *VirtualMachineInstanceGuestExecRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopyInto(out *VirtualMachineInstanceGuestExecResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestExecResult.
+func (in *VirtualMachineInstanceGuestExecResult) DeepCopy() *VirtualMachineInstanceGuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestFile) DeepCopyInto(out *VirtualMachineInstanceGuestFile) {
+	*out = *in
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestFile.
+func (in *VirtualMachineInstanceGuestFile) DeepCopy() *VirtualMachineInstanceGuestFile {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -4838,7 +5016,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -4932,6 +5110,18 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.AddedNodeSelector != nil {
+		in, out := &in.AddedNodeSelector, &out.AddedNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MigratedVolumes != nil {
+		in, out := &in.MigratedVolumes, &out.MigratedVolumes
+		*out = make([]StorageMigratedVolume, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4982,6 +5172,11 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 		*out = make([]int, len(*in))
 		copy(*out, *in)
 	}
+	if in.MigratedVolumes != nil {
+		in, out := &in.MigratedVolumes, &out.MigratedVolumes
+		*out = make([]StorageMigratedVolume, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5972,3 +6167,29 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateRolloutStage) DeepCopyInto(out *WorkloadUpdateRolloutStage) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateRolloutStage.
+func (in *WorkloadUpdateRolloutStage) DeepCopy() *WorkloadUpdateRolloutStage {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateRolloutStage)
+	in.DeepCopyInto(out)
+	return out
+}
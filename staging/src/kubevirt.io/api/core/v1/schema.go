@@ -1319,6 +1319,7 @@ type InterfaceBindingMethod struct {
 	SRIOV      *InterfaceSRIOV      `json:"sriov,omitempty"`
 	Macvtap    *InterfaceMacvtap    `json:"macvtap,omitempty"`
 	Passt      *InterfacePasst      `json:"passt,omitempty"`
+	VhostUser  *InterfaceVhostUser  `json:"vhostuser,omitempty"`
 }
 
 // InterfaceBridge connects to a given network via a linux bridge.
@@ -1339,6 +1340,9 @@ type InterfaceMacvtap struct{}
 // InterfacePasst connects to a given network.
 type InterfacePasst struct{}
 
+// InterfaceVhostUser connects to a given network via a vhost-user socket served by a userspace data plane, such as OVS-DPDK.
+type InterfaceVhostUser struct{}
+
 // PluginBinding represents a binding implemented in a plugin.
 type PluginBinding struct {
 	// Name references to the binding name as denined in the kubevirt CR.
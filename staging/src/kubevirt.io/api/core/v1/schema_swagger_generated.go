@@ -727,6 +727,12 @@ func (InterfacePasst) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InterfaceVhostUser) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "InterfaceVhostUser connects to a given network via a vhost-user socket served by a userspace data plane, such as OVS-DPDK.",
+	}
+}
+
 func (PluginBinding) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "PluginBinding represents a binding implemented in a plugin.",
@@ -687,6 +687,21 @@ type VirtualMachineInstanceMigrationState struct {
 	// If the VMI requires dedicated CPUs, this field will
 	// hold the numa topology on the target node
 	TargetNodeTopology string `json:"targetNodeTopology,omitempty"`
+	// MigratedVolumes lists the volumes whose data is copied to a new
+	// claim on the target during this migration
+	// +optional
+	// +listType=atomic
+	MigratedVolumes []StorageMigratedVolume `json:"migratedVolumes,omitempty"`
+}
+
+// StorageMigratedVolume maps a volume of a VirtualMachineInstance to the claim
+// its data is copied to during a live migration.
+type StorageMigratedVolume struct {
+	// VolumeName is the name of the VMI volume to move to the destination claim
+	VolumeName string `json:"volumeName"`
+	// DestinationClaim is the name of the PersistentVolumeClaim the volume is copied to.
+	// The claim must exist in the namespace of the VMI.
+	DestinationClaim string `json:"destinationClaim"`
 }
 
 type MigrationAbortStatus string
@@ -800,6 +815,9 @@ const (
 	// This annotation indicates that a migration is the result of an
 	// automated workload update
 	WorkloadUpdateMigrationAnnotation string = "kubevirt.io/workloadUpdateMigration"
+	// This annotation overrides the number of parallel outbound migrations of a
+	// particular node. Used on Node.
+	ParallelOutboundMigrationsAnnotation string = "kubevirt.io/parallelOutboundMigrations"
 	// This label declares whether a particular node is available for
 	// scheduling virtual machine instances on it. Used on Node.
 	NodeSchedulable string = "kubevirt.io/schedulable"
@@ -963,6 +981,7 @@ const (
 
 	// AllowPodBridgeNetworkLiveMigrationAnnotation allow to run live migration when the
 	// vm has the pod networking bind with a bridge
+	// Deprecated: the live migration of vms with the pod networking bind with a bridge is always allowed.
 	AllowPodBridgeNetworkLiveMigrationAnnotation string = "kubevirt.io/allow-pod-bridge-network-live-migration"
 
 	// VirtualMachineGenerationAnnotation is the generation of a Virtual Machine.
@@ -1253,6 +1272,23 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+
+	// AddedNodeSelector is an additional selector that can be used to
+	// complement a NodeSelector or NodeAffinity as set on the VM
+	// to restrict the set of allowed target nodes for a migration.
+	// In case of key collisions, values set on the VM objects
+	// are going to be preserved to ensure that addedNodeSelector
+	// can only restrict but not bypass constraints already set on the VM object.
+	// +optional
+	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
+
+	// MigratedVolumes moves the listed volumes to new PersistentVolumeClaims while
+	// the VMI keeps running. The data of each volume is copied to its destination
+	// claim during the migration and the VMI and its VM are switched over to the
+	// destination claims once the migration succeeded.
+	// +optional
+	// +listType=atomic
+	MigratedVolumes []StorageMigratedVolume `json:"migratedVolumes,omitempty"`
 }
 
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
@@ -1806,8 +1842,117 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// Paused stops the automated workload updates until it is unset
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// CanaryPercentage is the percentage of the workloads which are live migrated first.
+	// Once the canary is migrated, the remaining workloads are only updated after
+	// CanaryPeriod passed without the rollout being paused by failed migrations.
+	// Workloads are not evicted while the canary is migrated. Requires the
+	// LiveMigrate workload update method.
+	//
+	// Defaults to no canary
+	//
+	// +optional
+	CanaryPercentage *int `json:"canaryPercentage,omitempty"`
+
+	// CanaryPeriod is the time to wait after the canary is updated before
+	// updating the remaining workloads
+	//
+	// Defaults to 10 minutes
+	//
+	// +optional
+	CanaryPeriod *metav1.Duration `json:"canaryPeriod,omitempty"`
+
+	// MaxFailedMigrations is the number of failed workload update migrations
+	// after which the rollout is paused. The rollout continues once it is raised
+	// above the failed migrations or the rollout of a new version starts.
+	//
+	// Defaults to no limit
+	//
+	// +optional
+	MaxFailedMigrations *int `json:"maxFailedMigrations,omitempty"`
+
+	// RolloutOrder defines the order in which workloads are updated. Workloads
+	// matching a stage are only updated once all workloads matching the previous
+	// stages are updated. Workloads matching no stage are updated last.
+	//
+	// +listType=atomic
+	// +optional
+	RolloutOrder []WorkloadUpdateRolloutStage `json:"rolloutOrder,omitempty"`
+}
+
+// WorkloadUpdateRolloutStage selects the workloads which are updated together
+type WorkloadUpdateRolloutStage struct {
+	// NodeSelector selects the workloads by the labels of the node they run on
+	//
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// NamespaceSelector selects the workloads by the labels of their namespace
+	//
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
 }
 
+// KubeVirtWorkloadUpdateStatus reports the progress of the automated workload updates
+type KubeVirtWorkloadUpdateStatus struct {
+	// LauncherImage is the virt-launcher image the workloads are updated to
+	LauncherImage string `json:"launcherImage,omitempty"`
+
+	// StartTime is the time the rollout of the launcher image started
+	//
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// UpdatedWorkloads is the number of workloads which run the launcher image
+	UpdatedWorkloads int `json:"updatedWorkloads"`
+
+	// OutdatedWorkloads is the number of workloads which still need an update
+	OutdatedWorkloads int `json:"outdatedWorkloads"`
+
+	// MigratedWorkloads is the number of workloads which were successfully migrated
+	// by workload update migrations during the rollout
+	MigratedWorkloads int `json:"migratedWorkloads"`
+
+	// FailedMigrations is the number of workload update migrations which failed during the rollout
+	FailedMigrations int `json:"failedMigrations"`
+
+	// FinishedMigrations are the UIDs of the existing finished workload update migrations
+	// which are counted in MigratedWorkloads and FailedMigrations
+	//
+	// +listType=atomic
+	// +optional
+	FinishedMigrations []types.UID `json:"finishedMigrations,omitempty"`
+
+	// CanaryCompletionTime is the time the canary of the rollout was migrated
+	//
+	// +optional
+	// +nullable
+	CanaryCompletionTime *metav1.Time `json:"canaryCompletionTime,omitempty"`
+
+	// Paused indicates that no workloads are updated at the moment
+	Paused bool `json:"paused,omitempty"`
+
+	// PausedReason is a brief CamelCase string that describes why the rollout is paused
+	//
+	// +optional
+	PausedReason string `json:"pausedReason,omitempty"`
+}
+
+const (
+	// WorkloadUpdatePausedReasonPaused indicates that the rollout is paused in the workload update strategy
+	WorkloadUpdatePausedReasonPaused = "Paused"
+	// WorkloadUpdatePausedReasonCanary indicates that the rollout waits for the canary period to pass
+	WorkloadUpdatePausedReasonCanary = "CanaryPeriod"
+	// WorkloadUpdatePausedReasonFailedMigrations indicates that too many workload update migrations failed
+	WorkloadUpdatePausedReasonFailedMigrations = "MaxFailedMigrationsExceeded"
+)
+
 type KubeVirtSpec struct {
 	// The image tag to use for the continer images installed.
 	// Defaults to the same tag as the operator's container image.
@@ -1955,6 +2100,9 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// WorkloadUpdate reports the progress of the automated workload updates
+	// +optional
+	WorkloadUpdate *KubeVirtWorkloadUpdateStatus `json:"workloadUpdate,omitempty" optional:"true"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2111,6 +2259,11 @@ type MigrateOptions struct {
 	// +optional
 	// +listType=atomic
 	DryRun []string `json:"dryRun,omitempty" protobuf:"bytes,1,rep,name=dryRun"`
+	// AddedNodeSelector is an additional selector that is propagated to the
+	// created VirtualMachineInstanceMigration to restrict the set of allowed
+	// target nodes.
+	// +optional
+	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
 }
 
 // VirtualMachineInstanceGuestAgentInfo represents information from the installed guest agent
@@ -2188,6 +2341,43 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// VirtualMachineInstanceGuestExecRequest represents a command which is executed inside the guest by the guest agent
+type VirtualMachineInstanceGuestExecRequest struct {
+	// Command is the path of the executable inside the guest
+	Command string `json:"command"`
+	// Args are passed to the command as they are, no shell is involved
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command is allowed to run, defaults to 30 seconds
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// VirtualMachineInstanceGuestExecResult represents the result of a command executed inside the guest
+type VirtualMachineInstanceGuestExecResult struct {
+	// ExitCode of the command
+	ExitCode int32 `json:"exitCode"`
+	// StdOut is the output of the command, it is truncated when exceeding the size limit
+	// +optional
+	StdOut string `json:"stdOut,omitempty"`
+	// StdErr is the error output of the command, it is truncated when exceeding the size limit
+	// +optional
+	StdErr string `json:"stdErr,omitempty"`
+}
+
+// VirtualMachineInstanceGuestFile represents a file inside the guest which is read or written by the guest agent
+type VirtualMachineInstanceGuestFile struct {
+	// Path of the file inside the guest
+	Path string `json:"path"`
+	// Content of the file
+	// +optional
+	Content []byte `json:"content,omitempty"`
+	// Truncated is set when the file exceeds the size limit and only its beginning is returned
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2510,6 +2700,9 @@ type MigrationConfiguration struct {
 	// When set to true, DisableTLS will disable the additional layer of live migration encryption
 	// provided by KubeVirt. This is usually a bad idea. Defaults to false
 	DisableTLS *bool `json:"disableTLS,omitempty"`
+	// ParallelMigrationThreads is the number of multifd connections used to transfer the memory
+	// of a VMI during a live migration. By default, a single connection is used.
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
 	// Network is the name of the CNI network to use for live migrations. By default, migrations go
 	// through the pod network.
 	Network *string `json:"network,omitempty"`
@@ -2518,8 +2711,22 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// EvacuationOrder defines whether the VMIs with the most memory are migrated first or last
+	// when a node is drained. The priority class of the VMIs takes precedence.
+	// By default, no memory based order is applied.
+	EvacuationOrder *EvacuationOrder `json:"evacuationOrder,omitempty"`
 }
 
+// EvacuationOrder defines the order in which VMIs are migrated off a drained node
+type EvacuationOrder string
+
+const (
+	// EvacuationOrderLargestMemoryFirst migrates the VMIs with the most memory first
+	EvacuationOrderLargestMemoryFirst EvacuationOrder = "LargestMemoryFirst"
+	// EvacuationOrderLargestMemoryLast migrates the VMIs with the most memory last
+	EvacuationOrderLargestMemoryLast EvacuationOrder = "LargestMemoryLast"
+)
+
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
 	MemoryLimit *resource.Quantity `json:"memoryLimit"`
@@ -2661,6 +2868,7 @@ type NodeMediatedDeviceTypesConfig struct {
 type KSMConfiguration struct {
 	// NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.
 	// Empty NodeLabelSelector will enable ksm for every node.
+	// A KSMPolicy which selects a node and sets enabled takes precedence over this selector.
 	// +optional
 	NodeLabelSelector *metav1.LabelSelector `json:"nodeLabelSelector,omitempty"`
 }
@@ -2683,8 +2891,30 @@ type InterfaceBindingPlugin struct {
 	// If namespace is not specified, VMI namespace is assumed.
 	// version: 1alphav1
 	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
+	// DomainAttachmentType is a standard domain network attachment method kubevirt supports.
+	// Supported values: "tap", "managedTap", "vhostuser".
+	// The standard domain attachment can be used instead or in addition to the sidecarImage.
+	// version: 1alphav1
+	// +optional
+	DomainAttachmentType DomainAttachmentType `json:"domainAttachmentType,omitempty"`
+	// ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.
+	// The overhead is added once per binding, regardless of the number of interfaces using it.
+	// version: 1alphav1
+	// +optional
+	ComputeResourceOverhead *k8sv1.ResourceRequirements `json:"computeResourceOverhead,omitempty"`
 }
 
+type DomainAttachmentType string
+
+const (
+	// Tap attaches the pod interface, created as a tap device by the binding CNI plugin, to the domain as is.
+	Tap DomainAttachmentType = "tap"
+	// ManagedTap attaches a tap device which kubevirt creates and connects to the pod interface through a bridge.
+	ManagedTap DomainAttachmentType = "managedTap"
+	// VhostUser attaches a vhost-user socket, which is created by QEMU in a directory shared with the binding.
+	VhostUser DomainAttachmentType = "vhostuser"
+)
+
 // GuestAgentPing configures the guest-agent based ping probe
 type GuestAgentPing struct {
 }
@@ -191,6 +191,15 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"migrationConfiguration":         "Migration configurations to apply",
 		"targetCPUSet":                   "If the VMI requires dedicated CPUs, this field will\nhold the dedicated CPU set on the target node\n+listType=atomic",
 		"targetNodeTopology":             "If the VMI requires dedicated CPUs, this field will\nhold the numa topology on the target node",
+		"migratedVolumes":                "MigratedVolumes lists the volumes whose data is copied to a new\nclaim on the target during this migration\n+optional\n+listType=atomic",
+	}
+}
+
+func (StorageMigratedVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "StorageMigratedVolume maps a volume of a VirtualMachineInstance to the claim\nits data is copied to during a live migration.",
+		"volumeName":       "VolumeName is the name of the VMI volume to move to the destination claim",
+		"destinationClaim": "DestinationClaim is the name of the PersistentVolumeClaim the volume is copied to.\nThe claim must exist in the namespace of the VMI.",
 	}
 }
 
@@ -270,7 +279,9 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":           "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"addedNodeSelector": "AddedNodeSelector is an additional selector that can be used to\ncomplement a NodeSelector or NodeAffinity as set on the VM\nto restrict the set of allowed target nodes for a migration.\nIn case of key collisions, values set on the VM objects\nare going to be preserved to ensure that addedNodeSelector\ncan only restrict but not bypass constraints already set on the VM object.\n+optional",
+		"migratedVolumes":   "MigratedVolumes moves the listed volumes to new PersistentVolumeClaims while\nthe VMI keeps running. The data of each volume is copied to its destination\nclaim during the migration and the VMI and its VM are switched over to the\ndestination claims once the migration succeeded.\n+optional\n+listType=atomic",
 	}
 }
 
@@ -454,6 +465,35 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"paused":                "Paused stops the automated workload updates until it is unset\n\n+optional",
+		"canaryPercentage":      "CanaryPercentage is the percentage of the workloads which are live migrated first.\nOnce the canary is migrated, the remaining workloads are only updated after\nCanaryPeriod passed without the rollout being paused by failed migrations.\nWorkloads are not evicted while the canary is migrated. Requires the\nLiveMigrate workload update method.\n\nDefaults to no canary\n\n+optional",
+		"canaryPeriod":          "CanaryPeriod is the time to wait after the canary is updated before\nupdating the remaining workloads\n\nDefaults to 10 minutes\n\n+optional",
+		"maxFailedMigrations":   "MaxFailedMigrations is the number of failed workload update migrations\nafter which the rollout is paused. The rollout continues once it is raised\nabove the failed migrations or the rollout of a new version starts.\n\nDefaults to no limit\n\n+optional",
+		"rolloutOrder":          "RolloutOrder defines the order in which workloads are updated. Workloads\nmatching a stage are only updated once all workloads matching the previous\nstages are updated. Workloads matching no stage are updated last.\n\n+listType=atomic\n+optional",
+	}
+}
+
+func (WorkloadUpdateRolloutStage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "WorkloadUpdateRolloutStage selects the workloads which are updated together",
+		"nodeSelector":      "NodeSelector selects the workloads by the labels of the node they run on\n\n+optional",
+		"namespaceSelector": "NamespaceSelector selects the workloads by the labels of their namespace\n\n+optional",
+	}
+}
+
+func (KubeVirtWorkloadUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                     "KubeVirtWorkloadUpdateStatus reports the progress of the automated workload updates",
+		"launcherImage":        "LauncherImage is the virt-launcher image the workloads are updated to",
+		"startTime":            "StartTime is the time the rollout of the launcher image started\n\n+optional\n+nullable",
+		"updatedWorkloads":     "UpdatedWorkloads is the number of workloads which run the launcher image",
+		"outdatedWorkloads":    "OutdatedWorkloads is the number of workloads which still need an update",
+		"migratedWorkloads":    "MigratedWorkloads is the number of workloads which were successfully migrated\nby workload update migrations during the rollout",
+		"failedMigrations":     "FailedMigrations is the number of workload update migrations which failed during the rollout",
+		"finishedMigrations":   "FinishedMigrations are the UIDs of the existing finished workload update migrations\nwhich are counted in MigratedWorkloads and FailedMigrations\n\n+listType=atomic\n+optional",
+		"canaryCompletionTime": "CanaryCompletionTime is the time the canary of the rollout was migrated\n\n+optional\n+nullable",
+		"paused":               "Paused indicates that no workloads are updated at the moment",
+		"pausedReason":         "PausedReason is a brief CamelCase string that describes why the rollout is paused\n\n+optional",
 	}
 }
 
@@ -511,8 +551,9 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":               "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":    "+listType=atomic",
+		"workloadUpdate": "WorkloadUpdate reports the progress of the automated workload updates\n+optional",
 	}
 }
 
@@ -564,8 +605,9 @@ func (StopOptions) SwaggerDoc() map[string]string {
 
 func (MigrateOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "MigrateOptions may be provided on migrate request.",
-		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"":                  "MigrateOptions may be provided on migrate request.",
+		"dryRun":            "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+		"addedNodeSelector": "AddedNodeSelector is an additional selector that is propagated to the\ncreated VirtualMachineInstanceMigration to restrict the set of allowed\ntarget nodes.\n+optional",
 	}
 }
 
@@ -625,6 +667,33 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceGuestExecRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestExecRequest represents a command which is executed inside the guest by the guest agent",
+		"command":        "Command is the path of the executable inside the guest",
+		"args":           "Args are passed to the command as they are, no shell is involved\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command is allowed to run, defaults to 30 seconds\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "VirtualMachineInstanceGuestExecResult represents the result of a command executed inside the guest",
+		"exitCode": "ExitCode of the command",
+		"stdOut":   "StdOut is the output of the command, it is truncated when exceeding the size limit\n+optional",
+		"stdErr":   "StdErr is the error output of the command, it is truncated when exceeding the size limit\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestFile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceGuestFile represents a file inside the guest which is read or written by the guest agent",
+		"path":      "Path of the file inside the guest",
+		"content":   "Content of the file\n+optional",
+		"truncated": "Truncated is set when the file exceeds the size limit and only its beginning is returned\n+optional",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -778,8 +847,10 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"unsafeMigrationOverride":           "UnsafeMigrationOverride allows live migrations to occur even if the compatibility check\nindicates the migration will be unsafe to the guest. Defaults to false",
 		"allowPostCopy":                     "AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs\nto successfully live-migrate. However, events like a network failure can cause a VMI crash.\nIf set to true, migrations will still start in pre-copy, but switch to post-copy when\nCompletionTimeoutPerGiB triggers. Defaults to false",
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
+		"parallelMigrationThreads":          "ParallelMigrationThreads is the number of multifd connections used to transfer the memory\nof a VMI during a live migration. By default, a single connection is used.",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"evacuationOrder":                   "EvacuationOrder defines whether the VMIs with the most memory are migrated first or last\nwhen a node is drained. The priority class of the VMIs takes precedence.\nBy default, no memory based order is applied.",
 	}
 }
 
@@ -867,7 +938,7 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 func (KSMConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                  "KSMConfiguration holds information about KSM.\n+k8s:openapi-gen=true",
-		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\n+optional",
+		"nodeLabelSelector": "NodeLabelSelector is a selector that filters in which nodes the KSM will be enabled.\nEmpty NodeLabelSelector will enable ksm for every node.\nA KSMPolicy which selects a node and sets enabled takes precedence over this selector.\n+optional",
 	}
 }
 
@@ -881,6 +952,8 @@ func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"sidecarImage":                "SidecarImage references a container image that runs in the virt-launcher pod.\nThe sidecar handles (libvirt) domain configuration and optional services.\nversion: 1alphav1",
 		"networkAttachmentDefinition": "NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.\nFormat: <name>, <namespace>/<name>.\nIf namespace is not specified, VMI namespace is assumed.\nversion: 1alphav1",
+		"domainAttachmentType":        "DomainAttachmentType is a standard domain network attachment method kubevirt supports.\nSupported values: \"tap\", \"managedTap\", \"vhostuser\".\nThe standard domain attachment can be used instead or in addition to the sidecarImage.\nversion: 1alphav1\n+optional",
+		"computeResourceOverhead":     "ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.\nThe overhead is added once per binding, regardless of the number of interfaces using it.\nversion: 1alphav1\n+optional",
 	}
 }
 
@@ -193,6 +193,11 @@ func (in *VirtualMachineExportSpec) DeepCopyInto(out *VirtualMachineExportSpec)
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.BaseSnapshot != nil {
+		in, out := &in.BaseSnapshot, &out.BaseSnapshot
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -67,6 +67,12 @@ type VirtualMachineExportSpec struct {
 	// If this field is omitted, a reasonable default is applied.
 	// +optional
 	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+
+	// +optional
+	// BaseSnapshot is the name of an older VirtualMachineSnapshot of the same VirtualMachine.
+	// It is only valid when the source is a VirtualMachineSnapshot. The volumes of the source are then
+	// additionally exported as the list of extents which changed since the base snapshot
+	BaseSnapshot *string `json:"baseSnapshot,omitempty"`
 }
 
 // VirtualMachineExportPhase is the current phase of the VirtualMachineExport
@@ -176,10 +182,19 @@ const (
 	KubeVirtRaw ExportVolumeFormat = "raw"
 	// KubeVirtGZ is the volume in gzipped RAW format.
 	KubeVirtGz ExportVolumeFormat = "gzip"
+	// KubeVirtQcow2 is the volume converted to a sparse QCOW2 image
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
+	// KubeVirtQcow2Compressed is the volume converted to a sparse QCOW2 image with compressed clusters
+	KubeVirtQcow2Compressed ExportVolumeFormat = "compressed-qcow2"
 	// Dir is an uncompressed directory, which points to the root of a PersistentVolumeClaim, exposed using a FileServer https://pkg.go.dev/net/http#FileServer
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// ChangedExtents is a JSON list of the extents of the volume which changed since the base snapshot.
+	// The content of the extents can be read from the raw format using HTTP range requests
+	ChangedExtents ExportVolumeFormat = "changed-extents"
+	// Checksums is a JSON manifest with the size and the SHA256 checksum of the volume in RAW format
+	Checksums ExportVolumeFormat = "checksums"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
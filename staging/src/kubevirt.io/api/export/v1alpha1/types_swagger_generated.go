@@ -21,6 +21,7 @@ func (VirtualMachineExportSpec) SwaggerDoc() map[string]string {
 		"":               "VirtualMachineExportSpec is the spec for a VirtualMachineExport resource",
 		"tokenSecretRef": "+optional\nTokenSecretRef is the name of the custom-defined secret that contains the token used by the export server pod",
 		"ttlDuration":    "ttlDuration limits the lifetime of an export\nIf this field is set, after this duration has passed from counting from CreationTimestamp,\nthe export is eligible to be automatically deleted.\nIf this field is omitted, a reasonable default is applied.\n+optional",
+		"baseSnapshot":   "+optional\nBaseSnapshot is the name of an older VirtualMachineSnapshot of the same VirtualMachine.\nIt is only valid when the source is a VirtualMachineSnapshot. The volumes of the source are then\nadditionally exported as the list of extents which changed since the base snapshot",
 	}
 }
 
@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KSMPolicy configures kernel samepage merging (KSM) on the nodes it selects
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type KSMPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KSMPolicySpec `json:"spec" valid:"required"`
+	// +nullable
+	Status KSMPolicyStatus `json:"status,omitempty"`
+}
+
+type KSMPolicySpec struct {
+	// NodeSelector selects the nodes on which KSM is managed by this policy.
+	// An empty selector selects all nodes.
+	//+optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// Enabled explicitly enables or disables KSM on the selected nodes and takes precedence over the
+	// NodeLabelSelector of the KSMConfiguration in the KubeVirt CR. If unset, KSM is only enabled on
+	// the nodes selected by the KSMConfiguration and this policy only tunes it.
+	//+optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Priority decides which policy applies when several policies select the same node.
+	// The policy with the highest priority wins, ties are broken by the policy name. Defaults to 0
+	//+optional
+	Priority *int32 `json:"priority,omitempty"`
+
+	// FreePercent is the percentage of the node memory which has to be available.
+	// KSM starts merging pages when less memory is available and slows down once enough memory is available again.
+	// Defaults to 20
+	//+optional
+	FreePercent *int32 `json:"freePercent,omitempty"`
+
+	// PagesToScan bounds the number of pages KSM scans before it goes to sleep
+	//+optional
+	PagesToScan *KSMPagesToScan `json:"pagesToScan,omitempty"`
+
+	// SleepMillisecondsBaseline is the time KSM sleeps between scans on a node with 16GiB of used memory.
+	// The sleep time scales down with the amount of used memory, down to a tenth of the baseline. Defaults to 100
+	//+optional
+	SleepMillisecondsBaseline *int32 `json:"sleepMillisecondsBaseline,omitempty"`
+}
+
+// KSMPagesToScan bounds the number of pages KSM scans before it goes to sleep.
+// The number of pages is adapted on every heartbeat of virt-handler.
+type KSMPagesToScan struct {
+	// Min is the number of pages below which KSM stops when the node is not under memory pressure. Defaults to 64
+	//+optional
+	Min *int32 `json:"min,omitempty"`
+	// Max is the highest number of pages KSM scans under memory pressure. Defaults to 1250
+	//+optional
+	Max *int32 `json:"max,omitempty"`
+	// Init is the number of pages KSM scans when it starts. Defaults to 100
+	//+optional
+	Init *int32 `json:"init,omitempty"`
+	// Boost is the number of pages added on every heartbeat while the node is under memory pressure. Defaults to 300
+	//+optional
+	Boost *int32 `json:"boost,omitempty"`
+	// Decay is the number of pages removed on every heartbeat while the node is not under memory pressure. Defaults to 50
+	//+optional
+	Decay *int32 `json:"decay,omitempty"`
+}
+
+type KSMPolicyStatus struct {
+	// Nodes reports the state of KSM on the nodes managed by this policy, keyed by the node name
+	// +optional
+	Nodes map[string]KSMNodeStatus `json:"nodes,omitempty"`
+}
+
+// KSMNodeStatus reports the state of KSM on a node
+type KSMNodeStatus struct {
+	// Running indicates whether KSM is currently merging pages on the node
+	Running bool `json:"running"`
+	// PagesToScan is the number of pages KSM currently scans before it goes to sleep
+	// +optional
+	PagesToScan int64 `json:"pagesToScan,omitempty"`
+	// SleepMilliseconds is the time KSM currently sleeps between scans
+	// +optional
+	SleepMilliseconds int64 `json:"sleepMilliseconds,omitempty"`
+	// PagesShared is the number of shared pages which are in use
+	PagesShared int64 `json:"pagesShared"`
+	// PagesSharing is the number of additional sites which share these pages
+	PagesSharing int64 `json:"pagesSharing"`
+	// SavedMemory is the amount of memory saved by merging pages
+	SavedMemory resource.Quantity `json:"savedMemory"`
+	// LastProbeTime is the time the state was last reported by virt-handler
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+}
+
+// KSMPolicyList is a list of KSMPolicy
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KSMPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []KSMPolicy `json:"items"`
+}
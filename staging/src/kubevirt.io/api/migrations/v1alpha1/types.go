@@ -43,6 +43,12 @@ type MigrationPolicy struct {
 type MigrationPolicySpec struct {
 	Selectors *Selectors `json:"selectors"`
 
+	// Priority decides which policy applies when several policies match the same VMI.
+	// The policy with the highest priority wins, before the number of matching labels
+	// is considered. Defaults to 0
+	//+optional
+	Priority *int32 `json:"priority,omitempty"`
+
 	//+optional
 	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
 	//+optional
@@ -51,6 +57,19 @@ type MigrationPolicySpec struct {
 	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
 	//+optional
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	// ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
+	//+optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+	// ParallelMigrationThreads is the number of multifd connections used to transfer the memory of a VMI.
+	//+optional
+	ParallelMigrationThreads *uint32 `json:"parallelMigrationThreads,omitempty"`
+	// DisableTLS disables the additional layer of live migration encryption provided by KubeVirt.
+	//+optional
+	DisableTLS *bool `json:"disableTLS,omitempty"`
+	// MaxParallelMigrations is the maximum number of concurrent live migrations of VMIs matched by this policy.
+	// The cluster-wide and per-node limits still apply.
+	//+optional
+	MaxParallelMigrations *uint32 `json:"maxParallelMigrations,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -62,7 +81,32 @@ type Selectors struct {
 	VirtualMachineInstanceSelector LabelSelector `json:"virtualMachineInstanceSelector,omitempty"`
 }
 
+// MaxMatchedVirtualMachineInstances is the maximum number of VMIs listed in the status of a migration policy
+const MaxMatchedVirtualMachineInstances = 100
+
 type MigrationPolicyStatus struct {
+	// MatchedVirtualMachineInstancesCount is the number of VMIs this policy currently applies to
+	// +optional
+	MatchedVirtualMachineInstancesCount int32 `json:"matchedVirtualMachineInstancesCount,omitempty"`
+	// MigratingVirtualMachineInstancesCount is the number of VMIs this policy applies to which are currently migrating
+	// +optional
+	MigratingVirtualMachineInstancesCount int32 `json:"migratingVirtualMachineInstancesCount,omitempty"`
+	// MatchedVirtualMachineInstances are the first 100 VMIs this policy currently applies to,
+	// ordered by namespace and name
+	// +optional
+	// +listType=atomic
+	MatchedVirtualMachineInstances []MatchedVirtualMachineInstance `json:"matchedVirtualMachineInstances,omitempty"`
+	// ConflictingPolicies are the policies which match at least one VMI with the same
+	// priority and the same number of matching labels as this policy
+	// +optional
+	// +listType=set
+	ConflictingPolicies []string `json:"conflictingPolicies,omitempty"`
+}
+
+// MatchedVirtualMachineInstance references a VMI matched by a migration policy
+type MatchedVirtualMachineInstance struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -99,6 +143,21 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		changed = true
 		*clusterMigrationConfigurations.AllowPostCopy = *policySpec.AllowPostCopy
 	}
+	if policySpec.ProgressTimeout != nil {
+		changed = true
+		progressTimeout := *policySpec.ProgressTimeout
+		clusterMigrationConfigurations.ProgressTimeout = &progressTimeout
+	}
+	if policySpec.ParallelMigrationThreads != nil {
+		changed = true
+		parallelMigrationThreads := *policySpec.ParallelMigrationThreads
+		clusterMigrationConfigurations.ParallelMigrationThreads = &parallelMigrationThreads
+	}
+	if policySpec.DisableTLS != nil {
+		changed = true
+		disableTLS := *policySpec.DisableTLS
+		clusterMigrationConfigurations.DisableTLS = &disableTLS
+	}
 
 	return changed, nil
 }
@@ -11,10 +11,15 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
+		"priority":                 "Priority decides which policy applies when several policies match the same VMI.\nThe policy with the highest priority wins, before the number of matching labels\nis considered. Defaults to 0\n+optional",
+		"allowAutoConverge":        "+optional",
+		"bandwidthPerMigration":    "+optional",
+		"completionTimeoutPerGiB":  "+optional",
+		"allowPostCopy":            "+optional",
+		"progressTimeout":          "ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.\n+optional",
+		"parallelMigrationThreads": "ParallelMigrationThreads is the number of multifd connections used to transfer the memory of a VMI.\n+optional",
+		"disableTLS":               "DisableTLS disables the additional layer of live migration encryption provided by KubeVirt.\n+optional",
+		"maxParallelMigrations":    "MaxParallelMigrations is the maximum number of concurrent live migrations of VMIs matched by this policy.\nThe cluster-wide and per-node limits still apply.\n+optional",
 	}
 }
 
@@ -26,7 +31,18 @@ func (Selectors) SwaggerDoc() map[string]string {
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"matchedVirtualMachineInstancesCount":   "MatchedVirtualMachineInstancesCount is the number of VMIs this policy currently applies to\n+optional",
+		"migratingVirtualMachineInstancesCount": "MigratingVirtualMachineInstancesCount is the number of VMIs this policy applies to which are currently migrating\n+optional",
+		"matchedVirtualMachineInstances":        "MatchedVirtualMachineInstances are the first 100 VMIs this policy currently applies to,\nordered by namespace and name\n+optional\n+listType=atomic",
+		"conflictingPolicies":                   "ConflictingPolicies are the policies which match at least one VMI with the same\npriority and the same number of matching labels as this policy\n+optional\n+listType=set",
+	}
+}
+
+func (MatchedVirtualMachineInstance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MatchedVirtualMachineInstance references a VMI matched by a migration policy",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeCapabilities reports the virtualization capabilities of a node.
+// It is published by virt-handler and carries the name of the node it describes.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type NodeCapabilities struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +nullable
+	Status NodeCapabilitiesStatus `json:"status,omitempty"`
+}
+
+type NodeCapabilitiesStatus struct {
+	// CPU describes the CPU models and features the node can provide to guests
+	// +optional
+	CPU CPUCapabilities `json:"cpu,omitempty"`
+	// MachineTypes lists the machine types the emulator supports for the node architecture
+	// +optional
+	// +listType=atomic
+	MachineTypes []string `json:"machineTypes,omitempty"`
+	// SEV reports whether the node supports AMD Secure Encrypted Virtualization
+	// +optional
+	SEV SEVCapabilities `json:"sev,omitempty"`
+	// TDX reports whether the node supports Intel Trust Domain Extensions
+	// +optional
+	TDX TDXCapabilities `json:"tdx,omitempty"`
+	// Realtime indicates whether the node is able to run realtime workloads
+	// +optional
+	Realtime bool `json:"realtime,omitempty"`
+	// HugepageSizes lists the hugepage sizes supported by the node
+	// +optional
+	// +listType=atomic
+	HugepageSizes []resource.Quantity `json:"hugepageSizes,omitempty"`
+	// IOMMUGroups lists the IOMMU groups of the node and the PCI devices which belong to them
+	// +optional
+	// +listType=atomic
+	IOMMUGroups []IOMMUGroup `json:"iommuGroups,omitempty"`
+	// EmulatorVersion is the version of the emulator which runs the guests on the node
+	// +optional
+	EmulatorVersion string `json:"emulatorVersion,omitempty"`
+}
+
+// CPUCapabilities describes the CPU models and features a node can provide to guests
+type CPUCapabilities struct {
+	// Vendor is the vendor of the host CPU
+	// +optional
+	Vendor string `json:"vendor,omitempty"`
+	// HostModel is the CPU model used for guests with the host-model CPU mode
+	// +optional
+	HostModel string `json:"hostModel,omitempty"`
+	// HostModelObsolete indicates whether the host model is part of the obsolete CPU models
+	// +optional
+	HostModelObsolete bool `json:"hostModelObsolete,omitempty"`
+	// HostModelRequiredFeatures lists the features the host model requires on top of its definition
+	// +optional
+	// +listType=atomic
+	HostModelRequiredFeatures []string `json:"hostModelRequiredFeatures,omitempty"`
+	// Models lists the CPU models which are usable on the node
+	// +optional
+	// +listType=atomic
+	Models []string `json:"models,omitempty"`
+	// Features lists the CPU features which are supported by the node
+	// +optional
+	// +listType=atomic
+	Features []string `json:"features,omitempty"`
+	// HypervFeatures lists the Hyper-V enlightenments which are supported by the node
+	// +optional
+	// +listType=atomic
+	HypervFeatures []string `json:"hypervFeatures,omitempty"`
+	// TSC describes the time stamp counter of the node
+	// +optional
+	TSC *TSCCapabilities `json:"tsc,omitempty"`
+}
+
+// TSCCapabilities describes the time stamp counter of a node
+type TSCCapabilities struct {
+	// Frequency is the frequency of the counter in Hz
+	Frequency int64 `json:"frequency"`
+	// Scalable indicates whether the counter frequency can be scaled for guests
+	Scalable bool `json:"scalable"`
+}
+
+// SEVCapabilities reports the AMD Secure Encrypted Virtualization support of a node
+type SEVCapabilities struct {
+	// Supported indicates whether SEV is supported
+	Supported bool `json:"supported"`
+	// SupportedES indicates whether SEV-ES is supported
+	// +optional
+	SupportedES bool `json:"supportedES,omitempty"`
+	// CBitPos is the position of the C-bit in the page table entries
+	// +optional
+	CBitPos int32 `json:"cbitpos,omitempty"`
+	// ReducedPhysBits is the number of physical address bits lost when memory encryption is enabled
+	// +optional
+	ReducedPhysBits int32 `json:"reducedPhysBits,omitempty"`
+	// MaxGuests is the number of SEV guests which can run concurrently
+	// +optional
+	MaxGuests int32 `json:"maxGuests,omitempty"`
+	// MaxESGuests is the number of SEV-ES guests which can run concurrently
+	// +optional
+	MaxESGuests int32 `json:"maxESGuests,omitempty"`
+}
+
+// TDXCapabilities reports the Intel Trust Domain Extensions support of a node
+type TDXCapabilities struct {
+	// Supported indicates whether TDX is supported
+	Supported bool `json:"supported"`
+}
+
+// IOMMUGroup is an IOMMU group of a node
+type IOMMUGroup struct {
+	// ID is the number of the group
+	ID int32 `json:"id"`
+	// Devices lists the PCI addresses of the devices which belong to the group
+	// +optional
+	// +listType=atomic
+	Devices []string `json:"devices,omitempty"`
+}
+
+// NodeCapabilitiesList is a list of NodeCapabilities
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NodeCapabilitiesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []NodeCapabilities `json:"items"`
+}
@@ -22,12 +22,19 @@ package v1alpha1
 import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	virtv1 "kubevirt.io/api/core/v1"
 )
 
 const (
 	VirtualMachinePoolKind = "VirtualMachinePool"
+
+	VirtualMachineDisruptionBudgetKind = "VirtualMachineDisruptionBudget"
+
+	// VirtualMachinePoolDeletionCostAnnotation can be set on VirtualMachines of a pool to reflect
+	// their load. VirtualMachines with a lower cost are removed first by the LeastLoaded scale-in policy.
+	VirtualMachinePoolDeletionCostAnnotation = "pool.kubevirt.io/deletion-cost"
 )
 
 // VirtualMachinePool resource contains a VirtualMachine configuration
@@ -53,6 +60,29 @@ type VirtualMachineTemplateSpec struct {
 	Spec virtv1.VirtualMachineSpec `json:"spec,omitempty" valid:"required"`
 }
 
+// VirtualMachinePoolScaleInPolicy determines which VirtualMachines are removed first when a pool is scaled in
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolScaleInPolicy string
+
+const (
+	// VirtualMachinePoolScaleInRandom removes VirtualMachines in random order.
+	VirtualMachinePoolScaleInRandom VirtualMachinePoolScaleInPolicy = "Random"
+
+	// VirtualMachinePoolScaleInOldestFirst removes the VirtualMachines with the oldest creation timestamp first.
+	VirtualMachinePoolScaleInOldestFirst VirtualMachinePoolScaleInPolicy = "OldestFirst"
+
+	// VirtualMachinePoolScaleInNewestFirst removes the VirtualMachines with the newest creation timestamp first.
+	VirtualMachinePoolScaleInNewestFirst VirtualMachinePoolScaleInPolicy = "NewestFirst"
+
+	// VirtualMachinePoolScaleInNotReadyFirst removes VirtualMachines which are not ready first.
+	VirtualMachinePoolScaleInNotReadyFirst VirtualMachinePoolScaleInPolicy = "NotReadyFirst"
+
+	// VirtualMachinePoolScaleInLeastLoaded removes stopped VirtualMachines first, followed by the
+	// VirtualMachines with the lowest pool.kubevirt.io/deletion-cost annotation.
+	VirtualMachinePoolScaleInLeastLoaded VirtualMachinePoolScaleInPolicy = "LeastLoaded"
+)
+
 // +k8s:openapi-gen=true
 type VirtualMachinePoolConditionType string
 
@@ -89,6 +119,17 @@ type VirtualMachinePoolStatus struct {
 
 	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// UpdateRevision is the name of the revision of the pool spec VirtualMachines are updated to.
+	// Only reported when an update strategy is set.
+	// +optional
+	UpdateRevision string `json:"updateRevision,omitempty"`
+
+	// UpdatedReplicas is the number of VirtualMachines which run the update revision, either because
+	// their instance was created from it or because they are stopped and will start from it.
+	// Only reported when an update strategy is set.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -108,6 +149,98 @@ type VirtualMachinePoolSpec struct {
 	// Indicates that the pool is paused.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
+
+	// ScaleInPolicy determines which VirtualMachines are removed first when the pool is scaled in.
+	// One of Random, OldestFirst, NewestFirst, NotReadyFirst or LeastLoaded. Defaults to Random.
+	// +optional
+	ScaleInPolicy VirtualMachinePoolScaleInPolicy `json:"scaleInPolicy,omitempty"`
+
+	// DataVolumeTemplates is a list of DataVolumes created once per pool index, named
+	// <template name>-<pool name>-<index>. In contrast to the dataVolumeTemplates of the
+	// VirtualMachine template, they are not owned by the VirtualMachine and survive its recreation.
+	// Volumes of the VirtualMachine template referencing a template by name are pointed to the
+	// DataVolume of their index.
+	// +optional
+	// +listType=atomic
+	DataVolumeTemplates []virtv1.DataVolumeTemplateSpec `json:"dataVolumeTemplates,omitempty"`
+
+	// PersistentVolumeClaimRetentionPolicy describes the lifecycle of the DataVolumes created from
+	// DataVolumeTemplates. By default they are retained when the pool is scaled in or deleted.
+	// +optional
+	PersistentVolumeClaimRetentionPolicy *VirtualMachinePoolPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+
+	// UpdateStrategy controls how running VirtualMachines are updated when the template changes.
+	// Without an update strategy all outdated VirtualMachines are restarted at once.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// VirtualMachinePoolUpdateStrategy controls how running VirtualMachines of a pool are updated
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolUpdateStrategy struct {
+	// RollingUpdate paces the restarts of outdated VirtualMachines.
+	// +optional
+	RollingUpdate *VirtualMachinePoolRollingUpdate `json:"rollingUpdate,omitempty"`
+}
+
+// VirtualMachinePoolRollingUpdate paces the restarts of outdated VirtualMachines of a pool
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolRollingUpdate struct {
+	// MaxUnavailable is the maximum number of VirtualMachines which can be unavailable during the
+	// update. Value can be an absolute number or a percentage of the desired replicas, rounded down.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the maximum number of VirtualMachines which are created on top of the desired
+	// replicas while the update is in progress. Value can be an absolute number or a percentage
+	// of the desired replicas, rounded up. Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// Partition restricts the update to the VirtualMachines with an index greater than or equal
+	// to the partition. VirtualMachines with a lower index keep the template they were created from.
+	// Defaults to 0.
+	// +optional
+	Partition *int32 `json:"partition,omitempty"`
+
+	// PreferLiveUpdate applies template changes limited to live updatable features, like CPU
+	// sockets, guest memory or node affinity, to running VirtualMachines without restarting them.
+	// It takes effect for the features enabled in the liveUpdateFeatures of the template.
+	// +optional
+	PreferLiveUpdate bool `json:"preferLiveUpdate,omitempty"`
+}
+
+// VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType is a string enumeration of the policies
+// applied to the DataVolumes created from the DataVolumeTemplates of a pool
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType string
+
+const (
+	// RetainPersistentVolumeClaimRetentionPolicyType keeps the DataVolumes and their claims.
+	RetainPersistentVolumeClaimRetentionPolicyType VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType = "Retain"
+
+	// DeletePersistentVolumeClaimRetentionPolicyType deletes the DataVolumes and their claims.
+	DeletePersistentVolumeClaimRetentionPolicyType VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType = "Delete"
+)
+
+// VirtualMachinePoolPersistentVolumeClaimRetentionPolicy describes when the DataVolumes created from
+// the DataVolumeTemplates of a pool are deleted
+//
+// +k8s:openapi-gen=true
+type VirtualMachinePoolPersistentVolumeClaimRetentionPolicy struct {
+	// WhenDeleted specifies what happens to the DataVolumes when the pool is deleted.
+	// The pool owns the DataVolumes, or their claims once the DataVolumes are garbage collected, while the policy is Delete.
+	// Defaults to Retain.
+	// +optional
+	WhenDeleted VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+	// WhenScaled specifies what happens to the DataVolumes of an index when the pool is scaled in
+	// and the VirtualMachine of the index is removed. Defaults to Retain.
+	// +optional
+	WhenScaled VirtualMachinePoolPersistentVolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
 }
 
 // VirtualMachinePoolList is a list of VirtualMachinePool resources.
@@ -119,3 +252,83 @@ type VirtualMachinePoolList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []VirtualMachinePool `json:"items"`
 }
+
+// VirtualMachineDisruptionBudget limits the number of VirtualMachines of a group, selected by labels
+// or by their VirtualMachinePool, which are voluntarily disrupted at the same time, e.g. by node
+// drains or by workload updates. In contrast to a PodDisruptionBudget it is aware of the
+// VirtualMachines which are expected to run, even while they have no instance.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+type VirtualMachineDisruptionBudget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineDisruptionBudgetSpec   `json:"spec" valid:"required"`
+	Status VirtualMachineDisruptionBudgetStatus `json:"status,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetSpec struct {
+	// Selector selects the covered VirtualMachineInstances by their labels. The labels of the
+	// instance template of VirtualMachines are used to count stopped VirtualMachines.
+	// Mutually exclusive with VirtualMachinePoolName.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// VirtualMachinePoolName covers the VirtualMachines of the named pool in the namespace of the budget.
+	// Mutually exclusive with Selector.
+	// +optional
+	VirtualMachinePoolName string `json:"virtualMachinePoolName,omitempty"`
+
+	// MinAvailable is the number of covered VirtualMachines which must stay available. Value can be
+	// an absolute number or a percentage of the expected VirtualMachines, rounded up.
+	// Mutually exclusive with MaxUnavailable.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number of covered VirtualMachines which can be unavailable. Value can be
+	// an absolute number or a percentage of the expected VirtualMachines, rounded up.
+	// Mutually exclusive with MinAvailable. Defaults to 1 when MinAvailable is not set.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetStatus struct {
+	// ObservedGeneration is the generation of the budget the status was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ExpectedVirtualMachines is the number of covered VirtualMachines which are expected to run.
+	// +optional
+	ExpectedVirtualMachines int32 `json:"expectedVirtualMachines,omitempty"`
+
+	// CurrentHealthy is the number of covered VirtualMachineInstances which are running and ready.
+	// +optional
+	CurrentHealthy int32 `json:"currentHealthy,omitempty"`
+
+	// DesiredHealthy is the number of covered VirtualMachineInstances which must stay running and ready.
+	// +optional
+	DesiredHealthy int32 `json:"desiredHealthy,omitempty"`
+
+	// DisruptionsAllowed is the number of covered VirtualMachineInstances which can currently be disrupted.
+	// +optional
+	DisruptionsAllowed int32 `json:"disruptionsAllowed,omitempty"`
+
+	// PodDisruptionBudgetName is the name of the PodDisruptionBudget which protects the virt-launcher
+	// pods of the covered VirtualMachineInstances without a PodDisruptionBudget of their own from evictions.
+	// +optional
+	PodDisruptionBudgetName string `json:"podDisruptionBudgetName,omitempty"`
+}
+
+// VirtualMachineDisruptionBudgetList is a list of VirtualMachineDisruptionBudget resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+type VirtualMachineDisruptionBudgetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineDisruptionBudget `json:"items"`
+}
@@ -26,19 +26,50 @@ func (VirtualMachinePoolCondition) SwaggerDoc() map[string]string {
 
 func (VirtualMachinePoolStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "+k8s:openapi-gen=true",
-		"conditions":    "+listType=atomic",
-		"labelSelector": "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"":                "+k8s:openapi-gen=true",
+		"conditions":      "+listType=atomic",
+		"labelSelector":   "Canonical form of the label selector for HPA which consumes it through the scale subresource.",
+		"updateRevision":  "UpdateRevision is the name of the revision of the pool spec VirtualMachines are updated to.\nOnly reported when an update strategy is set.\n+optional",
+		"updatedReplicas": "UpdatedReplicas is the number of VirtualMachines which run the update revision, either because\ntheir instance was created from it or because they are stopped and will start from it.\nOnly reported when an update strategy is set.\n+optional",
 	}
 }
 
 func (VirtualMachinePoolSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                       "+k8s:openapi-gen=true",
-		"replicas":               "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
-		"selector":               "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
-		"virtualMachineTemplate": "Template describes the VM that will be created.",
-		"paused":                 "Indicates that the pool is paused.\n+optional",
+		"":                                     "+k8s:openapi-gen=true",
+		"replicas":                             "Number of desired pods. This is a pointer to distinguish between explicit\nzero and not specified. Defaults to 1.\n+optional",
+		"selector":                             "Label selector for pods. Existing Poolss whose pods are\nselected by this will be the ones affected by this deployment.",
+		"virtualMachineTemplate":               "Template describes the VM that will be created.",
+		"paused":                               "Indicates that the pool is paused.\n+optional",
+		"scaleInPolicy":                        "ScaleInPolicy determines which VirtualMachines are removed first when the pool is scaled in.\nOne of Random, OldestFirst, NewestFirst, NotReadyFirst or LeastLoaded. Defaults to Random.\n+optional",
+		"dataVolumeTemplates":                  "DataVolumeTemplates is a list of DataVolumes created once per pool index, named\n<template name>-<pool name>-<index>. In contrast to the dataVolumeTemplates of the\nVirtualMachine template, they are not owned by the VirtualMachine and survive its recreation.\nVolumes of the VirtualMachine template referencing a template by name are pointed to the\nDataVolume of their index.\n+optional\n+listType=atomic",
+		"persistentVolumeClaimRetentionPolicy": "PersistentVolumeClaimRetentionPolicy describes the lifecycle of the DataVolumes created from\nDataVolumeTemplates. By default they are retained when the pool is scaled in or deleted.\n+optional",
+		"updateStrategy":                       "UpdateStrategy controls how running VirtualMachines are updated when the template changes.\nWithout an update strategy all outdated VirtualMachines are restarted at once.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePoolUpdateStrategy controls how running VirtualMachines of a pool are updated\n\n+k8s:openapi-gen=true",
+		"rollingUpdate": "RollingUpdate paces the restarts of outdated VirtualMachines.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolRollingUpdate) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachinePoolRollingUpdate paces the restarts of outdated VirtualMachines of a pool\n\n+k8s:openapi-gen=true",
+		"maxUnavailable":   "MaxUnavailable is the maximum number of VirtualMachines which can be unavailable during the\nupdate. Value can be an absolute number or a percentage of the desired replicas, rounded down.\nDefaults to 1.\n+optional",
+		"maxSurge":         "MaxSurge is the maximum number of VirtualMachines which are created on top of the desired\nreplicas while the update is in progress. Value can be an absolute number or a percentage\nof the desired replicas, rounded up. Defaults to 0.\n+optional",
+		"partition":        "Partition restricts the update to the VirtualMachines with an index greater than or equal\nto the partition. VirtualMachines with a lower index keep the template they were created from.\nDefaults to 0.\n+optional",
+		"preferLiveUpdate": "PreferLiveUpdate applies template changes limited to live updatable features, like CPU\nsockets, guest memory or node affinity, to running VirtualMachines without restarting them.\nIt takes effect for the features enabled in the liveUpdateFeatures of the template.\n+optional",
+	}
+}
+
+func (VirtualMachinePoolPersistentVolumeClaimRetentionPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachinePoolPersistentVolumeClaimRetentionPolicy describes when the DataVolumes created from\nthe DataVolumeTemplates of a pool are deleted\n\n+k8s:openapi-gen=true",
+		"whenDeleted": "WhenDeleted specifies what happens to the DataVolumes when the pool is deleted.\nThe pool owns the DataVolumes, or their claims once the DataVolumes are garbage collected, while the policy is Delete.\nDefaults to Retain.\n+optional",
+		"whenScaled":  "WhenScaled specifies what happens to the DataVolumes of an index when the pool is scaled in\nand the VirtualMachine of the index is removed. Defaults to Retain.\n+optional",
 	}
 }
 
@@ -47,3 +78,37 @@ func (VirtualMachinePoolList) SwaggerDoc() map[string]string {
 		"": "VirtualMachinePoolList is a list of VirtualMachinePool resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true",
 	}
 }
+
+func (VirtualMachineDisruptionBudget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDisruptionBudget limits the number of VirtualMachines of a group, selected by labels\nor by their VirtualMachinePool, which are voluntarily disrupted at the same time, e.g. by node\ndrains or by workload updates. In contrast to a PodDisruptionBudget it is aware of the\nVirtualMachines which are expected to run, even while they have no instance.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true\n+genclient",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "+k8s:openapi-gen=true",
+		"selector":               "Selector selects the covered VirtualMachineInstances by their labels. The labels of the\ninstance template of VirtualMachines are used to count stopped VirtualMachines.\nMutually exclusive with VirtualMachinePoolName.\n+optional",
+		"virtualMachinePoolName": "VirtualMachinePoolName covers the VirtualMachines of the named pool in the namespace of the budget.\nMutually exclusive with Selector.\n+optional",
+		"minAvailable":           "MinAvailable is the number of covered VirtualMachines which must stay available. Value can be\nan absolute number or a percentage of the expected VirtualMachines, rounded up.\nMutually exclusive with MaxUnavailable.\n+optional",
+		"maxUnavailable":         "MaxUnavailable is the number of covered VirtualMachines which can be unavailable. Value can be\nan absolute number or a percentage of the expected VirtualMachines, rounded up.\nMutually exclusive with MinAvailable. Defaults to 1 when MinAvailable is not set.\n+optional",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "+k8s:openapi-gen=true",
+		"observedGeneration":      "ObservedGeneration is the generation of the budget the status was computed for.\n+optional",
+		"expectedVirtualMachines": "ExpectedVirtualMachines is the number of covered VirtualMachines which are expected to run.\n+optional",
+		"currentHealthy":          "CurrentHealthy is the number of covered VirtualMachineInstances which are running and ready.\n+optional",
+		"desiredHealthy":          "DesiredHealthy is the number of covered VirtualMachineInstances which must stay running and ready.\n+optional",
+		"disruptionsAllowed":      "DisruptionsAllowed is the number of covered VirtualMachineInstances which can currently be disrupted.\n+optional",
+		"podDisruptionBudgetName": "PodDisruptionBudgetName is the name of the PodDisruptionBudget which protects the virt-launcher\npods of the covered VirtualMachineInstances without a PodDisruptionBudget of their own from evictions.\n+optional",
+	}
+}
+
+func (VirtualMachineDisruptionBudgetList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineDisruptionBudgetList is a list of VirtualMachineDisruptionBudget resources.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+k8s:openapi-gen=true",
+	}
+}
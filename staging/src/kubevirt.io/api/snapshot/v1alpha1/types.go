@@ -31,6 +31,18 @@ import (
 
 const DefaultFailureDeadline = 5 * time.Minute
 
+// SnapshotScheduleLabel is set on the snapshots taken by a VirtualMachineSnapshotSchedule
+// and contains the name of the schedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// GroupSnapshotLabel is set on the snapshots taken by a VirtualMachineGroupSnapshot
+// and contains the name of the group snapshot
+const GroupSnapshotLabel = "snapshot.kubevirt.io/group-snapshot"
+
+// GroupRestoreLabel is set on the restores created by a VirtualMachineGroupRestore
+// and contains the name of the group restore
+const GroupRestoreLabel = "snapshot.kubevirt.io/group-restore"
+
 // VirtualMachineSnapshot defines the operation of snapshotting a VM
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -321,8 +333,42 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// TargetNamespace is the namespace the target is restored into, it defaults to the namespace of the
+	// VirtualMachineRestore. Restoring into another namespace requires the CrossNamespaceVolumeDataSource
+	// feature of the cluster, and a ReferenceGrant allowing the PersistentVolumeClaims of the target namespace
+	// to be populated from the VolumeSnapshots of the namespace of the VirtualMachineRestore
+	//
+	// +optional
+	TargetNamespace *string `json:"targetNamespace,omitempty"`
+
+	// Volumes lists the names of the volumes to restore, the other volumes of the target are left untouched.
+	// All the volumes are restored when empty. Volumes can only be restored selectively into an existing
+	// VirtualMachine or with the VolumesOnly mode
+	//
+	// +optional
+	// +listType=set
+	Volumes []string `json:"volumes,omitempty"`
+
+	// Mode defines whether the whole VirtualMachine is restored, or only its volumes as standalone
+	// PersistentVolumeClaims named after the target. Defaults to VirtualMachine
+	//
+	// +optional
+	Mode *VirtualMachineRestoreMode `json:"mode,omitempty"`
 }
 
+// VirtualMachineRestoreMode defines what a VirtualMachineRestore restores
+type VirtualMachineRestoreMode string
+
+const (
+	// VirtualMachineRestoreModeVirtualMachine restores the VirtualMachine along with its volumes
+	VirtualMachineRestoreModeVirtualMachine VirtualMachineRestoreMode = "VirtualMachine"
+
+	// VirtualMachineRestoreModeVolumesOnly restores the volumes as standalone PersistentVolumeClaims,
+	// without creating or updating a VirtualMachine
+	VirtualMachineRestoreModeVolumesOnly VirtualMachineRestoreMode = "VolumesOnly"
+)
+
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreStatus struct {
 	// +optional
@@ -361,3 +407,236 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineSnapshotSchedule defines the periodic snapshotting of a set of VMs
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// FreezePolicy defines whether the guest file systems have to be frozen
+// for a scheduled snapshot to be taken
+type FreezePolicy string
+
+const (
+	// FreezeBestEffort takes the snapshot even when the guest file systems cannot be frozen
+	FreezeBestEffort FreezePolicy = "BestEffort"
+
+	// FreezeRequired skips the snapshot of running VMs whose guest file systems cannot be frozen
+	FreezeRequired FreezePolicy = "Required"
+)
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is the cron expression, in the standard five fields format and
+	// evaluated in UTC, at which the selected VMs are snapshotted
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines to snapshot, in the namespace of the schedule
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Retention defines which of the snapshots taken by the schedule are kept.
+	// All of them are kept when unset
+	// +optional
+	Retention *SnapshotRetention `json:"retention,omitempty"`
+
+	// Freeze defines whether the guest file systems have to be frozen for a snapshot
+	// to be taken. Defaults to BestEffort
+	// +optional
+	Freeze *FreezePolicy `json:"freeze,omitempty"`
+
+	// Suspend stops the schedule from taking new snapshots, the retention still applies
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// DeletionPolicy is set on the snapshots taken by the schedule
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// FailureDeadline is set on the snapshots taken by the schedule
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// SnapshotRetention defines which of the successful snapshots taken by a schedule are kept for each VM, failed snapshots are deleted once a newer snapshot of the VM completes
+type SnapshotRetention struct {
+	// Count is the maximum number of snapshots kept for each VM
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+
+	// Age is the maximum age of the snapshots kept
+	// +optional
+	Age *metav1.Duration `json:"age,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastScheduleTime is the last time the VMs were snapshotted by the schedule
+	// +optional
+	// +nullable
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// LastSuccessTime is the creation time of the last snapshot which succeeded
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// NextScheduleTime is the next time the VMs are going to be snapshotted
+	// +optional
+	// +nullable
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// Error is the last failure of the schedule, either to take or to complete a snapshot
+	// +optional
+	Error *Error `json:"error,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// VirtualMachineGroupSnapshot defines the operation of snapshotting a set of VMs
+// at a single crash consistent point in time. The VMs are restored together with
+// a VirtualMachineGroupRestore
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineGroupSnapshotSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineGroupSnapshotStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot resource
+type VirtualMachineGroupSnapshotSpec struct {
+	// Selector selects the VirtualMachines to snapshot, in the namespace of the group snapshot
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is set on the snapshots of the VMs of the group
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the group snapshot
+	// to take. In case we pass this deadline we mark this group snapshot
+	// as failed.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot resource
+type VirtualMachineGroupSnapshotStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// Frozen is set once the guest file systems of all the running VMs of the group
+	// are frozen, the volumes of the VMs are only snapshotted afterwards
+	// +optional
+	Frozen *bool `json:"frozen,omitempty"`
+
+	// VirtualMachineSnapshots are the snapshots of the VMs of the group, each of them
+	// can be restored with a VirtualMachineRestore
+	// +optional
+	// +listType=atomic
+	VirtualMachineSnapshots []GroupSnapshotMember `json:"virtualMachineSnapshots,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// GroupSnapshotMember references the snapshot of a VM of a VirtualMachineGroupSnapshot
+type GroupSnapshotMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
+// VirtualMachineGroupSnapshotList is a list of VirtualMachineGroupSnapshot resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineGroupSnapshot `json:"items"`
+}
+
+// VirtualMachineGroupRestore defines the operation of restoring the VMs of a
+// VirtualMachineGroupSnapshot to the point in time of the group snapshot
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineGroupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineGroupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore resource
+type VirtualMachineGroupRestoreSpec struct {
+	// VirtualMachineGroupSnapshotName is the group snapshot the VMs are restored from,
+	// every VM of the group is restored into the VM it was snapshotted from
+	VirtualMachineGroupSnapshotName string `json:"virtualMachineGroupSnapshotName"`
+}
+
+// VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore resource
+type VirtualMachineGroupRestoreStatus struct {
+	// VirtualMachineRestores are the restores of the VMs of the group
+	// +optional
+	// +listType=atomic
+	VirtualMachineRestores []GroupRestoreMember `json:"virtualMachineRestores,omitempty"`
+
+	// RestoreTime is set once all the VMs of the group are restored
+	// +optional
+	// +nullable
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// GroupRestoreMember references the restore of a VM of a VirtualMachineGroupRestore
+type GroupRestoreMember struct {
+	VirtualMachineName string `json:"virtualMachineName"`
+
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+}
+
+// VirtualMachineGroupRestoreList is a list of VirtualMachineGroupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineGroupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineGroupRestore `json:"items"`
+}
@@ -139,9 +139,12 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":        "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target":  "initially only VirtualMachine type supported",
-		"patches": "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"":                "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":          "initially only VirtualMachine type supported",
+		"patches":         "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"targetNamespace": "TargetNamespace is the namespace the target is restored into, it defaults to the namespace of the\nVirtualMachineRestore. Restoring into another namespace requires the CrossNamespaceVolumeDataSource\nfeature of the cluster, and a ReferenceGrant allowing the PersistentVolumeClaims of the target namespace\nto be populated from the VolumeSnapshots of the namespace of the VirtualMachineRestore\n\n+optional",
+		"volumes":         "Volumes lists the names of the volumes to restore, the other volumes of the target are left untouched.\nAll the volumes are restored when empty. Volumes can only be restored selectively into an existing\nVirtualMachine or with the VolumesOnly mode\n\n+optional\n+listType=set",
+		"mode":            "Mode defines whether the whole VirtualMachine is restored, or only its volumes as standalone\nPersistentVolumeClaims named after the target. Defaults to VirtualMachine\n\n+optional",
 	}
 }
 
@@ -168,3 +171,124 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule defines the periodic snapshotting of a set of VMs\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is the cron expression, in the standard five fields format and\nevaluated in UTC, at which the selected VMs are snapshotted",
+		"selector":        "Selector selects the VirtualMachines to snapshot, in the namespace of the schedule",
+		"retention":       "Retention defines which of the snapshots taken by the schedule are kept.\nAll of them are kept when unset\n+optional",
+		"freeze":          "Freeze defines whether the guest file systems have to be frozen for a snapshot\nto be taken. Defaults to BestEffort\n+optional",
+		"suspend":         "Suspend stops the schedule from taking new snapshots, the retention still applies\n+optional",
+		"deletionPolicy":  "DeletionPolicy is set on the snapshots taken by the schedule\n+optional",
+		"failureDeadline": "FailureDeadline is set on the snapshots taken by the schedule\n+optional",
+	}
+}
+
+func (SnapshotRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "SnapshotRetention defines which of the successful snapshots taken by a schedule are kept for each VM, failed snapshots are deleted once a newer snapshot of the VM completes",
+		"count": "Count is the maximum number of snapshots kept for each VM\n+optional",
+		"age":   "Age is the maximum age of the snapshots kept\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "LastScheduleTime is the last time the VMs were snapshotted by the schedule\n+optional\n+nullable",
+		"lastSuccessTime":  "LastSuccessTime is the creation time of the last snapshot which succeeded\n+optional\n+nullable",
+		"nextScheduleTime": "NextScheduleTime is the next time the VMs are going to be snapshotted\n+optional\n+nullable",
+		"error":            "Error is the last failure of the schedule, either to take or to complete a snapshot\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineGroupSnapshot) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineGroupSnapshot defines the operation of snapshotting a set of VMs\nat a single crash consistent point in time. The VMs are restored together with\na VirtualMachineGroupRestore\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineGroupSnapshotSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineGroupSnapshotSpec is the spec for a VirtualMachineGroupSnapshot resource",
+		"selector":        "Selector selects the VirtualMachines to snapshot, in the namespace of the group snapshot",
+		"deletionPolicy":  "DeletionPolicy is set on the snapshots of the VMs of the group\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the group snapshot\nto take. In case we pass this deadline we mark this group snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineGroupSnapshotStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "VirtualMachineGroupSnapshotStatus is the status for a VirtualMachineGroupSnapshot resource",
+		"phase":                   "+optional",
+		"creationTime":            "+optional\n+nullable",
+		"readyToUse":              "+optional",
+		"frozen":                  "Frozen is set once the guest file systems of all the running VMs of the group\nare frozen, the volumes of the VMs are only snapshotted afterwards\n+optional",
+		"virtualMachineSnapshots": "VirtualMachineSnapshots are the snapshots of the VMs of the group, each of them\ncan be restored with a VirtualMachineRestore\n+optional\n+listType=atomic",
+		"error":                   "+optional",
+		"conditions":              "+optional",
+	}
+}
+
+func (GroupSnapshotMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "GroupSnapshotMember references the snapshot of a VM of a VirtualMachineGroupSnapshot",
+	}
+}
+
+func (VirtualMachineGroupSnapshotList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineGroupSnapshotList is a list of VirtualMachineGroupSnapshot resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineGroupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineGroupRestore defines the operation of restoring the VMs of a\nVirtualMachineGroupSnapshot to the point in time of the group snapshot\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineGroupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "VirtualMachineGroupRestoreSpec is the spec for a VirtualMachineGroupRestore resource",
+		"virtualMachineGroupSnapshotName": "VirtualMachineGroupSnapshotName is the group snapshot the VMs are restored from,\nevery VM of the group is restored into the VM it was snapshotted from",
+	}
+}
+
+func (VirtualMachineGroupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "VirtualMachineGroupRestoreStatus is the status for a VirtualMachineGroupRestore resource",
+		"virtualMachineRestores": "VirtualMachineRestores are the restores of the VMs of the group\n+optional\n+listType=atomic",
+		"restoreTime":            "RestoreTime is set once all the VMs of the group are restored\n+optional\n+nullable",
+		"complete":               "+optional",
+		"conditions":             "+optional",
+	}
+}
+
+func (GroupRestoreMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "GroupRestoreMember references the restore of a VM of a VirtualMachineGroupRestore",
+	}
+}
+
+func (VirtualMachineGroupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineGroupRestoreList is a list of VirtualMachineGroupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -29,7 +29,9 @@ import (
 	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
+	ksmv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	nodecapabilitiesv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/nodecapabilities/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 )
@@ -41,7 +43,9 @@ type Interface interface {
 	InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface
 	InstancetypeV1alpha2() instancetypev1alpha2.InstancetypeV1alpha2Interface
 	InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta1Interface
+	KsmV1alpha1() ksmv1alpha1.KsmV1alpha1Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
+	NodecapabilitiesV1alpha1() nodecapabilitiesv1alpha1.NodecapabilitiesV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 }
@@ -50,14 +54,16 @@ type Interface interface {
 // version included in a Clientset.
 type Clientset struct {
 	*discovery.DiscoveryClient
-	cloneV1alpha1        *clonev1alpha1.CloneV1alpha1Client
-	exportV1alpha1       *exportv1alpha1.ExportV1alpha1Client
-	instancetypeV1alpha1 *instancetypev1alpha1.InstancetypeV1alpha1Client
-	instancetypeV1alpha2 *instancetypev1alpha2.InstancetypeV1alpha2Client
-	instancetypeV1beta1  *instancetypev1beta1.InstancetypeV1beta1Client
-	migrationsV1alpha1   *migrationsv1alpha1.MigrationsV1alpha1Client
-	poolV1alpha1         *poolv1alpha1.PoolV1alpha1Client
-	snapshotV1alpha1     *snapshotv1alpha1.SnapshotV1alpha1Client
+	cloneV1alpha1            *clonev1alpha1.CloneV1alpha1Client
+	exportV1alpha1           *exportv1alpha1.ExportV1alpha1Client
+	instancetypeV1alpha1     *instancetypev1alpha1.InstancetypeV1alpha1Client
+	instancetypeV1alpha2     *instancetypev1alpha2.InstancetypeV1alpha2Client
+	instancetypeV1beta1      *instancetypev1beta1.InstancetypeV1beta1Client
+	ksmV1alpha1              *ksmv1alpha1.KsmV1alpha1Client
+	migrationsV1alpha1       *migrationsv1alpha1.MigrationsV1alpha1Client
+	nodecapabilitiesV1alpha1 *nodecapabilitiesv1alpha1.NodecapabilitiesV1alpha1Client
+	poolV1alpha1             *poolv1alpha1.PoolV1alpha1Client
+	snapshotV1alpha1         *snapshotv1alpha1.SnapshotV1alpha1Client
 }
 
 // CloneV1alpha1 retrieves the CloneV1alpha1Client
@@ -85,11 +91,21 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return c.instancetypeV1beta1
 }
 
+// KsmV1alpha1 retrieves the KsmV1alpha1Client
+func (c *Clientset) KsmV1alpha1() ksmv1alpha1.KsmV1alpha1Interface {
+	return c.ksmV1alpha1
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return c.migrationsV1alpha1
 }
 
+// NodecapabilitiesV1alpha1 retrieves the NodecapabilitiesV1alpha1Client
+func (c *Clientset) NodecapabilitiesV1alpha1() nodecapabilitiesv1alpha1.NodecapabilitiesV1alpha1Interface {
+	return c.nodecapabilitiesV1alpha1
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return c.poolV1alpha1
@@ -141,10 +157,18 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.ksmV1alpha1, err = ksmv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.migrationsV1alpha1, err = migrationsv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
 	}
+	cs.nodecapabilitiesV1alpha1, err = nodecapabilitiesv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.poolV1alpha1, err = poolv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -170,7 +194,9 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.NewForConfigOrDie(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.NewForConfigOrDie(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.NewForConfigOrDie(c)
+	cs.ksmV1alpha1 = ksmv1alpha1.NewForConfigOrDie(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.NewForConfigOrDie(c)
+	cs.nodecapabilitiesV1alpha1 = nodecapabilitiesv1alpha1.NewForConfigOrDie(c)
 	cs.poolV1alpha1 = poolv1alpha1.NewForConfigOrDie(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.NewForConfigOrDie(c)
 
@@ -186,7 +212,9 @@ func New(c rest.Interface) *Clientset {
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.New(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.New(c)
 	cs.instancetypeV1beta1 = instancetypev1beta1.New(c)
+	cs.ksmV1alpha1 = ksmv1alpha1.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
+	cs.nodecapabilitiesV1alpha1 = nodecapabilitiesv1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 
@@ -35,8 +35,12 @@ import (
 	fakeinstancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2/fake"
 	instancetypev1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
 	fakeinstancetypev1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1/fake"
+	ksmv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
+	fakeksmv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1/fake"
 	migrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
 	fakemigrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1/fake"
+	nodecapabilitiesv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/nodecapabilities/v1alpha1"
+	fakenodecapabilitiesv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/nodecapabilities/v1alpha1/fake"
 	poolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	fakepoolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1/fake"
 	snapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
@@ -115,11 +119,21 @@ func (c *Clientset) InstancetypeV1beta1() instancetypev1beta1.InstancetypeV1beta
 	return &fakeinstancetypev1beta1.FakeInstancetypeV1beta1{Fake: &c.Fake}
 }
 
+// KsmV1alpha1 retrieves the KsmV1alpha1Client
+func (c *Clientset) KsmV1alpha1() ksmv1alpha1.KsmV1alpha1Interface {
+	return &fakeksmv1alpha1.FakeKsmV1alpha1{Fake: &c.Fake}
+}
+
 // MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
 func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
 	return &fakemigrationsv1alpha1.FakeMigrationsV1alpha1{Fake: &c.Fake}
 }
 
+// NodecapabilitiesV1alpha1 retrieves the NodecapabilitiesV1alpha1Client
+func (c *Clientset) NodecapabilitiesV1alpha1() nodecapabilitiesv1alpha1.NodecapabilitiesV1alpha1Interface {
+	return &fakenodecapabilitiesv1alpha1.FakeNodecapabilitiesV1alpha1{Fake: &c.Fake}
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return &fakepoolv1alpha1.FakePoolV1alpha1{Fake: &c.Fake}
@@ -29,7 +29,9 @@ import (
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	ksmv1alpha1 "kubevirt.io/api/ksm/v1alpha1"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	nodecapabilitiesv1alpha1 "kubevirt.io/api/nodecapabilities/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 )
@@ -43,7 +45,9 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	instancetypev1beta1.AddToScheme,
+	ksmv1alpha1.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
+	nodecapabilitiesv1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 }
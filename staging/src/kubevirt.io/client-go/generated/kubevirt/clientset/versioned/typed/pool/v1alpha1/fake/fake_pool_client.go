@@ -28,6 +28,10 @@ type FakePoolV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakePoolV1alpha1) VirtualMachineDisruptionBudgets(namespace string) v1alpha1.VirtualMachineDisruptionBudgetInterface {
+	return &FakeVirtualMachineDisruptionBudgets{c, namespace}
+}
+
 func (c *FakePoolV1alpha1) VirtualMachinePools(namespace string) v1alpha1.VirtualMachinePoolInterface {
 	return &FakeVirtualMachinePools{c, namespace}
 }
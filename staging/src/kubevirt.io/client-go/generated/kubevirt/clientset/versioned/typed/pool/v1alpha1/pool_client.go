@@ -26,6 +26,7 @@ import (
 
 type PoolV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineDisruptionBudgetsGetter
 	VirtualMachinePoolsGetter
 }
 
@@ -34,6 +35,10 @@ type PoolV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PoolV1alpha1Client) VirtualMachineDisruptionBudgets(namespace string) VirtualMachineDisruptionBudgetInterface {
+	return newVirtualMachineDisruptionBudgets(c, namespace)
+}
+
 func (c *PoolV1alpha1Client) VirtualMachinePools(namespace string) VirtualMachinePoolInterface {
 	return newVirtualMachinePools(c, namespace)
 }
@@ -28,6 +28,14 @@ type FakeSnapshotV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineGroupRestores(namespace string) v1alpha1.VirtualMachineGroupRestoreInterface {
+	return &FakeVirtualMachineGroupRestores{c, namespace}
+}
+
+func (c *FakeSnapshotV1alpha1) VirtualMachineGroupSnapshots(namespace string) v1alpha1.VirtualMachineGroupSnapshotInterface {
+	return &FakeVirtualMachineGroupSnapshots{c, namespace}
+}
+
 func (c *FakeSnapshotV1alpha1) VirtualMachineRestores(namespace string) v1alpha1.VirtualMachineRestoreInterface {
 	return &FakeVirtualMachineRestores{c, namespace}
 }
@@ -40,6 +48,10 @@ func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotContents(namespace string)
 	return &FakeVirtualMachineSnapshotContents{c, namespace}
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotSchedules(namespace string) v1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return &FakeVirtualMachineSnapshotSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1alpha1) RESTClient() rest.Interface {
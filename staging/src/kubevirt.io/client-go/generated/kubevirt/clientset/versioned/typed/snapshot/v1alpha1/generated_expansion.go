@@ -18,8 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+type VirtualMachineGroupRestoreExpansion interface{}
+
+type VirtualMachineGroupSnapshotExpansion interface{}
+
 type VirtualMachineRestoreExpansion interface{}
 
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
@@ -26,9 +26,12 @@ import (
 
 type SnapshotV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	VirtualMachineGroupRestoresGetter
+	VirtualMachineGroupSnapshotsGetter
 	VirtualMachineRestoresGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1alpha1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -36,6 +39,14 @@ type SnapshotV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineGroupRestores(namespace string) VirtualMachineGroupRestoreInterface {
+	return newVirtualMachineGroupRestores(c, namespace)
+}
+
+func (c *SnapshotV1alpha1Client) VirtualMachineGroupSnapshots(namespace string) VirtualMachineGroupSnapshotInterface {
+	return newVirtualMachineGroupSnapshots(c, namespace)
+}
+
 func (c *SnapshotV1alpha1Client) VirtualMachineRestores(namespace string) VirtualMachineRestoreInterface {
 	return newVirtualMachineRestores(c, namespace)
 }
@@ -48,6 +59,10 @@ func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotContents(namespace string
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*SnapshotV1alpha1Client, error) {
 	config := *c
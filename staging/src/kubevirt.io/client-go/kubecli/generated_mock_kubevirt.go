@@ -76,9 +76,11 @@ import (
 	v1alpha19 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/clone/v1alpha1"
 	v1alpha110 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
 	v1beta116 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
-	v1alpha111 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
-	v1alpha112 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
-	v1alpha113 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
+	v1alpha111 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	v1alpha113 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/nodecapabilities/v1alpha1"
+	v1alpha114 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
+	v1alpha115 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	versioned2 "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
 	versioned3 "kubevirt.io/client-go/generated/prometheus-operator/clientset/versioned"
 	version "kubevirt.io/client-go/version"
@@ -135,9 +137,9 @@ func (_mr *_MockKubevirtClientRecorder) ReplicaSet(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplicaSet", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha112.VirtualMachinePoolInterface {
+func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha114.VirtualMachinePoolInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachinePool", namespace)
-	ret0, _ := ret[0].(v1alpha112.VirtualMachinePoolInterface)
+	ret0, _ := ret[0].(v1alpha114.VirtualMachinePoolInterface)
 	return ret0
 }
 
@@ -145,6 +147,16 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachinePool(arg0 interface{}) *go
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachinePool", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineDisruptionBudget(namespace string) v1alpha114.VirtualMachineDisruptionBudgetInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineDisruptionBudget", namespace)
+	ret0, _ := ret[0].(v1alpha114.VirtualMachineDisruptionBudgetInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineDisruptionBudget(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineDisruptionBudget", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachine(namespace string) VirtualMachineInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachine", namespace)
 	ret0, _ := ret[0].(VirtualMachineInterface)
@@ -175,9 +187,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineInstancePreset(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstancePreset", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha113.VirtualMachineSnapshotInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha115.VirtualMachineSnapshotInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshot", namespace)
-	ret0, _ := ret[0].(v1alpha113.VirtualMachineSnapshotInterface)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineSnapshotInterface)
 	return ret0
 }
 
@@ -185,9 +197,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshot(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshot", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha113.VirtualMachineSnapshotContentInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha115.VirtualMachineSnapshotContentInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotContent", namespace)
-	ret0, _ := ret[0].(v1alpha113.VirtualMachineSnapshotContentInterface)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineSnapshotContentInterface)
 	return ret0
 }
 
@@ -195,9 +207,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotContent(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotContent", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha113.VirtualMachineRestoreInterface {
+func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha115.VirtualMachineRestoreInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineRestore", namespace)
-	ret0, _ := ret[0].(v1alpha113.VirtualMachineRestoreInterface)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineRestoreInterface)
 	return ret0
 }
 
@@ -205,6 +217,36 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1alpha115.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineGroupSnapshot(namespace string) v1alpha115.VirtualMachineGroupSnapshotInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineGroupSnapshot", namespace)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineGroupSnapshotInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineGroupSnapshot(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineGroupSnapshot", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineGroupRestore(namespace string) v1alpha115.VirtualMachineGroupRestoreInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineGroupRestore", namespace)
+	ret0, _ := ret[0].(v1alpha115.VirtualMachineGroupRestoreInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineGroupRestore(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineGroupRestore", arg0)
+}
+
 func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1alpha110.VirtualMachineExportInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
 	ret0, _ := ret[0].(v1alpha110.VirtualMachineExportInterface)
@@ -255,9 +297,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterPreference() *gomoc
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterPreference")
 }
 
-func (_m *MockKubevirtClient) MigrationPolicy() v1alpha111.MigrationPolicyInterface {
+func (_m *MockKubevirtClient) MigrationPolicy() v1alpha112.MigrationPolicyInterface {
 	ret := _m.ctrl.Call(_m, "MigrationPolicy")
-	ret0, _ := ret[0].(v1alpha111.MigrationPolicyInterface)
+	ret0, _ := ret[0].(v1alpha112.MigrationPolicyInterface)
 	return ret0
 }
 
@@ -265,6 +307,26 @@ func (_mr *_MockKubevirtClientRecorder) MigrationPolicy() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationPolicy")
 }
 
+func (_m *MockKubevirtClient) KSMPolicy() v1alpha111.KSMPolicyInterface {
+	ret := _m.ctrl.Call(_m, "KSMPolicy")
+	ret0, _ := ret[0].(v1alpha111.KSMPolicyInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) KSMPolicy() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "KSMPolicy")
+}
+
+func (_m *MockKubevirtClient) NodeCapabilities() v1alpha113.NodeCapabilitiesInterface {
+	ret := _m.ctrl.Call(_m, "NodeCapabilities")
+	ret0, _ := ret[0].(v1alpha113.NodeCapabilitiesInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) NodeCapabilities() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NodeCapabilities")
+}
+
 func (_m *MockKubevirtClient) ExpandSpec(namespace string) ExpandSpecInterface {
 	ret := _m.ctrl.Call(_m, "ExpandSpec", namespace)
 	ret0, _ := ret[0].(ExpandSpecInterface)
@@ -425,9 +487,9 @@ func (_mr *_MockKubevirtClientRecorder) DynamicClient() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DynamicClient")
 }
 
-func (_m *MockKubevirtClient) MigrationPolicyClient() *v1alpha111.MigrationsV1alpha1Client {
+func (_m *MockKubevirtClient) MigrationPolicyClient() *v1alpha112.MigrationsV1alpha1Client {
 	ret := _m.ctrl.Call(_m, "MigrationPolicyClient")
-	ret0, _ := ret[0].(*v1alpha111.MigrationsV1alpha1Client)
+	ret0, _ := ret[0].(*v1alpha112.MigrationsV1alpha1Client)
 	return ret0
 }
 
@@ -1236,6 +1298,38 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, execRequest *v120.VirtualMachineInstanceGuestExecRequest) (v120.VirtualMachineInstanceGuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", ctx, name, execRequest)
+	ret0, _ := ret[0].(v120.VirtualMachineInstanceGuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestExec(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestFileRead(ctx context.Context, name string, path string) (v120.VirtualMachineInstanceGuestFile, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", ctx, name, path)
+	ret0, _ := ret[0].(v120.VirtualMachineInstanceGuestFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestFileWrite(ctx context.Context, name string, file *v120.VirtualMachineInstanceGuestFile) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", ctx, name, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v120.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
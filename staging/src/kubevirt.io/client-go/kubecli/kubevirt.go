@@ -52,7 +52,9 @@ import (
 	generatedclient "kubevirt.io/client-go/generated/kubevirt/clientset/versioned"
 	vmexportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
 	instancetypev1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
+	ksmv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/ksm/v1alpha1"
 	migrationsv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	nodecapabilitiesv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/nodecapabilities/v1alpha1"
 	poolv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	vmsnapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	networkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
@@ -65,18 +67,24 @@ type KubevirtClient interface {
 	VirtualMachineInstanceMigration(namespace string) VirtualMachineInstanceMigrationInterface
 	ReplicaSet(namespace string) ReplicaSetInterface
 	VirtualMachinePool(namespace string) poolv1.VirtualMachinePoolInterface
+	VirtualMachineDisruptionBudget(namespace string) poolv1.VirtualMachineDisruptionBudgetInterface
 	VirtualMachine(namespace string) VirtualMachineInterface
 	KubeVirt(namespace string) KubeVirtInterface
 	VirtualMachineInstancePreset(namespace string) VirtualMachineInstancePresetInterface
 	VirtualMachineSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.VirtualMachineRestoreInterface
+	VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineGroupSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineGroupSnapshotInterface
+	VirtualMachineGroupRestore(namespace string) vmsnapshotv1alpha1.VirtualMachineGroupRestoreInterface
 	VirtualMachineExport(namespace string) vmexportv1alpha1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
 	VirtualMachineClusterInstancetype() instancetypev1beta1.VirtualMachineClusterInstancetypeInterface
 	VirtualMachinePreference(namespace string) instancetypev1beta1.VirtualMachinePreferenceInterface
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
+	KSMPolicy() ksmv1.KSMPolicyInterface
+	NodeCapabilities() nodecapabilitiesv1.NodeCapabilitiesInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface
@@ -162,6 +170,10 @@ func (k kubevirt) VirtualMachinePool(namespace string) poolv1.VirtualMachinePool
 	return k.generatedKubeVirtClient.PoolV1alpha1().VirtualMachinePools(namespace)
 }
 
+func (k kubevirt) VirtualMachineDisruptionBudget(namespace string) poolv1.VirtualMachineDisruptionBudgetInterface {
+	return k.generatedKubeVirtClient.PoolV1alpha1().VirtualMachineDisruptionBudgets(namespace)
+}
+
 func (k kubevirt) VirtualMachineSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotInterface {
 	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(namespace)
 }
@@ -174,6 +186,18 @@ func (k kubevirt) VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.Vir
 	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirt) VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirt) VirtualMachineGroupSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineGroupSnapshotInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineGroupSnapshots(namespace)
+}
+
+func (k kubevirt) VirtualMachineGroupRestore(namespace string) vmsnapshotv1alpha1.VirtualMachineGroupRestoreInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineGroupRestores(namespace)
+}
+
 func (k kubevirt) VirtualMachineExport(namespace string) vmexportv1alpha1.VirtualMachineExportInterface {
 	return k.generatedKubeVirtClient.ExportV1alpha1().VirtualMachineExports(namespace)
 }
@@ -210,6 +234,14 @@ func (k kubevirt) MigrationPolicyClient() *migrationsv1.MigrationsV1alpha1Client
 	return k.migrationsClient
 }
 
+func (k kubevirt) KSMPolicy() ksmv1.KSMPolicyInterface {
+	return k.generatedKubeVirtClient.KsmV1alpha1().KSMPolicies()
+}
+
+func (k kubevirt) NodeCapabilities() nodecapabilitiesv1.NodeCapabilitiesInterface {
+	return k.generatedKubeVirtClient.NodecapabilitiesV1alpha1().NodeCapabilities()
+}
+
 func (k kubevirt) VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface {
 	return k.generatedKubeVirtClient.CloneV1alpha1().VirtualMachineClones(namespace)
 }
@@ -249,6 +281,9 @@ type VirtualMachineInstanceInterface interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestExec(ctx context.Context, name string, execRequest *v1.VirtualMachineInstanceGuestExecRequest) (v1.VirtualMachineInstanceGuestExecResult, error)
+	GuestFileRead(ctx context.Context, name string, path string) (v1.VirtualMachineInstanceGuestFile, error)
+	GuestFileWrite(ctx context.Context, name string, file *v1.VirtualMachineInstanceGuestFile) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
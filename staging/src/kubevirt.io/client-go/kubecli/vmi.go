@@ -454,6 +454,42 @@ func (v *vmis) FilesystemList(ctx context.Context, name string) (v1.VirtualMachi
 	return fsList, err
 }
 
+func (v *vmis) GuestExec(ctx context.Context, name string, execRequest *v1.VirtualMachineInstanceGuestExecRequest) (v1.VirtualMachineInstanceGuestExecResult, error) {
+	result := v1.VirtualMachineInstanceGuestExecResult{}
+	body, err := json.Marshal(execRequest)
+	if err != nil {
+		return result, fmt.Errorf("Cannot Marshal to json: %s", err)
+	}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestexec")
+	// the result does not implement runtime.Object, see the GuestOsInfo workaround
+	rawResult, err := v.restClient.Put().AbsPath(uri).Body(body).Do(ctx).Raw()
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(rawResult, &result)
+	return result, err
+}
+
+func (v *vmis) GuestFileRead(ctx context.Context, name string, path string) (v1.VirtualMachineInstanceGuestFile, error) {
+	file := v1.VirtualMachineInstanceGuestFile{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestfile")
+	rawFile, err := v.restClient.Get().AbsPath(uri).Param("path", path).Do(ctx).Raw()
+	if err != nil {
+		return file, err
+	}
+	err = json.Unmarshal(rawFile, &file)
+	return file, err
+}
+
+func (v *vmis) GuestFileWrite(ctx context.Context, name string, file *v1.VirtualMachineInstanceGuestFile) error {
+	body, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("Cannot Marshal to json: %s", err)
+	}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestfile")
+	return v.restClient.Put().AbsPath(uri).Body(body).Do(ctx).Error()
+}
+
 func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if screenshotOptions.MoveCursor == true {
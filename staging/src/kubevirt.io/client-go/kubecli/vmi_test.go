@@ -431,6 +431,78 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should execute a command inside the guest via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		execRequest := &v1.VirtualMachineInstanceGuestExecRequest{
+			Command: "/usr/bin/echo",
+			Args:    []string{"hello"},
+		}
+		execResult := v1.VirtualMachineInstanceGuestExecResult{
+			ExitCode: 0,
+			StdOut:   "hello\n",
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "guestexec")),
+			ghttp.VerifyBody([]byte(`{"command":"/usr/bin/echo","args":["hello"]}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, execResult),
+		))
+		result, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).GuestExec(context.Background(), "testvm", execRequest)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(execResult))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should read a guest file via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		file := v1.VirtualMachineInstanceGuestFile{
+			Path:    "/etc/hostname",
+			Content: []byte("testvm\n"),
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "guestfile"), "path=%2Fetc%2Fhostname"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, file),
+		))
+		fetchedFile, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).GuestFileRead(context.Background(), "testvm", "/etc/hostname")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedFile).To(Equal(file))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should write a guest file via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		file := &v1.VirtualMachineInstanceGuestFile{
+			Path:    "/tmp/test",
+			Content: []byte("test"),
+		}
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, subVMIPath, "guestfile")),
+			ghttp.VerifyBody([]byte(`{"path":"/tmp/test","content":"dGVzdA=="}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).GuestFileWrite(context.Background(), "testvm", file)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	It("should fetch SEV platform info via subresource", func() {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -1248,11 +1248,11 @@ var _ = SIGDescribe("Export", func() {
 		Expect(vmExport.Status.Links).ToNot(BeNil())
 		Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 		Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+		var formats []exportv1.VirtualMachineExportVolumeFormat
 		for _, volume := range vmExport.Status.Links.Internal.Volumes {
-			Expect(volume.Formats).To(HaveLen(2))
-			Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
+			formats = append(formats, volume.Formats...)
 		}
+		Expect(formats).To(ConsistOf(expectedVolumeFormats))
 	}
 
 	verifyMultiKubevirtInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName1, volumeName2 string) {
@@ -1265,6 +1265,14 @@ var _ = SIGDescribe("Export", func() {
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName1),
 			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName1),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2Compressed,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk-compressed.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName1),
+			},
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtRaw,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
@@ -1272,6 +1280,14 @@ var _ = SIGDescribe("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2Compressed,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk-compressed.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName2),
 			})
 	}
 
@@ -1284,6 +1300,14 @@ var _ = SIGDescribe("Export", func() {
 			exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.KubeVirtGz,
 				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
+			},
+			exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2Compressed,
+				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk-compressed.qcow2", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
 			})
 	}
 
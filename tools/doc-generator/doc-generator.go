@@ -184,6 +184,26 @@ func getMetricsNotIncludeInEndpointByDefault() metricList {
 			description: "Indication for a virt-operator that is ready to take the lead.",
 			mType:       "Gauge",
 		},
+		{
+			name:        "kubevirt_ksm_running",
+			description: "Indication for KSM merging pages on the node.",
+			mType:       "Gauge",
+		},
+		{
+			name:        "kubevirt_ksm_pages_shared",
+			description: "The number of shared pages which are in use on the node.",
+			mType:       "Gauge",
+		},
+		{
+			name:        "kubevirt_ksm_pages_sharing",
+			description: "The number of additional sites which share the shared pages on the node.",
+			mType:       "Gauge",
+		},
+		{
+			name:        "kubevirt_ksm_saved_memory_bytes",
+			description: "The amount of memory saved by KSM on the node.",
+			mType:       "Gauge",
+		},
 	}
 
 	for _, rule := range components.GetRecordingRules("") {